@@ -6,22 +6,39 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"io/fs"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
 
 	"base_app/internal/adapter/auth/inmemory"
+	"base_app/internal/adapter/blobstore/local"
+	"base_app/internal/adapter/cache"
+	"base_app/internal/adapter/eventsink/ndjson"
+	"base_app/internal/adapter/eventsink/subscriptions"
+	"base_app/internal/adapter/eventsink/webhook"
+	"base_app/internal/adapter/idempotency"
+	imagelocal "base_app/internal/adapter/imagestore/local"
+	"base_app/internal/adapter/repository/memory"
 	"base_app/internal/adapter/repository/postgresql"
+	"base_app/internal/adapter/webhooksender"
 	"base_app/internal/config"
+	"base_app/internal/entity"
 	apiHandler "base_app/internal/handler/http"
 	v1 "base_app/internal/handler/http/v1"
 	"base_app/internal/service"
 	"base_app/internal/usecase"
+	"base_app/internal/worker"
+	"base_app/pkg/envelope"
 	"base_app/pkg/logger"
+	"base_app/pkg/metrics"
 
 	"github.com/alexedwards/scs/redisstore"
 	"github.com/alexedwards/scs/v2"
@@ -33,6 +50,8 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/gomodule/redigo/redis"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"golang.org/x/text/language"
 )
 
 //go:embed web
@@ -45,11 +64,17 @@ const (
 var (
 	mode       string
 	configPath string
+	importFile string
+	dryRun     bool
+	prune      bool
 )
 
 func init() {
-	flag.StringVar(&mode, "mode", "Start", "Application run mode. Use 'Prepare' to run migrations.")
+	flag.StringVar(&mode, "mode", "Start", "Application run mode. Use 'Prepare' to run migrations, 'RotateKeys' to re-encrypt data values and webhook secrets with the active master key, 'ImportCatalog' to import catalog items from -file.")
 	flag.StringVar(&configPath, "config", "configs/config.yaml", "Path to the configuration file.")
+	flag.StringVar(&importFile, "file", "", "Catalog import file for 'ImportCatalog' mode, a .csv or .json file.")
+	flag.BoolVar(&dryRun, "dry-run", false, "Validate the catalog import and report what would change without writing anything.")
+	flag.BoolVar(&prune, "prune", false, "Delete catalog items whose SKU is not in the import file.")
 }
 
 func main() {
@@ -75,6 +100,10 @@ func main() {
 		runMigrations(cfg.Postgres, log)
 	case "Start":
 		runApp(cfg, log)
+	case "RotateKeys":
+		runRotateKeys(cfg, log)
+	case "ImportCatalog":
+		runImportCatalog(cfg, log)
 	default:
 		log.Error("invalid mode specified", slog.String("mode", mode))
 		os.Exit(1)
@@ -85,6 +114,8 @@ func runApp(cfg *config.Config, log *slog.Logger) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	appMetrics := metrics.New(appName)
+
 	if cfg.Sentry.Enabled {
 		if err := sentry.Init(sentry.ClientOptions{
 			Dsn:              cfg.Sentry.Dsn,
@@ -104,49 +135,260 @@ func runApp(cfg *config.Config, log *slog.Logger) {
 	sessionManager.Cookie.Secure = false
 	sessionManager.Cookie.SameSite = http.SameSiteLaxMode
 
+	var redisPool *redis.Pool
 	if cfg.Redis.Enabled {
-		redisPool := &redis.Pool{
-			MaxIdle: 10,
-			Dial: func() (redis.Conn, error) {
-				return redis.Dial("tcp", cfg.Redis.Host+":"+cfg.Redis.Port)
-			},
-		}
+		redisPool = newRedisPool(cfg.Redis)
 		sessionManager.Store = redisstore.New(redisPool)
 		log.Info("redis is configured as the session store")
 	} else {
 		log.Info("redis is disabled, using in-memory session store")
 	}
 
-	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
-		cfg.Postgres.User, cfg.Postgres.Password, cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.DBName, cfg.Postgres.SSLMode)
-	pgClient, err := pgxpool.New(ctx, dsn)
+	store, closeStore, err := openStorage(ctx, cfg, log)
 	if err != nil {
-		log.Error("failed to connect to postgres", slog.String("error", err.Error()))
+		log.Error("failed to open storage", slog.String("driver", cfg.Storage.Driver), slog.String("error", err.Error()))
 		os.Exit(1)
 	}
-	defer pgClient.Close()
-	log.Info("successfully connected to postgres")
+	defer closeStore()
+	repo := store.repo
 
-	var authService usecase.AuthService
-	switch cfg.Auth.Provider {
-	case "inmemory":
-		authService = inmemory.New(log)
-		log.Info("using in-memory auth provider")
-	case "postgres":
-		repo := postgresql.NewRepo(pgClient, log)
-		authService = service.NewAuthService(repo, log)
-		log.Info("using postgres auth provider")
+	authService := store.auth
+
+	var imageStore usecase.ImageStore
+	switch cfg.Catalog.Images.Storage {
+	case "local":
+		imageStore, err = imagelocal.New(cfg.Catalog.Images.Root, log)
+		if err != nil {
+			log.Error("failed to open catalog image storage", slog.String("root", cfg.Catalog.Images.Root), slog.String("error", err.Error()))
+			os.Exit(1)
+		}
 	default:
-		log.Error("invalid auth provider specified", "provider", cfg.Auth.Provider)
+		log.Error("invalid catalog image storage specified", "storage", cfg.Catalog.Images.Storage)
+		os.Exit(1)
+	}
+	imagePolicy, err := catalogImagePolicy(cfg.Catalog.Images)
+	if err != nil {
+		log.Error("invalid catalog image settings", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
 
-	repo := postgresql.NewRepo(pgClient, log)
-	dataService := service.NewDataService(repo, log)
-	catalogService := service.NewCatalogService(repo, log)
+	txManager, dataFeed := store.txManager, store.dataFeed
+	dataService := service.NewDataService(repo, dataFeed, log)
+	var catalogService usecase.CatalogService = service.NewCatalogService(repo, log)
+	if cfg.Catalog.Cache.Enabled && redisPool != nil {
+		catalogService = cache.NewCatalogCache(catalogService, redisPool, cache.CatalogTTL{
+			Item: cfg.Catalog.Cache.ItemTTL,
+			List: cfg.Catalog.Cache.ListTTL,
+		}, log)
+		log.Info("redis is configured as the catalog cache")
+	}
 	authUsecase := usecase.NewAuthUsecase(authService, log)
-	dataUsecase := usecase.NewDataUsecase(dataService, log)
-	catalogUsecase := usecase.NewCatalogUsecase(catalogService, log)
+	dataUsecase := usecase.NewDataUsecase(dataService, txManager, entity.DataQuota{
+		MaxKeys:       cfg.Data.Quota.MaxKeys,
+		MaxValueBytes: cfg.Data.Quota.MaxValueBytes,
+		MaxTotalBytes: cfg.Data.Quota.MaxTotalBytes,
+	}, log)
+	defaultLocale, err := language.Parse(cfg.Catalog.DefaultLocale)
+	if err != nil || defaultLocale == language.Und {
+		log.Error("invalid catalog default locale", slog.String("locale", cfg.Catalog.DefaultLocale))
+		os.Exit(1)
+	}
+	if cfg.Catalog.RecentlyViewedLimit < 1 {
+		log.Error("invalid catalog recently viewed limit", slog.Int("limit", cfg.Catalog.RecentlyViewedLimit))
+		os.Exit(1)
+	}
+	reservationPolicy := entity.CatalogReservationPolicy{
+		DefaultTTL: cfg.Catalog.Reservations.TTL,
+		MaxTTL:     cfg.Catalog.Reservations.MaxTTL,
+	}
+	if reservationPolicy.DefaultTTL <= 0 || reservationPolicy.DefaultTTL > reservationPolicy.MaxTTL {
+		log.Error("invalid catalog reservation ttl", slog.Duration("ttl", reservationPolicy.DefaultTTL),
+			slog.Duration("max_ttl", reservationPolicy.MaxTTL))
+		os.Exit(1)
+	}
+	if cfg.Catalog.Reviews.MaxBodyLength < 1 {
+		log.Error("invalid catalog review max body length", slog.Int("max_body_length", cfg.Catalog.Reviews.MaxBodyLength))
+		os.Exit(1)
+	}
+	catalogUsecase := usecase.NewCatalogUsecase(catalogService, imageStore, entity.CatalogSearchSettings{
+		Language:       cfg.Catalog.Search.Language,
+		FuzzyThreshold: cfg.Catalog.Search.FuzzyThreshold,
+	}, imagePolicy, defaultLocale.String(), cfg.Catalog.RecentlyViewedLimit, reservationPolicy, entity.CatalogReviewPolicy{
+		RequireApproval: cfg.Catalog.Reviews.RequireApproval,
+		MaxBodyLength:   cfg.Catalog.Reviews.MaxBodyLength,
+	}, log)
+	if err := catalogUsecase.SyncSearchLanguage(ctx); err != nil {
+		// Catalog writes would fail with a language PostgreSQL does not know.
+		log.Error("failed to apply catalog search language", slog.String("language", cfg.Catalog.Search.Language),
+			slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	var blobStore usecase.BlobStore
+	switch cfg.Attachments.Storage {
+	case "local":
+		blobStore, err = local.New(cfg.Attachments.Root, log)
+		if err != nil {
+			log.Error("failed to open attachment storage", slog.String("root", cfg.Attachments.Root), slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+	default:
+		log.Error("invalid attachment storage specified", "storage", cfg.Attachments.Storage)
+		os.Exit(1)
+	}
+	attachmentService := service.NewAttachmentService(repo, blobStore, log)
+	attachmentUsecase := usecase.NewAttachmentUsecase(attachmentService, entity.AttachmentPolicy{
+		MaxSize:      cfg.Attachments.MaxSize,
+		AllowedTypes: cfg.Attachments.AllowedTypes,
+	}, log)
+
+	webhookPolicy := entity.WebhookPolicy{
+		Retry: entity.RetryPolicy{
+			InitialBackoff: cfg.Webhooks.Retry.InitialBackoff,
+			MaxBackoff:     cfg.Webhooks.Retry.MaxBackoff,
+		},
+		MaxAttempts:  cfg.Webhooks.MaxAttempts,
+		DisableAfter: cfg.Webhooks.DisableAfter,
+		Timeout:      cfg.Webhooks.Timeout,
+	}
+	if webhookPolicy.MaxAttempts < 1 || webhookPolicy.DisableAfter < 1 {
+		log.Error("invalid webhook attempt limits", slog.Int("max_attempts", webhookPolicy.MaxAttempts),
+			slog.Int("disable_after", webhookPolicy.DisableAfter))
+		os.Exit(1)
+	}
+	webhookService := service.NewWebhookService(repo, webhooksender.New(cfg.Webhooks.Timeout), log)
+	webhookUsecase := usecase.NewWebhookUsecase(webhookService, txManager, webhookPolicy, log)
+
+	feedDone := make(chan struct{})
+	go func() {
+		defer close(feedDone)
+		dataFeed.Run(ctx)
+	}()
+
+	reaperDone := make(chan struct{})
+	if cfg.Data.Reaper.Enabled {
+		if cfg.Data.Reaper.Interval <= 0 || cfg.Data.Reaper.BatchSize < 1 {
+			log.Error("invalid data reaper settings", slog.Duration("interval", cfg.Data.Reaper.Interval),
+				slog.Int("batch_size", int(cfg.Data.Reaper.BatchSize)))
+			os.Exit(1)
+		}
+		reaper := worker.NewDataReaper(dataUsecase, cfg.Data.Reaper.Interval, cfg.Data.Reaper.BatchSize, appMetrics.DataPurgedTotal, log)
+		go func() {
+			defer close(reaperDone)
+			reaper.Run(ctx)
+		}()
+	} else {
+		close(reaperDone)
+		log.Info("data reaper is disabled")
+	}
+
+	collectorDone := make(chan struct{})
+	if cfg.Attachments.GC.Enabled {
+		if cfg.Attachments.GC.Interval <= 0 {
+			log.Error("invalid attachment gc interval", slog.Duration("interval", cfg.Attachments.GC.Interval))
+			os.Exit(1)
+		}
+		collector := worker.NewBlobCollector(attachmentUsecase, cfg.Attachments.GC.Interval, cfg.Attachments.GC.Grace, log)
+		go func() {
+			defer close(collectorDone)
+			collector.Run(ctx)
+		}()
+	} else {
+		close(collectorDone)
+		log.Info("blob collector is disabled")
+	}
+
+	imageCollectorDone := make(chan struct{})
+	if cfg.Catalog.Images.GC.Enabled {
+		if cfg.Catalog.Images.GC.Interval <= 0 {
+			log.Error("invalid catalog image gc interval", slog.Duration("interval", cfg.Catalog.Images.GC.Interval))
+			os.Exit(1)
+		}
+		collector := worker.NewCatalogImageCollector(catalogUsecase, cfg.Catalog.Images.GC.Interval, cfg.Catalog.Images.GC.Grace, log)
+		go func() {
+			defer close(imageCollectorDone)
+			collector.Run(ctx)
+		}()
+	} else {
+		close(imageCollectorDone)
+		log.Info("catalog image collector is disabled")
+	}
+
+	purgerDone := make(chan struct{})
+	if cfg.SoftDelete.Purge.Enabled {
+		if cfg.SoftDelete.Retention <= 0 || cfg.SoftDelete.Purge.Interval <= 0 || cfg.SoftDelete.Purge.BatchSize < 1 {
+			log.Error("invalid soft delete settings", slog.Duration("retention", cfg.SoftDelete.Retention),
+				slog.Duration("interval", cfg.SoftDelete.Purge.Interval), slog.Int("batch_size", int(cfg.SoftDelete.Purge.BatchSize)))
+			os.Exit(1)
+		}
+		purger := worker.NewDeletedPurger(dataUsecase, catalogUsecase, cfg.SoftDelete.Purge.Interval, cfg.SoftDelete.Retention,
+			cfg.SoftDelete.Purge.BatchSize, appMetrics.DeletedPurgedTotal, log)
+		go func() {
+			defer close(purgerDone)
+			purger.Run(ctx)
+		}()
+	} else {
+		close(purgerDone)
+		log.Info("deleted purger is disabled")
+	}
+
+	outboxDone := make(chan struct{})
+	if cfg.Outbox.Enabled {
+		if cfg.Outbox.Interval <= 0 || cfg.Outbox.PurgeInterval <= 0 || cfg.Outbox.BatchSize < 1 {
+			log.Error("invalid outbox settings", slog.Duration("interval", cfg.Outbox.Interval),
+				slog.Duration("purge_interval", cfg.Outbox.PurgeInterval), slog.Int("batch_size", int(cfg.Outbox.BatchSize)))
+			os.Exit(1)
+		}
+		sinks, closeSinks, err := newEventSinks(cfg.Outbox)
+		if err != nil {
+			log.Error("failed to create outbox event sinks", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		defer closeSinks()
+		if cfg.Webhooks.Enabled {
+			sinks = append(sinks, subscriptions.New(webhookUsecase))
+		}
+		if len(sinks) == 0 {
+			log.Info("outbox has no sinks, events are marked published without being delivered")
+		}
+		outboxService := service.NewOutboxService(repo, sinks, log)
+		outboxUsecase := usecase.NewOutboxUsecase(outboxService, entity.RetryPolicy{
+			InitialBackoff: cfg.Outbox.Retry.InitialBackoff,
+			MaxBackoff:     cfg.Outbox.Retry.MaxBackoff,
+		}, log)
+		relay := worker.NewOutboxRelay(outboxUsecase, cfg.Outbox.Interval, cfg.Outbox.PurgeInterval, cfg.Outbox.Retention,
+			cfg.Outbox.BatchSize, appMetrics.OutboxEventsTotal, log)
+		go func() {
+			defer close(outboxDone)
+			relay.Run(ctx)
+		}()
+	} else {
+		close(outboxDone)
+		log.Info("outbox relay is disabled, events stay pending")
+	}
+
+	webhookDone := make(chan struct{})
+	if cfg.Webhooks.Enabled {
+		if cfg.Webhooks.Interval <= 0 || cfg.Webhooks.PurgeInterval <= 0 || cfg.Webhooks.Timeout <= 0 ||
+			cfg.Webhooks.BatchSize < 1 {
+			log.Error("invalid webhook settings", slog.Duration("interval", cfg.Webhooks.Interval),
+				slog.Duration("purge_interval", cfg.Webhooks.PurgeInterval), slog.Duration("timeout", cfg.Webhooks.Timeout),
+				slog.Int("batch_size", int(cfg.Webhooks.BatchSize)))
+			os.Exit(1)
+		}
+		if !cfg.Outbox.Enabled {
+			log.Warn("webhooks are enabled but the outbox relay is not, no events will be delivered")
+		}
+		dispatcher := worker.NewWebhookDispatcher(webhookUsecase, cfg.Webhooks.Interval, cfg.Webhooks.PurgeInterval,
+			cfg.Webhooks.Retention, cfg.Webhooks.BatchSize, appMetrics.WebhookAttemptsTotal, log)
+		go func() {
+			defer close(webhookDone)
+			dispatcher.Run(ctx)
+		}()
+	} else {
+		close(webhookDone)
+		log.Info("webhook dispatcher is disabled")
+	}
 
 	contentFS, err := fs.Sub(embeddedFiles, "web")
 	if err != nil {
@@ -154,7 +396,8 @@ func runApp(cfg *config.Config, log *slog.Logger) {
 		os.Exit(1)
 	}
 
-	handler := apiHandler.NewHandler(authUsecase, dataUsecase, catalogUsecase, sessionManager, contentFS)
+	handler := apiHandler.NewHandler(authUsecase, dataUsecase, catalogUsecase, attachmentUsecase, webhookUsecase, sessionManager, contentFS,
+		cfg.Attachments.TransferTimeout)
 
 	ogenServer, err := v1.NewServer(handler, handler)
 	if err != nil {
@@ -162,13 +405,40 @@ func runApp(cfg *config.Config, log *slog.Logger) {
 		os.Exit(1)
 	}
 
+	var apiServer http.Handler = ogenServer
+	if cfg.Idempotency.Enabled {
+		var idempotencyStore usecase.IdempotencyStore
+		if redisPool != nil {
+			idempotencyStore = idempotency.NewRedisStore(redisPool, log)
+			log.Info("redis is configured as the idempotency store")
+		} else {
+			idempotencyStore = idempotency.NewInMemoryStore()
+			log.Info("redis is disabled, using in-memory idempotency store")
+		}
+		apiServer = apiHandler.Idempotency(idempotencyStore, sessionManager, cfg.Idempotency, log)(ogenServer)
+	}
+
 	router := chi.NewRouter()
 	router.Use(middleware.RequestID)
 	router.Use(middleware.RealIP)
 	router.Use(middleware.Recoverer)
 	router.Use(sessionManager.LoadAndSave)
 
-	router.Mount("/api/v1", ogenServer)
+	// Served outside ogen: SSE needs flushing and lifts the server WriteTimeout per request.
+	router.Get("/api/v1/data/watch", handler.WatchData)
+	// Served outside ogen: attachments are streamed and replace the server timeouts per request.
+	router.Post("/api/v1/data/attachments", handler.UploadAttachment)
+	router.Get("/api/v1/data/attachments/{id}/content", handler.DownloadAttachment)
+	// Served outside ogen for the same reasons; image files are cached by clients for good.
+	router.Post("/api/v1/catalog/{id}/images", handler.UploadCatalogImage)
+	router.Get("/api/v1/catalog/images/{image_id}", handler.DownloadCatalogImage)
+	router.Get("/api/v1/catalog/images/{image_id}/thumbnails/{size}", handler.DownloadCatalogThumbnail)
+	// Imports and exports stay ogen operations but get the transfer timeout like attachments.
+	router.With(handler.TransferDeadlines).Post("/api/v1/data:import", apiServer.ServeHTTP)
+	router.With(handler.TransferDeadlines).Get("/api/v1/data:export", apiServer.ServeHTTP)
+	router.Mount("/api/v1", apiServer)
+	// The only v2 operation; its path lives in the same contract, so ogen routes it as well.
+	router.Get("/api/v2/catalog", apiServer.ServeHTTP)
 	router.Get("/*", handler.ServeHTTP)
 
 	server := &http.Server{
@@ -179,6 +449,22 @@ func runApp(cfg *config.Config, log *slog.Logger) {
 		IdleTimeout:  cfg.HTTP.IdleTimeout,
 	}
 
+	// Long-lived SSE streams would otherwise hold Shutdown until its timeout.
+	server.RegisterOnShutdown(dataFeed.DisconnectAll)
+
+	var metricsServer *http.Server
+	if cfg.Metrics.Enabled {
+		metricsRouter := chi.NewRouter()
+		metricsRouter.Handle("/metrics", promhttp.Handler())
+		metricsServer = &http.Server{
+			Addr:         cfg.Metrics.Host + ":" + cfg.Metrics.Port,
+			Handler:      metricsRouter,
+			ReadTimeout:  cfg.HTTP.ReadTimeout,
+			WriteTimeout: cfg.HTTP.WriteTimeout,
+			IdleTimeout:  cfg.HTTP.IdleTimeout,
+		}
+	}
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 
@@ -190,6 +476,15 @@ func runApp(cfg *config.Config, log *slog.Logger) {
 			os.Exit(1)
 		}
 	}()
+	if metricsServer != nil {
+		go func() {
+			log.Info("metrics server starting", slog.String("addr", metricsServer.Addr))
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Error("metrics server error", slog.String("error", err.Error()))
+				os.Exit(1)
+			}
+		}()
+	}
 
 	<-stop
 
@@ -202,6 +497,20 @@ func runApp(cfg *config.Config, log *slog.Logger) {
 	} else {
 		log.Info("server gracefully stopped")
 	}
+	if metricsServer != nil {
+		if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+			log.Error("metrics server shutdown failed", slog.String("error", err.Error()))
+		}
+	}
+
+	cancel()
+	<-reaperDone
+	<-collectorDone
+	<-imageCollectorDone
+	<-purgerDone
+	<-outboxDone
+	<-webhookDone
+	<-feedDone
 }
 
 func runMigrations(cfg config.PostgresConfig, log *slog.Logger) {
@@ -219,3 +528,320 @@ func runMigrations(cfg config.PostgresConfig, log *slog.Logger) {
 	}
 	log.Info("migrations applied successfully")
 }
+
+func runRotateKeys(cfg *config.Config, log *slog.Logger) {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	keyring, err := loadKeyring(cfg.Data.Encryption)
+	if err != nil {
+		log.Error("failed to load data master keys", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	if keyring == nil {
+		log.Error("data encryption is disabled, nothing to rotate")
+		os.Exit(1)
+	}
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
+		cfg.Postgres.User, cfg.Postgres.Password, cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.DBName, cfg.Postgres.SSLMode)
+	pgClient, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		log.Error("failed to connect to postgres", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer pgClient.Close()
+
+	log.Info("rotating data keys", slog.String("active_key_id", keyring.ActiveKeyID()))
+	repo := postgresql.NewRepo(pgClient, keyring, log)
+	n, err := repo.RotateDataKeys(ctx, cfg.Data.Encryption.RotateBatchSize)
+	if err != nil {
+		log.Error("failed to rotate data keys", slog.Int64("rotated", n), slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	log.Info("data keys rotated successfully", slog.Int64("rotated", n))
+
+	n, err = repo.RotateWebhookSecrets(ctx)
+	if err != nil {
+		log.Error("failed to rotate webhook secrets", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	log.Info("webhook secrets rotated successfully", slog.Int64("rotated", n))
+}
+
+func runImportCatalog(cfg *config.Config, log *slog.Logger) {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	var format entity.DataFormat
+	switch strings.ToLower(filepath.Ext(importFile)) {
+	case ".csv":
+		format = entity.DataFormatCSV
+	case ".json":
+		format = entity.DataFormatJSON
+	default:
+		log.Error("catalog import file must be a .csv or .json file", slog.String("file", importFile))
+		os.Exit(1)
+	}
+	f, err := os.Open(importFile)
+	if err != nil {
+		log.Error("failed to open catalog import file", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
+		cfg.Postgres.User, cfg.Postgres.Password, cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.DBName, cfg.Postgres.SSLMode)
+	pgClient, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		log.Error("failed to connect to postgres", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer pgClient.Close()
+
+	// The keyring and the image store are only needed by data and image operations, which an
+	// import does not use.
+	repo := postgresql.NewRepo(pgClient, nil, log)
+	var catalogService usecase.CatalogService = service.NewCatalogService(repo, log)
+	if cfg.Catalog.Cache.Enabled && cfg.Redis.Enabled {
+		// Only used to invalidate the cache of running instances after the import.
+		redisPool := newRedisPool(cfg.Redis)
+		defer redisPool.Close()
+		catalogService = cache.NewCatalogCache(catalogService, redisPool, cache.CatalogTTL{
+			Item: cfg.Catalog.Cache.ItemTTL,
+			List: cfg.Catalog.Cache.ListTTL,
+		}, log)
+	}
+	catalogUsecase := usecase.NewCatalogUsecase(catalogService, nil, entity.CatalogSearchSettings{
+		Language:       cfg.Catalog.Search.Language,
+		FuzzyThreshold: cfg.Catalog.Search.FuzzyThreshold,
+	}, entity.CatalogImagePolicy{}, cfg.Catalog.DefaultLocale, cfg.Catalog.RecentlyViewedLimit, entity.CatalogReservationPolicy{}, entity.CatalogReviewPolicy{}, log)
+
+	report, err := catalogUsecase.ImportCatalogItems(ctx, f, entity.CatalogImportOptions{
+		Format: format,
+		DryRun: dryRun,
+		Prune:  prune,
+	})
+	if err != nil {
+		log.Error("failed to import catalog", slog.String("file", importFile), slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	for _, e := range report.Errors {
+		log.Error("invalid catalog import row", slog.Int("line", e.Line), slog.String("sku", e.Key), slog.String("error", e.Message))
+	}
+	if report.Failed > 0 {
+		log.Error("catalog import rejected", slog.Int("total", report.Total), slog.Int("failed", report.Failed))
+		os.Exit(1)
+	}
+	log.Info("catalog imported successfully", slog.Bool("dry_run", report.DryRun), slog.Bool("committed", report.Committed),
+		slog.Int("created", report.Created), slog.Int("updated", report.Updated), slog.Int("deleted", report.Deleted))
+}
+
+// storage is what the configured storage driver provides to the application.
+type storage struct {
+	repo interface {
+		usecase.UserRepo
+		usecase.DataRepo
+		usecase.AttachmentRepo
+		usecase.CatalogRepo
+		usecase.OutboxRepo
+		usecase.WebhookRepo
+	}
+	auth      usecase.AuthService
+	txManager usecase.TxManager
+	dataFeed  interface {
+		usecase.DataChangeFeed
+		Run(ctx context.Context)
+		DisconnectAll()
+	}
+}
+
+// openStorage sets up the repositories of cfg.Storage.Driver and the accounts of
+// cfg.Auth.Provider. The returned function releases their connections.
+func openStorage(ctx context.Context, cfg *config.Config, log *slog.Logger) (*storage, func(), error) {
+	switch cfg.Auth.Provider {
+	case "inmemory", "postgres":
+	default:
+		return nil, nil, fmt.Errorf("invalid auth provider %q", cfg.Auth.Provider)
+	}
+
+	switch cfg.Storage.Driver {
+	case "postgres":
+		keyring, err := loadKeyring(cfg.Data.Encryption)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load data master keys: %w", err)
+		}
+		if keyring != nil {
+			log.Info("data encryption is enabled", slog.String("active_key_id", keyring.ActiveKeyID()))
+		}
+
+		dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
+			cfg.Postgres.User, cfg.Postgres.Password, cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.DBName, cfg.Postgres.SSLMode)
+		pgClient, err := pgxpool.New(ctx, dsn)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to connect to postgres: %w", err)
+		}
+		txManager, err := postgresql.NewTxManager(pgClient, cfg.Postgres.Tx.Isolation, cfg.Postgres.Tx.MaxAttempts, entity.RetryPolicy{
+			InitialBackoff: cfg.Postgres.Tx.InitialBackoff,
+			MaxBackoff:     cfg.Postgres.Tx.MaxBackoff,
+		}, log)
+		if err != nil {
+			pgClient.Close()
+			return nil, nil, fmt.Errorf("invalid transaction settings: %w", err)
+		}
+		log.Info("successfully connected to postgres")
+		repo := postgresql.NewRepo(pgClient, keyring, log)
+		var auth usecase.AuthService = service.NewAuthService(repo, log)
+		if cfg.Auth.Provider == "inmemory" {
+			provider, err := newInmemoryAuth(cfg.Auth, log)
+			if err != nil {
+				pgClient.Close()
+				return nil, nil, err
+			}
+			auth = provider
+		}
+		log.Info("using " + cfg.Auth.Provider + " auth provider")
+		return &storage{
+			repo:      repo,
+			auth:      auth,
+			txManager: txManager,
+			dataFeed:  postgresql.NewDataFeed(pgClient, keyring, cfg.Data.Watch.Buffer, log),
+		}, pgClient.Close, nil
+	case "memory":
+		if cfg.Auth.Provider == "postgres" {
+			return nil, nil, errors.New("the postgres auth provider requires the postgres storage driver")
+		}
+		provider, err := newInmemoryAuth(cfg.Auth, log)
+		if err != nil {
+			return nil, nil, err
+		}
+		// Values never leave the process, so data encryption does not apply.
+		feed := memory.NewDataFeed(cfg.Data.Watch.Buffer)
+		repo := memory.New(feed)
+		// The repository keeps the accounts, so that logins and preferences share one store.
+		repo.AddUsers(provider.Users()...)
+		log.Warn("using in-memory storage, everything is lost on restart")
+		log.Info("using inmemory auth provider")
+		return &storage{repo: repo, auth: service.NewAuthService(repo, log), txManager: repo, dataFeed: feed}, func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown storage driver %q", cfg.Storage.Driver)
+	}
+}
+
+// newInmemoryAuth creates the in-memory auth provider with the configured admin account.
+func newInmemoryAuth(cfg config.AuthConfig, log *slog.Logger) (*inmemory.Adapter, error) {
+	provider, err := inmemory.New(log, inmemory.Admin{
+		Email:        cfg.Admin.Email,
+		PasswordHash: cfg.Admin.PasswordHash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to init in-memory auth provider: %w", err)
+	}
+	return provider, nil
+}
+
+// newRedisPool creates a pool of connections to the configured Redis server.
+func newRedisPool(cfg config.RedisConfig) *redis.Pool {
+	return &redis.Pool{
+		MaxIdle: 10,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", cfg.Host+":"+cfg.Port,
+				redis.DialPassword(cfg.Password),
+				redis.DialDatabase(cfg.DB),
+			)
+		},
+	}
+}
+
+// catalogImagePolicy validates the catalog image settings. Thumbnail sizes are sorted and
+// deduplicated, so items list their thumbnails smallest first.
+func catalogImagePolicy(cfg config.CatalogImagesConfig) (entity.CatalogImagePolicy, error) {
+	if cfg.MaxSize <= 0 {
+		return entity.CatalogImagePolicy{}, fmt.Errorf("max_size must be positive, got %d", cfg.MaxSize)
+	}
+	sizes := slices.Compact(slices.Sorted(slices.Values(cfg.ThumbnailSizes)))
+	for _, size := range sizes {
+		if size <= 0 {
+			return entity.CatalogImagePolicy{}, fmt.Errorf("thumbnail sizes must be positive, got %d", size)
+		}
+	}
+	return entity.CatalogImagePolicy{
+		MaxSize:        cfg.MaxSize,
+		ThumbnailSizes: sizes,
+	}, nil
+}
+
+// newEventSinks creates the outbox sinks named in cfg.Sinks. The returned function closes
+// the files opened for them.
+func newEventSinks(cfg config.OutboxConfig) ([]usecase.EventSink, func(), error) {
+	var sinks []usecase.EventSink
+	var files []*ndjson.Sink
+	closeFiles := func() {
+		for _, f := range files {
+			_ = f.Close()
+		}
+	}
+	for _, name := range cfg.Sinks {
+		switch name {
+		case "stdout":
+			sinks = append(sinks, ndjson.New(name, os.Stdout))
+		case "file":
+			if err := os.MkdirAll(filepath.Dir(cfg.File.Path), 0o750); err != nil {
+				closeFiles()
+				return nil, nil, err
+			}
+			f, err := ndjson.Open(name, cfg.File.Path)
+			if err != nil {
+				closeFiles()
+				return nil, nil, err
+			}
+			files = append(files, f)
+			sinks = append(sinks, f)
+		case "webhook":
+			if cfg.Webhook.URL == "" {
+				closeFiles()
+				return nil, nil, errors.New("outbox.webhook.url is required by the webhook sink")
+			}
+			sinks = append(sinks, webhook.New(cfg.Webhook.URL, cfg.Webhook.Timeout))
+		default:
+			closeFiles()
+			return nil, nil, fmt.Errorf("unknown outbox sink %q", name)
+		}
+	}
+	return sinks, closeFiles, nil
+}
+
+// loadKeyring reads the data master keys. It returns nil when encryption is disabled.
+func loadKeyring(cfg config.EncryptionConfig) (*envelope.Keyring, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	var source io.Reader
+	switch {
+	case cfg.MasterKeys != "":
+		source = strings.NewReader(cfg.MasterKeys)
+	case cfg.MasterKeysFile != "":
+		f, err := os.Open(cfg.MasterKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		source = f
+	default:
+		return nil, errors.New("no master keys configured")
+	}
+
+	keys, err := envelope.ParseKeys(source)
+	if err != nil {
+		return nil, err
+	}
+
+	activeID := cfg.ActiveKeyID
+	if activeID == "" && len(keys) == 1 {
+		for id := range keys {
+			activeID = id
+		}
+	}
+	return envelope.NewKeyring(keys, activeID)
+}
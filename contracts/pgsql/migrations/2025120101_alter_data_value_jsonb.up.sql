@@ -0,0 +1,2 @@
+ALTER TABLE data
+    ALTER COLUMN value TYPE JSONB USING to_jsonb(value);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS data_schemas (
+    prefix TEXT PRIMARY KEY,
+    schema JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS data_expires_at_idx;
+
+ALTER TABLE data
+    DROP COLUMN IF EXISTS expires_at;
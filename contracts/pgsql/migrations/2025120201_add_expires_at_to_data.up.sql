@@ -0,0 +1,5 @@
+ALTER TABLE data
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS data_expires_at_idx ON data (expires_at)
+    WHERE expires_at IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS data_changes_notify ON data;
+DROP FUNCTION IF EXISTS notify_data_change();
+DROP INDEX IF EXISTS data_key_id_idx;
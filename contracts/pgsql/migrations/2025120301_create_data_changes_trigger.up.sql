@@ -0,0 +1,34 @@
+CREATE INDEX IF NOT EXISTS data_key_id_idx ON data (key, id);
+
+-- Publishes every change of the data table on the data_changes channel.
+-- The payload is kept small (no value) to stay well below the 8000 byte NOTIFY limit;
+-- listeners load the row by id when they need it.
+CREATE OR REPLACE FUNCTION notify_data_change() RETURNS TRIGGER AS $$
+DECLARE
+    op TEXT;
+    rec data;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        rec := OLD;
+        -- Only the removal of the latest version of a key is a visible deletion.
+        IF EXISTS (SELECT 1 FROM data WHERE key = OLD.key AND id > OLD.id) THEN
+            RETURN OLD;
+        END IF;
+        op := 'delete';
+    ELSE
+        rec := NEW;
+        IF TG_OP = 'UPDATE' OR EXISTS (SELECT 1 FROM data WHERE key = NEW.key AND id < NEW.id) THEN
+            op := 'update';
+        ELSE
+            op := 'create';
+        END IF;
+    END IF;
+
+    PERFORM pg_notify('data_changes', json_build_object('id', rec.id, 'op', op, 'key', rec.key)::text);
+    RETURN rec;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER data_changes_notify
+    AFTER INSERT OR UPDATE OR DELETE ON data
+    FOR EACH ROW EXECUTE FUNCTION notify_data_change();
@@ -0,0 +1,34 @@
+-- Encrypted rows cannot be decrypted in SQL, so this fails while any exist.
+ALTER TABLE data DROP CONSTRAINT IF EXISTS data_value_check;
+ALTER TABLE data ALTER COLUMN value SET NOT NULL;
+
+CREATE OR REPLACE FUNCTION notify_data_change() RETURNS TRIGGER AS $$
+DECLARE
+    op TEXT;
+    rec data;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        rec := OLD;
+        -- Only the removal of the latest version of a key is a visible deletion.
+        IF EXISTS (SELECT 1 FROM data WHERE key = OLD.key AND id > OLD.id) THEN
+            RETURN OLD;
+        END IF;
+        op := 'delete';
+    ELSE
+        rec := NEW;
+        IF TG_OP = 'UPDATE' OR EXISTS (SELECT 1 FROM data WHERE key = NEW.key AND id < NEW.id) THEN
+            op := 'update';
+        ELSE
+            op := 'create';
+        END IF;
+    END IF;
+
+    PERFORM pg_notify('data_changes', json_build_object('id', rec.id, 'op', op, 'key', rec.key)::text);
+    RETURN rec;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE data
+    DROP COLUMN IF EXISTS value_key_id,
+    DROP COLUMN IF EXISTS value_data_key,
+    DROP COLUMN IF EXISTS value_ciphertext;
@@ -0,0 +1,42 @@
+-- Encrypted values live next to the JSONB column: value holds plaintext rows,
+-- value_ciphertext/value_data_key/value_key_id hold envelope-encrypted rows.
+ALTER TABLE data
+    ALTER COLUMN value DROP NOT NULL,
+    ADD COLUMN value_ciphertext BYTEA,
+    ADD COLUMN value_data_key BYTEA,
+    ADD COLUMN value_key_id TEXT;
+
+ALTER TABLE data ADD CONSTRAINT data_value_check CHECK (
+    (value IS NOT NULL AND value_ciphertext IS NULL AND value_data_key IS NULL AND value_key_id IS NULL)
+    OR (value IS NULL AND value_ciphertext IS NOT NULL AND value_data_key IS NOT NULL AND value_key_id IS NOT NULL)
+);
+
+-- Re-encrypting a row (key rotation) is not a change of the key, so it is not published.
+CREATE OR REPLACE FUNCTION notify_data_change() RETURNS TRIGGER AS $$
+DECLARE
+    op TEXT;
+    rec data;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        rec := OLD;
+        -- Only the removal of the latest version of a key is a visible deletion.
+        IF EXISTS (SELECT 1 FROM data WHERE key = OLD.key AND id > OLD.id) THEN
+            RETURN OLD;
+        END IF;
+        op := 'delete';
+    ELSE
+        rec := NEW;
+        IF TG_OP = 'UPDATE' AND NEW.value_key_id IS DISTINCT FROM OLD.value_key_id THEN
+            RETURN NEW;
+        END IF;
+        IF TG_OP = 'UPDATE' OR EXISTS (SELECT 1 FROM data WHERE key = NEW.key AND id < NEW.id) THEN
+            op := 'update';
+        ELSE
+            op := 'create';
+        END IF;
+    END IF;
+
+    PERFORM pg_notify('data_changes', json_build_object('id', rec.id, 'op', op, 'key', rec.key)::text);
+    RETURN rec;
+END;
+$$ LANGUAGE plpgsql;
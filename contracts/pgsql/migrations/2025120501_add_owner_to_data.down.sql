@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS data_owner_id_idx;
+
+ALTER TABLE data
+    DROP COLUMN IF EXISTS value_size,
+    DROP COLUMN IF EXISTS owner_id;
@@ -0,0 +1,11 @@
+-- owner_id has no foreign key: users of the in-memory auth provider are not in the users table.
+-- value_size is the plaintext size of the value, which cannot be derived from encrypted rows.
+ALTER TABLE data
+    ADD COLUMN owner_id UUID,
+    ADD COLUMN value_size INTEGER NOT NULL DEFAULT 0;
+
+-- Encrypted values carry a 12 byte nonce and a 16 byte tag.
+UPDATE data
+SET value_size = COALESCE(octet_length(value::text), octet_length(value_ciphertext) - 28);
+
+CREATE INDEX IF NOT EXISTS data_owner_id_idx ON data (owner_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS data_attachments;
+DROP TABLE IF EXISTS blobs;
@@ -0,0 +1,22 @@
+-- Content is stored once per digest in the blob store; blobs tracks it for garbage collection.
+CREATE TABLE IF NOT EXISTS blobs (
+    digest TEXT PRIMARY KEY,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Attachments belong to a data key rather than a row, so they survive new versions of the value.
+CREATE TABLE IF NOT EXISTS data_attachments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    key TEXT NOT NULL,
+    name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    digest TEXT NOT NULL REFERENCES blobs (digest),
+    created_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS data_attachments_key_idx ON data_attachments (key);
+CREATE INDEX IF NOT EXISTS data_attachments_digest_idx ON data_attachments (digest);
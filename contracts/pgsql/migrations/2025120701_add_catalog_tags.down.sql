@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS catalog_item_tags;
+DROP TABLE IF EXISTS catalog_tags;
+DROP INDEX IF EXISTS catalog_created_at_id_idx;
+DROP INDEX IF EXISTS catalog_title_id_idx;
+ALTER TABLE catalog
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,20 @@
+ALTER TABLE catalog
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- Keyset pagination walks these in both directions; id breaks ties between equal titles and timestamps.
+CREATE INDEX IF NOT EXISTS catalog_title_id_idx ON catalog (title, id);
+CREATE INDEX IF NOT EXISTS catalog_created_at_id_idx ON catalog (created_at, id);
+
+-- Tags are normalized to lower case by the application.
+CREATE TABLE IF NOT EXISTS catalog_tags (
+    name VARCHAR(64) PRIMARY KEY
+);
+
+CREATE TABLE IF NOT EXISTS catalog_item_tags (
+    item_id UUID NOT NULL REFERENCES catalog (id) ON DELETE CASCADE,
+    tag VARCHAR(64) NOT NULL REFERENCES catalog_tags (name) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY (item_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS catalog_item_tags_tag_idx ON catalog_item_tags (tag);
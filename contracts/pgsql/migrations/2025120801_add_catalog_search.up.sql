@@ -0,0 +1,14 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- The text search configuration is stored per row, so the configured language can change
+-- without a migration: the application rewrites search_language and the vector follows.
+ALTER TABLE catalog
+    ADD COLUMN IF NOT EXISTS search_language REGCONFIG NOT NULL DEFAULT 'simple',
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector(search_language, title), 'A') ||
+        setweight(to_tsvector(search_language, coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS catalog_search_vector_idx ON catalog USING GIN (search_vector);
+-- Serves the typo-tolerant fallback (word similarity on titles).
+CREATE INDEX IF NOT EXISTS catalog_title_trgm_idx ON catalog USING GIN (title gin_trgm_ops);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS catalog_category_id_idx;
+ALTER TABLE catalog
+    DROP COLUMN IF EXISTS category_id;
+DROP TABLE IF EXISTS catalog_categories;
@@ -0,0 +1,20 @@
+-- Categories form a tree. path is the materialized list of slugs from the root
+-- ("electronics/phones"), so a subtree is every path equal to or starting with "<path>/".
+-- The application rewrites the paths below a category when it is renamed or moved.
+CREATE TABLE IF NOT EXISTS catalog_categories (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    parent_id UUID REFERENCES catalog_categories (id) ON DELETE RESTRICT,
+    slug VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    path TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS catalog_categories_parent_id_idx ON catalog_categories (parent_id);
+
+-- Items of a deleted category become uncategorized.
+ALTER TABLE catalog
+    ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES catalog_categories (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS catalog_category_id_idx ON catalog (category_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS catalog_drafts;
+DROP TABLE IF EXISTS catalog_changesets;
@@ -0,0 +1,32 @@
+-- A changeset groups staged catalog edits that are published, and possibly rolled back, at once.
+CREATE TABLE IF NOT EXISTS catalog_changesets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    title VARCHAR(255) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published', 'rolled_back')),
+    created_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_by UUID,
+    published_at TIMESTAMPTZ,
+    rolled_back_by UUID,
+    rolled_back_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS catalog_changesets_created_at_idx ON catalog_changesets (created_at);
+
+-- A draft is the next version of one item, or its deletion. base_updated_at is the version of
+-- the item the draft was staged against (NULL for new items), so publishing can detect edits
+-- made in the meantime. before holds the item as it was when the draft was published.
+CREATE TABLE IF NOT EXISTS catalog_drafts (
+    changeset_id UUID NOT NULL REFERENCES catalog_changesets (id) ON DELETE CASCADE,
+    item_id UUID NOT NULL,
+    action VARCHAR(16) NOT NULL CHECK (action IN ('upsert', 'delete')),
+    title VARCHAR(255),
+    description TEXT,
+    disabled BOOLEAN NOT NULL DEFAULT FALSE,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    category_id UUID,
+    base_updated_at TIMESTAMPTZ,
+    before JSONB,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (changeset_id, item_id)
+);
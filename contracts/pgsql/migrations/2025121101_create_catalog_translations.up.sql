@@ -0,0 +1,11 @@
+-- Titles and descriptions of catalog items in other locales than the default one, which is
+-- stored in the catalog table itself. locale is a canonical BCP 47 tag such as "de" or "pt-BR".
+CREATE TABLE IF NOT EXISTS catalog_translations (
+    item_id UUID NOT NULL REFERENCES catalog (id) ON DELETE CASCADE,
+    locale VARCHAR(35) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (item_id, locale)
+);
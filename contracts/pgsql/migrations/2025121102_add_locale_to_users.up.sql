@@ -0,0 +1,3 @@
+-- Preferred locale for localized content; NULL follows the Accept-Language header.
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS locale VARCHAR(35);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS catalog_sku_key;
+
+ALTER TABLE catalog DROP COLUMN IF EXISTS sku;
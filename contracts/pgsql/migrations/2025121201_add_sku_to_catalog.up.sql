@@ -0,0 +1,4 @@
+-- sku is the external key bulk imports match items by. Items created through the API have none.
+ALTER TABLE catalog ADD COLUMN IF NOT EXISTS sku VARCHAR(64);
+
+CREATE UNIQUE INDEX IF NOT EXISTS catalog_sku_key ON catalog (sku);
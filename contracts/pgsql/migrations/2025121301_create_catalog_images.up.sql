@@ -0,0 +1,14 @@
+-- Images of catalog items. The original is stored in the image store under the image id and
+-- thumbnails under "<id>_<size>"; the rows of deleted items go with them and the files are
+-- removed by garbage collection.
+CREATE TABLE IF NOT EXISTS catalog_images (
+    id UUID PRIMARY KEY,
+    item_id UUID NOT NULL REFERENCES catalog (id) ON DELETE CASCADE,
+    content_type TEXT NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS catalog_images_item_id_idx ON catalog_images (item_id);
@@ -0,0 +1,14 @@
+-- Catalog items favorited by users. user_id has no foreign key: users of the in-memory auth
+-- provider are not in the users table.
+CREATE TABLE IF NOT EXISTS user_catalog_favorites (
+    user_id UUID NOT NULL,
+    item_id UUID NOT NULL REFERENCES catalog (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, item_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_catalog_favorites_item_id_idx ON user_catalog_favorites (item_id);
+
+-- Serves the keyset pagination of a user's favorites, most recently added first.
+CREATE INDEX IF NOT EXISTS user_catalog_favorites_user_id_created_at_idx
+    ON user_catalog_favorites (user_id, created_at DESC, item_id DESC);
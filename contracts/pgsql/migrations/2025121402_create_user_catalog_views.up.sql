@@ -0,0 +1,11 @@
+-- The catalog items each user viewed last, one row per item. Recording a view trims the
+-- history of the user to the configured size.
+CREATE TABLE IF NOT EXISTS user_catalog_views (
+    user_id UUID NOT NULL,
+    item_id UUID NOT NULL REFERENCES catalog (id) ON DELETE CASCADE,
+    viewed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, item_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_catalog_views_user_id_viewed_at_idx ON user_catalog_views (user_id, viewed_at DESC);
+CREATE INDEX IF NOT EXISTS user_catalog_views_item_id_idx ON user_catalog_views (item_id);
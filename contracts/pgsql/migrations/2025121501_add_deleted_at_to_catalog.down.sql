@@ -0,0 +1,9 @@
+-- Without the column, deleted items would reappear.
+DELETE FROM catalog WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS catalog_sku_key;
+CREATE UNIQUE INDEX IF NOT EXISTS catalog_sku_key ON catalog (sku);
+
+DROP INDEX IF EXISTS catalog_deleted_at_idx;
+
+ALTER TABLE catalog DROP COLUMN IF EXISTS deleted_at;
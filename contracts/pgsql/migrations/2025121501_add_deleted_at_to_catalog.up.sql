@@ -0,0 +1,9 @@
+-- Deleting an item only stamps deleted_at; reads skip such items until they are restored or
+-- purged after the retention period.
+ALTER TABLE catalog ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS catalog_deleted_at_idx ON catalog (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Deleted items keep their sku; it may be reused by a new item meanwhile.
+DROP INDEX IF EXISTS catalog_sku_key;
+CREATE UNIQUE INDEX IF NOT EXISTS catalog_sku_key ON catalog (sku) WHERE deleted_at IS NULL;
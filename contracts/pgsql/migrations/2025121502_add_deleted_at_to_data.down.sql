@@ -0,0 +1,36 @@
+-- Without the column, deleted keys would reappear.
+DELETE FROM data WHERE deleted_at IS NOT NULL;
+
+-- Restores the definition of 2025120401_add_value_encryption_to_data.
+CREATE OR REPLACE FUNCTION notify_data_change() RETURNS TRIGGER AS $$
+DECLARE
+    op TEXT;
+    rec data;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        rec := OLD;
+        -- Only the removal of the latest version of a key is a visible deletion.
+        IF EXISTS (SELECT 1 FROM data WHERE key = OLD.key AND id > OLD.id) THEN
+            RETURN OLD;
+        END IF;
+        op := 'delete';
+    ELSE
+        rec := NEW;
+        IF TG_OP = 'UPDATE' AND NEW.value_key_id IS DISTINCT FROM OLD.value_key_id THEN
+            RETURN NEW;
+        END IF;
+        IF TG_OP = 'UPDATE' OR EXISTS (SELECT 1 FROM data WHERE key = NEW.key AND id < NEW.id) THEN
+            op := 'update';
+        ELSE
+            op := 'create';
+        END IF;
+    END IF;
+
+    PERFORM pg_notify('data_changes', json_build_object('id', rec.id, 'op', op, 'key', rec.key)::text);
+    RETURN rec;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS data_deleted_at_idx;
+
+ALTER TABLE data DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,54 @@
+-- Deleting a key stamps deleted_at on all of its versions; reads skip them until the key is
+-- restored or the versions are purged after the retention period.
+ALTER TABLE data ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS data_deleted_at_idx ON data (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Soft deletes and restores are published as deletions and creations of the key. Purging
+-- rows that were soft deleted before is not a visible change.
+CREATE OR REPLACE FUNCTION notify_data_change() RETURNS TRIGGER AS $$
+DECLARE
+    op TEXT;
+    rec data;
+BEGIN
+    IF TG_OP = 'DELETE' OR (TG_OP = 'UPDATE' AND OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL) THEN
+        rec := OLD;
+        IF OLD.deleted_at IS NOT NULL THEN
+            RETURN OLD;
+        END IF;
+        -- Only the removal of the latest version of a key is a visible deletion.
+        IF EXISTS (SELECT 1 FROM data WHERE key = OLD.key AND id > OLD.id) THEN
+            RETURN OLD;
+        END IF;
+        op := 'delete';
+    ELSIF TG_OP = 'UPDATE' AND OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        rec := NEW;
+        -- Only the restore of the latest version of a key is a visible creation.
+        IF EXISTS (SELECT 1 FROM data WHERE key = NEW.key AND id > NEW.id) THEN
+            RETURN NEW;
+        END IF;
+        op := 'create';
+    ELSE
+        rec := NEW;
+        -- Re-encrypting a row (key rotation) is not a change of the key, so it is not published.
+        IF TG_OP = 'UPDATE' AND NEW.value_key_id IS DISTINCT FROM OLD.value_key_id THEN
+            RETURN NEW;
+        END IF;
+        -- Neither is any other update of a soft deleted row.
+        IF TG_OP = 'UPDATE' AND NEW.deleted_at IS NOT NULL THEN
+            RETURN NEW;
+        END IF;
+        IF TG_OP = 'UPDATE' OR EXISTS (SELECT 1 FROM data WHERE key = NEW.key AND id < NEW.id AND deleted_at IS NULL) THEN
+            op := 'update';
+        ELSE
+            op := 'create';
+        END IF;
+    END IF;
+
+    PERFORM pg_notify('data_changes', json_build_object('id', rec.id, 'op', op, 'key', rec.key)::text);
+    IF TG_OP = 'DELETE' THEN
+        RETURN OLD;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
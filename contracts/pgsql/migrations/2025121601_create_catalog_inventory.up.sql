@@ -0,0 +1,11 @@
+-- Price and stock of catalog items. Items without a row have no price and untracked stock.
+-- The price is stored as a decimal in major units; the currency decides how many fraction
+-- digits it may have.
+CREATE TABLE IF NOT EXISTS catalog_inventory (
+    item_id UUID PRIMARY KEY REFERENCES catalog (id) ON DELETE CASCADE,
+    price NUMERIC(19, 4) CHECK (price >= 0),
+    currency CHAR(3),
+    stock_quantity BIGINT CHECK (stock_quantity >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((price IS NULL) = (currency IS NULL))
+);
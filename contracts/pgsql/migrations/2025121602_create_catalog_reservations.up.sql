@@ -0,0 +1,15 @@
+-- Units of catalog items held for users. Reservations stop counting against the stock once
+-- they expire and are cleaned up by the next reservation of the item. user_id has no foreign
+-- key: users of the in-memory auth provider are not in the users table.
+CREATE TABLE IF NOT EXISTS catalog_reservations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    item_id UUID NOT NULL REFERENCES catalog (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    quantity BIGINT NOT NULL CHECK (quantity > 0),
+    unit_price NUMERIC(19, 4),
+    currency CHAR(3),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS catalog_reservations_item_id_expires_at_idx ON catalog_reservations (item_id, expires_at);
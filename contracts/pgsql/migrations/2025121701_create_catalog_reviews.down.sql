@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS catalog_review_stats;
+DROP TABLE IF EXISTS catalog_reviews;
@@ -0,0 +1,23 @@
+-- Reviews of catalog items, one per user and item. user_id has no foreign key: users of the
+-- in-memory auth provider are not in the users table.
+CREATE TABLE IF NOT EXISTS catalog_reviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    item_id UUID NOT NULL REFERENCES catalog (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    body TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL CHECK (status IN ('pending', 'approved', 'rejected')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (item_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS catalog_reviews_status_idx ON catalog_reviews (status, created_at);
+
+-- Running totals of the approved reviews of each item. They are adjusted in the transaction
+-- of every review change, so reads never aggregate catalog_reviews.
+CREATE TABLE IF NOT EXISTS catalog_review_stats (
+    item_id UUID PRIMARY KEY REFERENCES catalog (id) ON DELETE CASCADE,
+    review_count BIGINT NOT NULL DEFAULT 0,
+    rating_sum BIGINT NOT NULL DEFAULT 0
+);
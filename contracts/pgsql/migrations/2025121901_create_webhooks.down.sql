@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS users_outbox_created ON users;
+DROP FUNCTION IF EXISTS outbox_user_created();
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_endpoints;
@@ -0,0 +1,57 @@
+-- Endpoints registered by admins. Each event of a subscribed type is delivered to the URL,
+-- signed with the secret. Endpoints are disabled after too many failures in a row.
+-- Like data values, secret holds the secret when no master keys are configured, and
+-- secret_ciphertext/secret_data_key/secret_key_id hold it envelope-encrypted otherwise.
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    url TEXT NOT NULL,
+    secret TEXT,
+    secret_ciphertext BYTEA,
+    secret_data_key BYTEA,
+    secret_key_id TEXT,
+    event_types TEXT[] NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    consecutive_failures INT NOT NULL DEFAULT 0,
+    disabled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT webhook_endpoints_secret_check CHECK (
+        (secret IS NOT NULL AND secret_ciphertext IS NULL AND secret_data_key IS NULL AND secret_key_id IS NULL)
+        OR (secret IS NULL AND secret_ciphertext IS NOT NULL AND secret_data_key IS NOT NULL AND secret_key_id IS NOT NULL)
+    )
+);
+
+-- One row per event and endpoint. The row keeps the outcome of the latest attempt and is the
+-- delivery log; redelivering an event resets its row to pending.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    endpoint_id UUID NOT NULL REFERENCES webhook_endpoints (id) ON DELETE CASCADE,
+    event_id BIGINT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    event_created_at TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_attempt_at TIMESTAMPTZ,
+    response_status INT,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (endpoint_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_endpoint_idx ON webhook_deliveries (endpoint_id, created_at DESC);
+
+-- Users are provisioned outside the application, so user.created is written by a trigger to
+-- cover every way a user is inserted.
+CREATE OR REPLACE FUNCTION outbox_user_created() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO outbox (event_type, payload) VALUES ('user.created', json_build_object('id', NEW.id));
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER users_outbox_created
+    AFTER INSERT ON users
+    FOR EACH ROW EXECUTE FUNCTION outbox_user_created();
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"slices"
+	"sync"
 	"time"
 
 	"base_app/internal/entity"
@@ -15,29 +17,75 @@ import (
 // Adapter implements the AuthService interface with an in-memory store.
 type Adapter struct {
 	log *slog.Logger
+
+	mu    sync.RWMutex // Guards the mutable fields of users
+	users []entity.User
 }
 
-// New creates a new in-memory auth adapter.
-func New(log *slog.Logger) *Adapter {
-	return &Adapter{
-		log: log,
+// Admin holds the credentials of the admin account of the in-memory store.
+type Admin struct {
+	Email        string
+	PasswordHash string // bcrypt hash of the password
+}
+
+// New creates a new in-memory auth adapter. The store holds a fixed test user and, when
+// admin is not empty, an admin account. It returns an error if admin is incomplete or its
+// password hash is not a bcrypt hash.
+func New(log *slog.Logger, admin Admin) (*Adapter, error) {
+	users := []entity.User{
+		{
+			ID:        uuid.New(),
+			Email:     "test@example.com",
+			Password:  "$2a$10$WhWf0qQzwtD8fz6p/Ge.2e8Y6WhZRN/vopNJXofJ7vEaG4KEukRPS", // "password123"
+			Role:      entity.RoleUser,
+			CreatedAt: time.Now(),
+		},
+	}
+
+	switch {
+	case admin.Email == "" && admin.PasswordHash == "":
+		log.Warn("no admin account configured for the in-memory auth provider")
+	case admin.Email == "" || admin.PasswordHash == "":
+		return nil, errors.New("the admin account needs both an email and a password hash")
+	case !hash.IsHash(admin.PasswordHash):
+		return nil, errors.New("the admin password hash is not a bcrypt hash")
+	case slices.ContainsFunc(users, func(u entity.User) bool { return u.Email == admin.Email }):
+		return nil, errors.New("the admin email is taken by a test user")
+	default:
+		users = append(users, entity.User{
+			ID:        uuid.New(),
+			Email:     admin.Email,
+			Password:  admin.PasswordHash,
+			Role:      entity.RoleAdmin,
+			CreatedAt: time.Now(),
+		})
 	}
+
+	return &Adapter{
+		log:   log,
+		users: users,
+	}, nil
 }
 
-var hardcodedUser = entity.User{
-	ID:        uuid.New(),
-	Email:     "test@example.com",
-	Password:  "$2a$10$WhWf0qQzwtD8fz6p/Ge.2e8Y6WhZRN/vopNJXofJ7vEaG4KEukRPS", // "password123"
-	CreatedAt: time.Now(),
+// Users returns a copy of the accounts of the store, to seed a repository with them.
+func (a *Adapter) Users() []entity.User {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return slices.Clone(a.users)
 }
 
 // GetUserByEmail simulates fetching a user from an in-memory store.
 func (a *Adapter) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
 	const op = "adapter.inmemory.GetUserByEmail"
 
-	if email == hardcodedUser.Email {
-		a.log.Info("found user in in-memory store", slog.String("op", op), slog.String("email", email))
-		return &hardcodedUser, nil
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for i := range a.users {
+		if email == a.users[i].Email {
+			a.log.Info("found user in in-memory store", slog.String("op", op), slog.String("email", email))
+			user := a.users[i]
+			return &user, nil
+		}
 	}
 
 	a.log.Warn("user not found in in-memory store", slog.String("op", op), slog.String("email", email))
@@ -47,8 +95,26 @@ func (a *Adapter) GetUserByEmail(ctx context.Context, email string) (*entity.Use
 // Authenticate is a dummy implementation for the in-memory adapter.
 // The actual password check happens in the usecase.
 func (a *Adapter) Authenticate(ctx context.Context, email, password string) (*entity.User, error) {
-	if email == hardcodedUser.Email && hash.CheckPasswordHash(password, hardcodedUser.Password) {
-		return &hardcodedUser, nil
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for i := range a.users {
+		if email == a.users[i].Email && hash.CheckPasswordHash(password, a.users[i].Password) {
+			user := a.users[i]
+			return &user, nil
+		}
 	}
 	return nil, errors.New("invalid credentials")
 }
+
+// SetUserLocale stores the preferred locale of a user until the process exits.
+func (a *Adapter) SetUserLocale(ctx context.Context, id uuid.UUID, locale string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i := range a.users {
+		if id == a.users[i].ID {
+			a.users[i].Locale = locale
+			return nil
+		}
+	}
+	return entity.ErrNotFound
+}
@@ -0,0 +1,173 @@
+package local
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"base_app/internal/entity"
+)
+
+// tmpDir holds uploads until their digest is known. It lives under the root so the
+// final rename stays on the same filesystem.
+const tmpDir = "tmp"
+
+// Store implements usecase.BlobStore on the local filesystem.
+// Content is stored at <root>/<digest[0:2]>/<digest[2:4]>/<digest>.
+type Store struct {
+	root string
+	log  *slog.Logger
+}
+
+// New creates a new local blob store rooted at root, creating the directory if needed.
+func New(root string, log *slog.Logger) (*Store, error) {
+	if err := os.MkdirAll(filepath.Join(root, tmpDir), 0o750); err != nil {
+		return nil, err
+	}
+	return &Store{
+		root: root,
+		log:  log,
+	}, nil
+}
+
+// Put streams r into a temporary file while hashing it and moves it into place.
+// When the content already exists the upload is discarded and the existing file is touched,
+// so a concurrent orphan sweep does not remove it.
+func (s *Store) Put(ctx context.Context, r io.Reader) (string, int64, error) {
+	const op = "adapter.blobstore.local.Put"
+
+	tmp, err := os.CreateTemp(filepath.Join(s.root, tmpDir), "upload-*")
+	if err != nil {
+		s.log.Error("failed to create temp file", slog.String("op", op), slog.String("error", err.Error()))
+		return "", 0, err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, h), contextReader{ctx: ctx, r: r})
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	digest := hex.EncodeToString(h.Sum(nil))
+	path := s.path(digest)
+	if _, err := os.Stat(path); err == nil {
+		now := time.Now()
+		return digest, size, os.Chtimes(path, now, now)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		s.log.Error("failed to create blob directory", slog.String("op", op), slog.String("error", err.Error()))
+		return "", 0, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		s.log.Error("failed to store blob", slog.String("op", op), slog.String("error", err.Error()))
+		return "", 0, err
+	}
+	return digest, size, nil
+}
+
+// Open returns the stored file. It implements io.ReadSeeker, so callers can serve ranges.
+func (s *Store) Open(_ context.Context, digest string) (io.ReadCloser, error) {
+	if !validDigest(digest) {
+		return nil, entity.ErrNotFound
+	}
+	f, err := os.Open(s.path(digest))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, entity.ErrNotFound
+	}
+	return f, err
+}
+
+// Exists reports whether content is stored under digest.
+func (s *Store) Exists(_ context.Context, digest string) (bool, error) {
+	if !validDigest(digest) {
+		return false, nil
+	}
+	_, err := os.Stat(s.path(digest))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Delete removes the content stored under digest. Missing content is not an error.
+func (s *Store) Delete(_ context.Context, digest string) error {
+	if !validDigest(digest) {
+		return fmt.Errorf("invalid blob digest %q", digest)
+	}
+	err := os.Remove(s.path(digest))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Walk calls fn for every stored blob, skipping uploads in progress.
+func (s *Store) Walk(ctx context.Context, fn func(digest string, modTime time.Time) error) error {
+	return filepath.WalkDir(s.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path == filepath.Join(s.root, tmpDir) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !validDigest(d.Name()) {
+			return nil
+		}
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return fn(d.Name(), info.ModTime())
+	})
+}
+
+func (s *Store) path(digest string) string {
+	return filepath.Join(s.root, digest[0:2], digest[2:4], digest)
+}
+
+// validDigest guards paths against anything but a hex encoded SHA-256.
+func validDigest(digest string) bool {
+	if len(digest) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(digest)
+	return err == nil
+}
+
+// contextReader stops a long upload when its request is cancelled.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (cr contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return cr.r.Read(p)
+}
@@ -0,0 +1,128 @@
+package local
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"base_app/internal/entity"
+)
+
+func newStore(t *testing.T) *Store {
+	t.Helper()
+	s, err := New(t.TempDir(), slog.New(slog.DiscardHandler))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return s
+}
+
+func TestStorePutStoresContentOnce(t *testing.T) {
+	ctx := context.Background()
+	s := newStore(t)
+
+	sum := sha256.Sum256([]byte("hello"))
+	want := hex.EncodeToString(sum[:])
+	for range 2 {
+		digest, size, err := s.Put(ctx, strings.NewReader("hello"))
+		if err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+		if digest != want || size != 5 {
+			t.Errorf("Put = %s, %d; want %s, 5", digest, size, want)
+		}
+	}
+
+	var digests []string
+	err := s.Walk(ctx, func(digest string, _ time.Time) error {
+		digests = append(digests, digest)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk: %v", err)
+	}
+	if len(digests) != 1 || digests[0] != want {
+		t.Errorf("Walk found %v, want [%s]", digests, want)
+	}
+
+	rc, err := s.Open(ctx, want)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	content, _ := io.ReadAll(rc)
+	_ = rc.Close()
+	if string(content) != "hello" {
+		t.Errorf("Open returned %q", content)
+	}
+}
+
+func TestStorePutDiscardsFailedUploads(t *testing.T) {
+	s := newStore(t)
+
+	_, _, err := s.Put(context.Background(), io.MultiReader(strings.NewReader("partial"), errReader{}))
+	if err == nil {
+		t.Fatal("Put succeeded with a failing reader")
+	}
+	entries, _ := os.ReadDir(filepath.Join(s.root, tmpDir))
+	if len(entries) != 0 {
+		t.Errorf("failed upload left %d temp files", len(entries))
+	}
+	err = s.Walk(context.Background(), func(digest string, _ time.Time) error {
+		t.Errorf("Walk found %s after a failed upload", digest)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk: %v", err)
+	}
+}
+
+func TestStoreDelete(t *testing.T) {
+	ctx := context.Background()
+	s := newStore(t)
+
+	digest, _, err := s.Put(ctx, strings.NewReader("bye"))
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := s.Delete(ctx, digest); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := s.Delete(ctx, digest); err != nil {
+		t.Errorf("Delete of missing content: %v", err)
+	}
+	if ok, err := s.Exists(ctx, digest); ok || err != nil {
+		t.Errorf("Exists = %v, %v after Delete", ok, err)
+	}
+	if _, err := s.Open(ctx, digest); !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("Open = %v, want ErrNotFound", err)
+	}
+}
+
+func TestStoreRejectsInvalidDigests(t *testing.T) {
+	ctx := context.Background()
+	s := newStore(t)
+
+	for _, digest := range []string{"", "../../etc/passwd", strings.Repeat("z", sha256.Size*2)} {
+		if _, err := s.Open(ctx, digest); !errors.Is(err, entity.ErrNotFound) {
+			t.Errorf("Open(%q) = %v, want ErrNotFound", digest, err)
+		}
+		if ok, err := s.Exists(ctx, digest); ok || err != nil {
+			t.Errorf("Exists(%q) = %v, %v", digest, ok, err)
+		}
+		if err := s.Delete(ctx, digest); err == nil {
+			t.Errorf("Delete(%q) succeeded", digest)
+		}
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
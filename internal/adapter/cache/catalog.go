@@ -0,0 +1,491 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"base_app/internal/entity"
+	"base_app/internal/usecase"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// keyPrefix namespaces catalog cache entries in a shared Redis database.
+	keyPrefix = "catalog:"
+	// generationKey holds a counter that is bumped on every catalog mutation. It is part of
+	// every entry key, so bumping it invalidates all entries at once; old entries expire.
+	generationKey = keyPrefix + "generation"
+)
+
+// CatalogTTL bounds how long cache entries live. Mutations made through the cache invalidate
+// it right away, so the TTLs only limit staleness after writes that bypass it and the memory
+// held by unused entries.
+type CatalogTTL struct {
+	Item time.Duration // Single items and categories
+	List time.Duration // Item listings, the category tree and tags
+}
+
+// CatalogCache implements usecase.CatalogService by caching catalog reads in Redis in front
+// of another CatalogService. Concurrent misses of the same entry share one load. Search and
+// changeset reads are not cached, nor is per-user state such as favorites. Redis failures
+// fall back to the wrapped service.
+type CatalogCache struct {
+	next  usecase.CatalogService
+	pool  *redis.Pool
+	ttl   CatalogTTL
+	group singleflight.Group
+	log   *slog.Logger
+}
+
+// NewCatalogCache creates a Redis cache in front of next.
+func NewCatalogCache(next usecase.CatalogService, pool *redis.Pool, ttl CatalogTTL, log *slog.Logger) *CatalogCache {
+	return &CatalogCache{
+		next: next,
+		pool: pool,
+		ttl:  ttl,
+		log:  log,
+	}
+}
+
+// GetCatalogItems retrieves the items of a category subtree, or all items for an empty category.
+func (c *CatalogCache) GetCatalogItems(ctx context.Context, category string) ([]entity.CatalogItem, error) {
+	return cached(ctx, c, "items:"+category, c.ttl.List, func(ctx context.Context) ([]entity.CatalogItem, error) {
+		return c.next.GetCatalogItems(ctx, category)
+	})
+}
+
+// ListCatalogItems retrieves one page of catalog items.
+func (c *CatalogCache) ListCatalogItems(ctx context.Context, q entity.CatalogQuery) ([]entity.CatalogItem, error) {
+	key, err := json.Marshal(q)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(key)
+
+	return cached(ctx, c, "page:"+hex.EncodeToString(sum[:]), c.ttl.List, func(ctx context.Context) ([]entity.CatalogItem, error) {
+		return c.next.ListCatalogItems(ctx, q)
+	})
+}
+
+// GetCatalogItem retrieves a single catalog item.
+func (c *CatalogCache) GetCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error) {
+	return cached(ctx, c, "item:"+id.String(), c.ttl.Item, func(ctx context.Context) (*entity.CatalogItem, error) {
+		return c.next.GetCatalogItem(ctx, id)
+	})
+}
+
+// CreateCatalogItem creates a catalog item and invalidates the cache.
+func (c *CatalogCache) CreateCatalogItem(ctx context.Context, item *entity.CatalogItem) error {
+	return c.invalidateAfter(ctx, c.next.CreateCatalogItem(ctx, item))
+}
+
+// UpdateCatalogItem replaces a catalog item and invalidates the cache.
+func (c *CatalogCache) UpdateCatalogItem(ctx context.Context, item *entity.CatalogItem) error {
+	return c.invalidateAfter(ctx, c.next.UpdateCatalogItem(ctx, item))
+}
+
+// SetCatalogItemDisabled enables or disables a catalog item and invalidates the cache.
+func (c *CatalogCache) SetCatalogItemDisabled(ctx context.Context, id uuid.UUID, disabled bool) (*entity.CatalogItem, error) {
+	item, err := c.next.SetCatalogItemDisabled(ctx, id, disabled)
+	return item, c.invalidateAfter(ctx, err)
+}
+
+// DeleteCatalogItem deletes a catalog item and invalidates the cache.
+func (c *CatalogCache) DeleteCatalogItem(ctx context.Context, id uuid.UUID) error {
+	return c.invalidateAfter(ctx, c.next.DeleteCatalogItem(ctx, id))
+}
+
+// ListDeletedCatalogItems retrieves deleted catalog items. They are only read by admins and
+// not cached.
+func (c *CatalogCache) ListDeletedCatalogItems(ctx context.Context, limit int32) ([]entity.CatalogItem, error) {
+	return c.next.ListDeletedCatalogItems(ctx, limit)
+}
+
+// RestoreCatalogItem undeletes a catalog item and invalidates the cache.
+func (c *CatalogCache) RestoreCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error) {
+	item, err := c.next.RestoreCatalogItem(ctx, id)
+	return item, c.invalidateAfter(ctx, err)
+}
+
+// PurgeDeletedCatalogItems permanently removes deleted catalog items. They are no longer
+// visible to cached reads, so the cache stays valid.
+func (c *CatalogCache) PurgeDeletedCatalogItems(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error) {
+	return c.next.PurgeDeletedCatalogItems(ctx, cutoff, batchSize)
+}
+
+// SearchCatalogItems runs a full-text search. Results are not cached.
+func (c *CatalogCache) SearchCatalogItems(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error) {
+	return c.next.SearchCatalogItems(ctx, q)
+}
+
+// SearchCatalogItemsFuzzy runs a typo-tolerant title search. Results are not cached.
+func (c *CatalogCache) SearchCatalogItemsFuzzy(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error) {
+	return c.next.SearchCatalogItemsFuzzy(ctx, q)
+}
+
+// SetCatalogSearchLanguage re-indexes catalog items; cached reads do not depend on it.
+func (c *CatalogCache) SetCatalogSearchLanguage(ctx context.Context, language string) (int64, error) {
+	return c.next.SetCatalogSearchLanguage(ctx, language)
+}
+
+// ListCatalogCategories retrieves the category tree.
+func (c *CatalogCache) ListCatalogCategories(ctx context.Context) ([]entity.CatalogCategory, error) {
+	return cached(ctx, c, "categories", c.ttl.List, func(ctx context.Context) ([]entity.CatalogCategory, error) {
+		return c.next.ListCatalogCategories(ctx)
+	})
+}
+
+// GetCatalogCategory retrieves a single category.
+func (c *CatalogCache) GetCatalogCategory(ctx context.Context, id uuid.UUID) (*entity.CatalogCategory, error) {
+	return cached(ctx, c, "category:"+id.String(), c.ttl.Item, func(ctx context.Context) (*entity.CatalogCategory, error) {
+		return c.next.GetCatalogCategory(ctx, id)
+	})
+}
+
+// CreateCatalogCategory creates a category and invalidates the cache.
+func (c *CatalogCache) CreateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error {
+	return c.invalidateAfter(ctx, c.next.CreateCatalogCategory(ctx, category))
+}
+
+// UpdateCatalogCategory renames or moves a category and invalidates the cache.
+func (c *CatalogCache) UpdateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error {
+	return c.invalidateAfter(ctx, c.next.UpdateCatalogCategory(ctx, category))
+}
+
+// DeleteCatalogCategory deletes a category and invalidates the cache.
+func (c *CatalogCache) DeleteCatalogCategory(ctx context.Context, id uuid.UUID) error {
+	return c.invalidateAfter(ctx, c.next.DeleteCatalogCategory(ctx, id))
+}
+
+// ListCatalogTags retrieves all tags with their item counts.
+func (c *CatalogCache) ListCatalogTags(ctx context.Context) ([]entity.CatalogTag, error) {
+	return cached(ctx, c, "tags", c.ttl.List, func(ctx context.Context) ([]entity.CatalogTag, error) {
+		return c.next.ListCatalogTags(ctx)
+	})
+}
+
+// CreateCatalogTag creates a tag and invalidates the cache.
+func (c *CatalogCache) CreateCatalogTag(ctx context.Context, name string) error {
+	return c.invalidateAfter(ctx, c.next.CreateCatalogTag(ctx, name))
+}
+
+// RenameCatalogTag renames a tag and invalidates the cache.
+func (c *CatalogCache) RenameCatalogTag(ctx context.Context, name, newName string) (*entity.CatalogTag, error) {
+	tag, err := c.next.RenameCatalogTag(ctx, name, newName)
+	return tag, c.invalidateAfter(ctx, err)
+}
+
+// DeleteCatalogTag deletes a tag and invalidates the cache.
+func (c *CatalogCache) DeleteCatalogTag(ctx context.Context, name string) error {
+	return c.invalidateAfter(ctx, c.next.DeleteCatalogTag(ctx, name))
+}
+
+// ListCatalogChangesets retrieves all catalog changesets. Changesets are not cached.
+func (c *CatalogCache) ListCatalogChangesets(ctx context.Context) ([]entity.CatalogChangeset, error) {
+	return c.next.ListCatalogChangesets(ctx)
+}
+
+// GetCatalogChangeset retrieves a catalog changeset with its drafts.
+func (c *CatalogCache) GetCatalogChangeset(ctx context.Context, id uuid.UUID) (*entity.CatalogChangeset, error) {
+	return c.next.GetCatalogChangeset(ctx, id)
+}
+
+// CreateCatalogChangeset creates a catalog changeset.
+func (c *CatalogCache) CreateCatalogChangeset(ctx context.Context, changeset *entity.CatalogChangeset) error {
+	return c.next.CreateCatalogChangeset(ctx, changeset)
+}
+
+// DeleteCatalogChangeset discards a draft catalog changeset.
+func (c *CatalogCache) DeleteCatalogChangeset(ctx context.Context, id uuid.UUID) error {
+	return c.next.DeleteCatalogChangeset(ctx, id)
+}
+
+// SaveCatalogDraft stages a draft; drafts are invisible until published.
+func (c *CatalogCache) SaveCatalogDraft(ctx context.Context, changesetID uuid.UUID, draft *entity.CatalogDraft) error {
+	return c.next.SaveCatalogDraft(ctx, changesetID, draft)
+}
+
+// DeleteCatalogDraft removes a draft from a catalog changeset.
+func (c *CatalogCache) DeleteCatalogDraft(ctx context.Context, changesetID, itemID uuid.UUID) error {
+	return c.next.DeleteCatalogDraft(ctx, changesetID, itemID)
+}
+
+// PublishCatalogChangeset applies a catalog changeset and invalidates the cache.
+func (c *CatalogCache) PublishCatalogChangeset(ctx context.Context, id, publishedBy uuid.UUID, language string) (*entity.CatalogChangeset, error) {
+	changeset, err := c.next.PublishCatalogChangeset(ctx, id, publishedBy, language)
+	return changeset, c.invalidateAfter(ctx, err)
+}
+
+// RollbackCatalogChangeset undoes a published catalog changeset and invalidates the cache.
+func (c *CatalogCache) RollbackCatalogChangeset(ctx context.Context, id, rolledBackBy uuid.UUID, language string) (*entity.CatalogChangeset, error) {
+	changeset, err := c.next.RollbackCatalogChangeset(ctx, id, rolledBackBy, language)
+	return changeset, c.invalidateAfter(ctx, err)
+}
+
+// ListCatalogTranslations retrieves the translations of a catalog item. They are not cached.
+func (c *CatalogCache) ListCatalogTranslations(ctx context.Context, itemID uuid.UUID) ([]entity.CatalogTranslation, error) {
+	return c.next.ListCatalogTranslations(ctx, itemID)
+}
+
+// ListCatalogTranslationsForItems retrieves the translations of catalog items into some locales.
+func (c *CatalogCache) ListCatalogTranslationsForItems(ctx context.Context, itemIDs []uuid.UUID, locales []string) ([]entity.CatalogTranslation, error) {
+	key, err := json.Marshal(struct {
+		ItemIDs []uuid.UUID
+		Locales []string
+	}{itemIDs, locales})
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(key)
+
+	return cached(ctx, c, "translations:"+hex.EncodeToString(sum[:]), c.ttl.List, func(ctx context.Context) ([]entity.CatalogTranslation, error) {
+		return c.next.ListCatalogTranslationsForItems(ctx, itemIDs, locales)
+	})
+}
+
+// SaveCatalogTranslation creates or replaces a translation and invalidates the cache.
+func (c *CatalogCache) SaveCatalogTranslation(ctx context.Context, t *entity.CatalogTranslation) error {
+	return c.invalidateAfter(ctx, c.next.SaveCatalogTranslation(ctx, t))
+}
+
+// DeleteCatalogTranslation deletes a translation and invalidates the cache.
+func (c *CatalogCache) DeleteCatalogTranslation(ctx context.Context, itemID uuid.UUID, locale string) error {
+	return c.invalidateAfter(ctx, c.next.DeleteCatalogTranslation(ctx, itemID, locale))
+}
+
+// ImportCatalogItems applies a catalog import and invalidates the cache unless nothing was written.
+func (c *CatalogCache) ImportCatalogItems(ctx context.Context, rows []entity.CatalogImportRow, opts entity.CatalogImportOptions, language string) (*entity.CatalogImportResult, error) {
+	res, err := c.next.ImportCatalogItems(ctx, rows, opts, language)
+	if opts.DryRun || (err == nil && len(res.Missing) > 0) {
+		return res, err
+	}
+	return res, c.invalidateAfter(ctx, err)
+}
+
+// CreateCatalogImage records an image and invalidates the cache, since items list their images.
+func (c *CatalogCache) CreateCatalogImage(ctx context.Context, img *entity.CatalogImage) error {
+	return c.invalidateAfter(ctx, c.next.CreateCatalogImage(ctx, img))
+}
+
+// GetCatalogImage retrieves a catalog image. Images are not cached; their files are served
+// with long-lived cache headers instead.
+func (c *CatalogCache) GetCatalogImage(ctx context.Context, id uuid.UUID) (*entity.CatalogImage, error) {
+	return c.next.GetCatalogImage(ctx, id)
+}
+
+// DeleteCatalogImage deletes the record of an image and invalidates the cache.
+func (c *CatalogCache) DeleteCatalogImage(ctx context.Context, itemID, id uuid.UUID) error {
+	return c.invalidateAfter(ctx, c.next.DeleteCatalogImage(ctx, itemID, id))
+}
+
+// CatalogImageExists reports whether a catalog image is recorded.
+func (c *CatalogCache) CatalogImageExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	return c.next.CatalogImageExists(ctx, id)
+}
+
+// cached returns the entry for key. On a miss, load fills the entry; concurrent misses of the
+// same entry wait for a single load. Errors from load are returned as is and not cached.
+func cached[T any](ctx context.Context, c *CatalogCache, key string, ttl time.Duration, load func(ctx context.Context) (T, error)) (T, error) {
+	const op = "adapter.cache.catalog"
+
+	generation, err := c.generation(ctx)
+	if err != nil {
+		c.log.Warn("catalog cache unavailable", slog.String("op", op), slog.String("error", err.Error()))
+		return load(ctx)
+	}
+	key = keyPrefix + generation + ":" + key
+
+	var v T
+	raw, err := c.get(ctx, key)
+	if err != nil {
+		c.log.Warn("failed to read catalog cache", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	if raw != nil && json.Unmarshal(raw, &v) == nil {
+		return v, nil
+	}
+
+	// The load outlives the caller that started it, as other callers may be waiting for it.
+	shared, err, _ := c.group.Do(key, func() (any, error) {
+		ctx := context.WithoutCancel(ctx)
+		v, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.set(ctx, key, raw, ttl); err != nil {
+			c.log.Warn("failed to fill catalog cache", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return raw, nil
+	})
+	if err != nil {
+		return v, err
+	}
+	// Every caller decodes its own copy, so callers never share slices or pointers.
+	err = json.Unmarshal(shared.([]byte), &v)
+	return v, err
+}
+
+// generation returns the current cache generation.
+func (c *CatalogCache) generation(ctx context.Context) (string, error) {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	n, err := redis.Int64(conn.Do("GET", generationKey))
+	if errors.Is(err, redis.ErrNil) {
+		return "0", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(n, 10), nil
+}
+
+// get returns the entry for key, or nil on a miss.
+func (c *CatalogCache) get(ctx context.Context, key string) ([]byte, error) {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	raw, err := redis.Bytes(conn.Do("GET", key))
+	if errors.Is(err, redis.ErrNil) {
+		return nil, nil
+	}
+	return raw, err
+}
+
+// set stores the entry for key.
+func (c *CatalogCache) set(ctx context.Context, key string, raw []byte, ttl time.Duration) error {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Do("SET", key, raw, "PX", ttl.Milliseconds())
+	return err
+}
+
+// invalidateAfter drops all cache entries unless the mutation failed, and returns err.
+// Entries filled by loads that started before the mutation land in the old generation, so
+// they are never read again. A failed invalidation only delays updates until the TTLs.
+func (c *CatalogCache) invalidateAfter(ctx context.Context, err error) error {
+	const op = "adapter.cache.catalog.invalidate"
+
+	if err != nil {
+		return err
+	}
+
+	conn, connErr := c.pool.GetContext(context.WithoutCancel(ctx))
+	if connErr != nil {
+		c.log.Error("failed to invalidate catalog cache", slog.String("op", op), slog.String("error", connErr.Error()))
+		return nil
+	}
+	defer conn.Close()
+
+	if _, err := conn.Do("INCR", generationKey); err != nil {
+		c.log.Error("failed to invalidate catalog cache", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return nil
+}
+
+// AddCatalogFavorite marks an item as a favorite of a user. Favorites are not part of the
+// cached items, so the cache stays valid.
+func (c *CatalogCache) AddCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error {
+	return c.next.AddCatalogFavorite(ctx, userID, itemID)
+}
+
+// DeleteCatalogFavorite unmarks a favorite item of a user; the cache stays valid.
+func (c *CatalogCache) DeleteCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error {
+	return c.next.DeleteCatalogFavorite(ctx, userID, itemID)
+}
+
+// ListCatalogFavorites retrieves one page of the favorites of a user. Favorites are per user,
+// so they are never cached.
+func (c *CatalogCache) ListCatalogFavorites(ctx context.Context, userID uuid.UUID, after *entity.CatalogFavoriteCursor, limit int) ([]entity.CatalogFavorite, error) {
+	return c.next.ListCatalogFavorites(ctx, userID, after, limit)
+}
+
+// ListCatalogFavoriteIDs returns those of itemIDs that are favorites of a user. Not cached,
+// like ListCatalogFavorites.
+func (c *CatalogCache) ListCatalogFavoriteIDs(ctx context.Context, userID uuid.UUID, itemIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return c.next.ListCatalogFavoriteIDs(ctx, userID, itemIDs)
+}
+
+// RecordCatalogView records that a user viewed an item. Views are not part of the cached
+// items, so the cache stays valid.
+func (c *CatalogCache) RecordCatalogView(ctx context.Context, userID, itemID uuid.UUID, keep int) error {
+	return c.next.RecordCatalogView(ctx, userID, itemID, keep)
+}
+
+// ListRecentlyViewedCatalogItems retrieves the items a user viewed last. Views are per user,
+// so they are never cached.
+func (c *CatalogCache) ListRecentlyViewedCatalogItems(ctx context.Context, userID uuid.UUID) ([]entity.CatalogItem, error) {
+	return c.next.ListRecentlyViewedCatalogItems(ctx, userID)
+}
+
+// SetCatalogInventory sets the price and stock of a catalog item and invalidates the cache.
+func (c *CatalogCache) SetCatalogInventory(ctx context.Context, id uuid.UUID, price *entity.Money, stock *int64) (*entity.CatalogItem, error) {
+	item, err := c.next.SetCatalogInventory(ctx, id, price, stock)
+	return item, c.invalidateAfter(ctx, err)
+}
+
+// ReserveCatalogItem reserves stock and invalidates the cache, since cached items carry their
+// reserved quantity. Expiring reservations show up in cached reads only after the entries expire.
+func (c *CatalogCache) ReserveCatalogItem(ctx context.Context, res *entity.CatalogReservation) error {
+	return c.invalidateAfter(ctx, c.next.ReserveCatalogItem(ctx, res))
+}
+
+// ReleaseCatalogReservation releases a reservation and invalidates the cache.
+func (c *CatalogCache) ReleaseCatalogReservation(ctx context.Context, itemID, reservationID, userID uuid.UUID) error {
+	return c.invalidateAfter(ctx, c.next.ReleaseCatalogReservation(ctx, itemID, reservationID, userID))
+}
+
+// GetCatalogReview retrieves the review a user left for an item. Reviews are not cached.
+func (c *CatalogCache) GetCatalogReview(ctx context.Context, itemID, userID uuid.UUID) (*entity.CatalogReview, error) {
+	return c.next.GetCatalogReview(ctx, itemID, userID)
+}
+
+// SaveCatalogReview saves a review and invalidates the cache, since cached items carry their
+// rating totals.
+func (c *CatalogCache) SaveCatalogReview(ctx context.Context, review *entity.CatalogReview) error {
+	return c.invalidateAfter(ctx, c.next.SaveCatalogReview(ctx, review))
+}
+
+// DeleteCatalogReview deletes a review and invalidates the cache.
+func (c *CatalogCache) DeleteCatalogReview(ctx context.Context, itemID, userID uuid.UUID) error {
+	return c.invalidateAfter(ctx, c.next.DeleteCatalogReview(ctx, itemID, userID))
+}
+
+// SetCatalogReviewStatus moderates a review and invalidates the cache.
+func (c *CatalogCache) SetCatalogReviewStatus(ctx context.Context, id uuid.UUID, status entity.CatalogReviewStatus) (*entity.CatalogReview, error) {
+	review, err := c.next.SetCatalogReviewStatus(ctx, id, status)
+	return review, c.invalidateAfter(ctx, err)
+}
+
+// ListCatalogReviews retrieves reviews of an item. Reviews are not cached; only the rating
+// totals are, as part of the items.
+func (c *CatalogCache) ListCatalogReviews(ctx context.Context, itemID uuid.UUID, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error) {
+	return c.next.ListCatalogReviews(ctx, itemID, status, limit)
+}
+
+// ListCatalogReviewsByStatus retrieves reviews for moderation. Reviews are not cached.
+func (c *CatalogCache) ListCatalogReviewsByStatus(ctx context.Context, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error) {
+	return c.next.ListCatalogReviewsByStatus(ctx, status, limit)
+}
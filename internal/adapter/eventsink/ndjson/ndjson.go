@@ -0,0 +1,66 @@
+package ndjson
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"base_app/internal/entity"
+)
+
+// Sink implements usecase.EventSink by writing every event as one line of JSON.
+type Sink struct {
+	name string
+	mu   sync.Mutex
+	w    io.Writer
+	// file is set when the sink owns a file, which is synced after every event.
+	file *os.File
+}
+
+// New creates a sink writing to w, such as os.Stdout.
+func New(name string, w io.Writer) *Sink {
+	return &Sink{name: name, w: w}
+}
+
+// Open creates a sink appending to the file at path, creating it if needed.
+func Open(name, path string) (*Sink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o640)
+	if err != nil {
+		return nil, err
+	}
+	return &Sink{name: name, w: f, file: f}, nil
+}
+
+func (s *Sink) Name() string {
+	return s.name
+}
+
+// Publish writes event as a single line. Lines are written whole, so concurrent readers
+// never see half an event.
+func (s *Sink) Publish(_ context.Context, event entity.OutboxEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.w.Write(line); err != nil {
+		return err
+	}
+	if s.file != nil {
+		return s.file.Sync()
+	}
+	return nil
+}
+
+// Close closes the file opened by Open. It does nothing for sinks created by New.
+func (s *Sink) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	return s.file.Close()
+}
@@ -0,0 +1,28 @@
+package subscriptions
+
+import (
+	"context"
+
+	"base_app/internal/entity"
+	"base_app/internal/usecase"
+)
+
+// Sink implements usecase.EventSink by queuing every event for the webhook endpoints
+// subscribed to it. The endpoints are called later by the webhook dispatcher, so a slow or
+// failing endpoint never holds back the outbox.
+type Sink struct {
+	webhookUsecase usecase.WebhookUsecase
+}
+
+// New creates a sink feeding webhookUC.
+func New(webhookUC usecase.WebhookUsecase) *Sink {
+	return &Sink{webhookUsecase: webhookUC}
+}
+
+func (s *Sink) Name() string {
+	return "webhook_subscriptions"
+}
+
+func (s *Sink) Publish(ctx context.Context, event entity.OutboxEvent) error {
+	return s.webhookUsecase.EnqueueWebhookDeliveries(ctx, event)
+}
@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"base_app/internal/entity"
+)
+
+// maxErrorBody bounds how much of a failed response is kept in the error.
+const maxErrorBody = 512
+
+// Sink implements usecase.EventSink by POSTing every event as JSON to a URL. Any response
+// other than 2xx is a failure, and the event is sent again later.
+type Sink struct {
+	url    string
+	client *http.Client
+}
+
+// New creates a sink posting to url, giving up on a request after timeout.
+func New(url string, timeout time.Duration) *Sink {
+	return &Sink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *Sink) Name() string {
+	return "webhook"
+}
+
+// Publish posts event. The X-Event-Id header lets receivers drop events they have already
+// processed.
+func (s *Sink) Publish(ctx context.Context, event entity.OutboxEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", strconv.FormatInt(event.ID, 10))
+	req.Header.Set("X-Event-Type", string(event.Type))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("webhook responded with status %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
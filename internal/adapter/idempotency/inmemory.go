@@ -0,0 +1,106 @@
+package idempotency
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"base_app/internal/entity"
+)
+
+type memoryEntry struct {
+	rec       entity.IdempotencyRecord
+	expiresAt time.Time
+}
+
+// InMemoryStore implements usecase.IdempotencyStore in process memory.
+// Records are not shared between instances and are lost on restart.
+type InMemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+	// expiries orders the entries by expiry, so eviction only looks at expired ones. Entries
+	// that were completed or released since leave stale items behind, which are skipped.
+	expiries expiryHeap
+}
+
+// NewInMemoryStore creates a new in-memory idempotency store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		entries: make(map[string]memoryEntry),
+	}
+}
+
+// Reserve claims key unless a record for it has not expired yet.
+func (s *InMemoryStore) Reserve(_ context.Context, key, requestHash string, lockTTL time.Duration) (*entity.IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.evictExpired(now)
+
+	if e, ok := s.entries[key]; ok {
+		rec := e.rec
+		return &rec, false, nil
+	}
+	s.put(key, memoryEntry{
+		rec:       entity.IdempotencyRecord{RequestHash: requestHash},
+		expiresAt: now.Add(lockTTL),
+	})
+	return nil, true, nil
+}
+
+// Complete stores the final response for key.
+func (s *InMemoryStore) Complete(_ context.Context, key string, rec *entity.IdempotencyRecord, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(key, memoryEntry{rec: *rec, expiresAt: time.Now().Add(ttl)})
+	return nil
+}
+
+// Release forgets key.
+func (s *InMemoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// put stores e under key. The caller must hold s.mu.
+func (s *InMemoryStore) put(key string, e memoryEntry) {
+	s.entries[key] = e
+	heap.Push(&s.expiries, expiryItem{key: key, expiresAt: e.expiresAt})
+}
+
+// evictExpired drops expired entries. The caller must hold s.mu.
+func (s *InMemoryStore) evictExpired(now time.Time) {
+	for len(s.expiries) > 0 && !now.Before(s.expiries[0].expiresAt) {
+		item := heap.Pop(&s.expiries).(expiryItem)
+		// The entry may have been replaced with a later expiry or released in the meantime.
+		if e, ok := s.entries[item.key]; ok && e.expiresAt.Equal(item.expiresAt) {
+			delete(s.entries, item.key)
+		}
+	}
+}
+
+type expiryItem struct {
+	key       string
+	expiresAt time.Time
+}
+
+// expiryHeap is a min-heap of expiry items for container/heap.
+type expiryHeap []expiryItem
+
+func (h expiryHeap) Len() int           { return len(h) }
+func (h expiryHeap) Less(i, j int) bool { return h[i].expiresAt.Before(h[j].expiresAt) }
+func (h expiryHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *expiryHeap) Push(x any)        { *h = append(*h, x.(expiryItem)) }
+
+func (h *expiryHeap) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
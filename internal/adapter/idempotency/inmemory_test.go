@@ -0,0 +1,43 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"base_app/internal/entity"
+)
+
+func TestInMemoryStoreEvictsExpiredEntries(t *testing.T) {
+	ctx := context.Background()
+	s := NewInMemoryStore()
+
+	if _, ok, _ := s.Reserve(ctx, "short", "h1", time.Millisecond); !ok {
+		t.Fatal("Reserve(short) did not claim a new key")
+	}
+	if _, ok, _ := s.Reserve(ctx, "long", "h2", time.Hour); !ok {
+		t.Fatal("Reserve(long) did not claim a new key")
+	}
+	// Completing replaces the lock expiry; the item of the lock must not evict the record.
+	if _, ok, _ := s.Reserve(ctx, "done", "h3", time.Millisecond); !ok {
+		t.Fatal("Reserve(done) did not claim a new key")
+	}
+	if err := s.Complete(ctx, "done", &entity.IdempotencyRecord{RequestHash: "h3", StatusCode: 201}, time.Hour); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok, _ := s.Reserve(ctx, "short", "h4", time.Hour); !ok {
+		t.Errorf("expired key still reserved")
+	}
+	if _, ok, _ := s.Reserve(ctx, "long", "h5", time.Hour); ok {
+		t.Errorf("live key reserved twice")
+	}
+	rec, ok, _ := s.Reserve(ctx, "done", "h3", time.Hour)
+	if ok || rec == nil || rec.StatusCode != 201 {
+		t.Errorf("Reserve(done) = %+v, %v; want the completed record", rec, ok)
+	}
+	if len(s.entries) != 3 {
+		t.Errorf("store holds %d entries, want 3", len(s.entries))
+	}
+}
@@ -0,0 +1,117 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"base_app/internal/entity"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// keyPrefix namespaces idempotency records in a shared Redis database.
+const keyPrefix = "idempotency:"
+
+// RedisStore implements usecase.IdempotencyStore on top of Redis, so replays
+// work across all instances that share the same Redis.
+type RedisStore struct {
+	pool *redis.Pool
+	log  *slog.Logger
+}
+
+// NewRedisStore creates a new Redis backed idempotency store.
+func NewRedisStore(pool *redis.Pool, log *slog.Logger) *RedisStore {
+	return &RedisStore{
+		pool: pool,
+		log:  log,
+	}
+}
+
+// Reserve claims key with SET NX. When the key exists, the stored record is returned.
+func (s *RedisStore) Reserve(ctx context.Context, key, requestHash string, lockTTL time.Duration) (*entity.IdempotencyRecord, bool, error) {
+	const op = "adapter.idempotency.redis.Reserve"
+
+	pending, err := json.Marshal(&entity.IdempotencyRecord{RequestHash: requestHash})
+	if err != nil {
+		return nil, false, err
+	}
+
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		s.log.Error("failed to get redis connection", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, false, err
+	}
+	defer conn.Close()
+
+	// A record can expire between SET and GET; one more round settles it.
+	for range 2 {
+		_, err := redis.String(conn.Do("SET", keyPrefix+key, pending, "NX", "PX", lockTTL.Milliseconds()))
+		if err == nil {
+			return nil, true, nil
+		}
+		if !errors.Is(err, redis.ErrNil) {
+			s.log.Error("failed to reserve idempotency key", slog.String("op", op), slog.String("error", err.Error()))
+			return nil, false, err
+		}
+
+		raw, err := redis.Bytes(conn.Do("GET", keyPrefix+key))
+		if errors.Is(err, redis.ErrNil) {
+			continue
+		}
+		if err != nil {
+			s.log.Error("failed to get idempotency record", slog.String("op", op), slog.String("error", err.Error()))
+			return nil, false, err
+		}
+
+		var rec entity.IdempotencyRecord
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return nil, false, err
+		}
+		return &rec, false, nil
+	}
+	return nil, false, errors.New("idempotency key is changing too fast")
+}
+
+// Complete overwrites the reservation with the final response.
+func (s *RedisStore) Complete(ctx context.Context, key string, rec *entity.IdempotencyRecord, ttl time.Duration) error {
+	const op = "adapter.idempotency.redis.Complete"
+
+	raw, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		s.log.Error("failed to get redis connection", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Do("SET", keyPrefix+key, raw, "PX", ttl.Milliseconds()); err != nil {
+		s.log.Error("failed to store idempotency record", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// Release deletes the reservation.
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	const op = "adapter.idempotency.redis.Release"
+
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		s.log.Error("failed to get redis connection", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Do("DEL", keyPrefix+key); err != nil {
+		s.log.Error("failed to release idempotency key", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// tmpDir holds files while they are written. It lives under the root so the final rename
+// stays on the same filesystem.
+const tmpDir = "tmp"
+
+// Store implements usecase.ImageStore on the local filesystem.
+// Files are stored at <root>/<name[0:2]>/<name>.
+type Store struct {
+	root string
+	log  *slog.Logger
+}
+
+// New creates a new local image store rooted at root, creating the directory if needed.
+func New(root string, log *slog.Logger) (*Store, error) {
+	if err := os.MkdirAll(filepath.Join(root, tmpDir), 0o750); err != nil {
+		return nil, err
+	}
+	return &Store{
+		root: root,
+		log:  log,
+	}, nil
+}
+
+// Put writes r into a temporary file and renames it into place, so readers see either the
+// previous content or all of the new one.
+func (s *Store) Put(ctx context.Context, name string, r io.Reader) error {
+	const op = "adapter.imagestore.local.Put"
+
+	if !validName(name) {
+		return fmt.Errorf("invalid image name %q", name)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Join(s.root, tmpDir), "image-*")
+	if err != nil {
+		s.log.Error("failed to create temp file", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	_, err = io.Copy(tmp, contextReader{ctx: ctx, r: r})
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	path := s.path(name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		s.log.Error("failed to create image directory", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		s.log.Error("failed to store image", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// Open returns the stored file. It implements io.ReadSeeker, so callers can serve ranges.
+func (s *Store) Open(_ context.Context, name string) (io.ReadCloser, error) {
+	if !validName(name) {
+		return nil, entity.ErrNotFound
+	}
+	f, err := os.Open(s.path(name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, entity.ErrNotFound
+	}
+	return f, err
+}
+
+// Delete removes the file stored under name. A missing file is not an error.
+func (s *Store) Delete(_ context.Context, name string) error {
+	if !validName(name) {
+		return fmt.Errorf("invalid image name %q", name)
+	}
+	err := os.Remove(s.path(name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Walk calls fn for every stored file, skipping files being written.
+func (s *Store) Walk(ctx context.Context, fn func(name string, modTime time.Time) error) error {
+	return filepath.WalkDir(s.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path == filepath.Join(s.root, tmpDir) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !validName(d.Name()) {
+			return nil
+		}
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return fn(d.Name(), info.ModTime())
+	})
+}
+
+func (s *Store) path(name string) string {
+	return filepath.Join(s.root, name[0:2], name)
+}
+
+// validName guards paths against anything but "<uuid>" or "<uuid>_<size>".
+func validName(name string) bool {
+	id, size, found := strings.Cut(name, "_")
+	if parsed, err := uuid.Parse(id); err != nil || parsed.String() != id {
+		return false
+	}
+	if !found {
+		return true
+	}
+	n, err := strconv.Atoi(size)
+	return err == nil && n > 0 && strconv.Itoa(n) == size
+}
+
+// contextReader stops a long write when its request is cancelled.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (cr contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return cr.r.Read(p)
+}
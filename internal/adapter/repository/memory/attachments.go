@@ -0,0 +1,157 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+type blob struct {
+	size       int64
+	lastUsedAt time.Time
+}
+
+// DataKeyExists reports whether the key has a live value.
+func (r *Repo) DataKeyExists(ctx context.Context, key string) (bool, error) {
+	defer r.lock(ctx)()
+
+	_, ok := r.liveData(key, time.Now())
+	return ok, nil
+}
+
+// CreateAttachment records the blob and the attachment once verify has confirmed that the
+// content is still stored. Garbage collection cannot run meanwhile, since verify is called
+// under the repository lock.
+func (r *Repo) CreateAttachment(ctx context.Context, att *entity.Attachment, verify func() error) error {
+	defer r.lock(ctx)()
+
+	if err := verify(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	b, ok := r.st.blobs[att.Digest]
+	if !ok {
+		b.size = att.Size
+	}
+	b.lastUsedAt = now
+	setRow(r.st, r.st.blobs, att.Digest, b)
+
+	created := *att
+	created.ID = uuid.New()
+	created.CreatedAt = now
+	setRow(r.st, r.st.attachments, created.ID, created)
+
+	*att = created
+	return nil
+}
+
+// GetAttachment retrieves an attachment by id. Attachments of a soft deleted key are hidden
+// until the key is restored.
+func (r *Repo) GetAttachment(ctx context.Context, id uuid.UUID) (*entity.Attachment, error) {
+	defer r.lock(ctx)()
+
+	att, ok := r.st.attachments[id]
+	if !ok || r.dataKeyDeleted(att.Key) {
+		return nil, entity.ErrNotFound
+	}
+	return &att, nil
+}
+
+// ListAttachments retrieves the attachments of a data key, oldest first. Attachments of a soft
+// deleted key are hidden until the key is restored.
+func (r *Repo) ListAttachments(ctx context.Context, key string) ([]entity.Attachment, error) {
+	defer r.lock(ctx)()
+
+	attachments := []entity.Attachment{}
+	if r.dataKeyDeleted(key) {
+		return attachments, nil
+	}
+	for _, att := range r.st.attachments {
+		if att.Key == key {
+			attachments = append(attachments, att)
+		}
+	}
+	slices.SortFunc(attachments, func(a, b entity.Attachment) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), compareIDs(a.ID, b.ID))
+	})
+	return attachments, nil
+}
+
+// dataKeyDeleted reports whether the newest version of key is soft deleted. The caller must
+// hold the lock.
+func (r *Repo) dataKeyDeleted(key string) bool {
+	versions := r.st.data[key]
+	return len(versions) > 0 && versions[len(versions)-1].DeletedAt != nil
+}
+
+// DeleteAttachment removes an attachment. Its content is left to garbage collection.
+// Attachments of a soft deleted key are hidden, so they cannot be deleted either.
+func (r *Repo) DeleteAttachment(ctx context.Context, id uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	if att, ok := r.st.attachments[id]; !ok || r.dataKeyDeleted(att.Key) {
+		return entity.ErrNotFound
+	}
+	deleteRow(r.st, r.st.attachments, id)
+	return nil
+}
+
+// DeleteDanglingAttachments removes attachments whose data key has no versions left.
+func (r *Repo) DeleteDanglingAttachments(ctx context.Context) (int64, error) {
+	defer r.lock(ctx)()
+
+	var n int64
+	for id, att := range r.st.attachments {
+		if len(r.st.data[att.Key]) == 0 {
+			deleteRow(r.st, r.st.attachments, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// DeleteUnusedBlobs deletes up to limit unreferenced blobs and calls remove with their
+// digests first. If remove fails the blobs are kept and retried on the next run.
+func (r *Repo) DeleteUnusedBlobs(ctx context.Context, olderThan time.Time, limit int32, remove func(digests []string) error) (int, error) {
+	defer r.lock(ctx)()
+
+	used := make(map[string]struct{})
+	for _, att := range r.st.attachments {
+		used[att.Digest] = struct{}{}
+	}
+	var digests []string
+	for digest, b := range r.st.blobs {
+		if _, ok := used[digest]; !ok && b.lastUsedAt.Before(olderThan) {
+			digests = append(digests, digest)
+		}
+	}
+	if len(digests) == 0 {
+		return 0, nil
+	}
+	slices.SortFunc(digests, func(a, b string) int {
+		return cmp.Or(r.st.blobs[a].lastUsedAt.Compare(r.st.blobs[b].lastUsedAt), strings.Compare(a, b))
+	})
+	digests = truncate(digests, limit)
+
+	if err := remove(digests); err != nil {
+		return 0, err
+	}
+	for _, digest := range digests {
+		deleteRow(r.st, r.st.blobs, digest)
+	}
+	return len(digests), nil
+}
+
+// BlobExists reports whether a blob is recorded for digest.
+func (r *Repo) BlobExists(ctx context.Context, digest string) (bool, error) {
+	defer r.lock(ctx)()
+
+	_, ok := r.st.blobs[digest]
+	return ok, nil
+}
@@ -0,0 +1,351 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+// GetCatalogItems retrieves all catalog items, or those in the category subtree at path category.
+func (r *Repo) GetCatalogItems(ctx context.Context, category string) ([]entity.CatalogItem, error) {
+	defer r.lock(ctx)()
+
+	inCategory := r.categoryFilter(category)
+	var items []entity.CatalogItem
+	for _, item := range r.st.items {
+		if item.DeletedAt == nil && inCategory(item) {
+			items = append(items, item)
+		}
+	}
+	slices.SortFunc(items, func(a, b entity.CatalogItem) int {
+		return cmp.Or(strings.Compare(a.Title, b.Title), compareIDs(a.ID, b.ID))
+	})
+	return r.withCatalogDetails(items), nil
+}
+
+// ListCatalogItems retrieves up to q.Limit catalog items following q.After in q.Sort order.
+func (r *Repo) ListCatalogItems(ctx context.Context, q entity.CatalogQuery) ([]entity.CatalogItem, error) {
+	defer r.lock(ctx)()
+
+	compare := func(a, b entity.CatalogItem) int {
+		return cmp.Or(strings.Compare(a.Title, b.Title), compareIDs(a.ID, b.ID))
+	}
+	var after entity.CatalogItem
+	if q.After != nil {
+		after = entity.CatalogItem{ID: q.After.ID, Title: q.After.Title, CreatedAt: q.After.CreatedAt}
+	}
+	if q.Sort == entity.CatalogSortCreatedAt || q.Sort == entity.CatalogSortCreatedAtDesc {
+		compare = func(a, b entity.CatalogItem) int {
+			return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), compareIDs(a.ID, b.ID))
+		}
+	}
+	if strings.HasPrefix(string(q.Sort), "-") {
+		ascending := compare
+		compare = func(a, b entity.CatalogItem) int { return ascending(b, a) }
+	}
+
+	inCategory := r.categoryFilter(q.Category)
+	titlePrefix := strings.ToLower(q.TitlePrefix)
+	var items []entity.CatalogItem
+	for _, item := range r.st.items {
+		switch {
+		case item.DeletedAt != nil,
+			q.Disabled != nil && item.Disabled != *q.Disabled,
+			!strings.HasPrefix(strings.ToLower(item.Title), titlePrefix),
+			q.Tag != "" && !slices.Contains(item.Tags, q.Tag),
+			!inCategory(item),
+			q.After != nil && compare(item, after) <= 0:
+			continue
+		}
+		items = append(items, item)
+	}
+	slices.SortFunc(items, compare)
+	return r.withCatalogDetails(truncate(items, int32(q.Limit))), nil
+}
+
+// GetCatalogItem retrieves a catalog item by id.
+func (r *Repo) GetCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error) {
+	defer r.lock(ctx)()
+
+	return r.getCatalogItem(id)
+}
+
+// getCatalogItem returns the live item id with its details. The caller must hold the lock.
+func (r *Repo) getCatalogItem(id uuid.UUID) (*entity.CatalogItem, error) {
+	item, ok := r.liveCatalogItem(id)
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	items := r.withCatalogDetails([]entity.CatalogItem{item})
+	return &items[0], nil
+}
+
+// CreateCatalogItem inserts a catalog item with its tags and fills in the generated fields.
+// It returns entity.ErrConflict if the sku is used by another item and a validation error if
+// the category does not exist.
+func (r *Repo) CreateCatalogItem(ctx context.Context, item *entity.CatalogItem) error {
+	defer r.lock(ctx)()
+
+	if err := r.checkItemCategory(item.CategoryID); err != nil {
+		return err
+	}
+	now := time.Now()
+	created, err := r.insertCatalogItem(*item, item.SearchLanguage, now)
+	if err != nil {
+		return err
+	}
+	r.addOutboxEvents(now, catalogEvent(entity.EventCatalogCreated, created.ID))
+
+	language := item.SearchLanguage
+	*item = r.withCatalogDetails([]entity.CatalogItem{created})[0]
+	item.SearchLanguage = language
+	return nil
+}
+
+// insertCatalogItem stores a new item under a generated id. The caller must hold the lock.
+func (r *Repo) insertCatalogItem(item entity.CatalogItem, language string, now time.Time) (entity.CatalogItem, error) {
+	if err := r.checkCatalogItemRefs(item, uuid.Nil); err != nil {
+		return entity.CatalogItem{}, err
+	}
+	stored := entity.CatalogItem{
+		ID:             uuid.New(),
+		SKU:            item.SKU,
+		Title:          item.Title,
+		Description:    item.Description,
+		Disabled:       item.Disabled,
+		Tags:           r.addCatalogTags(item.Tags),
+		CategoryID:     item.CategoryID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		SearchLanguage: language,
+	}
+	setRow(r.st, r.st.items, stored.ID, stored)
+	return stored, nil
+}
+
+// UpdateCatalogItem replaces the fields and tags of an existing catalog item. It returns a
+// validation error if the category does not exist.
+func (r *Repo) UpdateCatalogItem(ctx context.Context, item *entity.CatalogItem) error {
+	defer r.lock(ctx)()
+
+	if err := r.checkItemCategory(item.CategoryID); err != nil {
+		return err
+	}
+	now := time.Now()
+	updated, err := r.updateCatalogItem(item.ID, *item, item.SearchLanguage, now)
+	if err != nil {
+		return err
+	}
+	r.addOutboxEvents(now, catalogEvent(entity.EventCatalogUpdated, updated.ID))
+
+	language := item.SearchLanguage
+	*item = r.withCatalogDetails([]entity.CatalogItem{updated})[0]
+	item.SearchLanguage = language
+	return nil
+}
+
+// updateCatalogItem replaces the fields and tags of the live item id; its sku is kept.
+// The caller must hold the lock.
+func (r *Repo) updateCatalogItem(id uuid.UUID, item entity.CatalogItem, language string, now time.Time) (entity.CatalogItem, error) {
+	stored, ok := r.liveCatalogItem(id)
+	if !ok {
+		return entity.CatalogItem{}, entity.ErrNotFound
+	}
+	if err := r.checkCatalogItemRefs(entity.CatalogItem{CategoryID: item.CategoryID}, id); err != nil {
+		return entity.CatalogItem{}, err
+	}
+	stored.Title = item.Title
+	stored.Description = item.Description
+	stored.Disabled = item.Disabled
+	stored.CategoryID = item.CategoryID
+	stored.SearchLanguage = language
+	stored.Tags = r.addCatalogTags(item.Tags)
+	stored.UpdatedAt = now
+	setRow(r.st, r.st.items, id, stored)
+	return stored, nil
+}
+
+// SetCatalogItemDisabled enables or disables a catalog item.
+func (r *Repo) SetCatalogItemDisabled(ctx context.Context, id uuid.UUID, disabled bool) (*entity.CatalogItem, error) {
+	defer r.lock(ctx)()
+
+	item, ok := r.liveCatalogItem(id)
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	now := time.Now()
+	item.Disabled = disabled
+	item.UpdatedAt = now
+	setRow(r.st, r.st.items, id, item)
+	r.addOutboxEvents(now, catalogEvent(entity.EventCatalogUpdated, id))
+
+	items := r.withCatalogDetails([]entity.CatalogItem{item})
+	return &items[0], nil
+}
+
+// DeleteCatalogItem soft deletes a catalog item; it can be restored until it is purged.
+func (r *Repo) DeleteCatalogItem(ctx context.Context, id uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	now := time.Now()
+	if !r.deleteCatalogItem(id, now) {
+		return entity.ErrNotFound
+	}
+	r.addOutboxEvents(now, catalogEvent(entity.EventCatalogDeleted, id))
+	return nil
+}
+
+// deleteCatalogItem soft deletes the live item id and reports whether there was one.
+// The caller must hold the lock.
+func (r *Repo) deleteCatalogItem(id uuid.UUID, now time.Time) bool {
+	item, ok := r.liveCatalogItem(id)
+	if !ok {
+		return false
+	}
+	item.DeletedAt = &now
+	setRow(r.st, r.st.items, id, item)
+	return true
+}
+
+// liveCatalogItem returns the stored item id unless it is missing or deleted.
+// The caller must hold the lock.
+func (r *Repo) liveCatalogItem(id uuid.UUID) (entity.CatalogItem, bool) {
+	item, ok := r.st.items[id]
+	if !ok || item.DeletedAt != nil {
+		return entity.CatalogItem{}, false
+	}
+	return item, true
+}
+
+// checkItemCategory reports a category assigned to an item that does not exist, like the
+// foreign key violation of the postgres repository. The caller must hold the lock.
+func (r *Repo) checkItemCategory(id uuid.UUID) error {
+	if _, ok := r.st.categories[id]; id != uuid.Nil && !ok {
+		return entity.NewValidationError("category does not exist")
+	}
+	return nil
+}
+
+// checkCatalogItemRefs returns an entity.ErrConflict error if the category of item does not
+// exist or its sku is used by a live item other than id. The caller must hold the lock.
+func (r *Repo) checkCatalogItemRefs(item entity.CatalogItem, id uuid.UUID) error {
+	if item.CategoryID != uuid.Nil {
+		if _, ok := r.st.categories[item.CategoryID]; !ok {
+			return fmt.Errorf("%w: category %s does not exist", entity.ErrConflict, item.CategoryID)
+		}
+	}
+	if item.SKU != "" {
+		for _, other := range r.st.items {
+			if other.ID != id && other.SKU == item.SKU && other.DeletedAt == nil {
+				return fmt.Errorf("%w: sku %q is used by another item", entity.ErrConflict, item.SKU)
+			}
+		}
+	}
+	return nil
+}
+
+// addCatalogTags registers tags that do not exist yet and returns them sorted, without
+// duplicates, and nil when there are none. The caller must hold the lock.
+func (r *Repo) addCatalogTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	for _, tag := range tags {
+		setRow(r.st, r.st.tags, tag, struct{}{})
+	}
+	return slices.Compact(slices.Sorted(slices.Values(tags)))
+}
+
+// categoryFilter returns a function reporting whether an item is in the category subtree at
+// path. An empty path matches every item. The caller must hold the lock.
+func (r *Repo) categoryFilter(path string) func(item entity.CatalogItem) bool {
+	if path == "" {
+		return func(entity.CatalogItem) bool { return true }
+	}
+	ids := make(map[uuid.UUID]struct{})
+	for id, c := range r.st.categories {
+		if c.Path == path || strings.HasPrefix(c.Path, path+"/") {
+			ids[id] = struct{}{}
+		}
+	}
+	return func(item entity.CatalogItem) bool {
+		_, ok := ids[item.CategoryID]
+		return ok
+	}
+}
+
+// withCatalogDetails returns copies of stored items with their category paths, images,
+// inventory and review totals. The caller must hold the lock.
+func (r *Repo) withCatalogDetails(stored []entity.CatalogItem) []entity.CatalogItem {
+	items := make([]entity.CatalogItem, len(stored))
+	if len(stored) == 0 {
+		return items
+	}
+
+	now := time.Now()
+	index := make(map[uuid.UUID]int, len(stored))
+	for i, item := range stored {
+		item.Tags = slices.Clone(item.Tags)
+		item.SearchLanguage = ""
+		item.DeletedAt = nil
+		item.CategoryPath = r.st.categories[item.CategoryID].Path
+		if inv, ok := r.st.inventory[item.ID]; ok {
+			item.Price = clonePtr(inv.price)
+			item.StockQuantity = clonePtr(inv.stock)
+		}
+		items[i] = item
+		index[item.ID] = i
+	}
+
+	var images []entity.CatalogImage
+	for _, img := range r.st.images {
+		if _, ok := index[img.ItemID]; ok {
+			images = append(images, img)
+		}
+	}
+	slices.SortFunc(images, func(a, b entity.CatalogImage) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), compareIDs(a.ID, b.ID))
+	})
+	for _, img := range images {
+		i := index[img.ItemID]
+		items[i].Images = append(items[i].Images, img)
+	}
+
+	for _, res := range r.st.reservations {
+		if i, ok := index[res.ItemID]; ok && res.ExpiresAt.After(now) {
+			items[i].ReservedQuantity += res.Quantity
+		}
+	}
+	for _, review := range r.st.reviews {
+		if i, ok := index[review.ItemID]; ok && review.Status == entity.CatalogReviewApproved {
+			items[i].ReviewCount++
+			items[i].RatingSum += int64(review.Rating)
+		}
+	}
+	return items
+}
+
+// catalogItemsWhere returns the stored items matching keep, ordered by id.
+// The caller must hold the lock.
+func (r *Repo) catalogItemsWhere(keep func(item entity.CatalogItem) bool) []entity.CatalogItem {
+	var items []entity.CatalogItem
+	for _, id := range slices.SortedFunc(maps.Keys(r.st.items), compareIDs) {
+		if item := r.st.items[id]; keep(item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// compareIDs orders uuids like PostgreSQL does, byte by byte.
+func compareIDs(a, b uuid.UUID) int {
+	return bytes.Compare(a[:], b[:])
+}
@@ -0,0 +1,252 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+// ListCatalogCategories returns every category ordered by path.
+func (r *Repo) ListCatalogCategories(ctx context.Context) ([]entity.CatalogCategory, error) {
+	defer r.lock(ctx)()
+
+	categories := slices.Collect(maps.Values(r.st.categories))
+	slices.SortFunc(categories, func(a, b entity.CatalogCategory) int { return strings.Compare(a.Path, b.Path) })
+	if categories == nil {
+		categories = []entity.CatalogCategory{}
+	}
+	return categories, nil
+}
+
+// GetCatalogCategory retrieves a category by id.
+func (r *Repo) GetCatalogCategory(ctx context.Context, id uuid.UUID) (*entity.CatalogCategory, error) {
+	defer r.lock(ctx)()
+
+	category, ok := r.st.categories[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	return &category, nil
+}
+
+// CreateCatalogCategory inserts a category below category.ParentID and fills in its path and
+// generated fields. It returns entity.ErrNotFound if the parent does not exist and
+// entity.ErrConflict if the parent already has a child with the same slug.
+func (r *Repo) CreateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error {
+	defer r.lock(ctx)()
+
+	path, err := r.categoryPath(category.ParentID, category.Slug)
+	if err != nil {
+		return err
+	}
+	if r.categoryPathTaken(path, uuid.Nil) {
+		return entity.ErrConflict
+	}
+
+	now := time.Now()
+	created := entity.CatalogCategory{
+		ID:        uuid.New(),
+		ParentID:  category.ParentID,
+		Slug:      category.Slug,
+		Name:      category.Name,
+		Path:      path,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	setRow(r.st, r.st.categories, created.ID, created)
+
+	*category = created
+	return nil
+}
+
+// UpdateCatalogCategory renames and possibly moves a category, rewriting the paths of its
+// whole subtree. It returns entity.ErrNotFound if the category or the new parent does not
+// exist, entity.ErrConflict if the new path is taken, and a validation error if the new
+// parent lies within the category's own subtree.
+func (r *Repo) UpdateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error {
+	defer r.lock(ctx)()
+
+	current, ok := r.st.categories[category.ID]
+	if !ok {
+		return entity.ErrNotFound
+	}
+	if parent, ok := r.st.categories[category.ParentID]; ok {
+		if parent.Path == current.Path || strings.HasPrefix(parent.Path, current.Path+"/") {
+			return entity.NewValidationError("a category cannot be moved below itself")
+		}
+	}
+	path, err := r.categoryPath(category.ParentID, category.Slug)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	updated := current
+	updated.ParentID = category.ParentID
+	updated.Slug = category.Slug
+	updated.Name = category.Name
+	updated.Path = path
+	updated.UpdatedAt = now
+	err = r.atomic(func() error {
+		if r.categoryPathTaken(path, current.ID) {
+			return entity.ErrConflict
+		}
+		setRow(r.st, r.st.categories, current.ID, updated)
+		if path == current.Path {
+			return nil
+		}
+		for id, c := range r.st.categories {
+			if !strings.HasPrefix(c.Path, current.Path+"/") {
+				continue
+			}
+			c.Path = path + strings.TrimPrefix(c.Path, current.Path)
+			c.UpdatedAt = now
+			if r.categoryPathTaken(c.Path, id) {
+				return entity.ErrConflict
+			}
+			setRow(r.st, r.st.categories, id, c)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	*category = updated
+	return nil
+}
+
+// categoryPath returns the path of a category with slug below parentID. It returns
+// entity.ErrNotFound if the parent does not exist. The caller must hold the lock.
+func (r *Repo) categoryPath(parentID uuid.UUID, slug string) (string, error) {
+	if parentID == uuid.Nil {
+		return slug, nil
+	}
+	parent, ok := r.st.categories[parentID]
+	if !ok {
+		return "", entity.ErrNotFound
+	}
+	return parent.Path + "/" + slug, nil
+}
+
+// categoryPathTaken reports whether a category other than id has path. The caller must hold the lock.
+func (r *Repo) categoryPathTaken(path string, id uuid.UUID) bool {
+	for _, c := range r.st.categories {
+		if c.ID != id && c.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteCatalogCategory removes a category without subcategories; its items become
+// uncategorized. It returns entity.ErrConflict if the category still has subcategories.
+func (r *Repo) DeleteCatalogCategory(ctx context.Context, id uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	if _, ok := r.st.categories[id]; !ok {
+		return entity.ErrNotFound
+	}
+	for _, c := range r.st.categories {
+		if c.ParentID == id {
+			return entity.ErrConflict
+		}
+	}
+	deleteRow(r.st, r.st.categories, id)
+	for itemID, item := range r.st.items {
+		if item.CategoryID == id {
+			item.CategoryID = uuid.Nil
+			setRow(r.st, r.st.items, itemID, item)
+		}
+	}
+	return nil
+}
+
+// ListCatalogTags returns every tag with its number of items, ordered by name.
+func (r *Repo) ListCatalogTags(ctx context.Context) ([]entity.CatalogTag, error) {
+	defer r.lock(ctx)()
+
+	tags := make([]entity.CatalogTag, 0, len(r.st.tags))
+	for _, name := range slices.Sorted(maps.Keys(r.st.tags)) {
+		tags = append(tags, r.catalogTag(name))
+	}
+	return tags, nil
+}
+
+// catalogTag returns a tag with the number of live items carrying it. The caller must hold the lock.
+func (r *Repo) catalogTag(name string) entity.CatalogTag {
+	tag := entity.CatalogTag{Name: name}
+	for _, item := range r.st.items {
+		if item.DeletedAt == nil && slices.Contains(item.Tags, name) {
+			tag.Items++
+		}
+	}
+	return tag
+}
+
+// CreateCatalogTag adds a tag that no item carries yet. It returns entity.ErrConflict if the tag exists.
+func (r *Repo) CreateCatalogTag(ctx context.Context, name string) error {
+	defer r.lock(ctx)()
+
+	if _, ok := r.st.tags[name]; ok {
+		return entity.ErrConflict
+	}
+	setRow(r.st, r.st.tags, name, struct{}{})
+	return nil
+}
+
+// RenameCatalogTag renames a tag on every item carrying it. It returns entity.ErrConflict
+// if a tag named newName exists.
+func (r *Repo) RenameCatalogTag(ctx context.Context, name, newName string) (*entity.CatalogTag, error) {
+	defer r.lock(ctx)()
+
+	if _, ok := r.st.tags[name]; !ok {
+		return nil, entity.ErrNotFound
+	}
+	if newName != name {
+		if _, ok := r.st.tags[newName]; ok {
+			return nil, entity.ErrConflict
+		}
+		deleteRow(r.st, r.st.tags, name)
+		setRow(r.st, r.st.tags, newName, struct{}{})
+		r.replaceCatalogTag(name, newName)
+	}
+	tag := r.catalogTag(newName)
+	return &tag, nil
+}
+
+// DeleteCatalogTag removes a tag from every item and deletes it.
+func (r *Repo) DeleteCatalogTag(ctx context.Context, name string) error {
+	defer r.lock(ctx)()
+
+	if _, ok := r.st.tags[name]; !ok {
+		return entity.ErrNotFound
+	}
+	deleteRow(r.st, r.st.tags, name)
+	r.replaceCatalogTag(name, "")
+	return nil
+}
+
+// replaceCatalogTag renames name to newName on every item carrying it, or removes it when
+// newName is empty. The caller must hold the lock.
+func (r *Repo) replaceCatalogTag(name, newName string) {
+	for id, item := range r.st.items {
+		if !slices.Contains(item.Tags, name) {
+			continue
+		}
+		tags := slices.DeleteFunc(slices.Clone(item.Tags), func(tag string) bool { return tag == name })
+		if newName != "" {
+			tags = append(tags, newName)
+		}
+		item.Tags = nil
+		if len(tags) > 0 {
+			item.Tags = slices.Compact(slices.Sorted(slices.Values(tags)))
+		}
+		setRow(r.st, r.st.items, id, item)
+	}
+}
@@ -0,0 +1,309 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+type draftKey struct {
+	changesetID uuid.UUID
+	itemID      uuid.UUID
+}
+
+type draftRow struct {
+	changesetID uuid.UUID
+	draft       entity.CatalogDraft
+	before      *entity.CatalogItem // The item as it was before publishing, nil if it did not exist
+}
+
+// ListCatalogChangesets returns every changeset, newest first, without drafts.
+func (r *Repo) ListCatalogChangesets(ctx context.Context) ([]entity.CatalogChangeset, error) {
+	defer r.lock(ctx)()
+
+	changesets := slices.Collect(maps.Values(r.st.changesets))
+	slices.SortFunc(changesets, func(a, b entity.CatalogChangeset) int {
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), compareIDs(a.ID, b.ID))
+	})
+	if changesets == nil {
+		changesets = []entity.CatalogChangeset{}
+	}
+	return changesets, nil
+}
+
+// GetCatalogChangeset retrieves a changeset together with its drafts.
+func (r *Repo) GetCatalogChangeset(ctx context.Context, id uuid.UUID) (*entity.CatalogChangeset, error) {
+	defer r.lock(ctx)()
+
+	changeset, ok := r.st.changesets[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	drafts := r.catalogDrafts(id)
+	changeset.Drafts = make([]entity.CatalogDraft, len(drafts))
+	for i, d := range drafts {
+		changeset.Drafts[i] = d.draft
+		changeset.Drafts[i].Item.Tags = slices.Clone(d.draft.Item.Tags)
+		changeset.Drafts[i].Item.CategoryPath = r.st.categories[d.draft.Item.CategoryID].Path
+	}
+	return &changeset, nil
+}
+
+// CreateCatalogChangeset creates an empty draft changeset and fills in the generated fields.
+func (r *Repo) CreateCatalogChangeset(ctx context.Context, changeset *entity.CatalogChangeset) error {
+	defer r.lock(ctx)()
+
+	created := entity.CatalogChangeset{
+		ID:        uuid.New(),
+		Title:     changeset.Title,
+		Status:    entity.CatalogChangesetDraft,
+		CreatedBy: changeset.CreatedBy,
+		CreatedAt: time.Now(),
+	}
+	setRow(r.st, r.st.changesets, created.ID, created)
+
+	*changeset = created
+	return nil
+}
+
+// DeleteCatalogChangeset discards a draft changeset. Published changesets are kept as history.
+func (r *Repo) DeleteCatalogChangeset(ctx context.Context, id uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	if _, err := r.catalogChangeset(id, entity.CatalogChangesetDraft); err != nil {
+		return err
+	}
+	deleteRow(r.st, r.st.changesets, id)
+	for key := range r.st.drafts {
+		if key.changesetID == id {
+			deleteRow(r.st, r.st.drafts, key)
+		}
+	}
+	return nil
+}
+
+// SaveCatalogDraft stages draft in a draft changeset, replacing an earlier draft of the same item.
+func (r *Repo) SaveCatalogDraft(ctx context.Context, changesetID uuid.UUID, draft *entity.CatalogDraft) error {
+	defer r.lock(ctx)()
+
+	if _, err := r.catalogChangeset(changesetID, entity.CatalogChangesetDraft); err != nil {
+		return err
+	}
+
+	saved := entity.CatalogDraft{
+		Action:        draft.Action,
+		Item:          entity.CatalogItem{ID: draft.Item.ID, Tags: []string{}},
+		BaseUpdatedAt: clonePtr(draft.BaseUpdatedAt),
+		UpdatedAt:     time.Now(),
+	}
+	if draft.Action == entity.CatalogDraftUpsert {
+		saved.Item.Title = draft.Item.Title
+		saved.Item.Description = draft.Item.Description
+		saved.Item.Disabled = draft.Item.Disabled
+		saved.Item.CategoryID = draft.Item.CategoryID
+		if draft.Item.Tags != nil {
+			saved.Item.Tags = slices.Clone(draft.Item.Tags)
+		}
+	}
+	setRow(r.st, r.st.drafts, draftKey{changesetID, draft.Item.ID}, draftRow{changesetID: changesetID, draft: saved})
+
+	path := draft.Item.CategoryPath
+	*draft = saved
+	draft.Item.Tags = slices.Clone(saved.Item.Tags)
+	draft.Item.CategoryPath = path
+	return nil
+}
+
+// DeleteCatalogDraft removes the draft of an item from a draft changeset.
+func (r *Repo) DeleteCatalogDraft(ctx context.Context, changesetID, itemID uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	if _, err := r.catalogChangeset(changesetID, entity.CatalogChangesetDraft); err != nil {
+		return err
+	}
+	key := draftKey{changesetID, itemID}
+	if _, ok := r.st.drafts[key]; !ok {
+		return entity.ErrNotFound
+	}
+	deleteRow(r.st, r.st.drafts, key)
+	return nil
+}
+
+// PublishCatalogChangeset applies every draft of a changeset at once and records who
+// published it. The previous version of each item is kept for RollbackCatalogChangeset.
+// It returns an entity.ErrConflict error if the changeset is not a draft or an item changed
+// after its draft was staged; nothing is applied then.
+func (r *Repo) PublishCatalogChangeset(ctx context.Context, id, publishedBy uuid.UUID, language string) (*entity.CatalogChangeset, error) {
+	defer r.lock(ctx)()
+
+	changeset, err := r.catalogChangeset(id, entity.CatalogChangesetDraft)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = r.atomic(func() error {
+		for _, d := range r.catalogDrafts(id) {
+			itemID := d.draft.Item.ID
+			current, exists := r.liveCatalogItem(itemID)
+			base := d.draft.BaseUpdatedAt
+			if (base != nil) != exists || (exists && !current.UpdatedAt.Equal(*base)) {
+				return fmt.Errorf("%w: item %s changed after it was staged", entity.ErrConflict, itemID)
+			}
+
+			if exists {
+				before := r.withCatalogDetails([]entity.CatalogItem{current})[0]
+				d.before = &before
+				setRow(r.st, r.st.drafts, draftKey{id, itemID}, d)
+			}
+
+			event := catalogEvent(entity.EventCatalogUpdated, itemID)
+			switch d.draft.Action {
+			case entity.CatalogDraftDelete:
+				r.deleteCatalogItem(itemID, now)
+				event = catalogEvent(entity.EventCatalogDeleted, itemID)
+			default:
+				if err := r.writeCatalogItem(d.draft.Item, nil, language, now); err != nil {
+					return err
+				}
+				if !exists {
+					event = catalogEvent(entity.EventCatalogCreated, itemID)
+				}
+			}
+			r.addOutboxEvents(now, event)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changeset.Status = entity.CatalogChangesetPublished
+	changeset.PublishedBy = publishedBy
+	changeset.PublishedAt = &now
+	setRow(r.st, r.st.changesets, id, changeset)
+	return &changeset, nil
+}
+
+// RollbackCatalogChangeset restores every item of a published changeset to its version before
+// publishing, at once, and records who rolled it back. It returns an entity.ErrConflict error
+// if the changeset is not published or one of its items was changed after publishing; nothing
+// is restored then.
+func (r *Repo) RollbackCatalogChangeset(ctx context.Context, id, rolledBackBy uuid.UUID, language string) (*entity.CatalogChangeset, error) {
+	defer r.lock(ctx)()
+
+	changeset, err := r.catalogChangeset(id, entity.CatalogChangesetPublished)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = r.atomic(func() error {
+		for _, d := range r.catalogDrafts(id) {
+			itemID := d.draft.Item.ID
+			current, exists := r.liveCatalogItem(itemID)
+			// Publishing stamped every item it wrote with the publishing time.
+			var unchanged bool
+			if d.draft.Action == entity.CatalogDraftDelete {
+				unchanged = !exists
+			} else {
+				unchanged = exists && current.UpdatedAt.Equal(*changeset.PublishedAt)
+			}
+			if !unchanged {
+				return fmt.Errorf("%w: item %s changed after publishing", entity.ErrConflict, itemID)
+			}
+
+			if d.before == nil {
+				r.deleteCatalogItem(itemID, now)
+				r.addOutboxEvents(now, catalogEvent(entity.EventCatalogDeleted, itemID))
+				continue
+			}
+			if err := r.writeCatalogItem(*d.before, &d.before.CreatedAt, language, now); err != nil {
+				return err
+			}
+			event := catalogEvent(entity.EventCatalogUpdated, itemID)
+			if !exists {
+				event = catalogEvent(entity.EventCatalogRestored, itemID)
+			}
+			r.addOutboxEvents(now, event)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changeset.Status = entity.CatalogChangesetRolledBack
+	changeset.RolledBackBy = rolledBackBy
+	changeset.RolledBackAt = &now
+	setRow(r.st, r.st.changesets, id, changeset)
+	return &changeset, nil
+}
+
+// catalogChangeset returns a changeset after checking its status. The caller must hold the lock.
+func (r *Repo) catalogChangeset(id uuid.UUID, status entity.CatalogChangesetStatus) (entity.CatalogChangeset, error) {
+	changeset, ok := r.st.changesets[id]
+	if !ok {
+		return changeset, entity.ErrNotFound
+	}
+	if changeset.Status != status {
+		return changeset, fmt.Errorf("%w: changeset is %s", entity.ErrConflict, changeset.Status)
+	}
+	return changeset, nil
+}
+
+// catalogDrafts returns the drafts of a changeset, oldest first. The caller must hold the lock.
+func (r *Repo) catalogDrafts(changesetID uuid.UUID) []draftRow {
+	var drafts []draftRow
+	for key, d := range r.st.drafts {
+		if key.changesetID == changesetID {
+			drafts = append(drafts, d)
+		}
+	}
+	slices.SortFunc(drafts, func(a, b draftRow) int {
+		return cmp.Or(a.draft.UpdatedAt.Compare(b.draft.UpdatedAt), compareIDs(a.draft.Item.ID, b.draft.Item.ID))
+	})
+	return drafts
+}
+
+// writeCatalogItem creates or replaces an item under its id, together with its tags, and
+// restores it if it was deleted. createdAt is kept for existing items and defaults to now for
+// new ones. An empty SKU keeps the SKU of an existing item. A category deleted or a SKU taken
+// in the meantime is reported as a conflict. The caller must hold the lock.
+func (r *Repo) writeCatalogItem(item entity.CatalogItem, createdAt *time.Time, language string, now time.Time) error {
+	if item.CategoryID != uuid.Nil {
+		if _, ok := r.st.categories[item.CategoryID]; !ok {
+			return fmt.Errorf("%w: the category of item %s no longer exists", entity.ErrConflict, item.ID)
+		}
+	}
+
+	stored, exists := r.st.items[item.ID]
+	if !exists {
+		stored = entity.CatalogItem{ID: item.ID, CreatedAt: now}
+		if createdAt != nil {
+			stored.CreatedAt = *createdAt
+		}
+	}
+	if item.SKU != "" {
+		stored.SKU = item.SKU
+	}
+	if err := r.checkCatalogItemRefs(entity.CatalogItem{SKU: stored.SKU}, item.ID); err != nil {
+		return fmt.Errorf("%w: the sku of item %s is used by another item", entity.ErrConflict, item.ID)
+	}
+	stored.Title = item.Title
+	stored.Description = item.Description
+	stored.Disabled = item.Disabled
+	stored.CategoryID = item.CategoryID
+	stored.SearchLanguage = language
+	stored.Tags = r.addCatalogTags(item.Tags)
+	stored.UpdatedAt = now
+	stored.DeletedAt = nil
+	setRow(r.st, r.st.items, item.ID, stored)
+	return nil
+}
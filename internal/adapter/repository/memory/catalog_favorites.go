@@ -0,0 +1,151 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+type userItemKey struct {
+	userID uuid.UUID
+	itemID uuid.UUID
+}
+
+// AddCatalogFavorite marks an item as a favorite of a user. Adding a favorite twice is not an
+// error. It returns entity.ErrNotFound if the item does not exist or is deleted.
+func (r *Repo) AddCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	if _, ok := r.liveCatalogItem(itemID); !ok {
+		return entity.ErrNotFound
+	}
+	key := userItemKey{userID, itemID}
+	if _, ok := r.st.favorites[key]; !ok {
+		setRow(r.st, r.st.favorites, key, time.Now())
+	}
+	return nil
+}
+
+// DeleteCatalogFavorite unmarks a favorite item of a user. It returns entity.ErrNotFound if
+// the item is not a favorite of the user.
+func (r *Repo) DeleteCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	key := userItemKey{userID, itemID}
+	if _, ok := r.st.favorites[key]; !ok {
+		return entity.ErrNotFound
+	}
+	deleteRow(r.st, r.st.favorites, key)
+	return nil
+}
+
+// ListCatalogFavorites retrieves up to limit favorite items of a user after the after cursor,
+// most recently added first. A nil after starts at the first favorite.
+func (r *Repo) ListCatalogFavorites(ctx context.Context, userID uuid.UUID, after *entity.CatalogFavoriteCursor, limit int) ([]entity.CatalogFavorite, error) {
+	defer r.lock(ctx)()
+
+	var favorites []entity.CatalogFavorite
+	for key, addedAt := range r.st.favorites {
+		if key.userID != userID {
+			continue
+		}
+		if after != nil && compareFavorites(addedAt, key.itemID, after.AddedAt, after.ItemID) <= 0 {
+			continue
+		}
+		if item, ok := r.liveCatalogItem(key.itemID); ok {
+			favorites = append(favorites, entity.CatalogFavorite{Item: item, AddedAt: addedAt})
+		}
+	}
+	slices.SortFunc(favorites, func(a, b entity.CatalogFavorite) int {
+		return compareFavorites(a.AddedAt, a.Item.ID, b.AddedAt, b.Item.ID)
+	})
+	if len(favorites) > limit {
+		favorites = favorites[:limit]
+	}
+
+	items := make([]entity.CatalogItem, len(favorites))
+	for i := range favorites {
+		items[i] = favorites[i].Item
+	}
+	for i, item := range r.withCatalogDetails(items) {
+		favorites[i].Item = item
+	}
+	return favorites, nil
+}
+
+// compareFavorites orders favorites like the PostgreSQL listing: most recently added first,
+// ties broken by descending item id.
+func compareFavorites(aAdded time.Time, aID uuid.UUID, bAdded time.Time, bID uuid.UUID) int {
+	return cmp.Or(bAdded.Compare(aAdded), compareIDs(bID, aID))
+}
+
+// ListCatalogFavoriteIDs returns those of itemIDs that are favorites of a user.
+func (r *Repo) ListCatalogFavoriteIDs(ctx context.Context, userID uuid.UUID, itemIDs []uuid.UUID) ([]uuid.UUID, error) {
+	defer r.lock(ctx)()
+
+	var ids []uuid.UUID
+	for _, id := range itemIDs {
+		if _, ok := r.st.favorites[userItemKey{userID, id}]; ok && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// RecordCatalogView records that a user viewed an item now and drops all but the keep most
+// recent views of the user. It returns entity.ErrNotFound if the item does not exist or is deleted.
+func (r *Repo) RecordCatalogView(ctx context.Context, userID, itemID uuid.UUID, keep int) error {
+	defer r.lock(ctx)()
+
+	if _, ok := r.liveCatalogItem(itemID); !ok {
+		return entity.ErrNotFound
+	}
+	setRow(r.st, r.st.views, userItemKey{userID, itemID}, time.Now())
+
+	var views []userItemKey
+	for key := range r.st.views {
+		if key.userID == userID {
+			views = append(views, key)
+		}
+	}
+	if len(views) <= keep {
+		return nil
+	}
+	slices.SortFunc(views, func(a, b userItemKey) int {
+		return cmp.Or(r.st.views[b].Compare(r.st.views[a]), compareIDs(a.itemID, b.itemID))
+	})
+	for _, key := range views[max(keep, 0):] {
+		deleteRow(r.st, r.st.views, key)
+	}
+	return nil
+}
+
+// ListRecentlyViewedCatalogItems retrieves the items a user viewed, most recently viewed first.
+func (r *Repo) ListRecentlyViewedCatalogItems(ctx context.Context, userID uuid.UUID) ([]entity.CatalogItem, error) {
+	defer r.lock(ctx)()
+
+	return r.withCatalogDetails(r.userCatalogItems(r.st.views, userID)), nil
+}
+
+// userCatalogItems returns the live items of a user in the views table, most recent first.
+// The caller must hold the lock.
+func (r *Repo) userCatalogItems(table map[userItemKey]time.Time, userID uuid.UUID) []entity.CatalogItem {
+	var items []entity.CatalogItem
+	for key := range table {
+		if key.userID != userID {
+			continue
+		}
+		if item, ok := r.liveCatalogItem(key.itemID); ok {
+			items = append(items, item)
+		}
+	}
+	slices.SortFunc(items, func(a, b entity.CatalogItem) int {
+		ta, tb := table[userItemKey{userID, a.ID}], table[userItemKey{userID, b.ID}]
+		return cmp.Or(tb.Compare(ta), compareIDs(a.ID, b.ID))
+	})
+	return items
+}
@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+// CreateCatalogImage records an image under its preassigned id and fills in the generated
+// fields. It returns entity.ErrNotFound if the item does not exist.
+func (r *Repo) CreateCatalogImage(ctx context.Context, img *entity.CatalogImage) error {
+	defer r.lock(ctx)()
+
+	if _, ok := r.st.items[img.ItemID]; !ok {
+		return entity.ErrNotFound
+	}
+	if _, ok := r.st.images[img.ID]; ok {
+		return entity.ErrConflict
+	}
+
+	now := time.Now()
+	created := entity.CatalogImage{
+		ID:          img.ID,
+		ItemID:      img.ItemID,
+		ContentType: img.ContentType,
+		Width:       img.Width,
+		Height:      img.Height,
+		Size:        img.Size,
+		CreatedAt:   now,
+	}
+	setRow(r.st, r.st.images, created.ID, created)
+	r.addOutboxEvents(now, catalogEvent(entity.EventCatalogUpdated, img.ItemID))
+
+	*img = created
+	return nil
+}
+
+// GetCatalogImage retrieves a catalog image by id. Images of deleted items are not found.
+func (r *Repo) GetCatalogImage(ctx context.Context, id uuid.UUID) (*entity.CatalogImage, error) {
+	defer r.lock(ctx)()
+
+	img, ok := r.st.images[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	if _, ok := r.liveCatalogItem(img.ItemID); !ok {
+		return nil, entity.ErrNotFound
+	}
+	return &img, nil
+}
+
+// DeleteCatalogImage deletes an image of an item. It returns entity.ErrNotFound if the item
+// has no image with that id.
+func (r *Repo) DeleteCatalogImage(ctx context.Context, itemID, id uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	img, ok := r.st.images[id]
+	if !ok || img.ItemID != itemID {
+		return entity.ErrNotFound
+	}
+	deleteRow(r.st, r.st.images, id)
+	r.addOutboxEvents(time.Now(), catalogEvent(entity.EventCatalogUpdated, itemID))
+	return nil
+}
+
+// CatalogImageExists reports whether an image with id is recorded.
+func (r *Repo) CatalogImageExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	defer r.lock(ctx)()
+
+	_, ok := r.st.images[id]
+	return ok, nil
+}
@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+// errDryRun makes a dry run import discard its writes.
+var errDryRun = errors.New("dry run")
+
+// ImportCatalogItems applies import rows at once, matching items by SKU. Upserts create the
+// items with unknown SKUs and update the others; with opts.Prune, items whose SKU is not among
+// rows are deleted as well. If a delete row names an unknown SKU, nothing is written and the
+// SKUs are reported in Missing. A dry run discards its writes after counting.
+// It returns an entity.ErrConflict error if a SKU or a category changed concurrently.
+func (r *Repo) ImportCatalogItems(ctx context.Context, rows []entity.CatalogImportRow, opts entity.CatalogImportOptions, language string) (*entity.CatalogImportResult, error) {
+	defer r.lock(ctx)()
+
+	ids := make(map[string]uuid.UUID)
+	for _, item := range r.st.items {
+		if item.SKU != "" && item.DeletedAt == nil {
+			ids[item.SKU] = item.ID
+		}
+	}
+
+	res := &entity.CatalogImportResult{}
+	for _, row := range rows {
+		if row.Action == entity.CatalogImportDelete {
+			if _, ok := ids[row.Item.SKU]; !ok {
+				res.Missing = append(res.Missing, row.Item.SKU)
+			}
+		}
+	}
+	if len(res.Missing) > 0 {
+		return res, nil
+	}
+
+	now := time.Now()
+	err := r.atomic(func() error {
+		var events []entity.OutboxEvent
+		for _, row := range rows {
+			id, exists := ids[row.Item.SKU]
+			switch {
+			case row.Action == entity.CatalogImportDelete:
+				r.deleteCatalogItem(id, now)
+				events = append(events, catalogEvent(entity.EventCatalogDeleted, id))
+				res.Deleted++
+			case exists:
+				if err := r.checkImportedCategory(row.Item); err != nil {
+					return err
+				}
+				if _, err := r.updateCatalogItem(id, row.Item, language, now); err != nil {
+					return err
+				}
+				events = append(events, catalogEvent(entity.EventCatalogUpdated, id))
+				res.Updated++
+			default:
+				if err := r.checkImportedCategory(row.Item); err != nil {
+					return err
+				}
+				item, err := r.insertCatalogItem(row.Item, language, now)
+				if err != nil {
+					return fmt.Errorf("%w: sku %q was created concurrently", entity.ErrConflict, row.Item.SKU)
+				}
+				events = append(events, catalogEvent(entity.EventCatalogCreated, item.ID))
+				res.Created++
+			}
+		}
+
+		if opts.Prune {
+			skus := make(map[string]struct{}, len(rows))
+			for _, row := range rows {
+				skus[row.Item.SKU] = struct{}{}
+			}
+			for _, id := range slices.SortedFunc(maps.Keys(r.st.items), compareIDs) {
+				item := r.st.items[id]
+				if _, ok := skus[item.SKU]; ok || item.SKU == "" || item.DeletedAt != nil {
+					continue
+				}
+				r.deleteCatalogItem(id, now)
+				events = append(events, catalogEvent(entity.EventCatalogDeleted, id))
+				res.Deleted++
+			}
+		}
+
+		if opts.DryRun {
+			return errDryRun
+		}
+		r.addOutboxEvents(now, events...)
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+	return res, nil
+}
+
+// checkImportedCategory reports a category deleted after the import was validated as a
+// conflict. The caller must hold the lock.
+func (r *Repo) checkImportedCategory(item entity.CatalogItem) error {
+	if item.CategoryID == uuid.Nil {
+		return nil
+	}
+	if _, ok := r.st.categories[item.CategoryID]; !ok {
+		return fmt.Errorf("%w: the category of sku %q no longer exists", entity.ErrConflict, item.SKU)
+	}
+	return nil
+}
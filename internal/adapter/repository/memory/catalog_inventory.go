@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+type inventoryRow struct {
+	price *entity.Money
+	stock *int64 // Nil when the stock is not tracked
+}
+
+// SetCatalogInventory replaces the price and stock quantity of an item. A nil price removes
+// the price and a nil stock stops tracking the stock. It returns entity.ErrNotFound if the
+// item does not exist or is deleted.
+func (r *Repo) SetCatalogInventory(ctx context.Context, id uuid.UUID, price *entity.Money, stock *int64) (*entity.CatalogItem, error) {
+	defer r.lock(ctx)()
+
+	if _, ok := r.liveCatalogItem(id); !ok {
+		return nil, entity.ErrNotFound
+	}
+	setRow(r.st, r.st.inventory, id, inventoryRow{price: clonePtr(price), stock: clonePtr(stock)})
+	r.addOutboxEvents(time.Now(), catalogEvent(entity.EventCatalogUpdated, id))
+	return r.getCatalogItem(id)
+}
+
+// ReserveCatalogItem reserves res.Quantity units of res.ItemID for res.UserID until
+// res.ExpiresAt and fills in the generated fields. Reservations are serialized by the
+// repository lock, so they cannot oversell the stock. It returns entity.ErrNotFound if the
+// item does not exist or is deleted, and entity.ErrConflict if the item is disabled, its
+// stock is not tracked or too few units are left.
+func (r *Repo) ReserveCatalogItem(ctx context.Context, res *entity.CatalogReservation) error {
+	defer r.lock(ctx)()
+
+	item, ok := r.liveCatalogItem(res.ItemID)
+	if !ok {
+		return entity.ErrNotFound
+	}
+	inventory, ok := r.st.inventory[res.ItemID]
+	if !ok {
+		return fmt.Errorf("%w: the stock of item %s is not tracked", entity.ErrConflict, res.ItemID)
+	}
+	if item.Disabled {
+		return fmt.Errorf("%w: item %s is disabled", entity.ErrConflict, res.ItemID)
+	}
+	if inventory.stock == nil {
+		return fmt.Errorf("%w: the stock of item %s is not tracked", entity.ErrConflict, res.ItemID)
+	}
+
+	now := time.Now()
+	var reserved int64
+	for id, other := range r.st.reservations {
+		if other.ItemID != res.ItemID {
+			continue
+		}
+		if !other.ExpiresAt.After(now) {
+			deleteRow(r.st, r.st.reservations, id)
+			continue
+		}
+		reserved += other.Quantity
+	}
+	if available := max(*inventory.stock-reserved, 0); available < res.Quantity {
+		return fmt.Errorf("%w: only %d units of item %s are available", entity.ErrConflict, available, res.ItemID)
+	}
+
+	created := entity.CatalogReservation{
+		ID:        uuid.New(),
+		ItemID:    res.ItemID,
+		UserID:    res.UserID,
+		Quantity:  res.Quantity,
+		UnitPrice: clonePtr(inventory.price),
+		CreatedAt: now,
+		ExpiresAt: res.ExpiresAt,
+	}
+	setRow(r.st, r.st.reservations, created.ID, created)
+
+	*res = created
+	res.UnitPrice = clonePtr(created.UnitPrice)
+	return nil
+}
+
+// ReleaseCatalogReservation deletes a reservation of an item made by userID. It returns
+// entity.ErrNotFound if there is no such reservation, e.g. because it expired and was purged.
+func (r *Repo) ReleaseCatalogReservation(ctx context.Context, itemID, reservationID, userID uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	res, ok := r.st.reservations[reservationID]
+	if !ok || res.ItemID != itemID || res.UserID != userID {
+		return entity.ErrNotFound
+	}
+	deleteRow(r.st, r.st.reservations, reservationID)
+	return nil
+}
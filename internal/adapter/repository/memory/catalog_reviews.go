@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+// GetCatalogReview retrieves the review of an item left by userID. It returns
+// entity.ErrNotFound if the user has not reviewed the item or the item is deleted.
+func (r *Repo) GetCatalogReview(ctx context.Context, itemID, userID uuid.UUID) (*entity.CatalogReview, error) {
+	defer r.lock(ctx)()
+
+	if _, ok := r.liveCatalogItem(itemID); !ok {
+		return nil, entity.ErrNotFound
+	}
+	review, ok := r.catalogReview(itemID, userID)
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	return &review, nil
+}
+
+// SaveCatalogReview creates or replaces the review of review.ItemID by review.UserID and
+// fills in the generated fields. Replacing a rejected review makes it pending, whatever
+// review.Status asks for. The rating totals of an item are computed from its approved
+// reviews when it is read. It returns entity.ErrNotFound if the item does not exist or is deleted.
+func (r *Repo) SaveCatalogReview(ctx context.Context, review *entity.CatalogReview) error {
+	defer r.lock(ctx)()
+
+	if _, ok := r.liveCatalogItem(review.ItemID); !ok {
+		return entity.ErrNotFound
+	}
+
+	now := time.Now()
+	saved, ok := r.catalogReview(review.ItemID, review.UserID)
+	if !ok {
+		saved = entity.CatalogReview{
+			ID:        uuid.New(),
+			ItemID:    review.ItemID,
+			UserID:    review.UserID,
+			CreatedAt: now,
+		}
+	}
+	saved.Rating = review.Rating
+	saved.Body = review.Body
+	if saved.Status == entity.CatalogReviewRejected {
+		// Edits of a rejected review go back to moderation.
+		saved.Status = entity.CatalogReviewPending
+	} else {
+		saved.Status = review.Status
+	}
+	saved.UpdatedAt = now
+	setRow(r.st, r.st.reviews, saved.ID, saved)
+	r.addOutboxEvents(now, catalogEvent(entity.EventCatalogUpdated, saved.ItemID))
+
+	*review = saved
+	return nil
+}
+
+// DeleteCatalogReview deletes the review of an item left by userID. It returns
+// entity.ErrNotFound if there is no such review.
+func (r *Repo) DeleteCatalogReview(ctx context.Context, itemID, userID uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	review, ok := r.catalogReview(itemID, userID)
+	if !ok {
+		return entity.ErrNotFound
+	}
+	deleteRow(r.st, r.st.reviews, review.ID)
+	r.addOutboxEvents(time.Now(), catalogEvent(entity.EventCatalogUpdated, itemID))
+	return nil
+}
+
+// SetCatalogReviewStatus moderates a review and stamps its update time. It returns
+// entity.ErrNotFound if the review does not exist.
+func (r *Repo) SetCatalogReviewStatus(ctx context.Context, id uuid.UUID, status entity.CatalogReviewStatus) (*entity.CatalogReview, error) {
+	defer r.lock(ctx)()
+
+	review, ok := r.st.reviews[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	now := time.Now()
+	review.Status = status
+	review.UpdatedAt = now
+	setRow(r.st, r.st.reviews, id, review)
+	r.addOutboxEvents(now, catalogEvent(entity.EventCatalogUpdated, review.ItemID))
+	return &review, nil
+}
+
+// ListCatalogReviews retrieves up to limit reviews of an item with the given status, newest first.
+func (r *Repo) ListCatalogReviews(ctx context.Context, itemID uuid.UUID, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error) {
+	defer r.lock(ctx)()
+
+	reviews := r.catalogReviewsWhere(func(review entity.CatalogReview) bool {
+		return review.ItemID == itemID && review.Status == status
+	})
+	slices.SortFunc(reviews, func(a, b entity.CatalogReview) int {
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), compareIDs(a.ID, b.ID))
+	})
+	return truncate(reviews, limit), nil
+}
+
+// ListCatalogReviewsByStatus retrieves up to limit reviews of all items with the given
+// status, least recently changed first.
+func (r *Repo) ListCatalogReviewsByStatus(ctx context.Context, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error) {
+	defer r.lock(ctx)()
+
+	reviews := r.catalogReviewsWhere(func(review entity.CatalogReview) bool { return review.Status == status })
+	slices.SortFunc(reviews, func(a, b entity.CatalogReview) int {
+		return cmp.Or(a.UpdatedAt.Compare(b.UpdatedAt), compareIDs(a.ID, b.ID))
+	})
+	return truncate(reviews, limit), nil
+}
+
+// catalogReview returns the review of an item left by userID. The caller must hold the lock.
+func (r *Repo) catalogReview(itemID, userID uuid.UUID) (entity.CatalogReview, bool) {
+	for _, review := range r.st.reviews {
+		if review.ItemID == itemID && review.UserID == userID {
+			return review, true
+		}
+	}
+	return entity.CatalogReview{}, false
+}
+
+// catalogReviewsWhere returns the reviews of live items matching keep. The caller must hold the lock.
+func (r *Repo) catalogReviewsWhere(keep func(review entity.CatalogReview) bool) []entity.CatalogReview {
+	reviews := []entity.CatalogReview{}
+	for _, review := range r.st.reviews {
+		if _, ok := r.liveCatalogItem(review.ItemID); ok && keep(review) {
+			reviews = append(reviews, review)
+		}
+	}
+	return reviews
+}
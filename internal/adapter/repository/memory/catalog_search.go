@@ -0,0 +1,236 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+
+	"base_app/internal/entity"
+)
+
+// Markers put around matched words, as ts_headline does for the PostgreSQL repository.
+const (
+	highlightStart = "\x01"
+	highlightStop  = "\x02"
+)
+
+var highlightReplacer = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+// SearchCatalogItems runs a query over titles and descriptions and returns the best ranked
+// matches. It approximates PostgreSQL full-text search without stemming: every query word
+// not prefixed with "-" must start a word of the item, and words prefixed with "-" must not.
+// Title matches rank higher than description matches.
+func (r *Repo) SearchCatalogItems(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error) {
+	defer r.lock(ctx)()
+
+	var include, exclude []string
+	for _, field := range strings.Fields(strings.ToLower(q.Query)) {
+		negated := strings.HasPrefix(field, "-")
+		for _, word := range searchWords(field) {
+			if negated {
+				exclude = append(exclude, word)
+			} else if word != "or" {
+				include = append(include, word)
+			}
+		}
+	}
+	if len(include) == 0 {
+		return []entity.CatalogSearchHit{}, nil
+	}
+
+	type match struct {
+		item entity.CatalogItem
+		rank float32
+	}
+	var matches []match
+	for _, item := range r.st.items {
+		if item.DeletedAt != nil || q.Disabled != nil && item.Disabled != *q.Disabled {
+			continue
+		}
+		title, description := searchWords(item.Title), searchWords(item.Description)
+		matchesAny := func(word string) bool {
+			return slices.ContainsFunc(title, hasPrefix(word)) || slices.ContainsFunc(description, hasPrefix(word))
+		}
+		if !allOf(include, matchesAny) || slices.ContainsFunc(exclude, matchesAny) {
+			continue
+		}
+		var rank float32
+		for _, word := range include {
+			if slices.ContainsFunc(title, hasPrefix(word)) {
+				rank += 1
+			}
+			if slices.ContainsFunc(description, hasPrefix(word)) {
+				rank += 0.5
+			}
+		}
+		matches = append(matches, match{item: item, rank: rank / float32(len(title)+len(description))})
+	}
+	slices.SortFunc(matches, func(a, b match) int {
+		return cmp.Or(cmp.Compare(b.rank, a.rank), compareIDs(a.item.ID, b.item.ID))
+	})
+	matches = truncate(matches, int32(q.Limit))
+
+	stored := make([]entity.CatalogItem, len(matches))
+	for i, m := range matches {
+		stored[i] = m.item
+	}
+	items := r.withCatalogDetails(stored)
+	hits := make([]entity.CatalogSearchHit, len(matches))
+	for i, m := range matches {
+		hits[i] = entity.CatalogSearchHit{
+			Item:                 items[i],
+			Score:                m.rank,
+			TitleHighlight:       highlightHTML(markWords(m.item.Title, include)),
+			DescriptionHighlight: highlightHTML(markWords(m.item.Description, include)),
+		}
+	}
+	return hits, nil
+}
+
+// SearchCatalogItemsFuzzy returns items whose title contains words similar to the query, by
+// the trigram word similarity of pg_trgm. It tolerates typos that SearchCatalogItems cannot,
+// at the cost of ignoring descriptions.
+func (r *Repo) SearchCatalogItemsFuzzy(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error) {
+	defer r.lock(ctx)()
+
+	type match struct {
+		item       entity.CatalogItem
+		similarity float32
+	}
+	var matches []match
+	for _, item := range r.st.items {
+		if item.DeletedAt != nil || q.Disabled != nil && item.Disabled != *q.Disabled {
+			continue
+		}
+		if s := wordSimilarity(q.Query, item.Title); s > 0 && s >= q.FuzzyThreshold {
+			matches = append(matches, match{item: item, similarity: s})
+		}
+	}
+	slices.SortFunc(matches, func(a, b match) int {
+		return cmp.Or(cmp.Compare(b.similarity, a.similarity), compareIDs(a.item.ID, b.item.ID))
+	})
+	matches = truncate(matches, int32(q.Limit))
+
+	stored := make([]entity.CatalogItem, len(matches))
+	for i, m := range matches {
+		stored[i] = m.item
+	}
+	items := r.withCatalogDetails(stored)
+	hits := make([]entity.CatalogSearchHit, len(matches))
+	for i, m := range matches {
+		hits[i] = entity.CatalogSearchHit{
+			Item:                 items[i],
+			Score:                m.similarity,
+			TitleHighlight:       html.EscapeString(m.item.Title),
+			DescriptionHighlight: html.EscapeString(m.item.Description),
+		}
+	}
+	return hits, nil
+}
+
+// SetCatalogSearchLanguage records language on every item not yet indexed with it.
+// It returns the number of items that changed.
+func (r *Repo) SetCatalogSearchLanguage(ctx context.Context, language string) (int64, error) {
+	defer r.lock(ctx)()
+
+	var n int64
+	for id, item := range r.st.items {
+		if item.SearchLanguage != language {
+			item.SearchLanguage = language
+			setRow(r.st, r.st.items, id, item)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// searchWords splits s into lowercase words of letters and digits.
+func searchWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+}
+
+func hasPrefix(prefix string) func(word string) bool {
+	return func(word string) bool { return strings.HasPrefix(word, prefix) }
+}
+
+func allOf(words []string, match func(word string) bool) bool {
+	for _, word := range words {
+		if !match(word) {
+			return false
+		}
+	}
+	return true
+}
+
+// markWords wraps every word of s starting with one of prefixes in highlight markers.
+func markWords(s string, prefixes []string) string {
+	var b strings.Builder
+	word := func(c rune) bool { return unicode.IsLetter(c) || unicode.IsDigit(c) }
+	for len(s) > 0 {
+		end := strings.IndexFunc(s, func(c rune) bool { return !word(c) })
+		if end == 0 {
+			next := strings.IndexFunc(s, word)
+			if next < 0 {
+				next = len(s)
+			}
+			b.WriteString(s[:next])
+			s = s[next:]
+			continue
+		}
+		if end < 0 {
+			end = len(s)
+		}
+		w := s[:end]
+		if slices.ContainsFunc(prefixes, func(p string) bool { return strings.HasPrefix(strings.ToLower(w), p) }) {
+			b.WriteString(highlightStart + w + highlightStop)
+		} else {
+			b.WriteString(w)
+		}
+		s = s[end:]
+	}
+	return b.String()
+}
+
+// wordSimilarity approximates word_similarity of pg_trgm: the largest share of the trigrams
+// of query found in a run of consecutive words of text.
+func wordSimilarity(query, text string) float32 {
+	want := trigrams(searchWords(query))
+	if len(want) == 0 {
+		return 0
+	}
+	words := searchWords(text)
+	var best float32
+	for i := range words {
+		for j := i + 1; j <= len(words); j++ {
+			var common int
+			for t := range trigrams(words[i:j]) {
+				if _, ok := want[t]; ok {
+					common++
+				}
+			}
+			best = max(best, float32(common)/float32(len(want)))
+		}
+	}
+	return best
+}
+
+// trigrams returns the trigrams of words, each padded like pg_trgm does.
+func trigrams(words []string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = struct{}{}
+		}
+	}
+	return set
+}
+
+func highlightHTML(s string) string {
+	return highlightReplacer.Replace(html.EscapeString(s))
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+type translationKey struct {
+	itemID uuid.UUID
+	locale string
+}
+
+// ListCatalogTranslations returns the translations of an item ordered by locale.
+func (r *Repo) ListCatalogTranslations(ctx context.Context, itemID uuid.UUID) ([]entity.CatalogTranslation, error) {
+	defer r.lock(ctx)()
+
+	translations := []entity.CatalogTranslation{}
+	for key, t := range r.st.translations {
+		if key.itemID == itemID {
+			translations = append(translations, t)
+		}
+	}
+	slices.SortFunc(translations, func(a, b entity.CatalogTranslation) int { return strings.Compare(a.Locale, b.Locale) })
+	return translations, nil
+}
+
+// ListCatalogTranslationsForItems returns the translations of the given items into any of
+// the given locales, in no particular order.
+func (r *Repo) ListCatalogTranslationsForItems(ctx context.Context, itemIDs []uuid.UUID, locales []string) ([]entity.CatalogTranslation, error) {
+	if len(itemIDs) == 0 || len(locales) == 0 {
+		return nil, nil
+	}
+	defer r.lock(ctx)()
+
+	var translations []entity.CatalogTranslation
+	for _, id := range itemIDs {
+		for _, locale := range locales {
+			if t, ok := r.st.translations[translationKey{id, locale}]; ok {
+				translations = append(translations, t)
+			}
+		}
+	}
+	return translations, nil
+}
+
+// SaveCatalogTranslation creates or replaces the translation of an item into one locale and
+// fills in the generated fields. It returns entity.ErrNotFound if the item does not exist.
+func (r *Repo) SaveCatalogTranslation(ctx context.Context, t *entity.CatalogTranslation) error {
+	defer r.lock(ctx)()
+
+	if _, ok := r.st.items[t.ItemID]; !ok {
+		return entity.ErrNotFound
+	}
+
+	now := time.Now()
+	key := translationKey{t.ItemID, t.Locale}
+	saved := entity.CatalogTranslation{
+		ItemID:      t.ItemID,
+		Locale:      t.Locale,
+		Title:       t.Title,
+		Description: t.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if old, ok := r.st.translations[key]; ok {
+		saved.CreatedAt = old.CreatedAt
+	}
+	setRow(r.st, r.st.translations, key, saved)
+	r.addOutboxEvents(now, catalogEvent(entity.EventCatalogUpdated, t.ItemID))
+
+	*t = saved
+	return nil
+}
+
+// DeleteCatalogTranslation deletes the translation of an item into one locale.
+func (r *Repo) DeleteCatalogTranslation(ctx context.Context, itemID uuid.UUID, locale string) error {
+	defer r.lock(ctx)()
+
+	key := translationKey{itemID, locale}
+	if _, ok := r.st.translations[key]; !ok {
+		return entity.ErrNotFound
+	}
+	deleteRow(r.st, r.st.translations, key)
+	r.addOutboxEvents(time.Now(), catalogEvent(entity.EventCatalogUpdated, itemID))
+	return nil
+}
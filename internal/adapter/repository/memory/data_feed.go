@@ -0,0 +1,135 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"base_app/internal/entity"
+)
+
+type subscription struct {
+	prefix string
+	ch     chan entity.DataEvent
+}
+
+// DataFeed fans the data changes of a Repo out to subscribers once they are committed.
+// Deleting a key, soft or for good, is published as a deletion; restoring it as an update.
+type DataFeed struct {
+	buffer int
+
+	mu   sync.Mutex
+	subs map[*subscription]struct{}
+}
+
+// NewDataFeed creates a new DataFeed. buffer is the number of events queued per
+// subscriber before a slow subscriber is disconnected.
+func NewDataFeed(buffer int) *DataFeed {
+	return &DataFeed{
+		buffer: buffer,
+		subs:   make(map[*subscription]struct{}),
+	}
+}
+
+// Run waits until ctx is cancelled and then disconnects every subscriber. Changes are
+// published by the repository, so there is nothing to listen to.
+func (f *DataFeed) Run(ctx context.Context) {
+	<-ctx.Done()
+	f.DisconnectAll()
+}
+
+// Subscribe returns a channel of events for keys starting with prefix.
+// The channel is closed when ctx is done or when the subscriber falls too far behind.
+func (f *DataFeed) Subscribe(ctx context.Context, prefix string) <-chan entity.DataEvent {
+	sub := &subscription{
+		prefix: prefix,
+		ch:     make(chan entity.DataEvent, f.buffer),
+	}
+
+	f.mu.Lock()
+	f.subs[sub] = struct{}{}
+	f.mu.Unlock()
+
+	context.AfterFunc(ctx, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.remove(sub)
+	})
+	return sub.ch
+}
+
+func (f *DataFeed) publish(ev entity.DataEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for sub := range f.subs {
+		if !strings.HasPrefix(ev.Key, sub.prefix) {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+			// The subscriber is too slow; it can resume from its last event id.
+			f.remove(sub)
+		}
+	}
+}
+
+// remove unregisters a subscriber and closes its channel. The caller must hold f.mu.
+func (f *DataFeed) remove(sub *subscription) {
+	if _, ok := f.subs[sub]; !ok {
+		return
+	}
+	delete(f.subs, sub)
+	close(sub.ch)
+}
+
+// DisconnectAll closes every subscriber channel, ending their streams.
+func (f *DataFeed) DisconnectAll() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for sub := range f.subs {
+		f.remove(sub)
+	}
+}
+
+// ListDataChanges returns create and update events after the given version id for keys starting with prefix.
+// Deletions cannot be replayed because the versions no longer exist.
+func (r *Repo) ListDataChanges(ctx context.Context, afterID int64, prefix string, limit int32) ([]entity.DataEvent, error) {
+	defer r.lock(ctx)()
+
+	var events []entity.DataEvent
+	for key, versions := range r.st.data {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		for i, row := range versions {
+			if row.ID <= afterID || row.DeletedAt != nil {
+				continue
+			}
+			ev := entity.DataEvent{ID: row.ID, Type: entity.DataEventCreated, Key: key, Data: toData(row)}
+			if i > 0 {
+				ev.Type = entity.DataEventUpdated
+			}
+			events = append(events, ev)
+		}
+	}
+	slices.SortFunc(events, func(a, b entity.DataEvent) int { return cmp.Compare(a.ID, b.ID) })
+	return truncate(events, limit), nil
+}
+
+// truncate returns the first limit elements of s.
+func truncate[S ~[]E, E any](s S, limit int32) S {
+	if limit >= 0 && len(s) > int(limit) {
+		return s[:limit]
+	}
+	return s
+}
+
+// restoredDataEvent is the change published for a key that became live again.
+func restoredDataEvent(row dataRow) entity.DataEvent {
+	return entity.DataEvent{ID: row.ID, Type: entity.DataEventUpdated, Key: row.Key, Data: toData(row)}
+}
@@ -0,0 +1,214 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"base_app/internal/entity"
+	"base_app/internal/usecase"
+)
+
+// dataImportTx implements usecase.DataImportTx by buffering writes until Commit, which
+// applies them in one go. Chunks see the keys written by earlier chunks of the import.
+type dataImportTx struct {
+	r *Repo
+
+	mu      sync.Mutex
+	done    bool
+	writes  []importWrite
+	written map[string]entity.Data // Latest record written per key
+	deleted map[string]struct{}    // Keys whose stored versions an upsert replaces
+}
+
+// importWrite is a chunk as it will be applied: the stored versions of replaced keys are
+// soft deleted and records are added as new versions once check accepts the usage.
+type importWrite struct {
+	replace []string
+	records []entity.Data
+	check   func(others entity.DataUsage, records []entity.Data) error
+}
+
+var errImportDone = errors.New("import transaction is already committed or rolled back")
+
+// BeginDataImport starts a buffered bulk data import.
+func (r *Repo) BeginDataImport(_ context.Context) (usecase.DataImportTx, error) {
+	return &dataImportTx{
+		r:       r,
+		written: make(map[string]entity.Data),
+		deleted: make(map[string]struct{}),
+	}, nil
+}
+
+// WriteChunk resolves conflicts with existing keys and buffers the records. The quota check
+// sees the writes buffered by earlier chunks and runs again on Commit, against the usage of
+// the owner at that time.
+func (t *dataImportTx) WriteChunk(ctx context.Context, records []entity.Data, onConflict entity.ConflictMode, check func(others entity.DataUsage, records []entity.Data) error) (*entity.ImportChunkResult, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done {
+		return nil, errImportDone
+	}
+
+	existing := t.liveKeys(ctx, records)
+	res := &entity.ImportChunkResult{}
+	var replace []string
+	if len(existing) > 0 {
+		switch onConflict {
+		case entity.ConflictFail:
+			res.Conflicts = existing
+			return res, nil
+		case entity.ConflictSkip:
+			res.Conflicts = existing
+			records = slices.DeleteFunc(slices.Clone(records), func(rec entity.Data) bool {
+				return slices.Contains(existing, rec.Key)
+			})
+		case entity.ConflictUpsert:
+			replace = existing
+			res.Updated = len(existing)
+		}
+	}
+
+	if check != nil && len(records) > 0 {
+		if err := check(t.usage(ctx, records), records); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, key := range replace {
+		t.deleted[key] = struct{}{}
+	}
+	for _, rec := range records {
+		t.written[rec.Key] = rec
+	}
+	t.writes = append(t.writes, importWrite{replace: replace, records: slices.Clone(records), check: check})
+	res.Created = len(records) - res.Updated
+	return res, nil
+}
+
+// liveKeys returns the distinct keys of records that are live, either in the repository or
+// among the records written earlier by this import.
+func (t *dataImportTx) liveKeys(ctx context.Context, records []entity.Data) []string {
+	defer t.r.lock(ctx)()
+
+	now := time.Now()
+	live := make(map[string]struct{})
+	for _, rec := range records {
+		if w, ok := t.written[rec.Key]; ok && (w.ExpiresAt == nil || w.ExpiresAt.After(now)) {
+			live[rec.Key] = struct{}{}
+			continue
+		}
+		if _, ok := t.deleted[rec.Key]; ok {
+			continue
+		}
+		if _, ok := t.r.liveData(rec.Key, now); ok {
+			live[rec.Key] = struct{}{}
+		}
+	}
+	return slices.Sorted(maps.Keys(live))
+}
+
+// usage returns the usage of the owner of records, who are the same, of the keys other than
+// those of records, counting the writes buffered by the import.
+func (t *dataImportTx) usage(ctx context.Context, records []entity.Data) entity.DataUsage {
+	defer t.r.lock(ctx)()
+
+	now := time.Now()
+	ownerID := records[0].OwnerID
+	skip := make(map[string]struct{}, len(records))
+	for _, rec := range records {
+		skip[rec.Key] = struct{}{}
+	}
+	var usage entity.DataUsage
+	count := func(data entity.Data) {
+		if data.OwnerID == ownerID && (data.ExpiresAt == nil || data.ExpiresAt.After(now)) {
+			usage.KeyCount++
+			usage.TotalBytes += int64(len(data.Value))
+		}
+	}
+	for key, w := range t.written {
+		if _, ok := skip[key]; !ok {
+			count(w)
+		}
+	}
+	for key := range t.r.st.data {
+		_, skipped := skip[key]
+		_, written := t.written[key]
+		_, deleted := t.deleted[key]
+		if skipped || written || deleted {
+			continue
+		}
+		if cur, ok := t.r.liveData(key, now); ok {
+			count(cur.Data)
+		}
+	}
+	return usage
+}
+
+// Commit applies every buffered chunk to the repository. The quota check of each chunk runs
+// again before it is applied; when one fails, nothing is applied and its error is returned.
+func (t *dataImportTx) Commit(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done {
+		return errImportDone
+	}
+	t.done = true
+
+	defer t.r.lock(ctx)()
+	now := time.Now()
+	return t.r.atomic(func() error {
+		for _, w := range t.writes {
+			if w.check != nil && len(w.records) > 0 {
+				keys := make([]string, len(w.records))
+				for i, rec := range w.records {
+					keys[i] = rec.Key
+				}
+				if err := w.check(t.r.dataUsage(w.records[0].OwnerID, keys, now), w.records); err != nil {
+					return err
+				}
+			}
+			t.r.softDeleteData(w.replace, now)
+			for i := range w.records {
+				t.r.saveData(&w.records[i], now)
+			}
+		}
+		return nil
+	})
+}
+
+// Rollback discards the buffered chunks.
+func (t *dataImportTx) Rollback(_ context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done {
+		return errImportDone
+	}
+	t.done = true
+	t.writes = nil
+	return nil
+}
+
+// ExportData calls fn for the latest live value of every key, in key order. The values are
+// copied first, so fn runs without holding the repository lock.
+func (r *Repo) ExportData(ctx context.Context, fn func(*entity.Data) error) error {
+	unlock := r.lock(ctx)
+	now := time.Now()
+	var entries []*entity.Data
+	for _, key := range slices.Sorted(maps.Keys(r.st.data)) {
+		if row, ok := r.liveData(key, now); ok {
+			entries = append(entries, toData(row))
+		}
+	}
+	unlock()
+
+	for _, data := range entries {
+		if err := fn(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
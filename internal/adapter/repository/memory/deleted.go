@@ -0,0 +1,208 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+// DeleteData soft deletes every version of a key. It returns entity.ErrNotFound if the key
+// has no live value.
+func (r *Repo) DeleteData(ctx context.Context, key string) error {
+	defer r.lock(ctx)()
+
+	now := time.Now()
+	if _, ok := r.liveData(key, now); !ok {
+		return entity.ErrNotFound
+	}
+	r.softDeleteData([]string{key}, now)
+	r.addOutboxEvents(now, dataEvent(entity.EventDataDeleted, key))
+	r.st.changes = append(r.st.changes, entity.DataEvent{Type: entity.DataEventDeleted, Key: key})
+	return nil
+}
+
+// softDeleteData stamps every version of keys that is not deleted yet. The caller must hold
+// the lock.
+func (r *Repo) softDeleteData(keys []string, now time.Time) {
+	for _, key := range keys {
+		versions := slices.Clone(r.st.data[key])
+		for i := range versions {
+			if versions[i].DeletedAt == nil {
+				versions[i].DeletedAt = &now
+			}
+		}
+		if len(versions) > 0 {
+			setRow(r.st, r.st.data, key, versions)
+		}
+	}
+}
+
+// ListDeletedData retrieves the last value of up to limit deleted keys that have not been
+// written again, most recently deleted first.
+func (r *Repo) ListDeletedData(ctx context.Context, limit int32) ([]entity.Data, error) {
+	defer r.lock(ctx)()
+
+	now := time.Now()
+	var entries []entity.Data
+	for key, versions := range r.st.data {
+		if _, ok := r.liveData(key, now); ok {
+			continue
+		}
+		for i := len(versions) - 1; i >= 0; i-- {
+			if versions[i].DeletedAt != nil {
+				data := toData(versions[i])
+				data.DeletedAt = clonePtr(versions[i].DeletedAt)
+				entries = append(entries, *data)
+				break
+			}
+		}
+	}
+	slices.SortFunc(entries, func(a, b entity.Data) int {
+		return cmp.Or(b.DeletedAt.Compare(*a.DeletedAt), strings.Compare(a.Key, b.Key))
+	})
+	return truncate(entries, limit), nil
+}
+
+// RestoreData undeletes every version of a key and returns its current value. It returns
+// entity.ErrNotFound if the key has no deleted versions and entity.ErrConflict if the key
+// was written again after it was deleted.
+func (r *Repo) RestoreData(ctx context.Context, key string) (*entity.Data, error) {
+	defer r.lock(ctx)()
+
+	now := time.Now()
+	if _, ok := r.liveData(key, now); ok {
+		return nil, fmt.Errorf("%w: key %q has been written again since it was deleted", entity.ErrConflict, key)
+	}
+
+	// The versions written after the deletion are expired or hidden by an expired one and
+	// would hide the restored versions, so they are removed.
+	versions := slices.DeleteFunc(slices.Clone(r.st.data[key]), func(row dataRow) bool { return row.DeletedAt == nil })
+	if len(versions) == 0 {
+		return nil, entity.ErrNotFound
+	}
+	for i := range versions {
+		versions[i].DeletedAt = nil
+	}
+	prev := r.st.data[key]
+	setRow(r.st, r.st.data, key, versions)
+	row, ok := r.liveData(key, now)
+	if !ok {
+		// Every restored version has expired meanwhile.
+		setRow(r.st, r.st.data, key, prev)
+		return nil, entity.ErrNotFound
+	}
+
+	r.addOutboxEvents(now, dataEvent(entity.EventDataRestored, key))
+	r.st.changes = append(r.st.changes, restoredDataEvent(row))
+	return toData(row), nil
+}
+
+// PurgeDeletedData permanently removes data versions deleted before cutoff and returns the
+// number of removed rows. The repository purges everything at once; batchSize is accepted
+// for interface compatibility.
+func (r *Repo) PurgeDeletedData(ctx context.Context, cutoff time.Time, _ int32) (int64, error) {
+	defer r.lock(ctx)()
+
+	return r.deleteDataVersions(func(row dataRow) bool {
+		return row.DeletedAt != nil && row.DeletedAt.Before(cutoff)
+	}), nil
+}
+
+// ListDeletedCatalogItems retrieves up to limit deleted catalog items, most recently deleted first.
+func (r *Repo) ListDeletedCatalogItems(ctx context.Context, limit int32) ([]entity.CatalogItem, error) {
+	defer r.lock(ctx)()
+
+	deleted := r.catalogItemsWhere(func(item entity.CatalogItem) bool { return item.DeletedAt != nil })
+	slices.SortFunc(deleted, func(a, b entity.CatalogItem) int {
+		return cmp.Or(b.DeletedAt.Compare(*a.DeletedAt), compareIDs(a.ID, b.ID))
+	})
+	deleted = truncate(deleted, limit)
+
+	items := r.withCatalogDetails(deleted)
+	for i, item := range deleted {
+		items[i].DeletedAt = clonePtr(item.DeletedAt)
+	}
+	return items, nil
+}
+
+// RestoreCatalogItem undeletes a catalog item. It returns entity.ErrNotFound if the item is
+// not deleted and entity.ErrConflict if another item took over its sku meanwhile.
+func (r *Repo) RestoreCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error) {
+	defer r.lock(ctx)()
+
+	item, ok := r.st.items[id]
+	if !ok || item.DeletedAt == nil {
+		return nil, entity.ErrNotFound
+	}
+	if err := r.checkCatalogItemRefs(entity.CatalogItem{SKU: item.SKU}, id); err != nil {
+		return nil, fmt.Errorf("%w: the sku of item %s is used by another item", entity.ErrConflict, id)
+	}
+
+	now := time.Now()
+	item.DeletedAt = nil
+	item.UpdatedAt = now
+	setRow(r.st, r.st.items, id, item)
+	r.addOutboxEvents(now, catalogEvent(entity.EventCatalogRestored, id))
+
+	items := r.withCatalogDetails([]entity.CatalogItem{item})
+	return &items[0], nil
+}
+
+// PurgeDeletedCatalogItems permanently removes catalog items deleted before cutoff, together
+// with everything attached to them, and returns the number of removed items. The repository
+// purges everything at once; batchSize is accepted for interface compatibility.
+func (r *Repo) PurgeDeletedCatalogItems(ctx context.Context, cutoff time.Time, _ int32) (int64, error) {
+	defer r.lock(ctx)()
+
+	var n int64
+	for id, item := range r.st.items {
+		if item.DeletedAt != nil && item.DeletedAt.Before(cutoff) {
+			r.purgeCatalogItem(id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// purgeCatalogItem removes an item and every row referencing it, like the cascading foreign
+// keys of the catalog tables. The caller must hold the lock.
+func (r *Repo) purgeCatalogItem(id uuid.UUID) {
+	deleteRow(r.st, r.st.items, id)
+	deleteRow(r.st, r.st.inventory, id)
+	for key := range r.st.translations {
+		if key.itemID == id {
+			deleteRow(r.st, r.st.translations, key)
+		}
+	}
+	for imageID, img := range r.st.images {
+		if img.ItemID == id {
+			deleteRow(r.st, r.st.images, imageID)
+		}
+	}
+	for key := range r.st.favorites {
+		if key.itemID == id {
+			deleteRow(r.st, r.st.favorites, key)
+		}
+	}
+	for key := range r.st.views {
+		if key.itemID == id {
+			deleteRow(r.st, r.st.views, key)
+		}
+	}
+	for resID, res := range r.st.reservations {
+		if res.ItemID == id {
+			deleteRow(r.st, r.st.reservations, resID)
+		}
+	}
+	for reviewID, review := range r.st.reviews {
+		if review.ItemID == id {
+			deleteRow(r.st, r.st.reviews, reviewID)
+		}
+	}
+}
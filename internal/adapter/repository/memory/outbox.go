@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+type outboxRow struct {
+	entity.OutboxEvent
+	PublishedAt *time.Time
+	LastError   string
+}
+
+// RelayOutboxEvents hands up to limit pending events, oldest first, to publish and marks the
+// first delivered of them as published. When publish fails, the failure is recorded on the
+// first event it did not deliver, and the rest wait for the next call. The repository lock
+// is not held while publish runs, so sinks may call the repository; a relay already running
+// makes the call return without publishing anything.
+func (r *Repo) RelayOutboxEvents(ctx context.Context, limit int32, publish func(events []entity.OutboxEvent) (delivered int, err error)) (int, error) {
+	if !r.relayMu.TryLock() {
+		return 0, nil
+	}
+	defer r.relayMu.Unlock()
+
+	unlock := r.lock(ctx)
+	var events []entity.OutboxEvent
+	for _, row := range r.st.outbox {
+		if row.PublishedAt == nil {
+			events = append(events, row.OutboxEvent)
+		}
+	}
+	events = truncate(events, limit)
+	unlock()
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	delivered, publishErr := publish(slices.Clone(events))
+
+	defer r.lock(ctx)()
+	now := time.Now()
+	r.st.ownOutbox()
+	for i := range r.st.outbox {
+		row := &r.st.outbox[i]
+		switch {
+		case slices.ContainsFunc(events[:delivered], func(ev entity.OutboxEvent) bool { return ev.ID == row.ID }):
+			row.PublishedAt = &now
+		case publishErr != nil && delivered < len(events) && row.ID == events[delivered].ID:
+			row.Attempts++
+			row.LastAttemptAt = now
+			row.LastError = publishErr.Error()
+		}
+	}
+	return delivered, publishErr
+}
+
+// PurgePublishedOutboxEvents removes events published before cutoff and returns the number
+// of removed events. The repository purges everything at once; batchSize is accepted for
+// interface compatibility.
+func (r *Repo) PurgePublishedOutboxEvents(ctx context.Context, cutoff time.Time, _ int32) (int64, error) {
+	defer r.lock(ctx)()
+
+	r.st.ownOutbox()
+	n := len(r.st.outbox)
+	r.st.outbox = slices.DeleteFunc(r.st.outbox, func(row outboxRow) bool {
+		return row.PublishedAt != nil && row.PublishedAt.Before(cutoff)
+	})
+	return int64(n - len(r.st.outbox)), nil
+}
+
+// addOutboxEvents records events together with the change they describe. The caller must
+// hold the lock.
+func (r *Repo) addOutboxEvents(now time.Time, events ...entity.OutboxEvent) {
+	for _, ev := range events {
+		r.st.outboxSeq++
+		ev.ID = r.st.outboxSeq
+		ev.CreatedAt = now
+		r.st.outbox = append(r.st.outbox, outboxRow{OutboxEvent: ev})
+	}
+}
+
+func dataEvent(eventType entity.OutboxEventType, key string) entity.OutboxEvent {
+	return outboxEvent(eventType, entity.DataEventPayload{Key: key})
+}
+
+func catalogEvent(eventType entity.OutboxEventType, id uuid.UUID) entity.OutboxEvent {
+	return outboxEvent(eventType, entity.CatalogEventPayload{ID: id})
+}
+
+func outboxEvent(eventType entity.OutboxEventType, payload any) entity.OutboxEvent {
+	// The payloads are plain structs of strings and ids, which always marshal.
+	data, _ := json.Marshal(payload)
+	return entity.OutboxEvent{Type: eventType, Payload: data}
+}
@@ -0,0 +1,477 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+// Repo implements the use case repository interfaces in process memory, for development
+// without a database. It follows the semantics of the sqlc queries: soft deletion, versioned
+// data, outbox events written together with each mutation. Everything is lost on restart.
+//
+// Every call holds the lock of the repository for its whole duration, so calls are atomic
+// and serialized. Rows are written only through setRow and deleteRow and are never modified
+// in place, so a unit of work journals the rows it replaces and restores just those when it
+// fails.
+type Repo struct {
+	mu      sync.Mutex
+	st      *state
+	feed    *DataFeed
+	relayMu sync.Mutex
+}
+
+// state holds every table of the repository.
+type state struct {
+	users map[uuid.UUID]entity.User
+
+	data    map[string][]dataRow // Versions of each key, oldest first
+	dataSeq int64
+	changes []entity.DataEvent // Data events published when the lock is released
+	schemas map[string]entity.DataSchema
+
+	blobs       map[string]blob
+	attachments map[uuid.UUID]entity.Attachment
+
+	items        map[uuid.UUID]entity.CatalogItem // Columns of the catalog table and the item's tags
+	tags         map[string]struct{}
+	categories   map[uuid.UUID]entity.CatalogCategory
+	changesets   map[uuid.UUID]entity.CatalogChangeset
+	drafts       map[draftKey]draftRow
+	translations map[translationKey]entity.CatalogTranslation
+	images       map[uuid.UUID]entity.CatalogImage
+	favorites    map[userItemKey]time.Time
+	views        map[userItemKey]time.Time
+	inventory    map[uuid.UUID]inventoryRow
+	reservations map[uuid.UUID]entity.CatalogReservation
+	reviews      map[uuid.UUID]entity.CatalogReview
+
+	outbox    []outboxRow
+	outboxSeq int64
+
+	endpoints  map[uuid.UUID]entity.WebhookEndpoint
+	deliveries map[uuid.UUID]entity.WebhookDelivery
+
+	undo []func() // Restores the rows written in the running unit of work; nil outside of one
+}
+
+type dataRow struct {
+	ID int64
+	entity.Data
+}
+
+// New creates an empty repository that publishes data changes to feed. Users are added
+// with AddUsers.
+func New(feed *DataFeed) *Repo {
+	return &Repo{
+		st: &state{
+			users:        make(map[uuid.UUID]entity.User),
+			data:         make(map[string][]dataRow),
+			schemas:      make(map[string]entity.DataSchema),
+			blobs:        make(map[string]blob),
+			attachments:  make(map[uuid.UUID]entity.Attachment),
+			items:        make(map[uuid.UUID]entity.CatalogItem),
+			tags:         make(map[string]struct{}),
+			categories:   make(map[uuid.UUID]entity.CatalogCategory),
+			changesets:   make(map[uuid.UUID]entity.CatalogChangeset),
+			drafts:       make(map[draftKey]draftRow),
+			translations: make(map[translationKey]entity.CatalogTranslation),
+			images:       make(map[uuid.UUID]entity.CatalogImage),
+			favorites:    make(map[userItemKey]time.Time),
+			views:        make(map[userItemKey]time.Time),
+			inventory:    make(map[uuid.UUID]inventoryRow),
+			reservations: make(map[uuid.UUID]entity.CatalogReservation),
+			reviews:      make(map[uuid.UUID]entity.CatalogReview),
+			endpoints:    make(map[uuid.UUID]entity.WebhookEndpoint),
+			deliveries:   make(map[uuid.UUID]entity.WebhookDelivery),
+		},
+		feed: feed,
+	}
+}
+
+// savepoint is the state at the start of a unit of work, apart from the rows it journals.
+type savepoint struct {
+	undo      int
+	changes   int
+	outbox    []outboxRow
+	dataSeq   int64
+	outboxSeq int64
+}
+
+func (s *state) savepoint() savepoint {
+	return savepoint{
+		undo:      len(s.undo),
+		changes:   len(s.changes),
+		outbox:    s.outbox,
+		dataSeq:   s.dataSeq,
+		outboxSeq: s.outboxSeq,
+	}
+}
+
+// rollbackTo undoes every write made since sp was taken.
+func (s *state) rollbackTo(sp savepoint) {
+	for i := len(s.undo) - 1; i >= sp.undo; i-- {
+		s.undo[i]()
+	}
+	s.undo = s.undo[:sp.undo]
+	s.changes = s.changes[:sp.changes]
+	s.outbox = sp.outbox
+	s.dataSeq = sp.dataSeq
+	s.outboxSeq = sp.outboxSeq
+}
+
+// setRow stores v under k in the table m of s.
+func setRow[K comparable, V any](s *state, m map[K]V, k K, v V) {
+	journal(s, m, k)
+	m[k] = v
+}
+
+// deleteRow removes the row k from the table m of s.
+func deleteRow[K comparable, V any](s *state, m map[K]V, k K) {
+	journal(s, m, k)
+	delete(m, k)
+}
+
+// journal records how to restore the row k of m when a unit of work is running.
+func journal[K comparable, V any](s *state, m map[K]V, k K) {
+	if s.undo == nil {
+		return
+	}
+	old, ok := m[k]
+	s.undo = append(s.undo, func() {
+		if ok {
+			m[k] = old
+		} else {
+			delete(m, k)
+		}
+	})
+}
+
+// ownOutbox lets the caller modify outbox rows in place. Within a unit of work the rows are
+// copied first, so the savepoints keep the rows they saw.
+func (s *state) ownOutbox() {
+	if s.undo != nil {
+		s.outbox = slices.Clone(s.outbox)
+	}
+}
+
+// txKey is the context key marking a unit of work that holds the lock of a repository.
+type txKey struct{}
+
+// inTx reports whether ctx runs in a unit of work of r, which already holds its lock.
+func (r *Repo) inTx(ctx context.Context) bool {
+	held, _ := ctx.Value(txKey{}).(*Repo)
+	return held == r
+}
+
+// lock acquires the repository for one call and returns the function releasing it.
+// Within a unit of work the lock is already held and nothing happens.
+func (r *Repo) lock(ctx context.Context) func() {
+	if r.inTx(ctx) {
+		return func() {}
+	}
+	r.mu.Lock()
+	return r.unlock
+}
+
+// unlock releases the repository and publishes the data changes made while it was held.
+func (r *Repo) unlock() {
+	changes := r.st.changes
+	r.st.changes = nil
+	r.mu.Unlock()
+
+	for _, ev := range changes {
+		r.feed.publish(ev)
+	}
+}
+
+// WithinTx calls fn with a context in which every call to the repository runs under one
+// lock, committed together when fn returns nil. When fn fails, the state is restored to
+// what it was before fn, outbox events and data changes included. Nested calls restore
+// only what their own fn changed. Calls made with other contexts wait until fn returns,
+// so fn must not wait for them.
+func (r *Repo) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !r.inTx(ctx) {
+		r.mu.Lock()
+		defer r.unlock()
+		ctx = context.WithValue(ctx, txKey{}, r)
+	}
+	return r.atomic(func() error { return fn(ctx) })
+}
+
+// atomic runs fn and restores the state when it fails, so multi-step writes happen
+// completely or not at all. Only the rows fn writes are journaled. The caller must hold
+// the lock.
+func (r *Repo) atomic(fn func() error) error {
+	if r.st.undo == nil {
+		r.st.undo = []func(){}
+		defer func() { r.st.undo = nil }()
+	}
+	sp := r.st.savepoint()
+	if err := fn(); err != nil {
+		r.st.rollbackTo(sp)
+		return err
+	}
+	return nil
+}
+
+// AddUsers stores users, replacing any with the same ID. It seeds the accounts of the
+// inmemory auth provider, as the repository has no other way to create users.
+func (r *Repo) AddUsers(users ...entity.User) {
+	defer r.lock(context.Background())()
+
+	for _, u := range users {
+		setRow(r.st, r.st.users, u.ID, u)
+	}
+}
+
+// GetUserByEmail retrieves a user by their email address.
+func (r *Repo) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
+	defer r.lock(ctx)()
+
+	for _, u := range r.st.users {
+		if u.Email == email {
+			return &u, nil
+		}
+	}
+	return nil, entity.ErrNotFound
+}
+
+// SetUserLocale stores the preferred locale of a user; an empty locale clears it.
+func (r *Repo) SetUserLocale(ctx context.Context, id uuid.UUID, locale string) error {
+	defer r.lock(ctx)()
+
+	u, ok := r.st.users[id]
+	if !ok {
+		return entity.ErrNotFound
+	}
+	u.Locale = locale
+	setRow(r.st, r.st.users, id, u)
+	return nil
+}
+
+// SaveData saves data as the newest version of its key.
+func (r *Repo) SaveData(ctx context.Context, data *entity.Data) error {
+	defer r.lock(ctx)()
+
+	r.saveData(data, time.Now())
+	return nil
+}
+
+// LockDataUsage returns the owner's usage of their keys other than excludeKeys. Within a
+// unit of work the repository lock is held until it ends, which serializes the writes.
+func (r *Repo) LockDataUsage(ctx context.Context, ownerID uuid.UUID, excludeKeys []string) (*entity.DataUsage, error) {
+	defer r.lock(ctx)()
+
+	usage := r.dataUsage(ownerID, excludeKeys, time.Now())
+	return &usage, nil
+}
+
+// GetDataUsage returns the number and total size of the live keys the owner wrote last.
+func (r *Repo) GetDataUsage(ctx context.Context, ownerID uuid.UUID) (*entity.DataUsage, error) {
+	defer r.lock(ctx)()
+
+	usage := r.dataUsage(ownerID, nil, time.Now())
+	return &usage, nil
+}
+
+// dataUsage counts the live keys other than excludeKeys whose current version was written
+// by ownerID.
+func (r *Repo) dataUsage(ownerID uuid.UUID, excludeKeys []string, now time.Time) entity.DataUsage {
+	var usage entity.DataUsage
+	for key := range r.st.data {
+		if slices.Contains(excludeKeys, key) {
+			continue
+		}
+		cur, ok := r.liveData(key, now)
+		if ok && cur.OwnerID == ownerID {
+			usage.KeyCount++
+			usage.TotalBytes += int64(len(cur.Value))
+		}
+	}
+	return usage
+}
+
+// saveData appends a version of data.Key with its outbox event and data change.
+// The caller must hold the lock.
+func (r *Repo) saveData(data *entity.Data, now time.Time) {
+	r.st.dataSeq++
+	row := dataRow{ID: r.st.dataSeq, Data: entity.Data{
+		OwnerID:   data.OwnerID,
+		Key:       data.Key,
+		Value:     bytes.Clone(data.Value),
+		ExpiresAt: clonePtr(data.ExpiresAt),
+		CreatedAt: now,
+	}}
+	versions := r.st.data[data.Key]
+	eventType := entity.DataEventCreated
+	if len(versions) > 0 {
+		eventType = entity.DataEventUpdated
+	}
+	setRow(r.st, r.st.data, data.Key, append(slices.Clip(versions), row))
+
+	r.addOutboxEvents(now, dataEvent(entity.EventDataSaved, data.Key))
+	r.st.changes = append(r.st.changes, entity.DataEvent{ID: row.ID, Type: eventType, Key: row.Key, Data: toData(row)})
+}
+
+// GetData retrieves the current value stored under a key.
+func (r *Repo) GetData(ctx context.Context, key string) (*entity.Data, error) {
+	defer r.lock(ctx)()
+
+	row, ok := r.liveData(key, time.Now())
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	return toData(row), nil
+}
+
+// liveData returns the newest version of key unless it expired or was deleted: an expired
+// newest version hides the older ones. The caller must hold the lock.
+func (r *Repo) liveData(key string, now time.Time) (dataRow, bool) {
+	versions := r.st.data[key]
+	if len(versions) == 0 || !isLiveData(versions[len(versions)-1], now) {
+		return dataRow{}, false
+	}
+	return versions[len(versions)-1], true
+}
+
+func isLiveData(row dataRow, now time.Time) bool {
+	return row.DeletedAt == nil && !isExpiredData(row, now)
+}
+
+func isExpiredData(row dataRow, now time.Time) bool {
+	return row.ExpiresAt != nil && !row.ExpiresAt.After(now)
+}
+
+// PurgeExpiredData deletes expired data together with the older versions of its keys, which
+// it hides for good, and returns the number of deleted rows. Soft deleted versions are kept
+// for restores. The repository purges everything at once; batchSize is accepted for
+// interface compatibility.
+func (r *Repo) PurgeExpiredData(ctx context.Context, _ int32) (int64, error) {
+	defer r.lock(ctx)()
+
+	now := time.Now()
+	hiddenBelow := make(map[string]int64) // ID of the newest expired version of each key
+	for key, versions := range r.st.data {
+		for i := len(versions) - 1; i >= 0; i-- {
+			if isExpiredData(versions[i], now) {
+				hiddenBelow[key] = versions[i].ID
+				break
+			}
+		}
+	}
+	return r.deleteDataVersions(func(row dataRow) bool {
+		return isExpiredData(row, now) || row.DeletedAt == nil && row.ID < hiddenBelow[row.Key]
+	}), nil
+}
+
+// deleteDataVersions removes the versions matching drop and returns their number. Removing
+// the newest version of a key is published as a deletion. The caller must hold the lock.
+func (r *Repo) deleteDataVersions(drop func(row dataRow) bool) int64 {
+	var n int64
+	for _, key := range slices.Sorted(maps.Keys(r.st.data)) {
+		versions := r.st.data[key]
+		kept := slices.DeleteFunc(slices.Clone(versions), drop)
+		removed := len(versions) - len(kept)
+		if removed == 0 {
+			continue
+		}
+		n += int64(removed)
+		if len(kept) == 0 || kept[len(kept)-1].ID != versions[len(versions)-1].ID {
+			r.st.changes = append(r.st.changes, entity.DataEvent{Type: entity.DataEventDeleted, Key: key})
+		}
+		if len(kept) == 0 {
+			deleteRow(r.st, r.st.data, key)
+		} else {
+			setRow(r.st, r.st.data, key, kept)
+		}
+	}
+	return n
+}
+
+// GetDataSchemaForKey returns the schema with the longest prefix matching the key.
+func (r *Repo) GetDataSchemaForKey(ctx context.Context, key string) (*entity.DataSchema, error) {
+	defer r.lock(ctx)()
+
+	var (
+		best  entity.DataSchema
+		found bool
+	)
+	for prefix, schema := range r.st.schemas {
+		if strings.HasPrefix(key, prefix) && (!found || len(prefix) > len(best.Prefix)) {
+			best, found = schema, true
+		}
+	}
+	if !found {
+		return nil, entity.ErrNotFound
+	}
+	return &best, nil
+}
+
+// ListDataSchemas retrieves all registered data schemas ordered by prefix.
+func (r *Repo) ListDataSchemas(ctx context.Context) ([]entity.DataSchema, error) {
+	defer r.lock(ctx)()
+
+	schemas := make([]entity.DataSchema, 0, len(r.st.schemas))
+	for _, prefix := range slices.Sorted(maps.Keys(r.st.schemas)) {
+		schemas = append(schemas, r.st.schemas[prefix])
+	}
+	return schemas, nil
+}
+
+// SaveDataSchema creates or replaces the schema for a prefix.
+func (r *Repo) SaveDataSchema(ctx context.Context, schema *entity.DataSchema) error {
+	defer r.lock(ctx)()
+
+	now := time.Now()
+	saved := entity.DataSchema{
+		Prefix:    schema.Prefix,
+		Schema:    bytes.Clone(schema.Schema),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if old, ok := r.st.schemas[schema.Prefix]; ok {
+		saved.CreatedAt = old.CreatedAt
+	}
+	setRow(r.st, r.st.schemas, schema.Prefix, saved)
+
+	*schema = saved
+	return nil
+}
+
+// DeleteDataSchema removes the schema registered for a prefix.
+func (r *Repo) DeleteDataSchema(ctx context.Context, prefix string) error {
+	defer r.lock(ctx)()
+
+	if _, ok := r.st.schemas[prefix]; !ok {
+		return entity.ErrNotFound
+	}
+	deleteRow(r.st, r.st.schemas, prefix)
+	return nil
+}
+
+// toData returns a copy of a data version that shares nothing with the stored one.
+func toData(row dataRow) *entity.Data {
+	return &entity.Data{
+		OwnerID:   row.OwnerID,
+		Key:       row.Key,
+		Value:     bytes.Clone(row.Value),
+		ExpiresAt: clonePtr(row.ExpiresAt),
+		CreatedAt: row.CreatedAt,
+	}
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
@@ -0,0 +1,275 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var errTest = errors.New("unit of work failed")
+
+func save(t *testing.T, r *Repo, ctx context.Context, key, value string, expiresAt *time.Time) {
+	t.Helper()
+	if err := r.SaveData(ctx, &entity.Data{Key: key, Value: json.RawMessage(value), ExpiresAt: expiresAt}); err != nil {
+		t.Fatalf("SaveData(%q): %v", key, err)
+	}
+}
+
+// value returns the current value of key, or "" if it has none.
+func value(t *testing.T, r *Repo, key string) string {
+	t.Helper()
+	data, err := r.GetData(context.Background(), key)
+	if errors.Is(err, entity.ErrNotFound) {
+		return ""
+	}
+	if err != nil {
+		t.Fatalf("GetData(%q): %v", key, err)
+	}
+	return string(data.Value)
+}
+
+func TestSaveDataKeepsVersions(t *testing.T) {
+	r := New(NewDataFeed(16))
+	ctx := context.Background()
+	save(t, r, ctx, "k", `1`, nil)
+	save(t, r, ctx, "k", `2`, nil)
+
+	if got := value(t, r, "k"); got != `2` {
+		t.Errorf("value = %s, want the newest version", got)
+	}
+	if n := len(r.st.data["k"]); n != 2 {
+		t.Errorf("key has %d versions, want 2", n)
+	}
+	changes, err := r.ListDataChanges(ctx, 0, "", -1)
+	if err != nil {
+		t.Fatalf("ListDataChanges: %v", err)
+	}
+	if len(changes) != 2 || changes[0].Type != entity.DataEventCreated || changes[1].Type != entity.DataEventUpdated {
+		t.Errorf("changes = %+v, want the creation and an update", changes)
+	}
+	if n := len(r.st.outbox); n != 2 {
+		t.Errorf("wrote %d outbox events, want 2", n)
+	}
+}
+
+func TestDataExpiry(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+	tests := []struct {
+		name       string
+		expiresAt  []*time.Time // Of the versions of the key, oldest first
+		want       string
+		wantPurged int64
+	}{
+		{"live", []*time.Time{nil, &future}, `2`, 0},
+		{"expired", []*time.Time{&past}, "", 1},
+		{"expired newest hides older", []*time.Time{nil, &past}, "", 2},
+		{"live newest over expired", []*time.Time{&past, nil}, `2`, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(NewDataFeed(16))
+			for i, expiresAt := range tt.expiresAt {
+				save(t, r, context.Background(), "k", string(rune('1'+i)), expiresAt)
+			}
+			if got := value(t, r, "k"); got != tt.want {
+				t.Errorf("value = %q, want %q", got, tt.want)
+			}
+
+			n, err := r.PurgeExpiredData(context.Background(), 10)
+			if err != nil || n != tt.wantPurged {
+				t.Errorf("PurgeExpiredData() = %d, %v; want %d", n, err, tt.wantPurged)
+			}
+			if got := value(t, r, "k"); got != tt.want {
+				t.Errorf("value after the purge = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeleteAndRestoreData(t *testing.T) {
+	r := New(NewDataFeed(16))
+	ctx := context.Background()
+	save(t, r, ctx, "k", `1`, nil)
+	save(t, r, ctx, "k", `2`, nil)
+
+	if err := r.DeleteData(ctx, "k"); err != nil {
+		t.Fatalf("DeleteData: %v", err)
+	}
+	if got := value(t, r, "k"); got != "" {
+		t.Errorf("deleted key reads %s", got)
+	}
+	if err := r.DeleteData(ctx, "k"); !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("DeleteData(deleted) = %v, want ErrNotFound", err)
+	}
+	deleted, err := r.ListDeletedData(ctx, 10)
+	if err != nil || len(deleted) != 1 || string(deleted[0].Value) != `2` || deleted[0].DeletedAt == nil {
+		t.Fatalf("ListDeletedData() = %+v, %v; want the last value of k", deleted, err)
+	}
+
+	restored, err := r.RestoreData(ctx, "k")
+	if err != nil || string(restored.Value) != `2` {
+		t.Fatalf("RestoreData() = %+v, %v; want the last value", restored, err)
+	}
+	if n := len(r.st.data["k"]); n != 2 {
+		t.Errorf("restored key has %d versions, want 2", n)
+	}
+	if _, err := r.RestoreData(ctx, "k"); !errors.Is(err, entity.ErrConflict) {
+		t.Errorf("RestoreData(live) = %v, want ErrConflict", err)
+	}
+	if _, err := r.RestoreData(ctx, "unknown"); !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("RestoreData(unknown) = %v, want ErrNotFound", err)
+	}
+
+	// Written again after a deletion, the key can no longer be restored.
+	if err := r.DeleteData(ctx, "k"); err != nil {
+		t.Fatalf("DeleteData: %v", err)
+	}
+	save(t, r, ctx, "k", `3`, nil)
+	if _, err := r.RestoreData(ctx, "k"); !errors.Is(err, entity.ErrConflict) {
+		t.Errorf("RestoreData(rewritten) = %v, want ErrConflict", err)
+	}
+
+	n, err := r.PurgeDeletedData(ctx, time.Now().Add(time.Second), 10)
+	if err != nil || n != 2 {
+		t.Errorf("PurgeDeletedData() = %d, %v; want the 2 deleted versions", n, err)
+	}
+	if got := value(t, r, "k"); got != `3` {
+		t.Errorf("value after the purge = %s, want 3", got)
+	}
+}
+
+func TestWithinTxRollsBack(t *testing.T) {
+	r := New(NewDataFeed(16))
+	user := entity.User{ID: uuid.New(), Email: "a@example.com"}
+	r.AddUsers(user)
+
+	err := r.WithinTx(context.Background(), func(ctx context.Context) error {
+		save(t, r, ctx, "k", `1`, nil)
+		if err := r.SetUserLocale(ctx, user.ID, "de"); err != nil {
+			t.Fatalf("SetUserLocale: %v", err)
+		}
+		return errTest
+	})
+	if !errors.Is(err, errTest) {
+		t.Fatalf("WithinTx() = %v, want %v", err, errTest)
+	}
+	if got := value(t, r, "k"); got != "" {
+		t.Errorf("rolled back write reads %s", got)
+	}
+	if got, _ := r.GetUserByEmail(context.Background(), user.Email); got.Locale != "" {
+		t.Errorf("rolled back locale reads %q", got.Locale)
+	}
+	if len(r.st.outbox) != 0 || len(r.st.changes) != 0 || r.st.dataSeq != 0 {
+		t.Errorf("rollback kept %d outbox events, %d data changes and sequence %d", len(r.st.outbox), len(r.st.changes), r.st.dataSeq)
+	}
+}
+
+func TestWithinTxRestoresOverwrittenAndDeletedRows(t *testing.T) {
+	r := New(NewDataFeed(16))
+	save(t, r, context.Background(), "kept", `1`, nil)
+	save(t, r, context.Background(), "deleted", `2`, nil)
+
+	err := r.WithinTx(context.Background(), func(ctx context.Context) error {
+		save(t, r, ctx, "kept", `3`, nil)
+		save(t, r, ctx, "kept", `4`, nil)
+		if err := r.DeleteData(ctx, "deleted"); err != nil {
+			t.Fatalf("DeleteData: %v", err)
+		}
+		return errTest
+	})
+	if !errors.Is(err, errTest) {
+		t.Fatalf("WithinTx() = %v, want %v", err, errTest)
+	}
+	if got := value(t, r, "kept"); got != `1` {
+		t.Errorf("overwritten key reads %q, want 1", got)
+	}
+	if got := value(t, r, "deleted"); got != `2` {
+		t.Errorf("deleted key reads %q, want 2", got)
+	}
+	if n := len(r.st.data["kept"]); n != 1 {
+		t.Errorf("overwritten key has %d versions, want 1", n)
+	}
+	if r.st.undo != nil {
+		t.Errorf("journal of %d entries kept after the unit of work", len(r.st.undo))
+	}
+}
+
+func TestWithinTxNestedRollsBackOwnWrites(t *testing.T) {
+	r := New(NewDataFeed(16))
+
+	err := r.WithinTx(context.Background(), func(ctx context.Context) error {
+		save(t, r, ctx, "outer", `1`, nil)
+		if err := r.WithinTx(ctx, func(ctx context.Context) error {
+			save(t, r, ctx, "inner", `2`, nil)
+			return errTest
+		}); !errors.Is(err, errTest) {
+			t.Errorf("nested WithinTx() = %v, want %v", err, errTest)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WithinTx() = %v", err)
+	}
+	if got := value(t, r, "outer"); got != `1` {
+		t.Errorf("outer write = %q, want 1", got)
+	}
+	if got := value(t, r, "inner"); got != "" {
+		t.Errorf("rolled back nested write reads %s", got)
+	}
+	if n := len(r.st.outbox); n != 1 {
+		t.Errorf("wrote %d outbox events, want the outer one", n)
+	}
+}
+
+func TestUsers(t *testing.T) {
+	r := New(NewDataFeed(16))
+	ctx := context.Background()
+	user := entity.User{ID: uuid.New(), Email: "a@example.com", Password: "hash", Role: entity.RoleUser}
+	r.AddUsers(user, entity.User{ID: uuid.New(), Email: "b@example.com", Role: entity.RoleAdmin})
+
+	got, err := r.GetUserByEmail(ctx, user.Email)
+	if err != nil || *got != user {
+		t.Fatalf("GetUserByEmail() = %+v, %v; want %+v", got, err, user)
+	}
+	if _, err := r.GetUserByEmail(ctx, "unknown@example.com"); !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("GetUserByEmail(unknown) = %v, want ErrNotFound", err)
+	}
+
+	for _, locale := range []string{"de", ""} {
+		if err := r.SetUserLocale(ctx, user.ID, locale); err != nil {
+			t.Fatalf("SetUserLocale(%q): %v", locale, err)
+		}
+		if got, _ := r.GetUserByEmail(ctx, user.Email); got.Locale != locale {
+			t.Errorf("locale = %q, want %q", got.Locale, locale)
+		}
+	}
+	if err := r.SetUserLocale(ctx, uuid.New(), "de"); !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("SetUserLocale(unknown) = %v, want ErrNotFound", err)
+	}
+}
+
+func TestCatalogItemWithMissingCategory(t *testing.T) {
+	r := New(NewDataFeed(16))
+	ctx := context.Background()
+	var vErr *entity.ValidationError
+
+	item := &entity.CatalogItem{Title: "lamp", CategoryID: uuid.New()}
+	if err := r.CreateCatalogItem(ctx, item); !errors.As(err, &vErr) {
+		t.Fatalf("CreateCatalogItem = %v, want a validation error", err)
+	}
+	item.CategoryID = uuid.Nil
+	if err := r.CreateCatalogItem(ctx, item); err != nil {
+		t.Fatalf("CreateCatalogItem: %v", err)
+	}
+	item.CategoryID = uuid.New()
+	if err := r.UpdateCatalogItem(ctx, item); !errors.As(err, &vErr) {
+		t.Errorf("UpdateCatalogItem = %v, want a validation error", err)
+	}
+}
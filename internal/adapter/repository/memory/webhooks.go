@@ -0,0 +1,287 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+// ListWebhookEndpoints retrieves all webhook endpoints, oldest first.
+func (r *Repo) ListWebhookEndpoints(ctx context.Context) ([]entity.WebhookEndpoint, error) {
+	defer r.lock(ctx)()
+
+	endpoints := slices.Collect(maps.Values(r.st.endpoints))
+	slices.SortFunc(endpoints, func(a, b entity.WebhookEndpoint) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), compareIDs(a.ID, b.ID))
+	})
+	for i := range endpoints {
+		endpoints[i] = toWebhookEndpoint(endpoints[i])
+	}
+	if endpoints == nil {
+		endpoints = []entity.WebhookEndpoint{}
+	}
+	return endpoints, nil
+}
+
+// GetWebhookEndpoint retrieves a webhook endpoint by id.
+func (r *Repo) GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*entity.WebhookEndpoint, error) {
+	defer r.lock(ctx)()
+
+	endpoint, ok := r.st.endpoints[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	endpoint = toWebhookEndpoint(endpoint)
+	return &endpoint, nil
+}
+
+// CreateWebhookEndpoint registers an endpoint and fills in the generated fields.
+func (r *Repo) CreateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	defer r.lock(ctx)()
+
+	now := time.Now()
+	created := entity.WebhookEndpoint{
+		ID:         uuid.New(),
+		URL:        endpoint.URL,
+		Secret:     endpoint.Secret,
+		EventTypes: slices.Clone(endpoint.EventTypes),
+		Enabled:    endpoint.Enabled,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	setRow(r.st, r.st.endpoints, created.ID, created)
+
+	*endpoint = toWebhookEndpoint(created)
+	return nil
+}
+
+// UpdateWebhookEndpoint replaces the settings of an endpoint and fills in the generated
+// fields. Re-enabling an endpoint clears its failure count. It returns entity.ErrNotFound if
+// the endpoint does not exist.
+func (r *Repo) UpdateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	defer r.lock(ctx)()
+
+	updated, ok := r.st.endpoints[endpoint.ID]
+	if !ok {
+		return entity.ErrNotFound
+	}
+	now := time.Now()
+	switch {
+	case endpoint.Enabled:
+		if !updated.Enabled {
+			updated.ConsecutiveFailures = 0
+		}
+		updated.DisabledAt = nil
+	case updated.Enabled:
+		updated.DisabledAt = &now
+	}
+	updated.URL = endpoint.URL
+	updated.Secret = endpoint.Secret
+	updated.EventTypes = slices.Clone(endpoint.EventTypes)
+	updated.Enabled = endpoint.Enabled
+	updated.UpdatedAt = now
+	setRow(r.st, r.st.endpoints, updated.ID, updated)
+
+	*endpoint = toWebhookEndpoint(updated)
+	return nil
+}
+
+// DeleteWebhookEndpoint removes an endpoint together with its delivery log.
+func (r *Repo) DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error {
+	defer r.lock(ctx)()
+
+	if _, ok := r.st.endpoints[id]; !ok {
+		return entity.ErrNotFound
+	}
+	deleteRow(r.st, r.st.endpoints, id)
+	for deliveryID, d := range r.st.deliveries {
+		if d.EndpointID == id {
+			deleteRow(r.st, r.st.deliveries, deliveryID)
+		}
+	}
+	return nil
+}
+
+// CreateWebhookDeliveries queues event for every endpoint subscribed to its type, enabled or
+// not, and returns the number of new deliveries. Queuing the same event again adds nothing.
+func (r *Repo) CreateWebhookDeliveries(ctx context.Context, event entity.OutboxEvent) (int64, error) {
+	defer r.lock(ctx)()
+
+	queued := make(map[uuid.UUID]struct{})
+	for _, d := range r.st.deliveries {
+		if d.Event.ID == event.ID {
+			queued[d.EndpointID] = struct{}{}
+		}
+	}
+
+	now := time.Now()
+	var n int64
+	for id, endpoint := range r.st.endpoints {
+		if _, ok := queued[id]; ok || !slices.Contains(endpoint.EventTypes, event.Type) {
+			continue
+		}
+		d := entity.WebhookDelivery{
+			ID:            uuid.New(),
+			EndpointID:    id,
+			Event:         event,
+			Status:        entity.WebhookDeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		}
+		d.Event.Payload = bytes.Clone(event.Payload)
+		setRow(r.st, r.st.deliveries, d.ID, d)
+		n++
+	}
+	return n, nil
+}
+
+// ClaimDueWebhookDeliveries claims up to limit due deliveries of enabled endpoints until
+// leaseUntil. Claimed deliveries that are not recorded by then become due again.
+func (r *Repo) ClaimDueWebhookDeliveries(ctx context.Context, leaseUntil time.Time, limit int32) ([]entity.WebhookDispatch, error) {
+	defer r.lock(ctx)()
+
+	now := time.Now()
+	var due []entity.WebhookDelivery
+	for _, d := range r.st.deliveries {
+		if d.Status == entity.WebhookDeliveryPending && !d.NextAttemptAt.After(now) && r.st.endpoints[d.EndpointID].Enabled {
+			due = append(due, d)
+		}
+	}
+	slices.SortFunc(due, func(a, b entity.WebhookDelivery) int {
+		return cmp.Or(a.NextAttemptAt.Compare(b.NextAttemptAt), compareIDs(a.ID, b.ID))
+	})
+	due = truncate(due, limit)
+
+	dispatches := make([]entity.WebhookDispatch, len(due))
+	for i, d := range due {
+		d.NextAttemptAt = leaseUntil
+		setRow(r.st, r.st.deliveries, d.ID, d)
+		endpoint := r.st.endpoints[d.EndpointID]
+		dispatches[i] = entity.WebhookDispatch{
+			WebhookDelivery: toWebhookDelivery(d),
+			URL:             endpoint.URL,
+			Secret:          endpoint.Secret,
+		}
+	}
+	return dispatches, nil
+}
+
+// RecordWebhookAttempt stores the outcome of an attempt set on delivery and updates the
+// failure count of its endpoint. A success clears the count; a failure that brings it to
+// disableAfter disables the endpoint, which is then reported.
+func (r *Repo) RecordWebhookAttempt(ctx context.Context, delivery *entity.WebhookDelivery, disableAfter int) (bool, error) {
+	defer r.lock(ctx)()
+
+	if d, ok := r.st.deliveries[delivery.ID]; ok {
+		d.Status = delivery.Status
+		d.Attempts = delivery.Attempts
+		d.NextAttemptAt = delivery.NextAttemptAt
+		d.LastAttemptAt = clonePtr(delivery.LastAttemptAt)
+		d.ResponseStatus = delivery.ResponseStatus
+		d.LastError = delivery.LastError
+		setRow(r.st, r.st.deliveries, d.ID, d)
+	}
+
+	endpoint, ok := r.st.endpoints[delivery.EndpointID]
+	if !ok {
+		// The endpoint was deleted while the delivery was sent.
+		return false, nil
+	}
+	if delivery.Status == entity.WebhookDeliverySucceeded {
+		endpoint.ConsecutiveFailures = 0
+		setRow(r.st, r.st.endpoints, endpoint.ID, endpoint)
+		return false, nil
+	}
+	now := time.Now()
+	endpoint.ConsecutiveFailures++
+	if endpoint.Enabled && endpoint.ConsecutiveFailures >= disableAfter {
+		endpoint.Enabled = false
+		endpoint.DisabledAt = &now
+	}
+	endpoint.UpdatedAt = now
+	setRow(r.st, r.st.endpoints, endpoint.ID, endpoint)
+	return !endpoint.Enabled && endpoint.ConsecutiveFailures == disableAfter, nil
+}
+
+// GetWebhookDelivery retrieves a webhook delivery by id.
+func (r *Repo) GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	defer r.lock(ctx)()
+
+	d, ok := r.st.deliveries[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	d = toWebhookDelivery(d)
+	return &d, nil
+}
+
+// ListWebhookDeliveries retrieves up to limit deliveries to an endpoint, newest first. An
+// empty status lists deliveries of every status.
+func (r *Repo) ListWebhookDeliveries(ctx context.Context, endpointID uuid.UUID, status entity.WebhookDeliveryStatus, limit int32) ([]entity.WebhookDelivery, error) {
+	defer r.lock(ctx)()
+
+	deliveries := []entity.WebhookDelivery{}
+	for _, d := range r.st.deliveries {
+		if d.EndpointID == endpointID && (status == "" || d.Status == status) {
+			deliveries = append(deliveries, toWebhookDelivery(d))
+		}
+	}
+	slices.SortFunc(deliveries, func(a, b entity.WebhookDelivery) int {
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), compareIDs(a.ID, b.ID))
+	})
+	return truncate(deliveries, limit), nil
+}
+
+// RedeliverWebhookDelivery makes a delivery due at once with a fresh retry schedule. The
+// outcome of the last attempt is kept until the next one.
+func (r *Repo) RedeliverWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	defer r.lock(ctx)()
+
+	d, ok := r.st.deliveries[id]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	d.Status = entity.WebhookDeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = time.Now()
+	setRow(r.st, r.st.deliveries, id, d)
+
+	d = toWebhookDelivery(d)
+	return &d, nil
+}
+
+// PurgeWebhookDeliveries removes finished deliveries created before cutoff and returns the
+// number of removed deliveries. The repository purges everything at once; batchSize is
+// accepted for interface compatibility.
+func (r *Repo) PurgeWebhookDeliveries(ctx context.Context, cutoff time.Time, _ int32) (int64, error) {
+	defer r.lock(ctx)()
+
+	var n int64
+	for id, d := range r.st.deliveries {
+		if d.Status != entity.WebhookDeliveryPending && d.CreatedAt.Before(cutoff) {
+			deleteRow(r.st, r.st.deliveries, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// toWebhookEndpoint returns a copy of a stored endpoint that shares nothing with it.
+func toWebhookEndpoint(endpoint entity.WebhookEndpoint) entity.WebhookEndpoint {
+	endpoint.EventTypes = slices.Clone(endpoint.EventTypes)
+	endpoint.DisabledAt = clonePtr(endpoint.DisabledAt)
+	return endpoint
+}
+
+// toWebhookDelivery returns a copy of a stored delivery that shares nothing with it.
+func toWebhookDelivery(d entity.WebhookDelivery) entity.WebhookDelivery {
+	d.Event.Payload = bytes.Clone(d.Event.Payload)
+	d.LastAttemptAt = clonePtr(d.LastAttemptAt)
+	return d
+}
@@ -0,0 +1,188 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DataKeyExists reports whether the key has a live value.
+func (r *Repo) DataKeyExists(ctx context.Context, key string) (bool, error) {
+	const op = "adapter.sqlc.DataKeyExists"
+
+	keys, err := r.Queries.GetLiveDataKeys(ctx, []string{key})
+	if err != nil {
+		r.log.Error("failed to check data key", slog.String("op", op), slog.String("error", err.Error()))
+		return false, err
+	}
+	return len(keys) > 0, nil
+}
+
+// CreateAttachment records the blob and the attachment in one transaction.
+// Upserting the blob row locks it against garbage collection until verify has confirmed
+// that the content is still stored.
+func (r *Repo) CreateAttachment(ctx context.Context, att *entity.Attachment, verify func() error) error {
+	const op = "adapter.sqlc.CreateAttachment"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	if err := q.UpsertBlob(ctx, sqlc.UpsertBlobParams{Digest: att.Digest, Size: att.Size}); err != nil {
+		r.log.Error("failed to record blob", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	row, err := q.CreateAttachment(ctx, sqlc.CreateAttachmentParams{
+		Key:         att.Key,
+		Name:        att.Name,
+		ContentType: att.ContentType,
+		Size:        att.Size,
+		Digest:      att.Digest,
+		CreatedBy:   toUUID(att.CreatedBy),
+	})
+	if err != nil {
+		r.log.Error("failed to create attachment", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	if err := verify(); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit attachment", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	*att = *toAttachment(row)
+	return nil
+}
+
+// GetAttachment retrieves an attachment by id.
+func (r *Repo) GetAttachment(ctx context.Context, id uuid.UUID) (*entity.Attachment, error) {
+	const op = "adapter.sqlc.GetAttachment"
+
+	row, err := r.Queries.GetAttachment(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get attachment", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return toAttachment(row), nil
+}
+
+// ListAttachments retrieves the attachments of a data key, oldest first.
+func (r *Repo) ListAttachments(ctx context.Context, key string) ([]entity.Attachment, error) {
+	const op = "adapter.sqlc.ListAttachments"
+
+	rows, err := r.Queries.ListAttachments(ctx, key)
+	if err != nil {
+		r.log.Error("failed to list attachments", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	attachments := make([]entity.Attachment, len(rows))
+	for i, row := range rows {
+		attachments[i] = *toAttachment(row)
+	}
+	return attachments, nil
+}
+
+// DeleteAttachment removes an attachment. Its content is left to garbage collection.
+func (r *Repo) DeleteAttachment(ctx context.Context, id uuid.UUID) error {
+	const op = "adapter.sqlc.DeleteAttachment"
+
+	n, err := r.Queries.DeleteAttachment(ctx, id)
+	if err != nil {
+		r.log.Error("failed to delete attachment", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n == 0 {
+		return entity.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteDanglingAttachments removes attachments whose data key has no rows left.
+func (r *Repo) DeleteDanglingAttachments(ctx context.Context) (int64, error) {
+	const op = "adapter.sqlc.DeleteDanglingAttachments"
+
+	n, err := r.Queries.DeleteDanglingAttachments(ctx)
+	if err != nil {
+		r.log.Error("failed to delete dangling attachments", slog.String("op", op), slog.String("error", err.Error()))
+		return 0, err
+	}
+	return n, nil
+}
+
+// DeleteUnusedBlobs deletes up to limit unreferenced blob rows and calls remove with their
+// digests before committing. If remove fails the rows are kept and retried on the next run.
+func (r *Repo) DeleteUnusedBlobs(ctx context.Context, olderThan time.Time, limit int32, remove func(digests []string) error) (int, error) {
+	const op = "adapter.sqlc.DeleteUnusedBlobs"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	digests, err := r.Queries.WithTx(tx).DeleteUnusedBlobs(ctx, sqlc.DeleteUnusedBlobsParams{
+		OlderThan: pgtype.Timestamptz{Time: olderThan, Valid: true},
+		BatchSize: limit,
+	})
+	if err != nil {
+		r.log.Error("failed to delete unused blobs", slog.String("op", op), slog.String("error", err.Error()))
+		return 0, err
+	}
+	if len(digests) == 0 {
+		return 0, nil
+	}
+
+	if err := remove(digests); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit blob deletion", slog.String("op", op), slog.String("error", err.Error()))
+		return 0, err
+	}
+	return len(digests), nil
+}
+
+// BlobExists reports whether a blob row exists for digest.
+func (r *Repo) BlobExists(ctx context.Context, digest string) (bool, error) {
+	const op = "adapter.sqlc.BlobExists"
+
+	exists, err := r.Queries.BlobExists(ctx, digest)
+	if err != nil {
+		r.log.Error("failed to check blob", slog.String("op", op), slog.String("error", err.Error()))
+		return false, err
+	}
+	return exists, nil
+}
+
+func toAttachment(row sqlc.DataAttachment) *entity.Attachment {
+	return &entity.Attachment{
+		ID:          row.ID,
+		Key:         row.Key,
+		Name:        row.Name,
+		ContentType: row.ContentType,
+		Size:        row.Size,
+		Digest:      row.Digest,
+		CreatedBy:   uuid.UUID(row.CreatedBy.Bytes),
+		CreatedAt:   row.CreatedAt.Time,
+	}
+}
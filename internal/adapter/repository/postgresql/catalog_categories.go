@@ -0,0 +1,294 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// PostgreSQL error codes the catalog maps to entity errors.
+const (
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+)
+
+// ListCatalogCategories returns every category ordered by path.
+func (r *Repo) ListCatalogCategories(ctx context.Context) ([]entity.CatalogCategory, error) {
+	const op = "adapter.sqlc.ListCatalogCategories"
+
+	rows, err := r.Queries.ListCatalogCategories(ctx)
+	if err != nil {
+		r.log.Error("failed to list catalog categories", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	categories := make([]entity.CatalogCategory, len(rows))
+	for i, row := range rows {
+		categories[i] = *toCatalogCategory(row)
+	}
+	return categories, nil
+}
+
+// GetCatalogCategory retrieves a category by id.
+func (r *Repo) GetCatalogCategory(ctx context.Context, id uuid.UUID) (*entity.CatalogCategory, error) {
+	const op = "adapter.sqlc.GetCatalogCategory"
+
+	row, err := r.Queries.GetCatalogCategory(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get catalog category", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return toCatalogCategory(row), nil
+}
+
+// CreateCatalogCategory inserts a category below category.ParentID and fills in its path and
+// generated fields. It returns entity.ErrNotFound if the parent does not exist and
+// entity.ErrConflict if the parent already has a child with the same slug.
+func (r *Repo) CreateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error {
+	const op = "adapter.sqlc.CreateCatalogCategory"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	path := category.Slug
+	if category.ParentID != uuid.Nil {
+		// The lock keeps the parent from being moved or deleted before the child is inserted.
+		parent, err := q.GetCatalogCategoryForUpdate(ctx, category.ParentID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return entity.ErrNotFound
+			}
+			r.log.Error("failed to get parent category", slog.String("op", op), slog.String("error", err.Error()))
+			return err
+		}
+		path = parent.Path + "/" + category.Slug
+	}
+
+	row, err := q.CreateCatalogCategory(ctx, sqlc.CreateCatalogCategoryParams{
+		ParentID: toUUID(category.ParentID),
+		Slug:     category.Slug,
+		Name:     category.Name,
+		Path:     path,
+	})
+	if err != nil {
+		if isPgError(err, pgUniqueViolation) {
+			return entity.ErrConflict
+		}
+		r.log.Error("failed to create catalog category", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog category", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	*category = *toCatalogCategory(row)
+	return nil
+}
+
+// UpdateCatalogCategory renames and possibly moves a category, rewriting the paths of its
+// whole subtree. It returns entity.ErrNotFound if the category or the new parent does not
+// exist, entity.ErrConflict if the new path is taken, and a validation error if the new
+// parent lies within the category's own subtree.
+func (r *Repo) UpdateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error {
+	const op = "adapter.sqlc.UpdateCatalogCategory"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	current, err := q.GetCatalogCategoryForUpdate(ctx, category.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ErrNotFound
+		}
+		r.log.Error("failed to get catalog category", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	path := category.Slug
+	if category.ParentID != uuid.Nil {
+		parent, err := q.GetCatalogCategoryForUpdate(ctx, category.ParentID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return entity.ErrNotFound
+			}
+			r.log.Error("failed to get parent category", slog.String("op", op), slog.String("error", err.Error()))
+			return err
+		}
+		if parent.Path == current.Path || strings.HasPrefix(parent.Path, current.Path+"/") {
+			return entity.NewValidationError("a category cannot be moved below itself")
+		}
+		path = parent.Path + "/" + category.Slug
+	}
+
+	row, err := q.UpdateCatalogCategory(ctx, sqlc.UpdateCatalogCategoryParams{
+		ID:       category.ID,
+		ParentID: toUUID(category.ParentID),
+		Slug:     category.Slug,
+		Name:     category.Name,
+		Path:     path,
+	})
+	if err != nil {
+		if isPgError(err, pgUniqueViolation) {
+			return entity.ErrConflict
+		}
+		r.log.Error("failed to update catalog category", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if path != current.Path {
+		if err := q.LockCatalogCategoryDescendants(ctx, current.Path); err != nil {
+			r.log.Error("failed to lock catalog subcategories", slog.String("op", op), slog.String("error", err.Error()))
+			return err
+		}
+		_, err := q.MoveCatalogCategoryDescendants(ctx, sqlc.MoveCatalogCategoryDescendantsParams{
+			NewPath: path,
+			OldPath: current.Path,
+		})
+		if err != nil {
+			if isPgError(err, pgUniqueViolation) {
+				return entity.ErrConflict
+			}
+			r.log.Error("failed to move catalog subcategories", slog.String("op", op), slog.String("error", err.Error()))
+			return err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog category", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	*category = *toCatalogCategory(row)
+	return nil
+}
+
+// DeleteCatalogCategory removes a category without subcategories; its items become
+// uncategorized. It returns entity.ErrConflict if the category still has subcategories.
+func (r *Repo) DeleteCatalogCategory(ctx context.Context, id uuid.UUID) error {
+	const op = "adapter.sqlc.DeleteCatalogCategory"
+
+	n, err := r.Queries.DeleteCatalogCategory(ctx, id)
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			return entity.ErrConflict
+		}
+		r.log.Error("failed to delete catalog category", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n == 0 {
+		return entity.ErrNotFound
+	}
+	return nil
+}
+
+// ListCatalogTags returns every tag with its number of items, ordered by name.
+func (r *Repo) ListCatalogTags(ctx context.Context) ([]entity.CatalogTag, error) {
+	const op = "adapter.sqlc.ListCatalogTags"
+
+	rows, err := r.Queries.ListCatalogTags(ctx)
+	if err != nil {
+		r.log.Error("failed to list catalog tags", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	tags := make([]entity.CatalogTag, len(rows))
+	for i, row := range rows {
+		tags[i] = entity.CatalogTag{Name: row.Name, Items: row.ItemCount}
+	}
+	return tags, nil
+}
+
+// CreateCatalogTag adds a tag that no item carries yet. It returns entity.ErrConflict if the tag exists.
+func (r *Repo) CreateCatalogTag(ctx context.Context, name string) error {
+	const op = "adapter.sqlc.CreateCatalogTag"
+
+	n, err := r.Queries.CreateCatalogTag(ctx, name)
+	if err != nil {
+		r.log.Error("failed to create catalog tag", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n == 0 {
+		return entity.ErrConflict
+	}
+	return nil
+}
+
+// RenameCatalogTag renames a tag on every item carrying it. It returns entity.ErrConflict
+// if a tag named newName exists.
+func (r *Repo) RenameCatalogTag(ctx context.Context, name, newName string) (*entity.CatalogTag, error) {
+	const op = "adapter.sqlc.RenameCatalogTag"
+
+	n, err := r.Queries.RenameCatalogTag(ctx, sqlc.RenameCatalogTagParams{NewName: newName, Name: name})
+	if err != nil {
+		if isPgError(err, pgUniqueViolation) {
+			return nil, entity.ErrConflict
+		}
+		r.log.Error("failed to rename catalog tag", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if n == 0 {
+		return nil, entity.ErrNotFound
+	}
+
+	row, err := r.Queries.GetCatalogTag(ctx, newName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get catalog tag", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return &entity.CatalogTag{Name: row.Name, Items: row.ItemCount}, nil
+}
+
+// DeleteCatalogTag removes a tag from every item and deletes it.
+func (r *Repo) DeleteCatalogTag(ctx context.Context, name string) error {
+	const op = "adapter.sqlc.DeleteCatalogTag"
+
+	n, err := r.Queries.DeleteCatalogTag(ctx, name)
+	if err != nil {
+		r.log.Error("failed to delete catalog tag", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n == 0 {
+		return entity.ErrNotFound
+	}
+	return nil
+}
+
+func toCatalogCategory(row sqlc.CatalogCategory) *entity.CatalogCategory {
+	return &entity.CatalogCategory{
+		ID:        row.ID,
+		ParentID:  uuid.UUID(row.ParentID.Bytes),
+		Slug:      row.Slug,
+		Name:      row.Name,
+		Path:      row.Path,
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+}
+
+// isPgError reports whether err is a PostgreSQL error with the given SQLSTATE code.
+func isPgError(err error, code string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
@@ -0,0 +1,422 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ListCatalogChangesets returns every changeset, newest first, without drafts.
+func (r *Repo) ListCatalogChangesets(ctx context.Context) ([]entity.CatalogChangeset, error) {
+	const op = "adapter.sqlc.ListCatalogChangesets"
+
+	rows, err := r.Queries.ListCatalogChangesets(ctx)
+	if err != nil {
+		r.log.Error("failed to list catalog changesets", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	changesets := make([]entity.CatalogChangeset, len(rows))
+	for i, row := range rows {
+		changesets[i] = *toCatalogChangeset(row)
+	}
+	return changesets, nil
+}
+
+// GetCatalogChangeset retrieves a changeset together with its drafts.
+func (r *Repo) GetCatalogChangeset(ctx context.Context, id uuid.UUID) (*entity.CatalogChangeset, error) {
+	const op = "adapter.sqlc.GetCatalogChangeset"
+
+	row, err := r.Queries.GetCatalogChangeset(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get catalog changeset", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	drafts, err := r.Queries.ListCatalogDrafts(ctx, id)
+	if err != nil {
+		r.log.Error("failed to list catalog drafts", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	categoryIDs := make([]uuid.UUID, len(drafts))
+	for i, d := range drafts {
+		categoryIDs[i] = uuid.UUID(d.CategoryID.Bytes)
+	}
+	paths, err := catalogCategoryPaths(ctx, r.Queries, categoryIDs)
+	if err != nil {
+		r.log.Error("failed to get draft category paths", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	changeset := toCatalogChangeset(row)
+	changeset.Drafts = make([]entity.CatalogDraft, len(drafts))
+	for i, d := range drafts {
+		changeset.Drafts[i] = *toCatalogDraft(d)
+		changeset.Drafts[i].Item.CategoryPath = paths[changeset.Drafts[i].Item.CategoryID]
+	}
+	return changeset, nil
+}
+
+// CreateCatalogChangeset creates an empty draft changeset and fills in the generated fields.
+func (r *Repo) CreateCatalogChangeset(ctx context.Context, changeset *entity.CatalogChangeset) error {
+	const op = "adapter.sqlc.CreateCatalogChangeset"
+
+	row, err := r.Queries.CreateCatalogChangeset(ctx, sqlc.CreateCatalogChangesetParams{
+		Title:     changeset.Title,
+		CreatedBy: toUUID(changeset.CreatedBy),
+	})
+	if err != nil {
+		r.log.Error("failed to create catalog changeset", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	*changeset = *toCatalogChangeset(row)
+	return nil
+}
+
+// DeleteCatalogChangeset discards a draft changeset. Published changesets are kept as history.
+func (r *Repo) DeleteCatalogChangeset(ctx context.Context, id uuid.UUID) error {
+	const op = "adapter.sqlc.DeleteCatalogChangeset"
+
+	return r.inDraftChangeset(ctx, op, id, func(q *sqlc.Queries) error {
+		_, err := q.DeleteCatalogChangeset(ctx, id)
+		return err
+	})
+}
+
+// SaveCatalogDraft stages draft in a draft changeset, replacing an earlier draft of the same item.
+func (r *Repo) SaveCatalogDraft(ctx context.Context, changesetID uuid.UUID, draft *entity.CatalogDraft) error {
+	const op = "adapter.sqlc.SaveCatalogDraft"
+
+	params := sqlc.UpsertCatalogDraftParams{
+		ChangesetID: changesetID,
+		ItemID:      draft.Item.ID,
+		Action:      string(draft.Action),
+		Tags:        []string{},
+	}
+	if draft.BaseUpdatedAt != nil {
+		params.BaseUpdatedAt = pgtype.Timestamptz{Time: *draft.BaseUpdatedAt, Valid: true}
+	}
+	if draft.Action == entity.CatalogDraftUpsert {
+		params.Title = pgtype.Text{String: draft.Item.Title, Valid: true}
+		params.Description = toText(draft.Item.Description)
+		params.Disabled = draft.Item.Disabled
+		params.CategoryID = toUUID(draft.Item.CategoryID)
+		if draft.Item.Tags != nil {
+			params.Tags = draft.Item.Tags
+		}
+	}
+
+	return r.inDraftChangeset(ctx, op, changesetID, func(q *sqlc.Queries) error {
+		row, err := q.UpsertCatalogDraft(ctx, params)
+		if err != nil {
+			return err
+		}
+		path := draft.Item.CategoryPath
+		*draft = *toCatalogDraft(row)
+		draft.Item.CategoryPath = path
+		return nil
+	})
+}
+
+// DeleteCatalogDraft removes the draft of an item from a draft changeset.
+func (r *Repo) DeleteCatalogDraft(ctx context.Context, changesetID, itemID uuid.UUID) error {
+	const op = "adapter.sqlc.DeleteCatalogDraft"
+
+	return r.inDraftChangeset(ctx, op, changesetID, func(q *sqlc.Queries) error {
+		n, err := q.DeleteCatalogDraft(ctx, sqlc.DeleteCatalogDraftParams{ChangesetID: changesetID, ItemID: itemID})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return entity.ErrNotFound
+		}
+		return nil
+	})
+}
+
+// PublishCatalogChangeset applies every draft of a changeset in one transaction and records
+// who published it. The previous version of each item is kept for RollbackCatalogChangeset.
+// It returns an entity.ErrConflict error if the changeset is not a draft or an item changed
+// after its draft was staged; nothing is applied then.
+func (r *Repo) PublishCatalogChangeset(ctx context.Context, id, publishedBy uuid.UUID, language string) (*entity.CatalogChangeset, error) {
+	const op = "adapter.sqlc.PublishCatalogChangeset"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	_, drafts, err := lockCatalogChangeset(ctx, q, id, entity.CatalogChangesetDraft)
+	if err != nil {
+		return nil, r.changesetError(op, err)
+	}
+
+	for _, d := range drafts {
+		current, exists, err := lockCatalogItem(ctx, q, d.ItemID)
+		if err != nil {
+			return nil, r.changesetError(op, err)
+		}
+		if d.BaseUpdatedAt.Valid != exists || (exists && !current.UpdatedAt.Time.Equal(d.BaseUpdatedAt.Time)) {
+			return nil, fmt.Errorf("%w: item %s changed after it was staged", entity.ErrConflict, d.ItemID)
+		}
+
+		if exists {
+			items, err := withCatalogDetails(ctx, q, []catalogRow{catalogRow(current)})
+			if err != nil {
+				return nil, r.changesetError(op, err)
+			}
+			before, err := json.Marshal(items[0])
+			if err != nil {
+				return nil, r.changesetError(op, err)
+			}
+			err = q.SetCatalogDraftBefore(ctx, sqlc.SetCatalogDraftBeforeParams{ChangesetID: id, ItemID: d.ItemID, Before: before})
+			if err != nil {
+				return nil, r.changesetError(op, err)
+			}
+		}
+
+		event := catalogEvent(entity.EventCatalogUpdated, d.ItemID)
+		switch {
+		case entity.CatalogDraftAction(d.Action) == entity.CatalogDraftDelete:
+			if _, err := q.DeleteCatalogItem(ctx, d.ItemID); err != nil {
+				return nil, r.changesetError(op, err)
+			}
+			event = catalogEvent(entity.EventCatalogDeleted, d.ItemID)
+		default:
+			err = writeCatalogItem(ctx, q, toCatalogDraft(d).Item, pgtype.Timestamptz{}, language)
+			if err != nil {
+				return nil, r.changesetError(op, err)
+			}
+			if !exists {
+				event = catalogEvent(entity.EventCatalogCreated, d.ItemID)
+			}
+		}
+		if err := addOutboxEvents(ctx, q, event); err != nil {
+			return nil, r.changesetError(op, err)
+		}
+	}
+
+	row, err := q.MarkCatalogChangesetPublished(ctx, sqlc.MarkCatalogChangesetPublishedParams{
+		ID:          id,
+		PublishedBy: toUUID(publishedBy),
+	})
+	if err != nil {
+		return nil, r.changesetError(op, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog changeset", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return toCatalogChangeset(row), nil
+}
+
+// RollbackCatalogChangeset restores every item of a published changeset to its version before
+// publishing, in one transaction, and records who rolled it back. It returns an
+// entity.ErrConflict error if the changeset is not published or one of its items was changed
+// after publishing; nothing is restored then.
+func (r *Repo) RollbackCatalogChangeset(ctx context.Context, id, rolledBackBy uuid.UUID, language string) (*entity.CatalogChangeset, error) {
+	const op = "adapter.sqlc.RollbackCatalogChangeset"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	changeset, drafts, err := lockCatalogChangeset(ctx, q, id, entity.CatalogChangesetPublished)
+	if err != nil {
+		return nil, r.changesetError(op, err)
+	}
+
+	for _, d := range drafts {
+		current, exists, err := lockCatalogItem(ctx, q, d.ItemID)
+		if err != nil {
+			return nil, r.changesetError(op, err)
+		}
+		// Publishing stamped every item it wrote with the publishing time.
+		var unchanged bool
+		if entity.CatalogDraftAction(d.Action) == entity.CatalogDraftDelete {
+			unchanged = !exists
+		} else {
+			unchanged = exists && current.UpdatedAt.Time.Equal(changeset.PublishedAt.Time)
+		}
+		if !unchanged {
+			return nil, fmt.Errorf("%w: item %s changed after publishing", entity.ErrConflict, d.ItemID)
+		}
+
+		if d.Before == nil {
+			if _, err := q.DeleteCatalogItem(ctx, d.ItemID); err != nil {
+				return nil, r.changesetError(op, err)
+			}
+			if err := addOutboxEvents(ctx, q, catalogEvent(entity.EventCatalogDeleted, d.ItemID)); err != nil {
+				return nil, r.changesetError(op, err)
+			}
+			continue
+		}
+		var before entity.CatalogItem
+		if err := json.Unmarshal(d.Before, &before); err != nil {
+			return nil, r.changesetError(op, err)
+		}
+		err = writeCatalogItem(ctx, q, before, pgtype.Timestamptz{Time: before.CreatedAt, Valid: true}, language)
+		if err != nil {
+			return nil, r.changesetError(op, err)
+		}
+		event := catalogEvent(entity.EventCatalogUpdated, d.ItemID)
+		if !exists {
+			event = catalogEvent(entity.EventCatalogRestored, d.ItemID)
+		}
+		if err := addOutboxEvents(ctx, q, event); err != nil {
+			return nil, r.changesetError(op, err)
+		}
+	}
+
+	row, err := q.MarkCatalogChangesetRolledBack(ctx, sqlc.MarkCatalogChangesetRolledBackParams{
+		ID:           id,
+		RolledBackBy: toUUID(rolledBackBy),
+	})
+	if err != nil {
+		return nil, r.changesetError(op, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog changeset", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return toCatalogChangeset(row), nil
+}
+
+// inDraftChangeset runs fn in a transaction that holds the lock of a draft changeset, so
+// edits cannot interleave with publishing it.
+func (r *Repo) inDraftChangeset(ctx context.Context, op string, id uuid.UUID, fn func(q *sqlc.Queries) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	if _, _, err := lockCatalogChangeset(ctx, q, id, entity.CatalogChangesetDraft); err != nil {
+		return r.changesetError(op, err)
+	}
+	if err := fn(q); err != nil {
+		return r.changesetError(op, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog changeset", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// changesetError logs unexpected errors; not found and conflict errors are expected outcomes.
+func (r *Repo) changesetError(op string, err error) error {
+	if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+		r.log.Error("failed to process catalog changeset", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return err
+}
+
+// lockCatalogChangeset locks a changeset, checks its status and returns it with its drafts.
+func lockCatalogChangeset(ctx context.Context, q *sqlc.Queries, id uuid.UUID, status entity.CatalogChangesetStatus) (sqlc.CatalogChangeset, []sqlc.CatalogDraft, error) {
+	row, err := q.GetCatalogChangesetForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return row, nil, entity.ErrNotFound
+		}
+		return row, nil, err
+	}
+	if row.Status != string(status) {
+		return row, nil, fmt.Errorf("%w: changeset is %s", entity.ErrConflict, row.Status)
+	}
+	drafts, err := q.ListCatalogDrafts(ctx, id)
+	return row, drafts, err
+}
+
+// lockCatalogItem locks an item row, reporting whether it exists.
+func lockCatalogItem(ctx context.Context, q *sqlc.Queries, id uuid.UUID) (sqlc.GetCatalogItemForUpdateRow, bool, error) {
+	row, err := q.GetCatalogItemForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return row, false, nil
+	}
+	return row, err == nil, err
+}
+
+// writeCatalogItem creates or replaces an item under its id, together with its tags.
+// An empty SKU keeps the SKU of an existing item. A category deleted or a SKU taken in the
+// meantime is reported as a conflict.
+func writeCatalogItem(ctx context.Context, q *sqlc.Queries, item entity.CatalogItem, createdAt pgtype.Timestamptz, language string) error {
+	_, err := q.UpsertCatalogItem(ctx, sqlc.UpsertCatalogItemParams{
+		ID:             item.ID,
+		Title:          item.Title,
+		Description:    toText(item.Description),
+		Disabled:       item.Disabled,
+		CategoryID:     toUUID(item.CategoryID),
+		SearchLanguage: language,
+		CreatedAt:      createdAt,
+		Sku:            toText(item.SKU),
+	})
+	if isPgError(err, pgForeignKeyViolation) {
+		return fmt.Errorf("%w: the category of item %s no longer exists", entity.ErrConflict, item.ID)
+	}
+	if isPgError(err, pgUniqueViolation) {
+		return fmt.Errorf("%w: the sku of item %s is used by another item", entity.ErrConflict, item.ID)
+	}
+	if err != nil {
+		return err
+	}
+	return replaceCatalogItemTags(ctx, q, item.ID, item.Tags)
+}
+
+func toCatalogChangeset(row sqlc.CatalogChangeset) *entity.CatalogChangeset {
+	return &entity.CatalogChangeset{
+		ID:           row.ID,
+		Title:        row.Title,
+		Status:       entity.CatalogChangesetStatus(row.Status),
+		CreatedBy:    uuid.UUID(row.CreatedBy.Bytes),
+		CreatedAt:    row.CreatedAt.Time,
+		PublishedBy:  uuid.UUID(row.PublishedBy.Bytes),
+		PublishedAt:  toTimePtr(row.PublishedAt),
+		RolledBackBy: uuid.UUID(row.RolledBackBy.Bytes),
+		RolledBackAt: toTimePtr(row.RolledBackAt),
+	}
+}
+
+func toCatalogDraft(row sqlc.CatalogDraft) *entity.CatalogDraft {
+	return &entity.CatalogDraft{
+		Action: entity.CatalogDraftAction(row.Action),
+		Item: entity.CatalogItem{
+			ID:          row.ItemID,
+			Title:       row.Title.String,
+			Description: row.Description.String,
+			Disabled:    row.Disabled,
+			Tags:        row.Tags,
+			CategoryID:  uuid.UUID(row.CategoryID.Bytes),
+		},
+		BaseUpdatedAt: toTimePtr(row.BaseUpdatedAt),
+		UpdatedAt:     row.UpdatedAt.Time,
+	}
+}
+
+func toTimePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,149 @@
+package postgresql
+
+import (
+	"context"
+	"log/slog"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AddCatalogFavorite marks an item as a favorite of a user. Adding a favorite twice is not an
+// error. It returns entity.ErrNotFound if the item does not exist or is deleted.
+func (r *Repo) AddCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error {
+	const op = "adapter.sqlc.AddCatalogFavorite"
+
+	n, err := r.Queries.AddCatalogFavorite(ctx, sqlc.AddCatalogFavoriteParams{UserID: userID, ItemID: itemID})
+	if err != nil {
+		r.log.Error("failed to add catalog favorite", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n == 0 {
+		return entity.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteCatalogFavorite unmarks a favorite item of a user. It returns entity.ErrNotFound if
+// the item is not a favorite of the user.
+func (r *Repo) DeleteCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error {
+	const op = "adapter.sqlc.DeleteCatalogFavorite"
+
+	n, err := r.Queries.DeleteCatalogFavorite(ctx, sqlc.DeleteCatalogFavoriteParams{UserID: userID, ItemID: itemID})
+	if err != nil {
+		r.log.Error("failed to delete catalog favorite", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n == 0 {
+		return entity.ErrNotFound
+	}
+	return nil
+}
+
+// ListCatalogFavorites retrieves up to limit favorite items of a user after the after cursor,
+// most recently added first. A nil after starts at the first favorite.
+func (r *Repo) ListCatalogFavorites(ctx context.Context, userID uuid.UUID, after *entity.CatalogFavoriteCursor, limit int) ([]entity.CatalogFavorite, error) {
+	const op = "adapter.sqlc.ListCatalogFavorites"
+
+	params := sqlc.ListCatalogFavoriteItemsParams{UserID: userID, PageSize: int32(limit)}
+	if after != nil {
+		params.AfterItemID = toUUID(after.ItemID)
+		params.AfterAddedAt = pgtype.Timestamptz{Time: after.AddedAt, Valid: true}
+	}
+	rows, err := r.Queries.ListCatalogFavoriteItems(ctx, params)
+	if err != nil {
+		r.log.Error("failed to list catalog favorites", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	catalogRows := make([]catalogRow, len(rows))
+	for i, row := range rows {
+		catalogRows[i] = catalogRow{
+			ID:          row.ID,
+			Title:       row.Title,
+			Description: row.Description,
+			Disabled:    row.Disabled,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+			CategoryID:  row.CategoryID,
+			Sku:         row.Sku,
+		}
+	}
+	items, err := withCatalogDetails(ctx, r.Queries, catalogRows)
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	favorites := make([]entity.CatalogFavorite, len(items))
+	for i := range items {
+		favorites[i] = entity.CatalogFavorite{Item: items[i], AddedAt: rows[i].AddedAt.Time}
+	}
+	return favorites, nil
+}
+
+// ListCatalogFavoriteIDs returns those of itemIDs that are favorites of a user.
+func (r *Repo) ListCatalogFavoriteIDs(ctx context.Context, userID uuid.UUID, itemIDs []uuid.UUID) ([]uuid.UUID, error) {
+	const op = "adapter.sqlc.ListCatalogFavoriteIDs"
+
+	ids, err := r.Queries.ListCatalogFavoriteIDs(ctx, sqlc.ListCatalogFavoriteIDsParams{UserID: userID, ItemIds: itemIDs})
+	if err != nil {
+		r.log.Error("failed to list catalog favorite ids", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return ids, nil
+}
+
+// RecordCatalogView records that a user viewed an item now and drops all but the keep most
+// recent views of the user. It returns entity.ErrNotFound if the item does not exist or is deleted.
+func (r *Repo) RecordCatalogView(ctx context.Context, userID, itemID uuid.UUID, keep int) error {
+	const op = "adapter.sqlc.RecordCatalogView"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	n, err := q.RecordCatalogView(ctx, sqlc.RecordCatalogViewParams{UserID: userID, ItemID: itemID})
+	if err != nil {
+		r.log.Error("failed to record catalog view", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n == 0 {
+		return entity.ErrNotFound
+	}
+	if err := q.TrimCatalogViews(ctx, sqlc.TrimCatalogViewsParams{UserID: userID, Keep: int32(keep)}); err != nil {
+		r.log.Error("failed to trim catalog views", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog view", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// ListRecentlyViewedCatalogItems retrieves the items a user viewed, most recently viewed first.
+func (r *Repo) ListRecentlyViewedCatalogItems(ctx context.Context, userID uuid.UUID) ([]entity.CatalogItem, error) {
+	const op = "adapter.sqlc.ListRecentlyViewedCatalogItems"
+
+	rows, err := r.Queries.ListRecentlyViewedCatalogItems(ctx, userID)
+	if err != nil {
+		r.log.Error("failed to list recently viewed catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	items, err := withCatalogDetails(ctx, r.Queries, toCatalogRows(rows))
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return items, nil
+}
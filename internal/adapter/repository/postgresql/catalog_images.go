@@ -0,0 +1,105 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateCatalogImage records an image under its preassigned id and fills in the generated
+// fields. It returns entity.ErrNotFound if the item does not exist.
+func (r *Repo) CreateCatalogImage(ctx context.Context, img *entity.CatalogImage) error {
+	const op = "adapter.sqlc.CreateCatalogImage"
+
+	var row sqlc.CatalogImage
+	err := r.InTx(ctx, func(tx *Repo) error {
+		var err error
+		row, err = tx.Queries.CreateCatalogImage(ctx, sqlc.CreateCatalogImageParams{
+			ID:          img.ID,
+			ItemID:      img.ItemID,
+			ContentType: img.ContentType,
+			Width:       int32(img.Width),
+			Height:      int32(img.Height),
+			Size:        img.Size,
+		})
+		if err != nil {
+			return err
+		}
+		return addOutboxEvents(ctx, tx.Queries, catalogEvent(entity.EventCatalogUpdated, img.ItemID))
+	})
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			return entity.ErrNotFound
+		}
+		r.log.Error("failed to create catalog image", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	*img = *toCatalogImage(row)
+	return nil
+}
+
+// GetCatalogImage retrieves a catalog image by id.
+func (r *Repo) GetCatalogImage(ctx context.Context, id uuid.UUID) (*entity.CatalogImage, error) {
+	const op = "adapter.sqlc.GetCatalogImage"
+
+	row, err := r.Queries.GetCatalogImage(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get catalog image", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return toCatalogImage(row), nil
+}
+
+// DeleteCatalogImage deletes an image of an item. It returns entity.ErrNotFound if the item
+// has no image with that id.
+func (r *Repo) DeleteCatalogImage(ctx context.Context, itemID, id uuid.UUID) error {
+	const op = "adapter.sqlc.DeleteCatalogImage"
+
+	err := r.InTx(ctx, func(tx *Repo) error {
+		n, err := tx.Queries.DeleteCatalogImage(ctx, sqlc.DeleteCatalogImageParams{ID: id, ItemID: itemID})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return entity.ErrNotFound
+		}
+		return addOutboxEvents(ctx, tx.Queries, catalogEvent(entity.EventCatalogUpdated, itemID))
+	})
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		r.log.Error("failed to delete catalog image", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return err
+}
+
+// CatalogImageExists reports whether an image with id is recorded.
+func (r *Repo) CatalogImageExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	const op = "adapter.sqlc.CatalogImageExists"
+
+	exists, err := r.Queries.CatalogImageExists(ctx, id)
+	if err != nil {
+		r.log.Error("failed to check catalog image", slog.String("op", op), slog.String("error", err.Error()))
+		return false, err
+	}
+	return exists, nil
+}
+
+func toCatalogImage(row sqlc.CatalogImage) *entity.CatalogImage {
+	return &entity.CatalogImage{
+		ID:          row.ID,
+		ItemID:      row.ItemID,
+		ContentType: row.ContentType,
+		Width:       int(row.Width),
+		Height:      int(row.Height),
+		Size:        row.Size,
+		CreatedAt:   row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,153 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// ImportCatalogItems applies import rows in one transaction, matching items by SKU. Upserts
+// create the items with unknown SKUs and update the others; with opts.Prune, items whose SKU
+// is not among rows are deleted as well. If a delete row names an unknown SKU, nothing is
+// written and the SKUs are reported in Missing. A dry run rolls back after counting.
+// It returns an entity.ErrConflict error if a SKU or a category changed concurrently.
+func (r *Repo) ImportCatalogItems(ctx context.Context, rows []entity.CatalogImportRow, opts entity.CatalogImportOptions, language string) (*entity.CatalogImportResult, error) {
+	const op = "adapter.sqlc.ImportCatalogItems"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	skus := make([]string, len(rows))
+	for i, row := range rows {
+		skus[i] = row.Item.SKU
+	}
+	existing, err := q.ListCatalogItemIDsBySKU(ctx, skus)
+	if err != nil {
+		return nil, r.importError(op, err)
+	}
+	ids := make(map[string]uuid.UUID, len(existing))
+	for _, row := range existing {
+		ids[row.Sku.String] = row.ID
+	}
+
+	res := &entity.CatalogImportResult{}
+	var events []sqlc.CreateOutboxEventsParams
+	for _, row := range rows {
+		if row.Action == entity.CatalogImportDelete {
+			if _, ok := ids[row.Item.SKU]; !ok {
+				res.Missing = append(res.Missing, row.Item.SKU)
+			}
+		}
+	}
+	if len(res.Missing) > 0 {
+		return res, nil
+	}
+
+	for _, row := range rows {
+		id, exists := ids[row.Item.SKU]
+		switch {
+		case row.Action == entity.CatalogImportDelete:
+			if _, err := q.DeleteCatalogItem(ctx, id); err != nil {
+				return nil, r.importError(op, err)
+			}
+			events = append(events, catalogEvent(entity.EventCatalogDeleted, id))
+			res.Deleted++
+		case exists:
+			if err := updateImportedCatalogItem(ctx, q, id, row.Item, language); err != nil {
+				return nil, r.importError(op, err)
+			}
+			events = append(events, catalogEvent(entity.EventCatalogUpdated, id))
+			res.Updated++
+		default:
+			id, err := createImportedCatalogItem(ctx, q, row.Item, language)
+			if err != nil {
+				return nil, r.importError(op, err)
+			}
+			events = append(events, catalogEvent(entity.EventCatalogCreated, id))
+			res.Created++
+		}
+	}
+
+	if opts.Prune {
+		pruned, err := q.DeleteCatalogItemsNotInSKUs(ctx, skus)
+		if err != nil {
+			return nil, r.importError(op, err)
+		}
+		for _, id := range pruned {
+			events = append(events, catalogEvent(entity.EventCatalogDeleted, id))
+		}
+		res.Deleted += len(pruned)
+	}
+
+	if opts.DryRun {
+		return res, nil
+	}
+	if err := addOutboxEvents(ctx, q, events...); err != nil {
+		return nil, r.importError(op, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog import", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return res, nil
+}
+
+func createImportedCatalogItem(ctx context.Context, q *sqlc.Queries, item entity.CatalogItem, language string) (uuid.UUID, error) {
+	row, err := q.CreateCatalogItem(ctx, sqlc.CreateCatalogItemParams{
+		Title:          item.Title,
+		Description:    toText(item.Description),
+		Disabled:       item.Disabled,
+		CategoryID:     toUUID(item.CategoryID),
+		SearchLanguage: language,
+		Sku:            toText(item.SKU),
+	})
+	if isPgError(err, pgUniqueViolation) {
+		return uuid.Nil, fmt.Errorf("%w: sku %q was created concurrently", entity.ErrConflict, item.SKU)
+	}
+	if err := importedCategoryError(item, err); err != nil {
+		return uuid.Nil, err
+	}
+	return row.ID, replaceCatalogItemTags(ctx, q, row.ID, item.Tags)
+}
+
+func updateImportedCatalogItem(ctx context.Context, q *sqlc.Queries, id uuid.UUID, item entity.CatalogItem, language string) error {
+	_, err := q.UpdateCatalogItem(ctx, sqlc.UpdateCatalogItemParams{
+		ID:             id,
+		Title:          item.Title,
+		Description:    toText(item.Description),
+		Disabled:       item.Disabled,
+		CategoryID:     toUUID(item.CategoryID),
+		SearchLanguage: language,
+	})
+	if err := importedCategoryError(item, err); err != nil {
+		return err
+	}
+	return replaceCatalogItemTags(ctx, q, id, item.Tags)
+}
+
+// importedCategoryError reports a category deleted after the import was validated as a conflict.
+func importedCategoryError(item entity.CatalogItem, err error) error {
+	if isPgError(err, pgForeignKeyViolation) {
+		return fmt.Errorf("%w: the category of sku %q no longer exists", entity.ErrConflict, item.SKU)
+	}
+	return err
+}
+
+// importError logs unexpected errors; conflicts are expected outcomes.
+func (r *Repo) importError(op string, err error) error {
+	if !errors.Is(err, entity.ErrConflict) {
+		r.log.Error("failed to import catalog items", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return err
+}
@@ -0,0 +1,223 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SetCatalogInventory replaces the price and stock quantity of an item. A nil price removes
+// the price and a nil stock stops tracking the stock. It returns entity.ErrNotFound if the
+// item does not exist or is deleted.
+func (r *Repo) SetCatalogInventory(ctx context.Context, id uuid.UUID, price *entity.Money, stock *int64) (*entity.CatalogItem, error) {
+	const op = "adapter.sqlc.SetCatalogInventory"
+
+	amount, currency := fromMoney(price)
+	params := sqlc.UpsertCatalogInventoryParams{
+		ItemID:   id,
+		Price:    amount,
+		Currency: currency,
+	}
+	if stock != nil {
+		params.StockQuantity = pgtype.Int8{Int64: *stock, Valid: true}
+	}
+	err := r.InTx(ctx, func(tx *Repo) error {
+		n, err := tx.Queries.UpsertCatalogInventory(ctx, params)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return entity.ErrNotFound
+		}
+		return addOutboxEvents(ctx, tx.Queries, catalogEvent(entity.EventCatalogUpdated, id))
+	})
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			r.log.Error("failed to set catalog inventory", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	return r.GetCatalogItem(ctx, id)
+}
+
+// ReserveCatalogItem reserves res.Quantity units of res.ItemID for res.UserID until
+// res.ExpiresAt and fills in the generated fields. The inventory row of the item stays locked
+// until the reservation is stored, so concurrent reservations cannot oversell the stock.
+// It returns entity.ErrNotFound if the item does not exist or is deleted, and
+// entity.ErrConflict if the item is disabled, its stock is not tracked or too few units are left.
+func (r *Repo) ReserveCatalogItem(ctx context.Context, res *entity.CatalogReservation) error {
+	const op = "adapter.sqlc.ReserveCatalogItem"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	inventory, err := q.GetCatalogInventoryForUpdate(ctx, res.ItemID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Either the item is missing or it has no inventory row.
+		if _, err := q.GetCatalogItem(ctx, res.ItemID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return entity.ErrNotFound
+			}
+			r.log.Error("failed to get catalog item", slog.String("op", op), slog.String("error", err.Error()))
+			return err
+		}
+		return fmt.Errorf("%w: the stock of item %s is not tracked", entity.ErrConflict, res.ItemID)
+	}
+	if err != nil {
+		r.log.Error("failed to lock catalog inventory", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if inventory.Disabled {
+		return fmt.Errorf("%w: item %s is disabled", entity.ErrConflict, res.ItemID)
+	}
+	if !inventory.StockQuantity.Valid {
+		return fmt.Errorf("%w: the stock of item %s is not tracked", entity.ErrConflict, res.ItemID)
+	}
+
+	if err := q.DeleteExpiredCatalogReservations(ctx, res.ItemID); err != nil {
+		r.log.Error("failed to delete expired catalog reservations", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	reserved, err := q.GetReservedQuantity(ctx, res.ItemID)
+	if err != nil {
+		r.log.Error("failed to get reserved quantity", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if available := max(inventory.StockQuantity.Int64-reserved, 0); available < res.Quantity {
+		return fmt.Errorf("%w: only %d units of item %s are available", entity.ErrConflict, available, res.ItemID)
+	}
+
+	row, err := q.CreateCatalogReservation(ctx, sqlc.CreateCatalogReservationParams{
+		ItemID:    res.ItemID,
+		UserID:    res.UserID,
+		Quantity:  res.Quantity,
+		UnitPrice: inventory.Price,
+		Currency:  inventory.Currency,
+		ExpiresAt: pgtype.Timestamptz{Time: res.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		r.log.Error("failed to create catalog reservation", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	reservation, err := toCatalogReservation(row)
+	if err != nil {
+		r.log.Error("failed to convert catalog reservation", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog reservation", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	*res = *reservation
+	return nil
+}
+
+// ReleaseCatalogReservation deletes a reservation of an item made by userID. It returns
+// entity.ErrNotFound if there is no such reservation, e.g. because it expired and was purged.
+func (r *Repo) ReleaseCatalogReservation(ctx context.Context, itemID, reservationID, userID uuid.UUID) error {
+	const op = "adapter.sqlc.ReleaseCatalogReservation"
+
+	n, err := r.Queries.DeleteCatalogReservation(ctx, sqlc.DeleteCatalogReservationParams{
+		ID:     reservationID,
+		ItemID: itemID,
+		UserID: userID,
+	})
+	if err != nil {
+		r.log.Error("failed to release catalog reservation", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n == 0 {
+		return entity.ErrNotFound
+	}
+	return nil
+}
+
+// withCatalogInventory fills in the price and stock of items.
+func withCatalogInventory(ctx context.Context, q *sqlc.Queries, items []entity.CatalogItem, ids []uuid.UUID, index map[uuid.UUID]int) error {
+	rows, err := q.ListCatalogInventory(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		item := &items[index[row.ItemID]]
+		if item.Price, err = toMoney(row.Price, row.Currency); err != nil {
+			return err
+		}
+		if row.StockQuantity.Valid {
+			stock := row.StockQuantity.Int64
+			item.StockQuantity = &stock
+		}
+		item.ReservedQuantity = row.ReservedQuantity
+	}
+	return nil
+}
+
+func toCatalogReservation(row sqlc.CatalogReservation) (*entity.CatalogReservation, error) {
+	price, err := toMoney(row.UnitPrice, row.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.CatalogReservation{
+		ID:        row.ID,
+		ItemID:    row.ItemID,
+		UserID:    row.UserID,
+		Quantity:  row.Quantity,
+		UnitPrice: price,
+		CreatedAt: row.CreatedAt.Time,
+		ExpiresAt: row.ExpiresAt.Time,
+	}, nil
+}
+
+// fromMoney stores an amount in minor units as a decimal in major units, e.g. 1999 EUR cents as 19.99.
+func fromMoney(m *entity.Money) (pgtype.Numeric, pgtype.Text) {
+	if m == nil {
+		return pgtype.Numeric{}, pgtype.Text{}
+	}
+	return pgtype.Numeric{
+		Int:   big.NewInt(m.Amount),
+		Exp:   -int32(entity.CurrencyScale(m.Currency)),
+		Valid: true,
+	}, pgtype.Text{String: m.Currency, Valid: true}
+}
+
+// toMoney is the inverse of fromMoney. It fails on decimals with more fraction digits than
+// the currency has, which fromMoney never writes.
+func toMoney(amount pgtype.Numeric, currency pgtype.Text) (*entity.Money, error) {
+	if !amount.Valid || !currency.Valid {
+		return nil, nil
+	}
+	if amount.NaN || amount.InfinityModifier != pgtype.Finite {
+		return nil, fmt.Errorf("invalid %s amount", currency.String)
+	}
+
+	minor := new(big.Int).Set(amount.Int)
+	shift := int64(amount.Exp) + int64(entity.CurrencyScale(currency.String))
+	if shift >= 0 {
+		minor.Mul(minor, new(big.Int).Exp(big.NewInt(10), big.NewInt(shift), nil))
+	} else {
+		var rem big.Int
+		minor.QuoRem(minor, new(big.Int).Exp(big.NewInt(10), big.NewInt(-shift), nil), &rem)
+		if rem.Sign() != 0 {
+			return nil, fmt.Errorf("%s amount has too many fraction digits", currency.String)
+		}
+	}
+	if !minor.IsInt64() {
+		return nil, entity.ErrMoneyOverflow
+	}
+	return &entity.Money{Amount: minor.Int64(), Currency: currency.String}, nil
+}
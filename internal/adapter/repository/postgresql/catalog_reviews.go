@@ -0,0 +1,278 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetCatalogReview retrieves the review of an item left by userID. It returns
+// entity.ErrNotFound if the user has not reviewed the item or the item is deleted.
+func (r *Repo) GetCatalogReview(ctx context.Context, itemID, userID uuid.UUID) (*entity.CatalogReview, error) {
+	const op = "adapter.sqlc.GetCatalogReview"
+
+	row, err := r.Queries.GetCatalogReview(ctx, sqlc.GetCatalogReviewParams{ItemID: itemID, UserID: userID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get catalog review", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return toCatalogReview(row), nil
+}
+
+// SaveCatalogReview creates or replaces the review of review.ItemID by review.UserID and
+// fills in the generated fields. Replacing a rejected review makes it pending, whatever
+// review.Status asks for. The running totals of the item are updated in the same
+// transaction. It returns entity.ErrNotFound if the item does not exist or is deleted.
+func (r *Repo) SaveCatalogReview(ctx context.Context, review *entity.CatalogReview) error {
+	const op = "adapter.sqlc.SaveCatalogReview"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	old, err := q.GetCatalogReviewForUpdate(ctx, sqlc.GetCatalogReviewForUpdateParams{ItemID: review.ItemID, UserID: review.UserID})
+	var row sqlc.CatalogReview
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		row, err = q.CreateCatalogReview(ctx, sqlc.CreateCatalogReviewParams{
+			UserID: review.UserID,
+			Rating: int16(review.Rating),
+			Body:   review.Body,
+			Status: string(review.Status),
+			ItemID: review.ItemID,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ErrNotFound
+		}
+		if isPgError(err, pgUniqueViolation) {
+			return fmt.Errorf("%w: item %s was reviewed concurrently", entity.ErrConflict, review.ItemID)
+		}
+	case err != nil:
+		r.log.Error("failed to lock catalog review", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	default:
+		if _, err := q.GetCatalogItem(ctx, review.ItemID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return entity.ErrNotFound
+			}
+			r.log.Error("failed to get catalog item", slog.String("op", op), slog.String("error", err.Error()))
+			return err
+		}
+		row, err = q.UpdateCatalogReview(ctx, sqlc.UpdateCatalogReviewParams{
+			ID:     old.ID,
+			Rating: int16(review.Rating),
+			Body:   review.Body,
+			Status: string(review.Status),
+		})
+	}
+	if err != nil {
+		r.log.Error("failed to save catalog review", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	if err := addCatalogReviewStats(ctx, q, row.ItemID, reviewContribution(old), reviewContribution(row)); err != nil {
+		r.log.Error("failed to update catalog review stats", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := addOutboxEvents(ctx, q, catalogEvent(entity.EventCatalogUpdated, row.ItemID)); err != nil {
+		r.log.Error("failed to add outbox event", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog review", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	*review = *toCatalogReview(row)
+	return nil
+}
+
+// DeleteCatalogReview deletes the review of an item left by userID and removes it from the
+// running totals of the item. It returns entity.ErrNotFound if there is no such review.
+func (r *Repo) DeleteCatalogReview(ctx context.Context, itemID, userID uuid.UUID) error {
+	const op = "adapter.sqlc.DeleteCatalogReview"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	old, err := q.GetCatalogReviewForUpdate(ctx, sqlc.GetCatalogReviewForUpdateParams{ItemID: itemID, UserID: userID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ErrNotFound
+		}
+		r.log.Error("failed to lock catalog review", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := q.DeleteCatalogReview(ctx, old.ID); err != nil {
+		r.log.Error("failed to delete catalog review", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := addCatalogReviewStats(ctx, q, itemID, reviewContribution(old), reviewStats{}); err != nil {
+		r.log.Error("failed to update catalog review stats", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := addOutboxEvents(ctx, q, catalogEvent(entity.EventCatalogUpdated, itemID)); err != nil {
+		r.log.Error("failed to add outbox event", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog review deletion", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// SetCatalogReviewStatus moderates a review, adding it to or removing it from the running
+// totals of its item. It returns entity.ErrNotFound if the review does not exist.
+func (r *Repo) SetCatalogReviewStatus(ctx context.Context, id uuid.UUID, status entity.CatalogReviewStatus) (*entity.CatalogReview, error) {
+	const op = "adapter.sqlc.SetCatalogReviewStatus"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	old, err := q.GetCatalogReviewByIDForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to lock catalog review", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	row, err := q.SetCatalogReviewStatus(ctx, sqlc.SetCatalogReviewStatusParams{ID: id, Status: string(status)})
+	if err != nil {
+		r.log.Error("failed to set catalog review status", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if err := addCatalogReviewStats(ctx, q, row.ItemID, reviewContribution(old), reviewContribution(row)); err != nil {
+		r.log.Error("failed to update catalog review stats", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if err := addOutboxEvents(ctx, q, catalogEvent(entity.EventCatalogUpdated, row.ItemID)); err != nil {
+		r.log.Error("failed to add outbox event", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog review status", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return toCatalogReview(row), nil
+}
+
+// ListCatalogReviews retrieves up to limit reviews of an item with the given status, newest first.
+func (r *Repo) ListCatalogReviews(ctx context.Context, itemID uuid.UUID, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error) {
+	const op = "adapter.sqlc.ListCatalogReviews"
+
+	rows, err := r.Queries.ListCatalogReviews(ctx, sqlc.ListCatalogReviewsParams{
+		ItemID:   itemID,
+		Status:   string(status),
+		PageSize: limit,
+	})
+	if err != nil {
+		r.log.Error("failed to list catalog reviews", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return toCatalogReviews(rows), nil
+}
+
+// ListCatalogReviewsByStatus retrieves up to limit reviews of all items with the given
+// status, least recently changed first.
+func (r *Repo) ListCatalogReviewsByStatus(ctx context.Context, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error) {
+	const op = "adapter.sqlc.ListCatalogReviewsByStatus"
+
+	rows, err := r.Queries.ListCatalogReviewsByStatus(ctx, sqlc.ListCatalogReviewsByStatusParams{
+		Status:   string(status),
+		PageSize: limit,
+	})
+	if err != nil {
+		r.log.Error("failed to list catalog reviews", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return toCatalogReviews(rows), nil
+}
+
+// reviewStats is what a review adds to the running totals of its item.
+type reviewStats struct {
+	count, ratingSum int64
+}
+
+// reviewContribution returns what row adds to the totals: only approved reviews count. The
+// zero row of a review that does not exist yet adds nothing.
+func reviewContribution(row sqlc.CatalogReview) reviewStats {
+	if row.Status != string(entity.CatalogReviewApproved) {
+		return reviewStats{}
+	}
+	return reviewStats{count: 1, ratingSum: int64(row.Rating)}
+}
+
+// addCatalogReviewStats replaces the contribution old of a review with new. The caller must
+// hold the lock of the review row, so concurrent changes of the same review cannot both apply
+// a delta computed from the same old state.
+func addCatalogReviewStats(ctx context.Context, q *sqlc.Queries, itemID uuid.UUID, old, new reviewStats) error {
+	if old == new {
+		return nil
+	}
+	return q.AddCatalogReviewStats(ctx, sqlc.AddCatalogReviewStatsParams{
+		ItemID:      itemID,
+		ReviewCount: new.count - old.count,
+		RatingSum:   new.ratingSum - old.ratingSum,
+	})
+}
+
+// withCatalogReviewStats fills in the review totals of items.
+func withCatalogReviewStats(ctx context.Context, q *sqlc.Queries, items []entity.CatalogItem, ids []uuid.UUID, index map[uuid.UUID]int) error {
+	rows, err := q.ListCatalogReviewStats(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		item := &items[index[row.ItemID]]
+		item.ReviewCount = row.ReviewCount
+		item.RatingSum = row.RatingSum
+	}
+	return nil
+}
+
+func toCatalogReview(row sqlc.CatalogReview) *entity.CatalogReview {
+	return &entity.CatalogReview{
+		ID:        row.ID,
+		ItemID:    row.ItemID,
+		UserID:    row.UserID,
+		Rating:    int(row.Rating),
+		Body:      row.Body,
+		Status:    entity.CatalogReviewStatus(row.Status),
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+}
+
+func toCatalogReviews(rows []sqlc.CatalogReview) []entity.CatalogReview {
+	reviews := make([]entity.CatalogReview, len(rows))
+	for i, row := range rows {
+		reviews[i] = *toCatalogReview(row)
+	}
+	return reviews
+}
@@ -0,0 +1,130 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// statsDB applies AddCatalogReviewStats to in-memory totals like the upsert of the query does.
+// Other statements and unexpected arguments fail, so a changed query cannot pass unnoticed.
+type statsDB struct {
+	sqlc.DBTX
+	totals map[uuid.UUID]reviewStats
+	execs  int
+}
+
+func (db *statsDB) Exec(_ context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	if !strings.HasPrefix(sql, "-- name: AddCatalogReviewStats ") {
+		return pgconn.CommandTag{}, fmt.Errorf("unexpected statement %q", sql)
+	}
+	if len(args) != 3 {
+		return pgconn.CommandTag{}, fmt.Errorf("AddCatalogReviewStats got %d arguments, want 3", len(args))
+	}
+	id, okID := args[0].(uuid.UUID)
+	count, okCount := args[1].(int64)
+	ratingSum, okSum := args[2].(int64)
+	if !okID || !okCount || !okSum {
+		return pgconn.CommandTag{}, fmt.Errorf("AddCatalogReviewStats got arguments %T, %T, %T; want uuid.UUID, int64, int64",
+			args[0], args[1], args[2])
+	}
+
+	db.execs++
+	t := db.totals[id]
+	t.count += count
+	t.ratingSum += ratingSum
+	db.totals[id] = t
+	return pgconn.CommandTag{}, nil
+}
+
+func review(status entity.CatalogReviewStatus, rating int16) sqlc.CatalogReview {
+	return sqlc.CatalogReview{Status: string(status), Rating: rating}
+}
+
+func TestReviewContribution(t *testing.T) {
+	tests := []struct {
+		name string
+		row  sqlc.CatalogReview
+		want reviewStats
+	}{
+		{"no review", sqlc.CatalogReview{}, reviewStats{}},
+		{"pending", review(entity.CatalogReviewPending, 4), reviewStats{}},
+		{"rejected", review(entity.CatalogReviewRejected, 4), reviewStats{}},
+		{"approved", review(entity.CatalogReviewApproved, 4), reviewStats{count: 1, ratingSum: 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reviewContribution(tt.row); got != tt.want {
+				t.Errorf("reviewContribution() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCatalogReviewStatsFollowReviews replays the transitions of the write paths of a review
+// and checks after each that the running totals equal the totals recomputed from scratch.
+func TestCatalogReviewStatsFollowReviews(t *testing.T) {
+	var (
+		none     = sqlc.CatalogReview{}
+		approved = func(rating int16) sqlc.CatalogReview { return review(entity.CatalogReviewApproved, rating) }
+		pending  = func(rating int16) sqlc.CatalogReview { return review(entity.CatalogReviewPending, rating) }
+		rejected = func(rating int16) sqlc.CatalogReview { return review(entity.CatalogReviewRejected, rating) }
+	)
+	steps := []struct {
+		name     string
+		user     int
+		new      sqlc.CatalogReview
+		wantExec bool
+	}{
+		{"create approved", 0, approved(5), true},
+		{"create pending", 1, pending(1), false},
+		{"approve", 1, approved(1), true},
+		{"edit rating", 0, approved(3), true},
+		{"edit body only", 0, approved(3), false},
+		{"reset to pending", 0, pending(3), true},
+		{"edit pending", 0, pending(2), false},
+		{"reject", 1, rejected(1), true},
+		{"create approved", 2, approved(4), true},
+		{"approve after edit", 0, approved(2), true},
+		{"delete approved", 2, none, true},
+		{"delete rejected", 1, none, false},
+	}
+
+	ctx := context.Background()
+	itemID := uuid.New()
+	db := &statsDB{totals: make(map[uuid.UUID]reviewStats)}
+	q := sqlc.New(db)
+	reviews := make(map[int]sqlc.CatalogReview)
+	for _, step := range steps {
+		execs := db.execs
+		if err := addCatalogReviewStats(ctx, q, itemID, reviewContribution(reviews[step.user]), reviewContribution(step.new)); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if step.new == none {
+			delete(reviews, step.user)
+		} else {
+			reviews[step.user] = step.new
+		}
+
+		if got := db.execs > execs; got != step.wantExec {
+			t.Errorf("%s: updated the totals = %v, want %v", step.name, got, step.wantExec)
+		}
+		var want reviewStats
+		for _, row := range reviews {
+			if row.Status == string(entity.CatalogReviewApproved) {
+				want.count++
+				want.ratingSum += int64(row.Rating)
+			}
+		}
+		if got := db.totals[itemID]; got != want {
+			t.Errorf("%s: totals = %+v, want %+v", step.name, got, want)
+		}
+	}
+}
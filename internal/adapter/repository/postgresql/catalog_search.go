@@ -0,0 +1,150 @@
+package postgresql
+
+import (
+	"context"
+	"html"
+	"log/slog"
+	"strings"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Markers the search queries put around matched words. Control characters cannot clash
+// with HTML, so the text can be escaped before the markers become <mark> elements.
+const (
+	highlightStart = "\x01"
+	highlightStop  = "\x02"
+)
+
+var highlightReplacer = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+// SearchCatalogItems runs a full-text query and returns the best ranked matches.
+func (r *Repo) SearchCatalogItems(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error) {
+	const op = "adapter.sqlc.SearchCatalogItems"
+
+	rows, err := r.Queries.SearchCatalogItems(ctx, sqlc.SearchCatalogItemsParams{
+		Language: q.Language,
+		Query:    q.Query,
+		Disabled: toBool(q.Disabled),
+		PageSize: int32(q.Limit),
+	})
+	if err != nil {
+		r.log.Error("failed to search catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	catalogRows := make([]catalogRow, len(rows))
+	for i, row := range rows {
+		catalogRows[i] = catalogRow{
+			ID:          row.ID,
+			Title:       row.Title,
+			Description: row.Description,
+			Disabled:    row.Disabled,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+			CategoryID:  row.CategoryID,
+			Sku:         row.Sku,
+		}
+	}
+	items, err := withCatalogDetails(ctx, r.Queries, catalogRows)
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	hits := make([]entity.CatalogSearchHit, len(rows))
+	for i, row := range rows {
+		hits[i] = entity.CatalogSearchHit{
+			Item:                 items[i],
+			Score:                row.Rank,
+			TitleHighlight:       highlightHTML(row.TitleHighlight),
+			DescriptionHighlight: highlightHTML(row.DescriptionHighlight),
+		}
+	}
+	return hits, nil
+}
+
+// SearchCatalogItemsFuzzy returns items whose title contains a word similar to the query.
+// It tolerates typos that full-text search cannot, at the cost of ignoring descriptions.
+func (r *Repo) SearchCatalogItemsFuzzy(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error) {
+	const op = "adapter.sqlc.SearchCatalogItemsFuzzy"
+
+	// The threshold is a session setting; a transaction keeps it from leaking into the pool.
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := r.Queries.WithTx(tx)
+	if err := qtx.SetWordSimilarityThreshold(ctx, q.FuzzyThreshold); err != nil {
+		r.log.Error("failed to set similarity threshold", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	rows, err := qtx.SearchCatalogItemsFuzzy(ctx, sqlc.SearchCatalogItemsFuzzyParams{
+		Query:    q.Query,
+		Disabled: toBool(q.Disabled),
+		PageSize: int32(q.Limit),
+	})
+	if err != nil {
+		r.log.Error("failed to search catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	catalogRows := make([]catalogRow, len(rows))
+	for i, row := range rows {
+		catalogRows[i] = catalogRow{
+			ID:          row.ID,
+			Title:       row.Title,
+			Description: row.Description,
+			Disabled:    row.Disabled,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+			CategoryID:  row.CategoryID,
+			Sku:         row.Sku,
+		}
+	}
+	items, err := withCatalogDetails(ctx, qtx, catalogRows)
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	hits := make([]entity.CatalogSearchHit, len(rows))
+	for i, row := range rows {
+		hits[i] = entity.CatalogSearchHit{
+			Item:                 items[i],
+			Score:                row.Similarity,
+			TitleHighlight:       html.EscapeString(row.Title),
+			DescriptionHighlight: html.EscapeString(row.Description.String),
+		}
+	}
+	return hits, nil
+}
+
+// SetCatalogSearchLanguage re-indexes every item not yet indexed with language.
+// It returns the number of items that changed.
+func (r *Repo) SetCatalogSearchLanguage(ctx context.Context, language string) (int64, error) {
+	const op = "adapter.sqlc.SetCatalogSearchLanguage"
+
+	n, err := r.Queries.SetCatalogSearchLanguage(ctx, language)
+	if err != nil {
+		r.log.Error("failed to set catalog search language", slog.String("op", op), slog.String("error", err.Error()))
+		return 0, err
+	}
+	return n, nil
+}
+
+func highlightHTML(s string) string {
+	return highlightReplacer.Replace(html.EscapeString(s))
+}
+
+func toBool(b *bool) pgtype.Bool {
+	if b == nil {
+		return pgtype.Bool{}
+	}
+	return pgtype.Bool{Bool: *b, Valid: true}
+}
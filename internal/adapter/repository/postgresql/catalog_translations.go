@@ -0,0 +1,113 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+	"github.com/google/uuid"
+)
+
+// ListCatalogTranslations returns the translations of an item ordered by locale.
+func (r *Repo) ListCatalogTranslations(ctx context.Context, itemID uuid.UUID) ([]entity.CatalogTranslation, error) {
+	const op = "adapter.sqlc.ListCatalogTranslations"
+
+	rows, err := r.Queries.ListCatalogTranslations(ctx, itemID)
+	if err != nil {
+		r.log.Error("failed to list catalog translations", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	translations := make([]entity.CatalogTranslation, len(rows))
+	for i, row := range rows {
+		translations[i] = *toCatalogTranslation(row)
+	}
+	return translations, nil
+}
+
+// ListCatalogTranslationsForItems returns the translations of the given items into any of
+// the given locales, in no particular order.
+func (r *Repo) ListCatalogTranslationsForItems(ctx context.Context, itemIDs []uuid.UUID, locales []string) ([]entity.CatalogTranslation, error) {
+	const op = "adapter.sqlc.ListCatalogTranslationsForItems"
+
+	if len(itemIDs) == 0 || len(locales) == 0 {
+		return nil, nil
+	}
+	rows, err := r.Queries.ListCatalogTranslationsForItems(ctx, sqlc.ListCatalogTranslationsForItemsParams{
+		ItemIds: itemIDs,
+		Locales: locales,
+	})
+	if err != nil {
+		r.log.Error("failed to list catalog translations", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	translations := make([]entity.CatalogTranslation, len(rows))
+	for i, row := range rows {
+		translations[i] = *toCatalogTranslation(row)
+	}
+	return translations, nil
+}
+
+// SaveCatalogTranslation creates or replaces the translation of an item into one locale and
+// fills in the generated fields. It returns entity.ErrNotFound if the item does not exist.
+func (r *Repo) SaveCatalogTranslation(ctx context.Context, t *entity.CatalogTranslation) error {
+	const op = "adapter.sqlc.SaveCatalogTranslation"
+
+	var row sqlc.CatalogTranslation
+	err := r.InTx(ctx, func(tx *Repo) error {
+		var err error
+		row, err = tx.Queries.UpsertCatalogTranslation(ctx, sqlc.UpsertCatalogTranslationParams{
+			ItemID:      t.ItemID,
+			Locale:      t.Locale,
+			Title:       t.Title,
+			Description: toText(t.Description),
+		})
+		if err != nil {
+			return err
+		}
+		return addOutboxEvents(ctx, tx.Queries, catalogEvent(entity.EventCatalogUpdated, t.ItemID))
+	})
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			return entity.ErrNotFound
+		}
+		r.log.Error("failed to save catalog translation", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	*t = *toCatalogTranslation(row)
+	return nil
+}
+
+// DeleteCatalogTranslation deletes the translation of an item into one locale.
+func (r *Repo) DeleteCatalogTranslation(ctx context.Context, itemID uuid.UUID, locale string) error {
+	const op = "adapter.sqlc.DeleteCatalogTranslation"
+
+	err := r.InTx(ctx, func(tx *Repo) error {
+		n, err := tx.Queries.DeleteCatalogTranslation(ctx, sqlc.DeleteCatalogTranslationParams{ItemID: itemID, Locale: locale})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return entity.ErrNotFound
+		}
+		return addOutboxEvents(ctx, tx.Queries, catalogEvent(entity.EventCatalogUpdated, itemID))
+	})
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		r.log.Error("failed to delete catalog translation", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return err
+}
+
+func toCatalogTranslation(row sqlc.CatalogTranslation) *entity.CatalogTranslation {
+	return &entity.CatalogTranslation{
+		ItemID:      row.ItemID,
+		Locale:      row.Locale,
+		Title:       row.Title,
+		Description: row.Description.String,
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,162 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+	"base_app/pkg/envelope"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// errEncryptionDisabled is returned when an encrypted row is read without a keyring.
+var errEncryptionDisabled = errors.New("value is encrypted but no master keys are configured")
+
+// valueCipher encrypts data values before they are written and decrypts them after they are read,
+// so usecases only ever see plaintext. With a nil keyring values are stored as plain JSONB.
+// The data key is used as additional authenticated data, so a ciphertext cannot be moved to another key.
+type valueCipher struct {
+	keyring *envelope.Keyring
+}
+
+// sealedValue holds the columns that store a data value.
+type sealedValue struct {
+	Value      []byte
+	Ciphertext []byte
+	DataKey    []byte
+	KeyID      pgtype.Text
+}
+
+func (c valueCipher) seal(key string, value []byte) (sealedValue, error) {
+	if c.keyring == nil {
+		return sealedValue{Value: value}, nil
+	}
+
+	env, err := c.keyring.Seal(value, []byte(key))
+	if err != nil {
+		return sealedValue{}, err
+	}
+	return sealedValue{
+		Ciphertext: env.Ciphertext,
+		DataKey:    env.DataKey,
+		KeyID:      pgtype.Text{String: env.KeyID, Valid: true},
+	}, nil
+}
+
+func (c valueCipher) open(row sqlc.Datum) ([]byte, error) {
+	return c.openSealed(row.Key, sealedValue{
+		Value:      row.Value,
+		Ciphertext: row.ValueCiphertext,
+		DataKey:    row.ValueDataKey,
+		KeyID:      row.ValueKeyID,
+	})
+}
+
+// openSealed returns the plaintext of a value sealed under key.
+func (c valueCipher) openSealed(key string, v sealedValue) ([]byte, error) {
+	if !v.KeyID.Valid {
+		return v.Value, nil
+	}
+	if c.keyring == nil {
+		return nil, errEncryptionDisabled
+	}
+
+	return c.keyring.Open(&envelope.Envelope{
+		KeyID:      v.KeyID.String,
+		DataKey:    v.DataKey,
+		Ciphertext: v.Ciphertext,
+	}, []byte(key))
+}
+
+func (c valueCipher) toData(row sqlc.Datum) (*entity.Data, error) {
+	value, err := c.open(row)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &entity.Data{
+		OwnerID:   uuid.UUID(row.OwnerID.Bytes),
+		Key:       row.Key,
+		Value:     value,
+		CreatedAt: row.CreatedAt.Time,
+	}
+	if row.ExpiresAt.Valid {
+		expiresAt := row.ExpiresAt.Time
+		data.ExpiresAt = &expiresAt
+	}
+	if row.DeletedAt.Valid {
+		deletedAt := row.DeletedAt.Time
+		data.DeletedAt = &deletedAt
+	}
+	return data, nil
+}
+
+// RotateDataKeys re-encrypts every row not sealed with the active master key, including
+// plaintext rows, in transactions of batchSize rows. It returns the number of rows rewritten.
+// Rows are rewritten in place, so retired master keys can be removed once it finishes.
+func (r *Repo) RotateDataKeys(ctx context.Context, batchSize int32) (int64, error) {
+	const op = "adapter.sqlc.RotateDataKeys"
+
+	if r.cipher.keyring == nil {
+		return 0, errEncryptionDisabled
+	}
+
+	var (
+		total   int64
+		afterID int32
+	)
+	for {
+		n, lastID, err := r.rotateDataKeysBatch(ctx, afterID, batchSize)
+		if err != nil {
+			r.log.Error("failed to rotate data keys", slog.String("op", op), slog.String("error", err.Error()))
+			return total, err
+		}
+		total += int64(n)
+		if n < int(batchSize) {
+			return total, nil
+		}
+		afterID = lastID
+		r.log.Info("rotated data keys", slog.String("op", op), slog.Int64("rows", total))
+	}
+}
+
+func (r *Repo) rotateDataKeysBatch(ctx context.Context, afterID, batchSize int32) (int, int32, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	rows, err := q.ListDataForRotation(ctx, sqlc.ListDataForRotationParams{
+		AfterID:     afterID,
+		ActiveKeyID: r.cipher.keyring.ActiveKeyID(),
+		BatchSize:   batchSize,
+	})
+	if err != nil || len(rows) == 0 {
+		return 0, afterID, err
+	}
+
+	for _, row := range rows {
+		value, err := r.cipher.open(row)
+		if err != nil {
+			return 0, 0, err
+		}
+		sealed, err := r.cipher.seal(row.Key, value)
+		if err != nil {
+			return 0, 0, err
+		}
+		if err := q.UpdateDataEncryption(ctx, sqlc.UpdateDataEncryptionParams{
+			ID:              row.ID,
+			ValueCiphertext: sealed.Ciphertext,
+			ValueDataKey:    sealed.DataKey,
+			ValueKeyID:      sealed.KeyID,
+		}); err != nil {
+			return 0, 0, err
+		}
+	}
+	return len(rows), rows[len(rows)-1].ID, tx.Commit(ctx)
+}
@@ -0,0 +1,130 @@
+package postgresql
+
+import (
+	"errors"
+	"testing"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/pkg/envelope"
+
+	"github.com/google/uuid"
+)
+
+func testKeyring(t *testing.T, activeID string, ids ...string) *envelope.Keyring {
+	t.Helper()
+	keys := make(map[string][]byte, len(ids))
+	for _, id := range ids {
+		key := make([]byte, envelope.KeySize)
+		copy(key, id)
+		keys[id] = key
+	}
+	kr, err := envelope.NewKeyring(keys, activeID)
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	return kr
+}
+
+// sealedRow returns the row that stores value under key as c seals it.
+func sealedRow(t *testing.T, c valueCipher, key, value string) sqlc.Datum {
+	t.Helper()
+	sealed, err := c.seal(key, []byte(value))
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	return sqlc.Datum{
+		Key:             key,
+		Value:           sealed.Value,
+		ValueCiphertext: sealed.Ciphertext,
+		ValueDataKey:    sealed.DataKey,
+		ValueKeyID:      sealed.KeyID,
+	}
+}
+
+func TestValueCipherPlaintext(t *testing.T) {
+	row := sealedRow(t, valueCipher{}, "k", `"v"`)
+	if row.ValueKeyID.Valid || row.ValueCiphertext != nil || string(row.Value) != `"v"` {
+		t.Fatalf("plaintext row = %+v", row)
+	}
+
+	// Plaintext rows stay readable after encryption is enabled, so they can be rotated.
+	c := valueCipher{keyring: testKeyring(t, "k1", "k1")}
+	if got, err := c.open(row); err != nil || string(got) != `"v"` {
+		t.Errorf("open = %q, %v", got, err)
+	}
+}
+
+func TestValueCipherEncrypts(t *testing.T) {
+	c := valueCipher{keyring: testKeyring(t, "k1", "k1")}
+	row := sealedRow(t, c, "k", `"v"`)
+	if row.Value != nil || row.ValueKeyID.String != "k1" {
+		t.Fatalf("encrypted row = %+v", row)
+	}
+	if got, err := c.open(row); err != nil || string(got) != `"v"` {
+		t.Errorf("open = %q, %v", got, err)
+	}
+
+	// The key is authenticated, so a ciphertext copied to another key does not open.
+	moved := row
+	moved.Key = "other"
+	if _, err := c.open(moved); err == nil {
+		t.Errorf("open of a ciphertext moved to another key succeeded")
+	}
+
+	if _, err := (valueCipher{}).open(row); !errors.Is(err, errEncryptionDisabled) {
+		t.Errorf("open without keyring: err = %v, want errEncryptionDisabled", err)
+	}
+}
+
+func TestValueCipherRotation(t *testing.T) {
+	before := valueCipher{keyring: testKeyring(t, "k1", "k1")}
+	row := sealedRow(t, before, "k", `"v"`)
+
+	// RotateDataKeys opens each row and seals it again with the active key.
+	during := valueCipher{keyring: testKeyring(t, "k2", "k1", "k2")}
+	value, err := during.open(row)
+	if err != nil {
+		t.Fatalf("open with the old key still in the keyring: %v", err)
+	}
+	rotated := sealedRow(t, during, row.Key, string(value))
+	if rotated.ValueKeyID.String != "k2" {
+		t.Errorf("rotated key id = %q, want k2", rotated.ValueKeyID.String)
+	}
+
+	// Once every row is rotated, the old key can be removed.
+	after := valueCipher{keyring: testKeyring(t, "k2", "k2")}
+	if got, err := after.open(rotated); err != nil || string(got) != `"v"` {
+		t.Errorf("open of a rotated row after removing k1 = %q, %v", got, err)
+	}
+	if _, err := after.open(row); !errors.Is(err, envelope.ErrUnknownKey) {
+		t.Errorf("open of an unrotated row after removing k1: err = %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestValueCipherWebhookSecrets(t *testing.T) {
+	c := valueCipher{keyring: testKeyring(t, "k1", "k1")}
+	id := uuid.New()
+	sealed, err := c.sealSecret(id, "s3cret")
+	if err != nil {
+		t.Fatalf("sealSecret: %v", err)
+	}
+	if sealed.text().Valid || sealed.KeyID.String != "k1" {
+		t.Fatalf("sealed secret = %+v, want it encrypted", sealed)
+	}
+	if got, err := c.openSecret(id, sealed); err != nil || got != "s3cret" {
+		t.Errorf("openSecret = %q, %v", got, err)
+	}
+
+	// The endpoint id is authenticated, so a secret copied to another endpoint does not open.
+	if _, err := c.openSecret(uuid.New(), sealed); err == nil {
+		t.Errorf("open of a secret moved to another endpoint succeeded")
+	}
+
+	plain, err := (valueCipher{}).sealSecret(id, "s3cret")
+	if err != nil {
+		t.Fatalf("sealSecret without keyring: %v", err)
+	}
+	if text := plain.text(); !text.Valid || text.String != "s3cret" {
+		t.Errorf("plaintext secret column = %+v", text)
+	}
+}
@@ -0,0 +1,305 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+	"base_app/pkg/envelope"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// dataChangesChannel is the NOTIFY channel written by the data_changes_notify trigger.
+	dataChangesChannel = "data_changes"
+	// changesPageSize is the number of rows fetched per query when replaying changes.
+	changesPageSize = 500
+	// reconnectDelay is the pause between attempts to re-establish the LISTEN connection.
+	reconnectDelay = 2 * time.Second
+)
+
+// dataChangePayload is the JSON payload of a data_changes notification.
+type dataChangePayload struct {
+	ID  int64                `json:"id"`
+	Op  entity.DataEventType `json:"op"`
+	Key string               `json:"key"`
+}
+
+type subscription struct {
+	prefix string
+	ch     chan entity.DataEvent
+}
+
+// DataFeed listens for data change notifications on a dedicated connection and
+// fans them out to subscribers. One feed per process serves all subscribers.
+type DataFeed struct {
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+	cipher  valueCipher
+	buffer  int
+	log     *slog.Logger
+
+	mu     sync.Mutex
+	subs   map[*subscription]struct{}
+	lastID int64 // highest create/update id seen, used to catch up after a reconnect
+}
+
+// NewDataFeed creates a new DataFeed. buffer is the number of events queued per
+// subscriber before a slow subscriber is disconnected. keyring must match the one of the Repo.
+func NewDataFeed(pool *pgxpool.Pool, keyring *envelope.Keyring, buffer int, log *slog.Logger) *DataFeed {
+	return &DataFeed{
+		pool:    pool,
+		queries: sqlc.New(pool),
+		cipher:  valueCipher{keyring: keyring},
+		buffer:  buffer,
+		log:     log,
+		subs:    make(map[*subscription]struct{}),
+	}
+}
+
+// Run listens for notifications until ctx is cancelled, reconnecting on errors.
+func (f *DataFeed) Run(ctx context.Context) {
+	const op = "adapter.DataFeed.Run"
+
+	f.log.Info("data feed started", slog.String("op", op))
+	for {
+		err := f.listen(ctx)
+		if ctx.Err() != nil {
+			f.DisconnectAll()
+			f.log.Info("data feed stopped", slog.String("op", op))
+			return
+		}
+		f.log.Error("data feed connection lost", slog.String("op", op), slog.String("error", err.Error()))
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+// Subscribe returns a channel of events for keys starting with prefix.
+// The channel is closed when ctx is done or when the subscriber falls too far behind.
+func (f *DataFeed) Subscribe(ctx context.Context, prefix string) <-chan entity.DataEvent {
+	sub := &subscription{
+		prefix: prefix,
+		ch:     make(chan entity.DataEvent, f.buffer),
+	}
+
+	f.mu.Lock()
+	f.subs[sub] = struct{}{}
+	f.mu.Unlock()
+
+	context.AfterFunc(ctx, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.remove(sub)
+	})
+	return sub.ch
+}
+
+// ListDataChanges returns create and update events after the given row id for keys starting with prefix.
+// Deletions cannot be replayed because the rows no longer exist.
+func (r *Repo) ListDataChanges(ctx context.Context, afterID int64, prefix string, limit int32) ([]entity.DataEvent, error) {
+	const op = "adapter.sqlc.ListDataChanges"
+
+	events, err := listDataChanges(ctx, r.Queries, r.cipher, afterID, prefix, limit)
+	if err != nil {
+		r.log.Error("failed to list data changes", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return events, nil
+}
+
+func (f *DataFeed) listen(ctx context.Context) error {
+	conn, err := pgx.ConnectConfig(ctx, f.pool.Config().ConnConfig)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close(context.WithoutCancel(ctx)) }()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+dataChangesChannel); err != nil {
+		return err
+	}
+	if err := f.catchUp(ctx); err != nil {
+		return err
+	}
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		f.handle(ctx, n.Payload)
+	}
+}
+
+// catchUp publishes changes committed while the feed was not listening.
+func (f *DataFeed) catchUp(ctx context.Context) error {
+	f.mu.Lock()
+	lastID := f.lastID
+	f.mu.Unlock()
+	if lastID == 0 {
+		return nil
+	}
+
+	for {
+		events, err := listDataChanges(ctx, f.queries, f.cipher, lastID, "", changesPageSize)
+		if err != nil {
+			return err
+		}
+		for _, ev := range events {
+			f.publish(ev)
+			lastID = ev.ID
+		}
+		if len(events) < changesPageSize {
+			return nil
+		}
+	}
+}
+
+func (f *DataFeed) handle(ctx context.Context, payload string) {
+	const op = "adapter.DataFeed.handle"
+
+	var p dataChangePayload
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		f.log.Error("invalid data change payload", slog.String("op", op), slog.String("error", err.Error()))
+		return
+	}
+	if !f.hasSubscriber(p.Key) {
+		if p.Op != entity.DataEventDeleted {
+			f.trackID(p.ID)
+		}
+		return
+	}
+
+	ev := entity.DataEvent{Type: p.Op, Key: p.Key}
+	if p.Op != entity.DataEventDeleted {
+		row, err := f.queries.GetDataByID(ctx, int32(p.ID))
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Deleted before we got to it; the delete notification follows.
+			f.trackID(p.ID)
+			return
+		}
+		if err != nil {
+			f.log.Error("failed to load changed data", slog.String("op", op), slog.String("error", err.Error()))
+			return
+		}
+		data, err := f.cipher.toData(row)
+		if err != nil {
+			f.log.Error("failed to decrypt changed data", slog.String("op", op), slog.String("error", err.Error()))
+			return
+		}
+		ev.ID = p.ID
+		ev.Data = data
+	}
+	f.publish(ev)
+}
+
+func (f *DataFeed) publish(ev entity.DataEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if ev.ID > f.lastID {
+		f.lastID = ev.ID
+	}
+	for sub := range f.subs {
+		if !strings.HasPrefix(ev.Key, sub.prefix) {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+			// The subscriber is too slow; it can resume from its last event id.
+			f.remove(sub)
+		}
+	}
+}
+
+func (f *DataFeed) hasSubscriber(key string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for sub := range f.subs {
+		if strings.HasPrefix(key, sub.prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *DataFeed) trackID(id int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if id > f.lastID {
+		f.lastID = id
+	}
+}
+
+// remove unregisters a subscriber and closes its channel. The caller must hold f.mu.
+func (f *DataFeed) remove(sub *subscription) {
+	if _, ok := f.subs[sub]; !ok {
+		return
+	}
+	delete(f.subs, sub)
+	close(sub.ch)
+}
+
+// DisconnectAll closes every subscriber channel, ending their streams.
+func (f *DataFeed) DisconnectAll() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for sub := range f.subs {
+		f.remove(sub)
+	}
+}
+
+func listDataChanges(ctx context.Context, q *sqlc.Queries, c valueCipher, afterID int64, prefix string, limit int32) ([]entity.DataEvent, error) {
+	rows, err := q.ListDataChangesAfterID(ctx, sqlc.ListDataChangesAfterIDParams{
+		AfterID:  int32(afterID),
+		Prefix:   prefix,
+		PageSize: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]entity.DataEvent, len(rows))
+	for i, row := range rows {
+		data, err := c.toData(sqlc.Datum{
+			ID:              row.ID,
+			Key:             row.Key,
+			Value:           row.Value,
+			CreatedAt:       row.CreatedAt,
+			ExpiresAt:       row.ExpiresAt,
+			ValueCiphertext: row.ValueCiphertext,
+			ValueDataKey:    row.ValueDataKey,
+			ValueKeyID:      row.ValueKeyID,
+			OwnerID:         row.OwnerID,
+			ValueSize:       row.ValueSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		ev := entity.DataEvent{
+			ID:   int64(row.ID),
+			Type: entity.DataEventCreated,
+			Key:  row.Key,
+			Data: data,
+		}
+		if row.IsUpdate {
+			ev.Type = entity.DataEventUpdated
+		}
+		events[i] = ev
+	}
+	return events, nil
+}
@@ -0,0 +1,189 @@
+package postgresql
+
+import (
+	"context"
+	"log/slog"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+	"base_app/internal/usecase"
+	"github.com/jackc/pgx/v5"
+)
+
+// exportPageSize is the number of keys fetched per query while exporting.
+const exportPageSize = 500
+
+// dataImportTx implements usecase.DataImportTx on top of a pgx transaction.
+type dataImportTx struct {
+	tx     pgx.Tx
+	q      *sqlc.Queries
+	cipher valueCipher
+	log    *slog.Logger
+}
+
+// BeginDataImport starts a transaction for a bulk data import.
+func (r *Repo) BeginDataImport(ctx context.Context) (usecase.DataImportTx, error) {
+	const op = "adapter.sqlc.BeginDataImport"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin import transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &dataImportTx{
+		tx:     tx,
+		q:      r.Queries.WithTx(tx),
+		cipher: r.cipher,
+		log:    r.log,
+	}, nil
+}
+
+// WriteChunk resolves conflicts with existing keys and copies the records into the data table.
+// The quota check holds the owner's usage lock until the import transaction ends.
+func (t *dataImportTx) WriteChunk(ctx context.Context, records []entity.Data, onConflict entity.ConflictMode, check func(others entity.DataUsage, records []entity.Data) error) (*entity.ImportChunkResult, error) {
+	const op = "adapter.sqlc.WriteChunk"
+
+	keys := make([]string, len(records))
+	for i, rec := range records {
+		keys[i] = rec.Key
+	}
+
+	existing, err := t.q.GetLiveDataKeys(ctx, keys)
+	if err != nil {
+		t.log.Error("failed to get existing keys", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	res := &entity.ImportChunkResult{}
+	if len(existing) > 0 {
+		switch onConflict {
+		case entity.ConflictFail:
+			res.Conflicts = existing
+			return res, nil
+		case entity.ConflictSkip:
+			res.Conflicts = existing
+			records = withoutKeys(records, existing)
+		case entity.ConflictUpsert:
+			res.Updated = len(existing)
+		}
+	}
+
+	if check != nil && len(records) > 0 {
+		written := make([]string, len(records))
+		for i, rec := range records {
+			written[i] = rec.Key
+		}
+		// Replaced keys are excluded, so the bytes of their current values are freed.
+		others, err := lockDataUsage(ctx, t.q, records[0].OwnerID, written)
+		if err != nil {
+			t.log.Error("failed to get data usage", slog.String("op", op), slog.String("error", err.Error()))
+			return nil, err
+		}
+		if err := check(others, records); err != nil {
+			return nil, err
+		}
+	}
+
+	if res.Updated > 0 {
+		if _, err := t.q.DeleteDataByKeys(ctx, existing); err != nil {
+			t.log.Error("failed to replace existing keys", slog.String("op", op), slog.String("error", err.Error()))
+			return nil, err
+		}
+	}
+
+	params := make([]sqlc.CopyDataParams, len(records))
+	for i, rec := range records {
+		sealed, err := t.cipher.seal(rec.Key, rec.Value)
+		if err != nil {
+			t.log.Error("failed to encrypt data", slog.String("op", op), slog.String("error", err.Error()))
+			return nil, err
+		}
+		params[i] = sqlc.CopyDataParams{
+			Key:             rec.Key,
+			Value:           sealed.Value,
+			ValueCiphertext: sealed.Ciphertext,
+			ValueDataKey:    sealed.DataKey,
+			ValueKeyID:      sealed.KeyID,
+			ExpiresAt:       toTimestamptz(rec.ExpiresAt),
+			OwnerID:         toUUID(rec.OwnerID),
+			ValueSize:       int32(len(rec.Value)),
+		}
+	}
+
+	n, err := t.q.CopyData(ctx, params)
+	if err != nil {
+		t.log.Error("failed to copy data", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	events := make([]sqlc.CreateOutboxEventsParams, len(records))
+	for i, rec := range records {
+		events[i] = dataEvent(entity.EventDataSaved, rec.Key)
+	}
+	if err := addOutboxEvents(ctx, t.q, events...); err != nil {
+		t.log.Error("failed to add outbox events", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	res.Created = int(n) - res.Updated
+
+	return res, nil
+}
+
+// Commit commits the import transaction.
+func (t *dataImportTx) Commit(ctx context.Context) error {
+	return t.tx.Commit(ctx)
+}
+
+// Rollback aborts the import transaction.
+func (t *dataImportTx) Rollback(ctx context.Context) error {
+	return t.tx.Rollback(ctx)
+}
+
+// ExportData calls fn for the latest live value of every key, in key order.
+// Rows are fetched page by page so the full data set is never held in memory.
+func (r *Repo) ExportData(ctx context.Context, fn func(*entity.Data) error) error {
+	const op = "adapter.sqlc.ExportData"
+
+	var afterKey string
+	for {
+		rows, err := r.Queries.ListLiveDataAfterKey(ctx, sqlc.ListLiveDataAfterKeyParams{
+			AfterKey: afterKey,
+			PageSize: exportPageSize,
+		})
+		if err != nil {
+			r.log.Error("failed to list data", slog.String("op", op), slog.String("error", err.Error()))
+			return err
+		}
+
+		for _, row := range rows {
+			data, err := r.cipher.toData(row)
+			if err != nil {
+				r.log.Error("failed to decrypt data", slog.String("op", op), slog.String("error", err.Error()))
+				return err
+			}
+			if err := fn(data); err != nil {
+				return err
+			}
+		}
+
+		if len(rows) < exportPageSize {
+			return nil
+		}
+		afterKey = rows[len(rows)-1].Key
+	}
+}
+
+func withoutKeys(records []entity.Data, keys []string) []entity.Data {
+	drop := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		drop[k] = struct{}{}
+	}
+
+	kept := records[:0:0]
+	for _, rec := range records {
+		if _, ok := drop[rec.Key]; !ok {
+			kept = append(kept, rec)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,263 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// deletedPurgeLockID is the advisory lock key that serializes purging of soft deleted rows across replicas.
+const deletedPurgeLockID int64 = 0x7472617368 // "trash"
+
+// DeleteData soft deletes every version of a key. It returns entity.ErrNotFound if the key
+// has no live value.
+func (r *Repo) DeleteData(ctx context.Context, key string) error {
+	const op = "adapter.sqlc.DeleteData"
+
+	err := r.InTx(ctx, func(tx *Repo) error {
+		n, err := tx.Queries.SoftDeleteData(ctx, key)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return entity.ErrNotFound
+		}
+		return addOutboxEvents(ctx, tx.Queries, dataEvent(entity.EventDataDeleted, key))
+	})
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		r.log.Error("failed to delete data", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return err
+}
+
+// ListDeletedData retrieves the last value of up to limit deleted keys that have not been
+// written again, most recently deleted first.
+func (r *Repo) ListDeletedData(ctx context.Context, limit int32) ([]entity.Data, error) {
+	const op = "adapter.sqlc.ListDeletedData"
+
+	rows, err := r.Queries.ListDeletedData(ctx, limit)
+	if err != nil {
+		r.log.Error("failed to list deleted data", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	entries := make([]entity.Data, len(rows))
+	for i, row := range rows {
+		data, err := r.cipher.toData(row)
+		if err != nil {
+			r.log.Error("failed to decrypt data", slog.String("op", op), slog.String("error", err.Error()))
+			return nil, err
+		}
+		entries[i] = *data
+	}
+	return entries, nil
+}
+
+// RestoreData undeletes every version of a key and returns its current value. It returns
+// entity.ErrNotFound if the key has no deleted versions and entity.ErrConflict if the key
+// was written again after it was deleted.
+func (r *Repo) RestoreData(ctx context.Context, key string) (*entity.Data, error) {
+	const op = "adapter.sqlc.RestoreData"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	// Serializes restores of the same key, so both cannot see it deleted.
+	if err := q.AdvisoryXactLock(ctx, "data_restore:"+key); err != nil {
+		r.log.Error("failed to lock data key", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	live, err := q.DataKeyIsLive(ctx, key)
+	if err != nil {
+		r.log.Error("failed to check data key", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if live {
+		return nil, fmt.Errorf("%w: key %q has been written again since it was deleted", entity.ErrConflict, key)
+	}
+
+	n, err := q.RestoreData(ctx, key)
+	if err != nil {
+		r.log.Error("failed to restore data", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if n == 0 {
+		return nil, entity.ErrNotFound
+	}
+
+	row, err := q.GetData(ctx, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Every restored version has expired meanwhile.
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get data", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	data, err := r.cipher.toData(row)
+	if err != nil {
+		r.log.Error("failed to decrypt data", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if err := addOutboxEvents(ctx, q, dataEvent(entity.EventDataRestored, key)); err != nil {
+		r.log.Error("failed to add outbox event", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit data restore", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return data, nil
+}
+
+// PurgeDeletedData permanently removes data versions deleted before cutoff in batches of
+// batchSize and returns the number of removed rows.
+func (r *Repo) PurgeDeletedData(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error) {
+	const op = "adapter.sqlc.PurgeDeletedData"
+
+	n, err := r.purgeInBatches(ctx, deletedPurgeLockID, func(q *sqlc.Queries) (int64, error) {
+		return q.PurgeDeletedData(ctx, sqlc.PurgeDeletedDataParams{
+			Cutoff:    pgtype.Timestamptz{Time: cutoff, Valid: true},
+			BatchSize: batchSize,
+		})
+	}, batchSize)
+	if err != nil {
+		r.log.Error("failed to purge deleted data", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return n, err
+}
+
+// ListDeletedCatalogItems retrieves up to limit deleted catalog items, most recently deleted first.
+func (r *Repo) ListDeletedCatalogItems(ctx context.Context, limit int32) ([]entity.CatalogItem, error) {
+	const op = "adapter.sqlc.ListDeletedCatalogItems"
+
+	rows, err := r.Queries.ListDeletedCatalogItems(ctx, limit)
+	if err != nil {
+		r.log.Error("failed to list deleted catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	catalogRows := make([]catalogRow, len(rows))
+	for i, row := range rows {
+		catalogRows[i] = catalogRow{
+			ID:          row.ID,
+			Title:       row.Title,
+			Description: row.Description,
+			Disabled:    row.Disabled,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+			CategoryID:  row.CategoryID,
+			Sku:         row.Sku,
+		}
+	}
+	items, err := withCatalogDetails(ctx, r.Queries, catalogRows)
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	for i, row := range rows {
+		deletedAt := row.DeletedAt.Time
+		items[i].DeletedAt = &deletedAt
+	}
+	return items, nil
+}
+
+// RestoreCatalogItem undeletes a catalog item. It returns entity.ErrNotFound if the item is
+// not deleted and entity.ErrConflict if another item took over its sku meanwhile.
+func (r *Repo) RestoreCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error) {
+	const op = "adapter.sqlc.RestoreCatalogItem"
+
+	var row sqlc.RestoreCatalogItemRow
+	err := r.InTx(ctx, func(tx *Repo) error {
+		var err error
+		if row, err = tx.Queries.RestoreCatalogItem(ctx, id); err != nil {
+			return err
+		}
+		return addOutboxEvents(ctx, tx.Queries, catalogEvent(entity.EventCatalogRestored, id))
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		if isPgError(err, pgUniqueViolation) {
+			return nil, fmt.Errorf("%w: the sku of item %s is used by another item", entity.ErrConflict, id)
+		}
+		r.log.Error("failed to restore catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	items, err := withCatalogDetails(ctx, r.Queries, []catalogRow{catalogRow(row)})
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return &items[0], nil
+}
+
+// PurgeDeletedCatalogItems permanently removes catalog items deleted before cutoff, together
+// with everything attached to them, in batches of batchSize and returns the number of removed items.
+func (r *Repo) PurgeDeletedCatalogItems(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error) {
+	const op = "adapter.sqlc.PurgeDeletedCatalogItems"
+
+	n, err := r.purgeInBatches(ctx, deletedPurgeLockID, func(q *sqlc.Queries) (int64, error) {
+		return q.PurgeDeletedCatalogItems(ctx, sqlc.PurgeDeletedCatalogItemsParams{
+			Cutoff:    pgtype.Timestamptz{Time: cutoff, Valid: true},
+			BatchSize: batchSize,
+		})
+	}, batchSize)
+	if err != nil {
+		r.log.Error("failed to purge deleted catalog items", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return n, err
+}
+
+// purgeInBatches runs purge until it removes fewer than batchSize rows. Each batch runs in its
+// own transaction guarded by the advisory lock lockID; when another replica holds the lock,
+// it stops and leaves the work to that replica.
+func (r *Repo) purgeInBatches(ctx context.Context, lockID int64, purge func(q *sqlc.Queries) (int64, error), batchSize int32) (int64, error) {
+	var total int64
+	for {
+		n, locked, err := r.purgeBatch(ctx, lockID, purge)
+		if err != nil {
+			return total, err
+		}
+		total += n
+		if !locked || n < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
+
+func (r *Repo) purgeBatch(ctx context.Context, lockID int64, purge func(q *sqlc.Queries) (int64, error)) (int64, bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	locked, err := q.TryAdvisoryXactLock(ctx, lockID)
+	if err != nil || !locked {
+		return 0, false, err
+	}
+
+	n, err := purge(q)
+	if err != nil {
+		return 0, true, err
+	}
+	return n, true, tx.Commit(ctx)
+}
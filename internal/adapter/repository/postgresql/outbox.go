@@ -0,0 +1,146 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// outboxRelayLockID is the advisory lock key that lets only one replica relay events at a
+	// time, so events are published in order.
+	outboxRelayLockID int64 = 0x6f7574626f78 // "outbox"
+	// outboxPurgeLockID serializes purging of published events across replicas.
+	outboxPurgeLockID int64 = 0x6f7574707267 // "outprg"
+)
+
+// RelayOutboxEvents hands up to limit pending events, oldest first, to publish and marks the
+// first delivered of them as published. When publish fails, the failure is recorded on the
+// first event it did not deliver, and the rest wait for the next call. A session-level relay
+// lock on a connection of its own keeps other replicas from relaying meanwhile, so events are
+// published in order; if another replica holds it, nothing is published. No transaction is
+// open while publish runs: claiming and marking events are single statements, so slow sinks
+// hold no row locks and do not keep old row versions alive. Events delivered before they
+// could be marked are handed out again later.
+func (r *Repo) RelayOutboxEvents(ctx context.Context, limit int32, publish func(events []entity.OutboxEvent) (delivered int, err error)) (int, error) {
+	const op = "adapter.sqlc.RelayOutboxEvents"
+
+	conn, err := r.pool.Acquire(ctx)
+	if err != nil {
+		r.log.Error("failed to acquire connection", slog.String("op", op), slog.String("error", err.Error()))
+		return 0, err
+	}
+	defer conn.Release()
+
+	q := sqlc.New(conn)
+	locked, err := q.TryAdvisoryLock(ctx, outboxRelayLockID)
+	if err != nil {
+		r.log.Error("failed to lock outbox", slog.String("op", op), slog.String("error", err.Error()))
+		return 0, err
+	}
+	if !locked {
+		return 0, nil
+	}
+	defer func() {
+		// The lock outlives a canceled ctx otherwise; closing the connection releases it too.
+		if _, err := q.AdvisoryUnlock(context.WithoutCancel(ctx), outboxRelayLockID); err != nil {
+			r.log.Error("failed to unlock outbox", slog.String("op", op), slog.String("error", err.Error()))
+			_ = conn.Hijack().Close(context.WithoutCancel(ctx))
+		}
+	}()
+
+	rows, err := q.ListPendingOutboxEvents(ctx, limit)
+	if err != nil {
+		r.log.Error("failed to list pending outbox events", slog.String("op", op), slog.String("error", err.Error()))
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	events := make([]entity.OutboxEvent, len(rows))
+	for i, row := range rows {
+		events[i] = toOutboxEvent(row)
+	}
+
+	delivered, publishErr := publish(events)
+	if delivered > 0 {
+		ids := make([]int64, delivered)
+		for i := range ids {
+			ids[i] = events[i].ID
+		}
+		if err := q.MarkOutboxEventsPublished(ctx, ids); err != nil {
+			r.log.Error("failed to mark outbox events published", slog.String("op", op), slog.String("error", err.Error()))
+			return 0, err
+		}
+	}
+	if publishErr != nil && delivered < len(events) {
+		err := q.RecordOutboxFailure(ctx, sqlc.RecordOutboxFailureParams{
+			ID:        events[delivered].ID,
+			LastError: pgtype.Text{String: publishErr.Error(), Valid: true},
+		})
+		if err != nil {
+			r.log.Error("failed to record outbox failure", slog.String("op", op), slog.String("error", err.Error()))
+			return delivered, err
+		}
+	}
+	return delivered, publishErr
+}
+
+// PurgePublishedOutboxEvents removes events published before cutoff in batches of batchSize
+// and returns the number of removed events.
+func (r *Repo) PurgePublishedOutboxEvents(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error) {
+	const op = "adapter.sqlc.PurgePublishedOutboxEvents"
+
+	n, err := r.purgeInBatches(ctx, outboxPurgeLockID, func(q *sqlc.Queries) (int64, error) {
+		return q.DeletePublishedOutboxEvents(ctx, sqlc.DeletePublishedOutboxEventsParams{
+			Cutoff:    pgtype.Timestamptz{Time: cutoff, Valid: true},
+			BatchSize: batchSize,
+		})
+	}, batchSize)
+	if err != nil {
+		r.log.Error("failed to purge published outbox events", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return n, err
+}
+
+// addOutboxEvents records events in the transaction of q, so they are published if and only
+// if the change they describe is committed.
+func addOutboxEvents(ctx context.Context, q *sqlc.Queries, events ...sqlc.CreateOutboxEventsParams) error {
+	if len(events) == 0 {
+		return nil
+	}
+	_, err := q.CreateOutboxEvents(ctx, events)
+	return err
+}
+
+func dataEvent(eventType entity.OutboxEventType, key string) sqlc.CreateOutboxEventsParams {
+	return outboxEvent(eventType, entity.DataEventPayload{Key: key})
+}
+
+func catalogEvent(eventType entity.OutboxEventType, id uuid.UUID) sqlc.CreateOutboxEventsParams {
+	return outboxEvent(eventType, entity.CatalogEventPayload{ID: id})
+}
+
+func outboxEvent(eventType entity.OutboxEventType, payload any) sqlc.CreateOutboxEventsParams {
+	// The payloads are plain structs of strings and ids, which always marshal.
+	data, _ := json.Marshal(payload)
+	return sqlc.CreateOutboxEventsParams{EventType: string(eventType), Payload: data}
+}
+
+func toOutboxEvent(row sqlc.Outbox) entity.OutboxEvent {
+	return entity.OutboxEvent{
+		ID:            row.ID,
+		Type:          entity.OutboxEventType(row.EventType),
+		Payload:       row.Payload,
+		CreatedAt:     row.CreatedAt.Time,
+		Attempts:      int(row.Attempts),
+		LastAttemptAt: row.LastAttemptAt.Time,
+	}
+}
@@ -2,29 +2,82 @@ package postgresql
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"slices"
+	"time"
 
 	"base_app/internal/adapter/repository/postgresql/sqlc"
 	"base_app/internal/entity"
+	"base_app/pkg/envelope"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-// Repo implements the use case repository interfaces using sqlc.
+// dataReaperLockID is the advisory lock key that serializes expired data purging across replicas.
+const dataReaperLockID int64 = 0x64617461 // "data"
+
+// conn is a connection pool, or a transaction whose Begin starts a savepoint.
+type conn interface {
+	sqlc.DBTX
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Repo implements the use case repository interfaces using sqlc. Every mutation of catalog
+// items and data keys records its domain events in the outbox within its own transaction.
 type Repo struct {
 	*sqlc.Queries
-	pool *pgxpool.Pool
-	log  *slog.Logger
+	db     conn
+	pool   *pgxpool.Pool // For work that needs a connection of its own, outside any transaction
+	cipher valueCipher
+	log    *slog.Logger
 }
 
-// NewRepo creates a new repository.
-func NewRepo(pool *pgxpool.Pool, log *slog.Logger) *Repo {
+// NewRepo creates a new repository. Data values are encrypted with keyring when it is not nil.
+// Calls made within a TxManager unit of work run in its transaction.
+func NewRepo(pool *pgxpool.Pool, keyring *envelope.Keyring, log *slog.Logger) *Repo {
+	db := ctxConn{pool: pool}
 	return &Repo{
-		Queries: sqlc.New(pool),
+		Queries: sqlc.New(db),
+		db:      db,
 		pool:    pool,
+		cipher:  valueCipher{keyring: keyring},
 		log:     log,
 	}
 }
 
+// WithTx returns a repository that runs every statement in tx. Methods that need a
+// transaction of their own run it as a savepoint of tx, so nothing is committed before tx is.
+// Use cases do not see this type; they group writes with TxManager.WithinTx, which binds the
+// methods of Repo to its transaction through the context.
+func (r *Repo) WithTx(tx pgx.Tx) *Repo {
+	return &Repo{
+		Queries: r.Queries.WithTx(tx),
+		db:      tx,
+		pool:    r.pool,
+		cipher:  r.cipher,
+		log:     r.log,
+	}
+}
+
+// InTx calls fn with a repository bound to a new transaction, which is committed when fn
+// returns nil and rolled back otherwise. Writes made through several repository calls in fn,
+// and their outbox events, are committed together or not at all.
+func (r *Repo) InTx(ctx context.Context, fn func(tx *Repo) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(r.WithTx(tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
 // GetUserByEmail retrieves a user by their email address.
 func (r *Repo) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
 	const op = "adapter.sqlc.GetUserByEmail"
@@ -39,16 +92,32 @@ func (r *Repo) GetUserByEmail(ctx context.Context, email string) (*entity.User,
 		ID:       userRow.ID,
 		Email:    userRow.Email,
 		Password: userRow.PasswordHash,
+		Role:     userRow.Role,
+		Locale:   userRow.Locale.String,
 	}, nil
 }
 
+// SetUserLocale stores the preferred locale of a user; an empty locale clears it.
+func (r *Repo) SetUserLocale(ctx context.Context, id uuid.UUID, locale string) error {
+	const op = "adapter.sqlc.SetUserLocale"
+
+	n, err := r.Queries.SetUserLocale(ctx, sqlc.SetUserLocaleParams{ID: id, Locale: toText(locale)})
+	if err != nil {
+		r.log.Error("failed to set user locale", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n == 0 {
+		return entity.ErrNotFound
+	}
+	return nil
+}
+
 // SaveData saves data.
 func (r *Repo) SaveData(ctx context.Context, data *entity.Data) error {
 	const op = "adapter.sqlc.SaveData"
 
-	err := r.Queries.SaveData(ctx, sqlc.SaveDataParams{
-		Key:   data.Key,
-		Value: data.Value,
+	err := r.InTx(ctx, func(tx *Repo) error {
+		return saveData(ctx, tx.Queries, r.cipher, data)
 	})
 	if err != nil {
 		r.log.Error("failed to save data", slog.String("op", op), slog.String("error", err.Error()))
@@ -57,25 +126,592 @@ func (r *Repo) SaveData(ctx context.Context, data *entity.Data) error {
 	return nil
 }
 
-// GetCatalogItems retrieves all catalog items.
-func (r *Repo) GetCatalogItems(ctx context.Context) ([]entity.CatalogItem, error) {
+// LockDataUsage takes the advisory lock serializing the quota checks of an owner until the
+// transaction of the unit of work of ctx ends, and returns the owner's usage of their keys
+// other than excludeKeys.
+func (r *Repo) LockDataUsage(ctx context.Context, ownerID uuid.UUID, excludeKeys []string) (*entity.DataUsage, error) {
+	const op = "adapter.sqlc.LockDataUsage"
+
+	usage, err := lockDataUsage(ctx, r.Queries, ownerID, excludeKeys)
+	if err != nil {
+		r.log.Error("failed to lock data usage", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// GetDataUsage returns the number and total size of the live keys the owner wrote last.
+func (r *Repo) GetDataUsage(ctx context.Context, ownerID uuid.UUID) (*entity.DataUsage, error) {
+	const op = "adapter.sqlc.GetDataUsage"
+
+	usage, err := r.Queries.GetDataUsage(ctx, sqlc.GetDataUsageParams{OwnerID: toUUID(ownerID), ExcludeKeys: []string{}})
+	if err != nil {
+		r.log.Error("failed to get data usage", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return &entity.DataUsage{KeyCount: usage.KeyCount, TotalBytes: usage.TotalBytes}, nil
+}
+
+// lockDataUsage serializes the writes of an owner until the transaction of q ends and returns
+// the owner's usage of their keys other than excludeKeys.
+func lockDataUsage(ctx context.Context, q *sqlc.Queries, ownerID uuid.UUID, excludeKeys []string) (entity.DataUsage, error) {
+	if err := q.AdvisoryXactLock(ctx, "data_usage:"+ownerID.String()); err != nil {
+		return entity.DataUsage{}, err
+	}
+	// The lock is held, so this statement sees every write of the owner committed before it.
+	usage, err := q.GetDataUsage(ctx, sqlc.GetDataUsageParams{
+		OwnerID:     toUUID(ownerID),
+		ExcludeKeys: excludeKeys,
+	})
+	if err != nil {
+		return entity.DataUsage{}, err
+	}
+	return entity.DataUsage{KeyCount: usage.KeyCount, TotalBytes: usage.TotalBytes}, nil
+}
+
+func saveData(ctx context.Context, q *sqlc.Queries, c valueCipher, data *entity.Data) error {
+	sealed, err := c.seal(data.Key, data.Value)
+	if err != nil {
+		return err
+	}
+	err = q.SaveData(ctx, sqlc.SaveDataParams{
+		Key:             data.Key,
+		Value:           sealed.Value,
+		ValueCiphertext: sealed.Ciphertext,
+		ValueDataKey:    sealed.DataKey,
+		ValueKeyID:      sealed.KeyID,
+		ExpiresAt:       toTimestamptz(data.ExpiresAt),
+		OwnerID:         toUUID(data.OwnerID),
+		ValueSize:       int32(len(data.Value)),
+	})
+	if err != nil {
+		return err
+	}
+	return addOutboxEvents(ctx, q, dataEvent(entity.EventDataSaved, data.Key))
+}
+
+// GetData retrieves the latest unexpired value stored under a key.
+func (r *Repo) GetData(ctx context.Context, key string) (*entity.Data, error) {
+	const op = "adapter.sqlc.GetData"
+
+	row, err := r.Queries.GetData(ctx, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get data", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	data, err := r.cipher.toData(row)
+	if err != nil {
+		r.log.Error("failed to decrypt data", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return data, nil
+}
+
+// PurgeExpiredData deletes expired data in batches of batchSize and returns the number of deleted rows.
+// Each batch runs in its own transaction guarded by an advisory lock, so only one replica purges at a time.
+func (r *Repo) PurgeExpiredData(ctx context.Context, batchSize int32) (int64, error) {
+	const op = "adapter.sqlc.PurgeExpiredData"
+
+	n, err := r.purgeInBatches(ctx, dataReaperLockID, func(q *sqlc.Queries) (int64, error) {
+		return q.DeleteExpiredData(ctx, batchSize)
+	}, batchSize)
+	if err != nil {
+		r.log.Error("failed to purge expired data", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return n, err
+}
+
+func toUUID(id uuid.UUID) pgtype.UUID {
+	if id == uuid.Nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: id, Valid: true}
+}
+
+func toTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// GetDataSchemaForKey returns the schema with the longest prefix matching the key.
+func (r *Repo) GetDataSchemaForKey(ctx context.Context, key string) (*entity.DataSchema, error) {
+	const op = "adapter.sqlc.GetDataSchemaForKey"
+
+	row, err := r.Queries.GetDataSchemaForKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get data schema", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return toDataSchema(row), nil
+}
+
+// ListDataSchemas retrieves all registered data schemas.
+func (r *Repo) ListDataSchemas(ctx context.Context) ([]entity.DataSchema, error) {
+	const op = "adapter.sqlc.ListDataSchemas"
+
+	rows, err := r.Queries.ListDataSchemas(ctx)
+	if err != nil {
+		r.log.Error("failed to list data schemas", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	schemas := make([]entity.DataSchema, len(rows))
+	for i, row := range rows {
+		schemas[i] = *toDataSchema(row)
+	}
+
+	return schemas, nil
+}
+
+// SaveDataSchema creates or replaces the schema for a prefix.
+func (r *Repo) SaveDataSchema(ctx context.Context, schema *entity.DataSchema) error {
+	const op = "adapter.sqlc.SaveDataSchema"
+
+	row, err := r.Queries.UpsertDataSchema(ctx, sqlc.UpsertDataSchemaParams{
+		Prefix: schema.Prefix,
+		Schema: schema.Schema,
+	})
+	if err != nil {
+		r.log.Error("failed to save data schema", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	*schema = *toDataSchema(row)
+	return nil
+}
+
+// DeleteDataSchema removes the schema registered for a prefix.
+func (r *Repo) DeleteDataSchema(ctx context.Context, prefix string) error {
+	const op = "adapter.sqlc.DeleteDataSchema"
+
+	n, err := r.Queries.DeleteDataSchema(ctx, prefix)
+	if err != nil {
+		r.log.Error("failed to delete data schema", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n == 0 {
+		return entity.ErrNotFound
+	}
+	return nil
+}
+
+func toDataSchema(row sqlc.DataSchema) *entity.DataSchema {
+	return &entity.DataSchema{
+		Prefix:    row.Prefix,
+		Schema:    row.Schema,
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+}
+
+// GetCatalogItems retrieves all catalog items, or those in the category subtree at path category.
+func (r *Repo) GetCatalogItems(ctx context.Context, category string) ([]entity.CatalogItem, error) {
 	const op = "adapter.sqlc.GetCatalogItems"
 
-	rows, err := r.Queries.GetCatalogItems(ctx)
+	rows, err := r.Queries.GetCatalogItems(ctx, category)
 	if err != nil {
 		r.log.Error("failed to get catalog items", slog.String("op", op), slog.String("error", err.Error()))
 		return nil, err
 	}
 
+	items, err := withCatalogDetails(ctx, r.Queries, toCatalogRows(rows))
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return items, nil
+}
+
+// ListCatalogItems retrieves up to q.Limit catalog items following q.After in q.Sort order.
+func (r *Repo) ListCatalogItems(ctx context.Context, q entity.CatalogQuery) ([]entity.CatalogItem, error) {
+	const op = "adapter.sqlc.ListCatalogItems"
+
+	disabled := toBool(q.Disabled)
+	var afterID pgtype.UUID
+	if q.After != nil {
+		afterID = toUUID(q.After.ID)
+	}
+
+	var (
+		rows []catalogRow
+		err  error
+	)
+	switch q.Sort {
+	case entity.CatalogSortCreatedAt, entity.CatalogSortCreatedAtDesc:
+		params := sqlc.ListCatalogItemsByCreatedAtParams{
+			Disabled:    disabled,
+			TitlePrefix: q.TitlePrefix,
+			Tag:         q.Tag,
+			Category:    q.Category,
+			AfterID:     afterID,
+			PageSize:    int32(q.Limit),
+		}
+		if q.After != nil {
+			params.AfterCreatedAt = pgtype.Timestamptz{Time: q.After.CreatedAt, Valid: true}
+		}
+		if q.Sort == entity.CatalogSortCreatedAtDesc {
+			var page []sqlc.ListCatalogItemsByCreatedAtDescRow
+			page, err = r.Queries.ListCatalogItemsByCreatedAtDesc(ctx, sqlc.ListCatalogItemsByCreatedAtDescParams(params))
+			rows = toCatalogRows(page)
+		} else {
+			var page []sqlc.ListCatalogItemsByCreatedAtRow
+			page, err = r.Queries.ListCatalogItemsByCreatedAt(ctx, params)
+			rows = toCatalogRows(page)
+		}
+	default:
+		params := sqlc.ListCatalogItemsByTitleParams{
+			Disabled:    disabled,
+			TitlePrefix: q.TitlePrefix,
+			Tag:         q.Tag,
+			Category:    q.Category,
+			AfterID:     afterID,
+			PageSize:    int32(q.Limit),
+		}
+		if q.After != nil {
+			params.AfterTitle = pgtype.Text{String: q.After.Title, Valid: true}
+		}
+		if q.Sort == entity.CatalogSortTitleDesc {
+			var page []sqlc.ListCatalogItemsByTitleDescRow
+			page, err = r.Queries.ListCatalogItemsByTitleDesc(ctx, sqlc.ListCatalogItemsByTitleDescParams(params))
+			rows = toCatalogRows(page)
+		} else {
+			var page []sqlc.ListCatalogItemsByTitleRow
+			page, err = r.Queries.ListCatalogItemsByTitle(ctx, params)
+			rows = toCatalogRows(page)
+		}
+	}
+	if err != nil {
+		r.log.Error("failed to list catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	items, err := withCatalogDetails(ctx, r.Queries, rows)
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetCatalogItem retrieves a catalog item by id.
+func (r *Repo) GetCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error) {
+	const op = "adapter.sqlc.GetCatalogItem"
+
+	row, err := r.Queries.GetCatalogItem(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	items, err := withCatalogDetails(ctx, r.Queries, []catalogRow{catalogRow(row)})
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return &items[0], nil
+}
+
+// CreateCatalogItem inserts a catalog item with its tags and fills in the generated fields.
+// It returns a validation error if the category does not exist.
+func (r *Repo) CreateCatalogItem(ctx context.Context, item *entity.CatalogItem) error {
+	const op = "adapter.sqlc.CreateCatalogItem"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	row, err := q.CreateCatalogItem(ctx, sqlc.CreateCatalogItemParams{
+		Title:          item.Title,
+		Description:    toText(item.Description),
+		Disabled:       item.Disabled,
+		CategoryID:     toUUID(item.CategoryID),
+		SearchLanguage: item.SearchLanguage,
+		Sku:            toText(item.SKU),
+	})
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			// The category was deleted after the use case checked it.
+			return entity.NewValidationError("category does not exist")
+		}
+		r.log.Error("failed to create catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := replaceCatalogItemTags(ctx, q, row.ID, item.Tags); err != nil {
+		r.log.Error("failed to set catalog item tags", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := addOutboxEvents(ctx, q, catalogEvent(entity.EventCatalogCreated, row.ID)); err != nil {
+		r.log.Error("failed to add outbox event", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	items, err := withCatalogDetails(ctx, q, []catalogRow{catalogRow(row)})
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	language := item.SearchLanguage
+	*item = items[0]
+	item.SearchLanguage = language
+	return nil
+}
+
+// UpdateCatalogItem replaces the fields and tags of an existing catalog item. It returns a
+// validation error if the category does not exist.
+func (r *Repo) UpdateCatalogItem(ctx context.Context, item *entity.CatalogItem) error {
+	const op = "adapter.sqlc.UpdateCatalogItem"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		r.log.Error("failed to begin transaction", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.Queries.WithTx(tx)
+	row, err := q.UpdateCatalogItem(ctx, sqlc.UpdateCatalogItemParams{
+		ID:             item.ID,
+		Title:          item.Title,
+		Description:    toText(item.Description),
+		Disabled:       item.Disabled,
+		CategoryID:     toUUID(item.CategoryID),
+		SearchLanguage: item.SearchLanguage,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ErrNotFound
+		}
+		if isPgError(err, pgForeignKeyViolation) {
+			return entity.NewValidationError("category does not exist")
+		}
+		r.log.Error("failed to update catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := replaceCatalogItemTags(ctx, q, row.ID, item.Tags); err != nil {
+		r.log.Error("failed to set catalog item tags", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := addOutboxEvents(ctx, q, catalogEvent(entity.EventCatalogUpdated, row.ID)); err != nil {
+		r.log.Error("failed to add outbox event", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	items, err := withCatalogDetails(ctx, q, []catalogRow{catalogRow(row)})
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		r.log.Error("failed to commit catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	language := item.SearchLanguage
+	*item = items[0]
+	item.SearchLanguage = language
+	return nil
+}
+
+// SetCatalogItemDisabled enables or disables a catalog item.
+func (r *Repo) SetCatalogItemDisabled(ctx context.Context, id uuid.UUID, disabled bool) (*entity.CatalogItem, error) {
+	const op = "adapter.sqlc.SetCatalogItemDisabled"
+
+	var row sqlc.SetCatalogItemDisabledRow
+	err := r.InTx(ctx, func(tx *Repo) error {
+		var err error
+		row, err = tx.Queries.SetCatalogItemDisabled(ctx, sqlc.SetCatalogItemDisabledParams{
+			ID:       id,
+			Disabled: disabled,
+		})
+		if err != nil {
+			return err
+		}
+		return addOutboxEvents(ctx, tx.Queries, catalogEvent(entity.EventCatalogUpdated, id))
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to set catalog item disabled", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	items, err := withCatalogDetails(ctx, r.Queries, []catalogRow{catalogRow(row)})
+	if err != nil {
+		r.log.Error("failed to get catalog item details", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return &items[0], nil
+}
+
+// DeleteCatalogItem soft deletes a catalog item; it can be restored until it is purged.
+func (r *Repo) DeleteCatalogItem(ctx context.Context, id uuid.UUID) error {
+	const op = "adapter.sqlc.DeleteCatalogItem"
+
+	err := r.InTx(ctx, func(tx *Repo) error {
+		n, err := tx.Queries.DeleteCatalogItem(ctx, id)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return entity.ErrNotFound
+		}
+		return addOutboxEvents(ctx, tx.Queries, catalogEvent(entity.EventCatalogDeleted, id))
+	})
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		r.log.Error("failed to delete catalog item", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return err
+}
+
+// withCatalogDetails converts rows to entities and loads their tags, category paths, images
+// and inventory with one query each.
+func withCatalogDetails(ctx context.Context, q *sqlc.Queries, rows []catalogRow) ([]entity.CatalogItem, error) {
 	items := make([]entity.CatalogItem, len(rows))
+	if len(rows) == 0 {
+		return items, nil
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	index := make(map[uuid.UUID]int, len(rows))
 	for i, row := range rows {
-		items[i] = entity.CatalogItem{
-			ID:          row.ID,
-			Title:       row.Title,
-			Description: row.Description.String,
-			Disabled:    row.Disabled,
-		}
+		items[i] = *toCatalogItem(row)
+		ids[i] = row.ID
+		index[row.ID] = i
+	}
+
+	tags, err := q.ListCatalogItemTags(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tags {
+		i := index[t.ItemID]
+		items[i].Tags = append(items[i].Tags, t.Tag)
+	}
+
+	categoryIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		categoryIDs[i] = item.CategoryID
+	}
+	paths, err := catalogCategoryPaths(ctx, q, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].CategoryPath = paths[items[i].CategoryID]
+	}
+
+	images, err := q.ListCatalogImagesForItems(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, img := range images {
+		i := index[img.ItemID]
+		items[i].Images = append(items[i].Images, *toCatalogImage(img))
 	}
 
+	if err := withCatalogInventory(ctx, q, items, ids, index); err != nil {
+		return nil, err
+	}
+	if err := withCatalogReviewStats(ctx, q, items, ids, index); err != nil {
+		return nil, err
+	}
 	return items, nil
 }
+
+// catalogCategoryPaths maps category ids to their paths. uuid.Nil and duplicates are skipped.
+func catalogCategoryPaths(ctx context.Context, q *sqlc.Queries, ids []uuid.UUID) (map[uuid.UUID]string, error) {
+	var unique []uuid.UUID
+	for _, id := range ids {
+		if id != uuid.Nil && !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) == 0 {
+		return nil, nil
+	}
+
+	rows, err := q.ListCatalogCategoryPaths(ctx, unique)
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[uuid.UUID]string, len(rows))
+	for _, row := range rows {
+		paths[row.ID] = row.Path
+	}
+	return paths, nil
+}
+
+// replaceCatalogItemTags sets the tags of an item, creating tags that do not exist yet.
+func replaceCatalogItemTags(ctx context.Context, q *sqlc.Queries, itemID uuid.UUID, tags []string) error {
+	if err := q.DeleteCatalogItemTags(ctx, itemID); err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	if err := q.UpsertCatalogTags(ctx, tags); err != nil {
+		return err
+	}
+	return q.AddCatalogItemTags(ctx, sqlc.AddCatalogItemTagsParams{ItemID: itemID, Tags: tags})
+}
+
+// catalogRow holds the columns every catalog query returns. sqlc generates a row type per
+// query; they all convert to this one.
+type catalogRow struct {
+	ID          uuid.UUID
+	Title       string
+	Description pgtype.Text
+	Disabled    bool
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+	CategoryID  pgtype.UUID
+	Sku         pgtype.Text
+}
+
+func toCatalogRows[T sqlc.GetCatalogItemsRow | sqlc.ListCatalogItemsByTitleRow | sqlc.ListCatalogItemsByTitleDescRow |
+	sqlc.ListCatalogItemsByCreatedAtRow | sqlc.ListCatalogItemsByCreatedAtDescRow | sqlc.ListRecentlyViewedCatalogItemsRow](rows []T) []catalogRow {
+	out := make([]catalogRow, len(rows))
+	for i, row := range rows {
+		out[i] = catalogRow(row)
+	}
+	return out
+}
+
+func toCatalogItem(row catalogRow) *entity.CatalogItem {
+	return &entity.CatalogItem{
+		ID:          row.ID,
+		Title:       row.Title,
+		Description: row.Description.String,
+		Disabled:    row.Disabled,
+		CategoryID:  uuid.UUID(row.CategoryID.Bytes),
+		SKU:         row.Sku.String,
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
+
+// toText stores an empty string as NULL.
+func toText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
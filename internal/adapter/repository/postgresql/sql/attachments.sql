@@ -0,0 +1,58 @@
+-- name: UpsertBlob :exec
+INSERT INTO blobs (digest, size)
+VALUES ($1, $2)
+ON CONFLICT (digest) DO UPDATE
+SET last_used_at = NOW();
+
+-- name: CreateAttachment :one
+INSERT INTO data_attachments (key, name, content_type, size, digest, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, key, name, content_type, size, digest, created_by, created_at;
+
+-- name: GetAttachment :one
+-- Attachments of a soft deleted key are hidden until the key is restored.
+SELECT a.id, a.key, a.name, a.content_type, a.size, a.digest, a.created_by, a.created_at
+FROM data_attachments a
+WHERE a.id = $1
+  AND NOT EXISTS (
+      SELECT 1 FROM (SELECT deleted_at FROM data WHERE key = a.key ORDER BY id DESC LIMIT 1) cur
+      WHERE cur.deleted_at IS NOT NULL);
+
+-- name: ListAttachments :many
+-- Attachments of a soft deleted key are hidden until the key is restored.
+SELECT a.id, a.key, a.name, a.content_type, a.size, a.digest, a.created_by, a.created_at
+FROM data_attachments a
+WHERE a.key = $1
+  AND NOT EXISTS (
+      SELECT 1 FROM (SELECT deleted_at FROM data WHERE key = a.key ORDER BY id DESC LIMIT 1) cur
+      WHERE cur.deleted_at IS NOT NULL)
+ORDER BY a.created_at, a.id;
+
+-- name: DeleteAttachment :execrows
+-- Attachments of a soft deleted key are hidden, so they cannot be deleted either.
+DELETE FROM data_attachments a
+WHERE a.id = $1
+  AND NOT EXISTS (
+      SELECT 1 FROM (SELECT deleted_at FROM data WHERE key = a.key ORDER BY id DESC LIMIT 1) cur
+      WHERE cur.deleted_at IS NOT NULL);
+
+-- name: DeleteDanglingAttachments :execrows
+DELETE FROM data_attachments a
+WHERE NOT EXISTS (SELECT 1 FROM data d WHERE d.key = a.key);
+
+-- name: DeleteUnusedBlobs :many
+-- Blobs locked by an upload in progress are skipped; the upload refreshes last_used_at.
+DELETE FROM blobs
+WHERE digest IN (
+    SELECT b.digest
+    FROM blobs b
+    WHERE b.last_used_at < sqlc.arg(older_than)
+      AND NOT EXISTS (SELECT 1 FROM data_attachments a WHERE a.digest = b.digest)
+    ORDER BY b.last_used_at
+    LIMIT sqlc.arg(batch_size)::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING digest;
+
+-- name: BlobExists :one
+SELECT EXISTS (SELECT 1 FROM blobs WHERE digest = $1);
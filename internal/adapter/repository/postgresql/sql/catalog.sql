@@ -1,4 +1,260 @@
 -- name: GetCatalogItems :many
-SELECT id, title, description, disabled
+-- category is a category path; it matches items in that category and all categories below it.
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku
+FROM catalog c
+WHERE c.deleted_at IS NULL
+  AND (sqlc.arg(category)::text = '' OR c.category_id IN (
+      SELECT cc.id FROM catalog_categories cc
+      WHERE cc.path = sqlc.arg(category) OR starts_with(cc.path, sqlc.arg(category) || '/')))
+ORDER BY c.title;
+
+-- name: GetCatalogItem :one
+SELECT id, title, description, disabled, created_at, updated_at, category_id, sku
 FROM catalog
-ORDER BY title;
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListCatalogItemsByTitle :many
+-- Keyset page ordered by (title, id). after_title and after_id are the last row of the previous page.
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku
+FROM catalog c
+WHERE c.deleted_at IS NULL
+  AND (sqlc.narg(disabled)::boolean IS NULL OR c.disabled = sqlc.narg(disabled))
+  AND starts_with(lower(c.title), lower(sqlc.arg(title_prefix)::text))
+  AND (sqlc.arg(tag)::text = '' OR EXISTS (
+      SELECT 1 FROM catalog_item_tags t WHERE t.item_id = c.id AND t.tag = sqlc.arg(tag)))
+  AND (sqlc.arg(category)::text = '' OR c.category_id IN (
+      SELECT cc.id FROM catalog_categories cc
+      WHERE cc.path = sqlc.arg(category) OR starts_with(cc.path, sqlc.arg(category) || '/')))
+  AND (sqlc.narg(after_id)::uuid IS NULL OR (c.title, c.id) > (sqlc.narg(after_title)::text, sqlc.narg(after_id)))
+ORDER BY c.title, c.id
+LIMIT sqlc.arg(page_size);
+
+-- name: ListCatalogItemsByTitleDesc :many
+-- Keyset page ordered by (title, id) descending, see ListCatalogItemsByTitle.
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku
+FROM catalog c
+WHERE c.deleted_at IS NULL
+  AND (sqlc.narg(disabled)::boolean IS NULL OR c.disabled = sqlc.narg(disabled))
+  AND starts_with(lower(c.title), lower(sqlc.arg(title_prefix)::text))
+  AND (sqlc.arg(tag)::text = '' OR EXISTS (
+      SELECT 1 FROM catalog_item_tags t WHERE t.item_id = c.id AND t.tag = sqlc.arg(tag)))
+  AND (sqlc.arg(category)::text = '' OR c.category_id IN (
+      SELECT cc.id FROM catalog_categories cc
+      WHERE cc.path = sqlc.arg(category) OR starts_with(cc.path, sqlc.arg(category) || '/')))
+  AND (sqlc.narg(after_id)::uuid IS NULL OR (c.title, c.id) < (sqlc.narg(after_title)::text, sqlc.narg(after_id)))
+ORDER BY c.title DESC, c.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListCatalogItemsByCreatedAt :many
+-- Keyset page ordered by (created_at, id). after_created_at and after_id are the last row of the previous page.
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku
+FROM catalog c
+WHERE c.deleted_at IS NULL
+  AND (sqlc.narg(disabled)::boolean IS NULL OR c.disabled = sqlc.narg(disabled))
+  AND starts_with(lower(c.title), lower(sqlc.arg(title_prefix)::text))
+  AND (sqlc.arg(tag)::text = '' OR EXISTS (
+      SELECT 1 FROM catalog_item_tags t WHERE t.item_id = c.id AND t.tag = sqlc.arg(tag)))
+  AND (sqlc.arg(category)::text = '' OR c.category_id IN (
+      SELECT cc.id FROM catalog_categories cc
+      WHERE cc.path = sqlc.arg(category) OR starts_with(cc.path, sqlc.arg(category) || '/')))
+  AND (sqlc.narg(after_id)::uuid IS NULL OR (c.created_at, c.id) > (sqlc.narg(after_created_at)::timestamptz, sqlc.narg(after_id)))
+ORDER BY c.created_at, c.id
+LIMIT sqlc.arg(page_size);
+
+-- name: ListCatalogItemsByCreatedAtDesc :many
+-- Keyset page ordered by (created_at, id) descending, see ListCatalogItemsByCreatedAt.
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku
+FROM catalog c
+WHERE c.deleted_at IS NULL
+  AND (sqlc.narg(disabled)::boolean IS NULL OR c.disabled = sqlc.narg(disabled))
+  AND starts_with(lower(c.title), lower(sqlc.arg(title_prefix)::text))
+  AND (sqlc.arg(tag)::text = '' OR EXISTS (
+      SELECT 1 FROM catalog_item_tags t WHERE t.item_id = c.id AND t.tag = sqlc.arg(tag)))
+  AND (sqlc.arg(category)::text = '' OR c.category_id IN (
+      SELECT cc.id FROM catalog_categories cc
+      WHERE cc.path = sqlc.arg(category) OR starts_with(cc.path, sqlc.arg(category) || '/')))
+  AND (sqlc.narg(after_id)::uuid IS NULL OR (c.created_at, c.id) < (sqlc.narg(after_created_at)::timestamptz, sqlc.narg(after_id)))
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchCatalogItems :many
+-- Full-text matches ranked by ts_rank. Highlights mark matches with \x01 (start) and \x02 (stop).
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku,
+       ts_rank(c.search_vector, q.query) AS rank,
+       ts_headline(c.search_language, c.title, q.query,
+           'StartSel=' || chr(1) || ', StopSel=' || chr(2) || ', HighlightAll=true')::text AS title_highlight,
+       ts_headline(c.search_language, coalesce(c.description, ''), q.query,
+           'StartSel=' || chr(1) || ', StopSel=' || chr(2) || ', MaxFragments=2, MaxWords=30, MinWords=10')::text AS description_highlight
+FROM catalog c,
+     websearch_to_tsquery(sqlc.arg(language)::text::regconfig, sqlc.arg(query)::text) AS q (query)
+WHERE c.search_vector @@ q.query
+  AND c.deleted_at IS NULL
+  AND (sqlc.narg(disabled)::boolean IS NULL OR c.disabled = sqlc.narg(disabled))
+ORDER BY rank DESC, c.id
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchCatalogItemsFuzzy :many
+-- Typo-tolerant title matches for queries without full-text results. The match threshold is
+-- pg_trgm.word_similarity_threshold, see SetWordSimilarityThreshold.
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku,
+       word_similarity(sqlc.arg(query)::text, c.title) AS similarity
+FROM catalog c
+WHERE sqlc.arg(query) <% c.title
+  AND c.deleted_at IS NULL
+  AND (sqlc.narg(disabled)::boolean IS NULL OR c.disabled = sqlc.narg(disabled))
+ORDER BY similarity DESC, c.id
+LIMIT sqlc.arg(page_size);
+
+-- name: SetWordSimilarityThreshold :exec
+-- Applies to the current transaction only.
+SELECT set_config('pg_trgm.word_similarity_threshold', sqlc.arg(threshold)::real::text, true);
+
+-- name: SetCatalogSearchLanguage :execrows
+UPDATE catalog
+SET search_language = sqlc.arg(language)::text::regconfig
+WHERE search_language <> sqlc.arg(language)::text::regconfig;
+
+-- name: CreateCatalogItem :one
+INSERT INTO catalog (title, description, disabled, category_id, search_language, sku)
+VALUES (sqlc.arg(title), sqlc.arg(description), sqlc.arg(disabled), sqlc.arg(category_id),
+        sqlc.arg(search_language)::text::regconfig, sqlc.narg(sku))
+RETURNING id, title, description, disabled, created_at, updated_at, category_id, sku;
+
+-- name: UpdateCatalogItem :one
+UPDATE catalog
+SET title = sqlc.arg(title),
+    description = sqlc.arg(description),
+    disabled = sqlc.arg(disabled),
+    category_id = sqlc.arg(category_id),
+    search_language = sqlc.arg(search_language)::text::regconfig,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+RETURNING id, title, description, disabled, created_at, updated_at, category_id, sku;
+
+-- name: GetCatalogItemForUpdate :one
+SELECT id, title, description, disabled, created_at, updated_at, category_id, sku
+FROM catalog
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: UpsertCatalogItem :one
+-- Writes an item under a known id. created_at is kept for existing items and defaults to now for new ones.
+-- A NULL sku keeps the sku of an existing item. A deleted item is restored.
+INSERT INTO catalog (id, title, description, disabled, category_id, search_language, created_at, sku)
+VALUES (sqlc.arg(id), sqlc.arg(title), sqlc.arg(description), sqlc.arg(disabled), sqlc.arg(category_id),
+        sqlc.arg(search_language)::text::regconfig, coalesce(sqlc.narg(created_at)::timestamptz, NOW()), sqlc.narg(sku))
+ON CONFLICT (id) DO UPDATE
+SET title = EXCLUDED.title,
+    sku = coalesce(EXCLUDED.sku, catalog.sku),
+    description = EXCLUDED.description,
+    disabled = EXCLUDED.disabled,
+    category_id = EXCLUDED.category_id,
+    search_language = EXCLUDED.search_language,
+    updated_at = NOW(),
+    deleted_at = NULL
+RETURNING id, title, description, disabled, created_at, updated_at, category_id, sku;
+
+-- name: ListCatalogItemIDsBySKU :many
+-- Locks the items with the given skus for the rest of the transaction.
+SELECT id, sku
+FROM catalog
+WHERE sku = ANY(sqlc.arg(skus)::text[]) AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: DeleteCatalogItemsNotInSKUs :many
+-- Soft deletes the items with other skus and returns their ids. Items without a sku are kept.
+UPDATE catalog
+SET deleted_at = NOW()
+WHERE sku IS NOT NULL AND NOT (sku = ANY(sqlc.arg(skus)::text[])) AND deleted_at IS NULL
+RETURNING id;
+
+-- name: SetCatalogItemDisabled :one
+UPDATE catalog
+SET disabled = $2,
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, title, description, disabled, created_at, updated_at, category_id, sku;
+
+-- name: DeleteCatalogItem :execrows
+-- Soft deletes an item; PurgeDeletedCatalogItems removes it for good.
+UPDATE catalog
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListDeletedCatalogItems :many
+SELECT id, title, description, disabled, created_at, updated_at, category_id, sku, deleted_at
+FROM catalog
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id
+LIMIT sqlc.arg(page_size)::int;
+
+-- name: RestoreCatalogItem :one
+UPDATE catalog
+SET deleted_at = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, title, description, disabled, created_at, updated_at, category_id, sku;
+
+-- name: PurgeDeletedCatalogItems :execrows
+-- Permanently removes a batch of items deleted before the cutoff, together with their tags,
+-- translations, images, favorites and views.
+DELETE FROM catalog
+WHERE id IN (
+    SELECT id
+    FROM catalog
+    WHERE deleted_at < sqlc.arg(cutoff)::timestamptz
+    ORDER BY deleted_at
+    LIMIT sqlc.arg(batch_size)::int
+    FOR UPDATE SKIP LOCKED
+);
+
+-- name: ListCatalogItemTags :many
+SELECT item_id, tag
+FROM catalog_item_tags
+WHERE item_id = ANY(sqlc.arg(item_ids)::uuid[])
+ORDER BY item_id, tag;
+
+-- name: UpsertCatalogTags :exec
+INSERT INTO catalog_tags (name)
+SELECT unnest(sqlc.arg(names)::text[])
+ON CONFLICT (name) DO NOTHING;
+
+-- name: DeleteCatalogItemTags :exec
+DELETE FROM catalog_item_tags
+WHERE item_id = $1;
+
+-- name: AddCatalogItemTags :exec
+INSERT INTO catalog_item_tags (item_id, tag)
+SELECT sqlc.arg(item_id)::uuid, unnest(sqlc.arg(tags)::text[]);
+
+-- name: ListCatalogTags :many
+-- item_count only counts items that are not deleted.
+SELECT t.name, count(c.id) AS item_count
+FROM catalog_tags t
+LEFT JOIN catalog_item_tags it ON it.tag = t.name
+LEFT JOIN catalog c ON c.id = it.item_id AND c.deleted_at IS NULL
+GROUP BY t.name
+ORDER BY t.name;
+
+-- name: GetCatalogTag :one
+SELECT t.name, count(c.id) AS item_count
+FROM catalog_tags t
+LEFT JOIN catalog_item_tags it ON it.tag = t.name
+LEFT JOIN catalog c ON c.id = it.item_id AND c.deleted_at IS NULL
+WHERE t.name = $1
+GROUP BY t.name;
+
+-- name: CreateCatalogTag :execrows
+INSERT INTO catalog_tags (name)
+VALUES ($1)
+ON CONFLICT (name) DO NOTHING;
+
+-- name: RenameCatalogTag :execrows
+-- Item assignments follow through ON UPDATE CASCADE.
+UPDATE catalog_tags
+SET name = sqlc.arg(new_name)
+WHERE name = sqlc.arg(name);
+
+-- name: DeleteCatalogTag :execrows
+DELETE FROM catalog_tags
+WHERE name = $1;
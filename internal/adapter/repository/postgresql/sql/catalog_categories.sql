@@ -0,0 +1,54 @@
+-- name: ListCatalogCategories :many
+SELECT id, parent_id, slug, name, path, created_at, updated_at
+FROM catalog_categories
+ORDER BY path;
+
+-- name: GetCatalogCategory :one
+SELECT id, parent_id, slug, name, path, created_at, updated_at
+FROM catalog_categories
+WHERE id = $1;
+
+-- name: GetCatalogCategoryForUpdate :one
+SELECT id, parent_id, slug, name, path, created_at, updated_at
+FROM catalog_categories
+WHERE id = $1
+FOR UPDATE;
+
+-- name: LockCatalogCategoryDescendants :exec
+-- Creating a subcategory locks its parent, so this keeps new categories out of the subtree
+-- until the transaction ends.
+SELECT id
+FROM catalog_categories
+WHERE starts_with(path, sqlc.arg(path)::text || '/')
+FOR UPDATE;
+
+-- name: ListCatalogCategoryPaths :many
+SELECT id, path
+FROM catalog_categories
+WHERE id = ANY(sqlc.arg(ids)::uuid[]);
+
+-- name: CreateCatalogCategory :one
+INSERT INTO catalog_categories (parent_id, slug, name, path)
+VALUES ($1, $2, $3, $4)
+RETURNING id, parent_id, slug, name, path, created_at, updated_at;
+
+-- name: UpdateCatalogCategory :one
+UPDATE catalog_categories
+SET parent_id = $2,
+    slug = $3,
+    name = $4,
+    path = $5,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, parent_id, slug, name, path, created_at, updated_at;
+
+-- name: MoveCatalogCategoryDescendants :execrows
+-- Replaces the old_path prefix of every category below old_path after a rename or move.
+UPDATE catalog_categories
+SET path = sqlc.arg(new_path)::text || substr(path, length(sqlc.arg(old_path)::text) + 1),
+    updated_at = NOW()
+WHERE starts_with(path, sqlc.arg(old_path) || '/');
+
+-- name: DeleteCatalogCategory :execrows
+DELETE FROM catalog_categories
+WHERE id = $1;
@@ -0,0 +1,69 @@
+-- name: ListCatalogChangesets :many
+SELECT id, title, status, created_by, created_at, published_by, published_at, rolled_back_by, rolled_back_at
+FROM catalog_changesets
+ORDER BY created_at DESC, id;
+
+-- name: GetCatalogChangeset :one
+SELECT id, title, status, created_by, created_at, published_by, published_at, rolled_back_by, rolled_back_at
+FROM catalog_changesets
+WHERE id = $1;
+
+-- name: GetCatalogChangesetForUpdate :one
+SELECT id, title, status, created_by, created_at, published_by, published_at, rolled_back_by, rolled_back_at
+FROM catalog_changesets
+WHERE id = $1
+FOR UPDATE;
+
+-- name: CreateCatalogChangeset :one
+INSERT INTO catalog_changesets (title, created_by)
+VALUES ($1, $2)
+RETURNING id, title, status, created_by, created_at, published_by, published_at, rolled_back_by, rolled_back_at;
+
+-- name: MarkCatalogChangesetPublished :one
+UPDATE catalog_changesets
+SET status = 'published',
+    published_by = $2,
+    published_at = NOW()
+WHERE id = $1
+RETURNING id, title, status, created_by, created_at, published_by, published_at, rolled_back_by, rolled_back_at;
+
+-- name: MarkCatalogChangesetRolledBack :one
+UPDATE catalog_changesets
+SET status = 'rolled_back',
+    rolled_back_by = $2,
+    rolled_back_at = NOW()
+WHERE id = $1
+RETURNING id, title, status, created_by, created_at, published_by, published_at, rolled_back_by, rolled_back_at;
+
+-- name: DeleteCatalogChangeset :execrows
+DELETE FROM catalog_changesets
+WHERE id = $1;
+
+-- name: ListCatalogDrafts :many
+SELECT changeset_id, item_id, action, title, description, disabled, tags, category_id, base_updated_at, before, updated_at
+FROM catalog_drafts
+WHERE changeset_id = $1
+ORDER BY updated_at, item_id;
+
+-- name: UpsertCatalogDraft :one
+INSERT INTO catalog_drafts (changeset_id, item_id, action, title, description, disabled, tags, category_id, base_updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (changeset_id, item_id) DO UPDATE
+SET action = EXCLUDED.action,
+    title = EXCLUDED.title,
+    description = EXCLUDED.description,
+    disabled = EXCLUDED.disabled,
+    tags = EXCLUDED.tags,
+    category_id = EXCLUDED.category_id,
+    base_updated_at = EXCLUDED.base_updated_at,
+    updated_at = NOW()
+RETURNING changeset_id, item_id, action, title, description, disabled, tags, category_id, base_updated_at, before, updated_at;
+
+-- name: DeleteCatalogDraft :execrows
+DELETE FROM catalog_drafts
+WHERE changeset_id = $1 AND item_id = $2;
+
+-- name: SetCatalogDraftBefore :exec
+UPDATE catalog_drafts
+SET before = $3
+WHERE changeset_id = $1 AND item_id = $2;
@@ -0,0 +1,57 @@
+-- name: AddCatalogFavorite :execrows
+-- Affects no row if the item does not exist or is deleted. Adding a favorite again keeps it
+-- unchanged but still counts as one row.
+INSERT INTO user_catalog_favorites (user_id, item_id)
+SELECT sqlc.arg(user_id), c.id
+FROM catalog c
+WHERE c.id = sqlc.arg(item_id) AND c.deleted_at IS NULL
+ON CONFLICT (user_id, item_id) DO UPDATE SET created_at = user_catalog_favorites.created_at;
+
+-- name: DeleteCatalogFavorite :execrows
+DELETE FROM user_catalog_favorites
+WHERE user_id = $1 AND item_id = $2;
+
+-- name: ListCatalogFavoriteItems :many
+-- One page of favorites, most recently added first. The after_* arguments are the last row of
+-- the previous page, or NULL for the first one.
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku,
+    f.created_at AS added_at
+FROM user_catalog_favorites f
+JOIN catalog c ON c.id = f.item_id
+WHERE f.user_id = sqlc.arg(user_id) AND c.deleted_at IS NULL
+    AND (sqlc.narg(after_item_id)::uuid IS NULL
+        OR (f.created_at, f.item_id) < (sqlc.narg(after_added_at)::timestamptz, sqlc.narg(after_item_id)::uuid))
+ORDER BY f.created_at DESC, f.item_id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListCatalogFavoriteIDs :many
+-- The subset of item_ids that the user has favorited.
+SELECT item_id
+FROM user_catalog_favorites
+WHERE user_id = sqlc.arg(user_id) AND item_id = ANY(sqlc.arg(item_ids)::uuid[]);
+
+-- name: RecordCatalogView :execrows
+-- Affects no row if the item does not exist or is deleted.
+INSERT INTO user_catalog_views (user_id, item_id)
+SELECT sqlc.arg(user_id), c.id
+FROM catalog c
+WHERE c.id = sqlc.arg(item_id) AND c.deleted_at IS NULL
+ON CONFLICT (user_id, item_id) DO UPDATE SET viewed_at = NOW();
+
+-- name: TrimCatalogViews :exec
+-- Keeps the keep most recent views of the user.
+DELETE FROM user_catalog_views
+WHERE user_id = sqlc.arg(user_id) AND item_id NOT IN (
+    SELECT item_id
+    FROM user_catalog_views
+    WHERE user_id = sqlc.arg(user_id)
+    ORDER BY viewed_at DESC, item_id
+    LIMIT sqlc.arg(keep)::int
+);
+
+-- name: ListRecentlyViewedCatalogItems :many
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku
+FROM user_catalog_views v
+JOIN catalog c ON c.id = v.item_id
+WHERE v.user_id = $1 AND c.deleted_at IS NULL
+ORDER BY v.viewed_at DESC, c.id;
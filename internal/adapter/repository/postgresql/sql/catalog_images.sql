@@ -0,0 +1,24 @@
+-- name: CreateCatalogImage :one
+INSERT INTO catalog_images (id, item_id, content_type, width, height, size)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, item_id, content_type, width, height, size, created_at;
+
+-- name: GetCatalogImage :one
+-- Images of deleted items are not found.
+SELECT i.id, i.item_id, i.content_type, i.width, i.height, i.size, i.created_at
+FROM catalog_images i
+JOIN catalog c ON c.id = i.item_id AND c.deleted_at IS NULL
+WHERE i.id = $1;
+
+-- name: ListCatalogImagesForItems :many
+SELECT id, item_id, content_type, width, height, size, created_at
+FROM catalog_images
+WHERE item_id = ANY(sqlc.arg(item_ids)::uuid[])
+ORDER BY created_at, id;
+
+-- name: DeleteCatalogImage :execrows
+DELETE FROM catalog_images
+WHERE id = $1 AND item_id = $2;
+
+-- name: CatalogImageExists :one
+SELECT EXISTS (SELECT 1 FROM catalog_images WHERE id = $1);
@@ -0,0 +1,45 @@
+-- name: ListCatalogInventory :many
+-- reserved_quantity only counts unexpired reservations.
+SELECT i.item_id, i.price, i.currency, i.stock_quantity,
+       coalesce((SELECT sum(r.quantity) FROM catalog_reservations r
+                 WHERE r.item_id = i.item_id AND r.expires_at > NOW()), 0)::bigint AS reserved_quantity
+FROM catalog_inventory i
+WHERE i.item_id = ANY(sqlc.arg(item_ids)::uuid[]);
+
+-- name: UpsertCatalogInventory :execrows
+-- Affects no row if the item does not exist or is deleted.
+INSERT INTO catalog_inventory (item_id, price, currency, stock_quantity)
+SELECT c.id, sqlc.narg(price)::numeric, sqlc.narg(currency)::text, sqlc.narg(stock_quantity)::bigint
+FROM catalog c
+WHERE c.id = sqlc.arg(item_id) AND c.deleted_at IS NULL
+ON CONFLICT (item_id) DO UPDATE
+SET price = EXCLUDED.price,
+    currency = EXCLUDED.currency,
+    stock_quantity = EXCLUDED.stock_quantity,
+    updated_at = NOW();
+
+-- name: GetCatalogInventoryForUpdate :one
+-- Locks the inventory row of an item, so reservations of the item run one at a time.
+SELECT i.price, i.currency, i.stock_quantity, c.disabled
+FROM catalog_inventory i
+JOIN catalog c ON c.id = i.item_id
+WHERE i.item_id = $1 AND c.deleted_at IS NULL
+FOR UPDATE OF i;
+
+-- name: DeleteExpiredCatalogReservations :exec
+DELETE FROM catalog_reservations
+WHERE item_id = $1 AND expires_at <= NOW();
+
+-- name: GetReservedQuantity :one
+SELECT coalesce(sum(quantity), 0)::bigint AS reserved_quantity
+FROM catalog_reservations
+WHERE item_id = $1 AND expires_at > NOW();
+
+-- name: CreateCatalogReservation :one
+INSERT INTO catalog_reservations (item_id, user_id, quantity, unit_price, currency, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, item_id, user_id, quantity, unit_price, currency, created_at, expires_at;
+
+-- name: DeleteCatalogReservation :execrows
+DELETE FROM catalog_reservations
+WHERE id = $1 AND item_id = $2 AND user_id = $3;
@@ -0,0 +1,78 @@
+-- name: GetCatalogReview :one
+SELECT r.id, r.item_id, r.user_id, r.rating, r.body, r.status, r.created_at, r.updated_at
+FROM catalog_reviews r
+JOIN catalog c ON c.id = r.item_id
+WHERE r.item_id = $1 AND r.user_id = $2 AND c.deleted_at IS NULL;
+
+-- name: GetCatalogReviewForUpdate :one
+SELECT id, item_id, user_id, rating, body, status, created_at, updated_at
+FROM catalog_reviews
+WHERE item_id = $1 AND user_id = $2
+FOR UPDATE;
+
+-- name: GetCatalogReviewByIDForUpdate :one
+SELECT id, item_id, user_id, rating, body, status, created_at, updated_at
+FROM catalog_reviews
+WHERE id = $1
+FOR UPDATE;
+
+-- name: CreateCatalogReview :one
+-- Returns no row if the item does not exist or is deleted.
+INSERT INTO catalog_reviews (item_id, user_id, rating, body, status)
+SELECT c.id, sqlc.arg(user_id), sqlc.arg(rating), sqlc.arg(body), sqlc.arg(status)
+FROM catalog c
+WHERE c.id = sqlc.arg(item_id) AND c.deleted_at IS NULL
+RETURNING id, item_id, user_id, rating, body, status, created_at, updated_at;
+
+-- name: UpdateCatalogReview :one
+-- An edit of a rejected review goes back to moderation as pending instead of taking the
+-- status of new reviews.
+UPDATE catalog_reviews
+SET rating = $2,
+    body = $3,
+    status = CASE WHEN status = 'rejected' THEN 'pending' ELSE $4 END,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, item_id, user_id, rating, body, status, created_at, updated_at;
+
+-- name: SetCatalogReviewStatus :one
+UPDATE catalog_reviews
+SET status = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, item_id, user_id, rating, body, status, created_at, updated_at;
+
+-- name: DeleteCatalogReview :exec
+DELETE FROM catalog_reviews
+WHERE id = $1;
+
+-- name: ListCatalogReviews :many
+-- Reviews of an item with the given status, newest first.
+SELECT r.id, r.item_id, r.user_id, r.rating, r.body, r.status, r.created_at, r.updated_at
+FROM catalog_reviews r
+JOIN catalog c ON c.id = r.item_id
+WHERE r.item_id = sqlc.arg(item_id) AND r.status = sqlc.arg(status) AND c.deleted_at IS NULL
+ORDER BY r.created_at DESC, r.id
+LIMIT sqlc.arg(page_size)::int;
+
+-- name: ListCatalogReviewsByStatus :many
+-- Reviews of all items with the given status, oldest first, for moderation.
+SELECT r.id, r.item_id, r.user_id, r.rating, r.body, r.status, r.created_at, r.updated_at
+FROM catalog_reviews r
+JOIN catalog c ON c.id = r.item_id
+WHERE r.status = sqlc.arg(status) AND c.deleted_at IS NULL
+ORDER BY r.updated_at, r.id
+LIMIT sqlc.arg(page_size)::int;
+
+-- name: AddCatalogReviewStats :exec
+-- Adds the deltas to the running totals of an item.
+INSERT INTO catalog_review_stats (item_id, review_count, rating_sum)
+VALUES (sqlc.arg(item_id), sqlc.arg(review_count), sqlc.arg(rating_sum))
+ON CONFLICT (item_id) DO UPDATE
+SET review_count = catalog_review_stats.review_count + EXCLUDED.review_count,
+    rating_sum = catalog_review_stats.rating_sum + EXCLUDED.rating_sum;
+
+-- name: ListCatalogReviewStats :many
+SELECT item_id, review_count, rating_sum
+FROM catalog_review_stats
+WHERE item_id = ANY(sqlc.arg(item_ids)::uuid[]);
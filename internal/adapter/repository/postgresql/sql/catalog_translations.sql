@@ -0,0 +1,21 @@
+-- name: ListCatalogTranslations :many
+SELECT item_id, locale, title, description, created_at, updated_at
+FROM catalog_translations
+WHERE item_id = $1
+ORDER BY locale;
+
+-- name: ListCatalogTranslationsForItems :many
+SELECT item_id, locale, title, description, created_at, updated_at
+FROM catalog_translations
+WHERE item_id = ANY(sqlc.arg(item_ids)::uuid[]) AND locale = ANY(sqlc.arg(locales)::text[]);
+
+-- name: UpsertCatalogTranslation :one
+INSERT INTO catalog_translations (item_id, locale, title, description)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (item_id, locale) DO UPDATE
+SET title = EXCLUDED.title, description = EXCLUDED.description, updated_at = NOW()
+RETURNING item_id, locale, title, description, created_at, updated_at;
+
+-- name: DeleteCatalogTranslation :execrows
+DELETE FROM catalog_translations
+WHERE item_id = $1 AND locale = $2;
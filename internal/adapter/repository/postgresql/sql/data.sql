@@ -1,3 +1,175 @@
 -- name: SaveData :exec
-INSERT INTO data (key, value)
-VALUES ($1, $2);
+INSERT INTO data (key, value, value_ciphertext, value_data_key, value_key_id, expires_at, owner_id, value_size)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetData :one
+-- The newest version of a key, unless it expired or was deleted: an expired newest
+-- version hides the older ones.
+SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+FROM (
+    SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+    FROM data
+    WHERE key = $1
+    ORDER BY id DESC
+    LIMIT 1
+) cur
+WHERE (cur.expires_at IS NULL OR cur.expires_at > NOW())
+  AND cur.deleted_at IS NULL;
+
+-- name: DeleteExpiredData :execrows
+-- Removes a batch of expired versions together with the older versions of their keys,
+-- which they hide for good. Soft deleted versions are kept for restores.
+WITH expired AS (
+    SELECT id, key
+    FROM data
+    WHERE expires_at <= NOW()
+    ORDER BY expires_at
+    LIMIT sqlc.arg(batch_size)::int
+    FOR UPDATE SKIP LOCKED
+)
+DELETE FROM data d
+USING expired e
+WHERE d.key = e.key
+  AND (d.id = e.id OR (d.id < e.id AND d.deleted_at IS NULL));
+
+-- name: GetLiveDataKeys :many
+SELECT key
+FROM (
+    SELECT DISTINCT ON (key) key, expires_at, deleted_at
+    FROM data
+    WHERE key = ANY(sqlc.arg(keys)::text[])
+    ORDER BY key, id DESC
+) cur
+WHERE (cur.expires_at IS NULL OR cur.expires_at > NOW())
+  AND cur.deleted_at IS NULL;
+
+-- name: DeleteDataByKeys :execrows
+-- Soft deletes every version of the keys.
+UPDATE data
+SET deleted_at = NOW()
+WHERE key = ANY(sqlc.arg(keys)::text[])
+  AND deleted_at IS NULL;
+
+-- name: CopyData :copyfrom
+INSERT INTO data (key, value, value_ciphertext, value_data_key, value_key_id, expires_at, owner_id, value_size)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: ListLiveDataAfterKey :many
+SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+FROM (
+    SELECT DISTINCT ON (key) id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+    FROM data
+    WHERE key > sqlc.arg(after_key)::text
+    ORDER BY key, id DESC
+) cur
+WHERE (cur.expires_at IS NULL OR cur.expires_at > NOW())
+  AND cur.deleted_at IS NULL
+ORDER BY key
+LIMIT sqlc.arg(page_size)::int;
+
+-- name: GetDataByID :one
+SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+FROM data
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: ListDataChangesAfterID :many
+SELECT d.id, d.key, d.value, d.created_at, d.expires_at, d.value_ciphertext, d.value_data_key, d.value_key_id, d.owner_id, d.value_size,
+       EXISTS (SELECT 1 FROM data p WHERE p.key = d.key AND p.id < d.id) AS is_update
+FROM data d
+WHERE d.id > sqlc.arg(after_id)::int
+  AND starts_with(d.key, sqlc.arg(prefix)::text)
+  AND d.deleted_at IS NULL
+ORDER BY d.id
+LIMIT sqlc.arg(page_size)::int;
+
+-- name: ListDataForRotation :many
+-- Soft deleted versions are re-encrypted as well, since they may be restored.
+SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+FROM data
+WHERE id > sqlc.arg(after_id)::int
+  AND value_key_id IS DISTINCT FROM sqlc.arg(active_key_id)::text
+ORDER BY id
+LIMIT sqlc.arg(batch_size)::int
+FOR UPDATE;
+
+-- name: UpdateDataEncryption :exec
+UPDATE data
+SET value = NULL,
+    value_ciphertext = $2,
+    value_data_key = $3,
+    value_key_id = $4
+WHERE id = $1;
+
+-- name: GetDataUsage :one
+-- Usage of the owner's live keys other than exclude_keys. A key counts for the owner
+-- of its current value, i.e. its newest version, unless that expired or was deleted.
+SELECT COUNT(*)::bigint AS key_count,
+       COALESCE(SUM(cur.value_size), 0)::bigint AS total_bytes
+FROM (
+    SELECT DISTINCT ON (key) key, owner_id, value_size, expires_at, deleted_at
+    FROM data
+    WHERE key IN (SELECT key FROM data WHERE owner_id = sqlc.arg(owner_id))
+    ORDER BY key, id DESC
+) cur
+WHERE cur.owner_id = sqlc.arg(owner_id)
+  AND cur.key <> ALL(sqlc.arg(exclude_keys)::text[])
+  AND (cur.expires_at IS NULL OR cur.expires_at > NOW())
+  AND cur.deleted_at IS NULL;
+
+-- name: SoftDeleteData :execrows
+-- Stamps every version of a live key as deleted. Keys whose newest version expired are left alone.
+UPDATE data
+SET deleted_at = NOW()
+WHERE key = sqlc.arg(key)
+  AND deleted_at IS NULL
+  AND EXISTS (
+      SELECT 1 FROM (SELECT expires_at FROM data WHERE key = sqlc.arg(key) ORDER BY id DESC LIMIT 1) cur
+      WHERE cur.expires_at IS NULL OR cur.expires_at > NOW());
+
+-- name: ListDeletedData :many
+-- The latest version of each deleted key that has no live version, most recently deleted first.
+SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+FROM (
+    SELECT DISTINCT ON (d.key) d.id, d.key, d.value, d.created_at, d.expires_at, d.value_ciphertext, d.value_data_key,
+           d.value_key_id, d.owner_id, d.value_size, d.deleted_at
+    FROM data d
+    WHERE d.deleted_at IS NOT NULL
+      AND NOT EXISTS (
+          SELECT 1 FROM (SELECT expires_at, deleted_at FROM data WHERE key = d.key ORDER BY id DESC LIMIT 1) cur
+          WHERE cur.deleted_at IS NULL AND (cur.expires_at IS NULL OR cur.expires_at > NOW()))
+    ORDER BY d.key, d.id DESC
+) deleted
+ORDER BY deleted_at DESC, key
+LIMIT sqlc.arg(page_size)::int;
+
+-- name: RestoreData :execrows
+-- Restores every deleted version of a key. Callers check that the key has no live version,
+-- so its versions written after the deletion are expired or hidden by an expired one; they
+-- are removed first, as they would hide the restored versions.
+WITH dead AS (
+    DELETE FROM data
+    WHERE key = $1
+      AND deleted_at IS NULL
+)
+UPDATE data
+SET deleted_at = NULL
+WHERE key = $1
+  AND deleted_at IS NOT NULL;
+
+-- name: DataKeyIsLive :one
+SELECT EXISTS (
+    SELECT 1 FROM (SELECT expires_at, deleted_at FROM data WHERE key = $1 ORDER BY id DESC LIMIT 1) cur
+    WHERE cur.deleted_at IS NULL AND (cur.expires_at IS NULL OR cur.expires_at > NOW()));
+
+-- name: PurgeDeletedData :execrows
+-- Permanently removes a batch of versions deleted before the cutoff.
+DELETE FROM data
+WHERE id IN (
+    SELECT id
+    FROM data
+    WHERE deleted_at < sqlc.arg(cutoff)::timestamptz
+    ORDER BY deleted_at
+    LIMIT sqlc.arg(batch_size)::int
+    FOR UPDATE SKIP LOCKED
+);
@@ -0,0 +1,23 @@
+-- name: GetDataSchemaForKey :one
+SELECT prefix, schema, created_at, updated_at
+FROM data_schemas
+WHERE starts_with(sqlc.arg(key)::text, prefix)
+ORDER BY length(prefix) DESC
+LIMIT 1;
+
+-- name: ListDataSchemas :many
+SELECT prefix, schema, created_at, updated_at
+FROM data_schemas
+ORDER BY prefix;
+
+-- name: UpsertDataSchema :one
+INSERT INTO data_schemas (prefix, schema)
+VALUES ($1, $2)
+ON CONFLICT (prefix) DO UPDATE
+SET schema = EXCLUDED.schema,
+    updated_at = NOW()
+RETURNING prefix, schema, created_at, updated_at;
+
+-- name: DeleteDataSchema :execrows
+DELETE FROM data_schemas
+WHERE prefix = $1;
@@ -0,0 +1,12 @@
+-- name: AdvisoryXactLock :exec
+SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg(lock_key)::text, 0));
+
+-- name: TryAdvisoryXactLock :one
+SELECT pg_try_advisory_xact_lock(sqlc.arg(lock_id)::bigint);
+
+-- name: TryAdvisoryLock :one
+-- Session lock, held until AdvisoryUnlock or the end of the connection.
+SELECT pg_try_advisory_lock(sqlc.arg(lock_id)::bigint);
+
+-- name: AdvisoryUnlock :one
+SELECT pg_advisory_unlock(sqlc.arg(lock_id)::bigint);
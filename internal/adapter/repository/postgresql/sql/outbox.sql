@@ -0,0 +1,31 @@
+-- name: CreateOutboxEvents :copyfrom
+INSERT INTO outbox (event_type, payload)
+VALUES ($1, $2);
+
+-- name: ListPendingOutboxEvents :many
+SELECT id, event_type, payload, created_at, published_at, attempts, last_attempt_at, last_error
+FROM outbox
+WHERE published_at IS NULL
+ORDER BY id
+LIMIT sqlc.arg(batch_size)::int;
+
+-- name: MarkOutboxEventsPublished :exec
+UPDATE outbox
+SET published_at = NOW()
+WHERE id = ANY(sqlc.arg(ids)::bigint[]);
+
+-- name: RecordOutboxFailure :exec
+UPDATE outbox
+SET attempts = attempts + 1,
+    last_attempt_at = NOW(),
+    last_error = sqlc.arg(last_error)
+WHERE id = sqlc.arg(id);
+
+-- name: DeletePublishedOutboxEvents :execrows
+DELETE FROM outbox
+WHERE id IN (
+    SELECT id FROM outbox
+    WHERE published_at < sqlc.arg(cutoff)
+    ORDER BY id
+    LIMIT sqlc.arg(batch_size)::int
+);
@@ -1,4 +1,9 @@
 -- name: GetUserByEmail :one
-SELECT id, email, password_hash
+SELECT id, email, password_hash, role, locale
 FROM users
 WHERE email = $1;
+
+-- name: SetUserLocale :execrows
+UPDATE users
+SET locale = $2
+WHERE id = $1;
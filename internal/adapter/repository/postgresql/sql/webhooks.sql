@@ -0,0 +1,138 @@
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (id, url, secret, secret_ciphertext, secret_data_key, secret_key_id, event_types, enabled)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetWebhookEndpoint :one
+SELECT * FROM webhook_endpoints
+WHERE id = $1;
+
+-- name: ListWebhookEndpoints :many
+SELECT * FROM webhook_endpoints
+ORDER BY created_at, id;
+
+-- Re-enabling an endpoint clears its failure count, so it gets the full number of failures
+-- again before it is disabled.
+-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoints
+SET url = sqlc.arg(url),
+    secret = sqlc.arg(secret),
+    secret_ciphertext = sqlc.arg(secret_ciphertext),
+    secret_data_key = sqlc.arg(secret_data_key),
+    secret_key_id = sqlc.arg(secret_key_id),
+    event_types = sqlc.arg(event_types),
+    consecutive_failures = CASE WHEN sqlc.arg(enabled)::bool AND NOT enabled THEN 0 ELSE consecutive_failures END,
+    disabled_at = CASE
+        WHEN sqlc.arg(enabled)::bool THEN NULL
+        WHEN enabled THEN NOW()
+        ELSE disabled_at
+    END,
+    enabled = sqlc.arg(enabled)::bool,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- There are few endpoints, so their secrets are re-encrypted in a single transaction.
+-- name: ListWebhookEndpointsForRotation :many
+SELECT * FROM webhook_endpoints
+WHERE secret_key_id IS DISTINCT FROM sqlc.arg(active_key_id)::text
+ORDER BY id
+FOR UPDATE;
+
+-- name: UpdateWebhookEndpointSecretEncryption :exec
+UPDATE webhook_endpoints
+SET secret = NULL,
+    secret_ciphertext = $2,
+    secret_data_key = $3,
+    secret_key_id = $4
+WHERE id = $1;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoints
+WHERE id = $1;
+
+-- Fans an event out to the endpoints subscribed to its type. Deliveries of a disabled endpoint
+-- wait until it is enabled again. An event handed over again is not delivered twice.
+-- name: CreateWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries (endpoint_id, event_id, event_type, payload, event_created_at)
+SELECT id, sqlc.arg(event_id), sqlc.arg(event_type)::text, sqlc.arg(payload), sqlc.arg(event_created_at)
+FROM webhook_endpoints
+WHERE sqlc.arg(event_type)::text = ANY(event_types)
+ON CONFLICT (endpoint_id, event_id) DO NOTHING;
+
+-- Claims due deliveries of enabled endpoints by moving their next attempt to lease_until, so
+-- other replicas skip them while they are sent.
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries d
+SET next_attempt_at = sqlc.arg(lease_until)
+FROM webhook_endpoints e
+WHERE e.id = d.endpoint_id
+  AND d.id IN (
+    SELECT dd.id FROM webhook_deliveries dd
+    JOIN webhook_endpoints ee ON ee.id = dd.endpoint_id
+    WHERE dd.status = 'pending' AND dd.next_attempt_at <= NOW() AND ee.enabled
+    ORDER BY dd.next_attempt_at
+    LIMIT sqlc.arg(batch_size)::int
+    FOR UPDATE OF dd SKIP LOCKED
+  )
+RETURNING d.id, d.endpoint_id, d.event_id, d.event_type, d.payload, d.event_created_at, d.status,
+    d.attempts, d.next_attempt_at, d.last_attempt_at, d.response_status, d.last_error, d.created_at,
+    e.url, e.secret, e.secret_ciphertext, e.secret_data_key, e.secret_key_id;
+
+-- name: UpdateWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET status = sqlc.arg(status),
+    attempts = sqlc.arg(attempts),
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    last_attempt_at = sqlc.arg(last_attempt_at),
+    response_status = sqlc.narg(response_status),
+    last_error = sqlc.narg(last_error)
+WHERE id = sqlc.arg(id);
+
+-- name: ResetWebhookEndpointFailures :exec
+UPDATE webhook_endpoints
+SET consecutive_failures = 0
+WHERE id = $1 AND consecutive_failures > 0;
+
+-- Counts a failure in a row and disables the endpoint when it reaches disable_after.
+-- name: AddWebhookEndpointFailure :one
+UPDATE webhook_endpoints
+SET consecutive_failures = consecutive_failures + 1,
+    enabled = enabled AND consecutive_failures + 1 < sqlc.arg(disable_after)::int,
+    disabled_at = CASE
+        WHEN enabled AND consecutive_failures + 1 >= sqlc.arg(disable_after)::int THEN NOW()
+        ELSE disabled_at
+    END,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+RETURNING consecutive_failures, enabled;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE endpoint_id = sqlc.arg(endpoint_id)
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+ORDER BY created_at DESC, id
+LIMIT sqlc.arg(page_size)::int;
+
+-- Queues a delivery again with a fresh retry schedule. The outcome of the last attempt is
+-- kept until the next one.
+-- name: RedeliverWebhookDelivery :one
+UPDATE webhook_deliveries
+SET status = 'pending',
+    attempts = 0,
+    next_attempt_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteFinishedWebhookDeliveries :execrows
+DELETE FROM webhook_deliveries
+WHERE id IN (
+    SELECT id FROM webhook_deliveries
+    WHERE status <> 'pending' AND created_at < sqlc.arg(cutoff)
+    ORDER BY created_at
+    LIMIT sqlc.arg(batch_size)::int
+);
@@ -7,28 +7,741 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addCatalogItemTags = `-- name: AddCatalogItemTags :exec
+INSERT INTO catalog_item_tags (item_id, tag)
+SELECT $1::uuid, unnest($2::text[])
+`
+
+type AddCatalogItemTagsParams struct {
+	ItemID uuid.UUID `json:"item_id"`
+	Tags   []string  `json:"tags"`
+}
+
+func (q *Queries) AddCatalogItemTags(ctx context.Context, arg AddCatalogItemTagsParams) error {
+	_, err := q.db.Exec(ctx, addCatalogItemTags, arg.ItemID, arg.Tags)
+	return err
+}
+
+const createCatalogItem = `-- name: CreateCatalogItem :one
+INSERT INTO catalog (title, description, disabled, category_id, search_language, sku)
+VALUES ($1, $2, $3, $4,
+        $5::text::regconfig, $6)
+RETURNING id, title, description, disabled, created_at, updated_at, category_id, sku
+`
+
+type CreateCatalogItemParams struct {
+	Title          string      `json:"title"`
+	Description    pgtype.Text `json:"description"`
+	Disabled       bool        `json:"disabled"`
+	CategoryID     pgtype.UUID `json:"category_id"`
+	SearchLanguage string      `json:"search_language"`
+	Sku            pgtype.Text `json:"sku"`
+}
+
+type CreateCatalogItemRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+func (q *Queries) CreateCatalogItem(ctx context.Context, arg CreateCatalogItemParams) (CreateCatalogItemRow, error) {
+	row := q.db.QueryRow(ctx, createCatalogItem,
+		arg.Title,
+		arg.Description,
+		arg.Disabled,
+		arg.CategoryID,
+		arg.SearchLanguage,
+		arg.Sku,
+	)
+	var i CreateCatalogItemRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Disabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CategoryID,
+		&i.Sku,
+	)
+	return i, err
+}
+
+const createCatalogTag = `-- name: CreateCatalogTag :execrows
+INSERT INTO catalog_tags (name)
+VALUES ($1)
+ON CONFLICT (name) DO NOTHING
+`
+
+func (q *Queries) CreateCatalogTag(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.Exec(ctx, createCatalogTag, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCatalogItem = `-- name: DeleteCatalogItem :execrows
+UPDATE catalog
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+// Soft deletes an item; PurgeDeletedCatalogItems removes it for good.
+func (q *Queries) DeleteCatalogItem(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCatalogItem, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCatalogItemTags = `-- name: DeleteCatalogItemTags :exec
+DELETE FROM catalog_item_tags
+WHERE item_id = $1
+`
+
+func (q *Queries) DeleteCatalogItemTags(ctx context.Context, itemID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCatalogItemTags, itemID)
+	return err
+}
+
+const deleteCatalogItemsNotInSKUs = `-- name: DeleteCatalogItemsNotInSKUs :many
+UPDATE catalog
+SET deleted_at = NOW()
+WHERE sku IS NOT NULL AND NOT (sku = ANY($1::text[])) AND deleted_at IS NULL
+RETURNING id
+`
+
+// Soft deletes the items with other skus and returns their ids. Items without a sku are kept.
+func (q *Queries) DeleteCatalogItemsNotInSKUs(ctx context.Context, skus []string) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, deleteCatalogItemsNotInSKUs, skus)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteCatalogTag = `-- name: DeleteCatalogTag :execrows
+DELETE FROM catalog_tags
+WHERE name = $1
+`
+
+func (q *Queries) DeleteCatalogTag(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCatalogTag, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getCatalogItem = `-- name: GetCatalogItem :one
+SELECT id, title, description, disabled, created_at, updated_at, category_id, sku
+FROM catalog
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+type GetCatalogItemRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+func (q *Queries) GetCatalogItem(ctx context.Context, id uuid.UUID) (GetCatalogItemRow, error) {
+	row := q.db.QueryRow(ctx, getCatalogItem, id)
+	var i GetCatalogItemRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Disabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CategoryID,
+		&i.Sku,
+	)
+	return i, err
+}
+
+const getCatalogItemForUpdate = `-- name: GetCatalogItemForUpdate :one
+SELECT id, title, description, disabled, created_at, updated_at, category_id, sku
+FROM catalog
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE
+`
+
+type GetCatalogItemForUpdateRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+func (q *Queries) GetCatalogItemForUpdate(ctx context.Context, id uuid.UUID) (GetCatalogItemForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getCatalogItemForUpdate, id)
+	var i GetCatalogItemForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Disabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CategoryID,
+		&i.Sku,
+	)
+	return i, err
+}
+
 const getCatalogItems = `-- name: GetCatalogItems :many
-SELECT id, title, description, disabled
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku
+FROM catalog c
+WHERE c.deleted_at IS NULL
+  AND ($1::text = '' OR c.category_id IN (
+      SELECT cc.id FROM catalog_categories cc
+      WHERE cc.path = $1 OR starts_with(cc.path, $1 || '/')))
+ORDER BY c.title
+`
+
+type GetCatalogItemsRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+// category is a category path; it matches items in that category and all categories below it.
+func (q *Queries) GetCatalogItems(ctx context.Context, category string) ([]GetCatalogItemsRow, error) {
+	rows, err := q.db.Query(ctx, getCatalogItems, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCatalogItemsRow
+	for rows.Next() {
+		var i GetCatalogItemsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Disabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CategoryID,
+			&i.Sku,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCatalogTag = `-- name: GetCatalogTag :one
+SELECT t.name, count(c.id) AS item_count
+FROM catalog_tags t
+LEFT JOIN catalog_item_tags it ON it.tag = t.name
+LEFT JOIN catalog c ON c.id = it.item_id AND c.deleted_at IS NULL
+WHERE t.name = $1
+GROUP BY t.name
+`
+
+type GetCatalogTagRow struct {
+	Name      string `json:"name"`
+	ItemCount int64  `json:"item_count"`
+}
+
+func (q *Queries) GetCatalogTag(ctx context.Context, name string) (GetCatalogTagRow, error) {
+	row := q.db.QueryRow(ctx, getCatalogTag, name)
+	var i GetCatalogTagRow
+	err := row.Scan(
+		&i.Name,
+		&i.ItemCount,
+	)
+	return i, err
+}
+
+const listCatalogItemIDsBySKU = `-- name: ListCatalogItemIDsBySKU :many
+SELECT id, sku
+FROM catalog
+WHERE sku = ANY($1::text[]) AND deleted_at IS NULL
+FOR UPDATE
+`
+
+type ListCatalogItemIDsBySKURow struct {
+	ID  uuid.UUID   `json:"id"`
+	Sku pgtype.Text `json:"sku"`
+}
+
+// Locks the items with the given skus for the rest of the transaction.
+func (q *Queries) ListCatalogItemIDsBySKU(ctx context.Context, skus []string) ([]ListCatalogItemIDsBySKURow, error) {
+	rows, err := q.db.Query(ctx, listCatalogItemIDsBySKU, skus)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCatalogItemIDsBySKURow
+	for rows.Next() {
+		var i ListCatalogItemIDsBySKURow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Sku,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCatalogItemTags = `-- name: ListCatalogItemTags :many
+SELECT item_id, tag
+FROM catalog_item_tags
+WHERE item_id = ANY($1::uuid[])
+ORDER BY item_id, tag
+`
+
+func (q *Queries) ListCatalogItemTags(ctx context.Context, itemIds []uuid.UUID) ([]CatalogItemTag, error) {
+	rows, err := q.db.Query(ctx, listCatalogItemTags, itemIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CatalogItemTag
+	for rows.Next() {
+		var i CatalogItemTag
+		if err := rows.Scan(
+			&i.ItemID,
+			&i.Tag,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCatalogItemsByCreatedAt = `-- name: ListCatalogItemsByCreatedAt :many
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku
+FROM catalog c
+WHERE c.deleted_at IS NULL
+  AND ($1::boolean IS NULL OR c.disabled = $1)
+  AND starts_with(lower(c.title), lower($2::text))
+  AND ($3::text = '' OR EXISTS (
+      SELECT 1 FROM catalog_item_tags t WHERE t.item_id = c.id AND t.tag = $3))
+  AND ($4::text = '' OR c.category_id IN (
+      SELECT cc.id FROM catalog_categories cc
+      WHERE cc.path = $4 OR starts_with(cc.path, $4 || '/')))
+  AND ($5::uuid IS NULL OR (c.created_at, c.id) > ($6::timestamptz, $5))
+ORDER BY c.created_at, c.id
+LIMIT $7
+`
+
+type ListCatalogItemsByCreatedAtParams struct {
+	Disabled       pgtype.Bool        `json:"disabled"`
+	TitlePrefix    string             `json:"title_prefix"`
+	Tag            string             `json:"tag"`
+	Category       string             `json:"category"`
+	AfterID        pgtype.UUID        `json:"after_id"`
+	AfterCreatedAt pgtype.Timestamptz `json:"after_created_at"`
+	PageSize       int32              `json:"page_size"`
+}
+
+type ListCatalogItemsByCreatedAtRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+// Keyset page ordered by (created_at, id). after_created_at and after_id are the last row of the previous page.
+func (q *Queries) ListCatalogItemsByCreatedAt(ctx context.Context, arg ListCatalogItemsByCreatedAtParams) ([]ListCatalogItemsByCreatedAtRow, error) {
+	rows, err := q.db.Query(ctx, listCatalogItemsByCreatedAt,
+		arg.Disabled,
+		arg.TitlePrefix,
+		arg.Tag,
+		arg.Category,
+		arg.AfterID,
+		arg.AfterCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCatalogItemsByCreatedAtRow
+	for rows.Next() {
+		var i ListCatalogItemsByCreatedAtRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Disabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CategoryID,
+			&i.Sku,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCatalogItemsByCreatedAtDesc = `-- name: ListCatalogItemsByCreatedAtDesc :many
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku
+FROM catalog c
+WHERE c.deleted_at IS NULL
+  AND ($1::boolean IS NULL OR c.disabled = $1)
+  AND starts_with(lower(c.title), lower($2::text))
+  AND ($3::text = '' OR EXISTS (
+      SELECT 1 FROM catalog_item_tags t WHERE t.item_id = c.id AND t.tag = $3))
+  AND ($4::text = '' OR c.category_id IN (
+      SELECT cc.id FROM catalog_categories cc
+      WHERE cc.path = $4 OR starts_with(cc.path, $4 || '/')))
+  AND ($5::uuid IS NULL OR (c.created_at, c.id) < ($6::timestamptz, $5))
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT $7
+`
+
+type ListCatalogItemsByCreatedAtDescParams struct {
+	Disabled       pgtype.Bool        `json:"disabled"`
+	TitlePrefix    string             `json:"title_prefix"`
+	Tag            string             `json:"tag"`
+	Category       string             `json:"category"`
+	AfterID        pgtype.UUID        `json:"after_id"`
+	AfterCreatedAt pgtype.Timestamptz `json:"after_created_at"`
+	PageSize       int32              `json:"page_size"`
+}
+
+type ListCatalogItemsByCreatedAtDescRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+// Keyset page ordered by (created_at, id) descending, see ListCatalogItemsByCreatedAt.
+func (q *Queries) ListCatalogItemsByCreatedAtDesc(ctx context.Context, arg ListCatalogItemsByCreatedAtDescParams) ([]ListCatalogItemsByCreatedAtDescRow, error) {
+	rows, err := q.db.Query(ctx, listCatalogItemsByCreatedAtDesc,
+		arg.Disabled,
+		arg.TitlePrefix,
+		arg.Tag,
+		arg.Category,
+		arg.AfterID,
+		arg.AfterCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCatalogItemsByCreatedAtDescRow
+	for rows.Next() {
+		var i ListCatalogItemsByCreatedAtDescRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Disabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CategoryID,
+			&i.Sku,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCatalogItemsByTitle = `-- name: ListCatalogItemsByTitle :many
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku
+FROM catalog c
+WHERE c.deleted_at IS NULL
+  AND ($1::boolean IS NULL OR c.disabled = $1)
+  AND starts_with(lower(c.title), lower($2::text))
+  AND ($3::text = '' OR EXISTS (
+      SELECT 1 FROM catalog_item_tags t WHERE t.item_id = c.id AND t.tag = $3))
+  AND ($4::text = '' OR c.category_id IN (
+      SELECT cc.id FROM catalog_categories cc
+      WHERE cc.path = $4 OR starts_with(cc.path, $4 || '/')))
+  AND ($5::uuid IS NULL OR (c.title, c.id) > ($6::text, $5))
+ORDER BY c.title, c.id
+LIMIT $7
+`
+
+type ListCatalogItemsByTitleParams struct {
+	Disabled    pgtype.Bool `json:"disabled"`
+	TitlePrefix string      `json:"title_prefix"`
+	Tag         string      `json:"tag"`
+	Category    string      `json:"category"`
+	AfterID     pgtype.UUID `json:"after_id"`
+	AfterTitle  pgtype.Text `json:"after_title"`
+	PageSize    int32       `json:"page_size"`
+}
+
+type ListCatalogItemsByTitleRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+// Keyset page ordered by (title, id). after_title and after_id are the last row of the previous page.
+func (q *Queries) ListCatalogItemsByTitle(ctx context.Context, arg ListCatalogItemsByTitleParams) ([]ListCatalogItemsByTitleRow, error) {
+	rows, err := q.db.Query(ctx, listCatalogItemsByTitle,
+		arg.Disabled,
+		arg.TitlePrefix,
+		arg.Tag,
+		arg.Category,
+		arg.AfterID,
+		arg.AfterTitle,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCatalogItemsByTitleRow
+	for rows.Next() {
+		var i ListCatalogItemsByTitleRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Disabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CategoryID,
+			&i.Sku,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCatalogItemsByTitleDesc = `-- name: ListCatalogItemsByTitleDesc :many
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku
+FROM catalog c
+WHERE c.deleted_at IS NULL
+  AND ($1::boolean IS NULL OR c.disabled = $1)
+  AND starts_with(lower(c.title), lower($2::text))
+  AND ($3::text = '' OR EXISTS (
+      SELECT 1 FROM catalog_item_tags t WHERE t.item_id = c.id AND t.tag = $3))
+  AND ($4::text = '' OR c.category_id IN (
+      SELECT cc.id FROM catalog_categories cc
+      WHERE cc.path = $4 OR starts_with(cc.path, $4 || '/')))
+  AND ($5::uuid IS NULL OR (c.title, c.id) < ($6::text, $5))
+ORDER BY c.title DESC, c.id DESC
+LIMIT $7
+`
+
+type ListCatalogItemsByTitleDescParams struct {
+	Disabled    pgtype.Bool `json:"disabled"`
+	TitlePrefix string      `json:"title_prefix"`
+	Tag         string      `json:"tag"`
+	Category    string      `json:"category"`
+	AfterID     pgtype.UUID `json:"after_id"`
+	AfterTitle  pgtype.Text `json:"after_title"`
+	PageSize    int32       `json:"page_size"`
+}
+
+type ListCatalogItemsByTitleDescRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+// Keyset page ordered by (title, id) descending, see ListCatalogItemsByTitle.
+func (q *Queries) ListCatalogItemsByTitleDesc(ctx context.Context, arg ListCatalogItemsByTitleDescParams) ([]ListCatalogItemsByTitleDescRow, error) {
+	rows, err := q.db.Query(ctx, listCatalogItemsByTitleDesc,
+		arg.Disabled,
+		arg.TitlePrefix,
+		arg.Tag,
+		arg.Category,
+		arg.AfterID,
+		arg.AfterTitle,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCatalogItemsByTitleDescRow
+	for rows.Next() {
+		var i ListCatalogItemsByTitleDescRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Disabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CategoryID,
+			&i.Sku,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCatalogTags = `-- name: ListCatalogTags :many
+SELECT t.name, count(c.id) AS item_count
+FROM catalog_tags t
+LEFT JOIN catalog_item_tags it ON it.tag = t.name
+LEFT JOIN catalog c ON c.id = it.item_id AND c.deleted_at IS NULL
+GROUP BY t.name
+ORDER BY t.name
+`
+
+type ListCatalogTagsRow struct {
+	Name      string `json:"name"`
+	ItemCount int64  `json:"item_count"`
+}
+
+// item_count only counts items that are not deleted.
+func (q *Queries) ListCatalogTags(ctx context.Context) ([]ListCatalogTagsRow, error) {
+	rows, err := q.db.Query(ctx, listCatalogTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCatalogTagsRow
+	for rows.Next() {
+		var i ListCatalogTagsRow
+		if err := rows.Scan(
+			&i.Name,
+			&i.ItemCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeletedCatalogItems = `-- name: ListDeletedCatalogItems :many
+SELECT id, title, description, disabled, created_at, updated_at, category_id, sku, deleted_at
 FROM catalog
-ORDER BY title
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id
+LIMIT $1::int
 `
 
-func (q *Queries) GetCatalogItems(ctx context.Context) ([]Catalog, error) {
-	rows, err := q.db.Query(ctx, getCatalogItems)
+type ListDeletedCatalogItemsRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+	DeletedAt   pgtype.Timestamptz `json:"deleted_at"`
+}
+
+func (q *Queries) ListDeletedCatalogItems(ctx context.Context, pageSize int32) ([]ListDeletedCatalogItemsRow, error) {
+	rows, err := q.db.Query(ctx, listDeletedCatalogItems, pageSize)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Catalog
+	var items []ListDeletedCatalogItemsRow
 	for rows.Next() {
-		var i Catalog
+		var i ListDeletedCatalogItemsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
 			&i.Description,
 			&i.Disabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CategoryID,
+			&i.Sku,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -39,3 +752,416 @@ func (q *Queries) GetCatalogItems(ctx context.Context) ([]Catalog, error) {
 	}
 	return items, nil
 }
+
+const purgeDeletedCatalogItems = `-- name: PurgeDeletedCatalogItems :execrows
+DELETE FROM catalog
+WHERE id IN (
+    SELECT id
+    FROM catalog
+    WHERE deleted_at < $1::timestamptz
+    ORDER BY deleted_at
+    LIMIT $2::int
+    FOR UPDATE SKIP LOCKED
+)
+`
+
+type PurgeDeletedCatalogItemsParams struct {
+	Cutoff    pgtype.Timestamptz `json:"cutoff"`
+	BatchSize int32              `json:"batch_size"`
+}
+
+// Permanently removes a batch of items deleted before the cutoff, together with their tags,
+// translations, images, favorites and views.
+func (q *Queries) PurgeDeletedCatalogItems(ctx context.Context, arg PurgeDeletedCatalogItemsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedCatalogItems, arg.Cutoff, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const renameCatalogTag = `-- name: RenameCatalogTag :execrows
+UPDATE catalog_tags
+SET name = $1
+WHERE name = $2
+`
+
+type RenameCatalogTagParams struct {
+	NewName string `json:"new_name"`
+	Name    string `json:"name"`
+}
+
+// Item assignments follow through ON UPDATE CASCADE.
+func (q *Queries) RenameCatalogTag(ctx context.Context, arg RenameCatalogTagParams) (int64, error) {
+	result, err := q.db.Exec(ctx, renameCatalogTag, arg.NewName, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreCatalogItem = `-- name: RestoreCatalogItem :one
+UPDATE catalog
+SET deleted_at = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, title, description, disabled, created_at, updated_at, category_id, sku
+`
+
+type RestoreCatalogItemRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+func (q *Queries) RestoreCatalogItem(ctx context.Context, id uuid.UUID) (RestoreCatalogItemRow, error) {
+	row := q.db.QueryRow(ctx, restoreCatalogItem, id)
+	var i RestoreCatalogItemRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Disabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CategoryID,
+		&i.Sku,
+	)
+	return i, err
+}
+
+const searchCatalogItems = `-- name: SearchCatalogItems :many
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku,
+       ts_rank(c.search_vector, q.query) AS rank,
+       ts_headline(c.search_language, c.title, q.query,
+           'StartSel=' || chr(1) || ', StopSel=' || chr(2) || ', HighlightAll=true')::text AS title_highlight,
+       ts_headline(c.search_language, coalesce(c.description, ''), q.query,
+           'StartSel=' || chr(1) || ', StopSel=' || chr(2) || ', MaxFragments=2, MaxWords=30, MinWords=10')::text AS description_highlight
+FROM catalog c,
+     websearch_to_tsquery($1::text::regconfig, $2::text) AS q (query)
+WHERE c.search_vector @@ q.query
+  AND c.deleted_at IS NULL
+  AND ($3::boolean IS NULL OR c.disabled = $3)
+ORDER BY rank DESC, c.id
+LIMIT $4
+`
+
+type SearchCatalogItemsParams struct {
+	Language string      `json:"language"`
+	Query    string      `json:"query"`
+	Disabled pgtype.Bool `json:"disabled"`
+	PageSize int32       `json:"page_size"`
+}
+
+type SearchCatalogItemsRow struct {
+	ID                   uuid.UUID          `json:"id"`
+	Title                string             `json:"title"`
+	Description          pgtype.Text        `json:"description"`
+	Disabled             bool               `json:"disabled"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	CategoryID           pgtype.UUID        `json:"category_id"`
+	Sku                  pgtype.Text        `json:"sku"`
+	Rank                 float32            `json:"rank"`
+	TitleHighlight       string             `json:"title_highlight"`
+	DescriptionHighlight string             `json:"description_highlight"`
+}
+
+// Full-text matches ranked by ts_rank. Highlights mark matches with \x01 (start) and \x02 (stop).
+func (q *Queries) SearchCatalogItems(ctx context.Context, arg SearchCatalogItemsParams) ([]SearchCatalogItemsRow, error) {
+	rows, err := q.db.Query(ctx, searchCatalogItems,
+		arg.Language,
+		arg.Query,
+		arg.Disabled,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchCatalogItemsRow
+	for rows.Next() {
+		var i SearchCatalogItemsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Disabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CategoryID,
+			&i.Sku,
+			&i.Rank,
+			&i.TitleHighlight,
+			&i.DescriptionHighlight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchCatalogItemsFuzzy = `-- name: SearchCatalogItemsFuzzy :many
+SELECT c.id, c.title, c.description, c.disabled, c.created_at, c.updated_at, c.category_id, c.sku,
+       word_similarity($1::text, c.title) AS similarity
+FROM catalog c
+WHERE $1 <% c.title
+  AND c.deleted_at IS NULL
+  AND ($2::boolean IS NULL OR c.disabled = $2)
+ORDER BY similarity DESC, c.id
+LIMIT $3
+`
+
+type SearchCatalogItemsFuzzyParams struct {
+	Query    string      `json:"query"`
+	Disabled pgtype.Bool `json:"disabled"`
+	PageSize int32       `json:"page_size"`
+}
+
+type SearchCatalogItemsFuzzyRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+	Similarity  float32            `json:"similarity"`
+}
+
+// Typo-tolerant title matches for queries without full-text results. The match threshold is
+// pg_trgm.word_similarity_threshold, see SetWordSimilarityThreshold.
+func (q *Queries) SearchCatalogItemsFuzzy(ctx context.Context, arg SearchCatalogItemsFuzzyParams) ([]SearchCatalogItemsFuzzyRow, error) {
+	rows, err := q.db.Query(ctx, searchCatalogItemsFuzzy, arg.Query, arg.Disabled, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchCatalogItemsFuzzyRow
+	for rows.Next() {
+		var i SearchCatalogItemsFuzzyRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Disabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CategoryID,
+			&i.Sku,
+			&i.Similarity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setCatalogItemDisabled = `-- name: SetCatalogItemDisabled :one
+UPDATE catalog
+SET disabled = $2,
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, title, description, disabled, created_at, updated_at, category_id, sku
+`
+
+type SetCatalogItemDisabledParams struct {
+	ID       uuid.UUID `json:"id"`
+	Disabled bool      `json:"disabled"`
+}
+
+type SetCatalogItemDisabledRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+func (q *Queries) SetCatalogItemDisabled(ctx context.Context, arg SetCatalogItemDisabledParams) (SetCatalogItemDisabledRow, error) {
+	row := q.db.QueryRow(ctx, setCatalogItemDisabled, arg.ID, arg.Disabled)
+	var i SetCatalogItemDisabledRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Disabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CategoryID,
+		&i.Sku,
+	)
+	return i, err
+}
+
+const setCatalogSearchLanguage = `-- name: SetCatalogSearchLanguage :execrows
+UPDATE catalog
+SET search_language = $1::text::regconfig
+WHERE search_language <> $1::text::regconfig
+`
+
+func (q *Queries) SetCatalogSearchLanguage(ctx context.Context, language string) (int64, error) {
+	result, err := q.db.Exec(ctx, setCatalogSearchLanguage, language)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setWordSimilarityThreshold = `-- name: SetWordSimilarityThreshold :exec
+SELECT set_config('pg_trgm.word_similarity_threshold', $1::real::text, true)
+`
+
+// Applies to the current transaction only.
+func (q *Queries) SetWordSimilarityThreshold(ctx context.Context, threshold float32) error {
+	_, err := q.db.Exec(ctx, setWordSimilarityThreshold, threshold)
+	return err
+}
+
+const updateCatalogItem = `-- name: UpdateCatalogItem :one
+UPDATE catalog
+SET title = $1,
+    description = $2,
+    disabled = $3,
+    category_id = $4,
+    search_language = $5::text::regconfig,
+    updated_at = NOW()
+WHERE id = $6 AND deleted_at IS NULL
+RETURNING id, title, description, disabled, created_at, updated_at, category_id, sku
+`
+
+type UpdateCatalogItemParams struct {
+	Title          string      `json:"title"`
+	Description    pgtype.Text `json:"description"`
+	Disabled       bool        `json:"disabled"`
+	CategoryID     pgtype.UUID `json:"category_id"`
+	SearchLanguage string      `json:"search_language"`
+	ID             uuid.UUID   `json:"id"`
+}
+
+type UpdateCatalogItemRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+func (q *Queries) UpdateCatalogItem(ctx context.Context, arg UpdateCatalogItemParams) (UpdateCatalogItemRow, error) {
+	row := q.db.QueryRow(ctx, updateCatalogItem,
+		arg.Title,
+		arg.Description,
+		arg.Disabled,
+		arg.CategoryID,
+		arg.SearchLanguage,
+		arg.ID,
+	)
+	var i UpdateCatalogItemRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Disabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CategoryID,
+		&i.Sku,
+	)
+	return i, err
+}
+
+const upsertCatalogItem = `-- name: UpsertCatalogItem :one
+INSERT INTO catalog (id, title, description, disabled, category_id, search_language, created_at, sku)
+VALUES ($1, $2, $3, $4, $5,
+        $6::text::regconfig, coalesce($7::timestamptz, NOW()), $8)
+ON CONFLICT (id) DO UPDATE
+SET title = EXCLUDED.title,
+    sku = coalesce(EXCLUDED.sku, catalog.sku),
+    description = EXCLUDED.description,
+    disabled = EXCLUDED.disabled,
+    category_id = EXCLUDED.category_id,
+    search_language = EXCLUDED.search_language,
+    updated_at = NOW(),
+    deleted_at = NULL
+RETURNING id, title, description, disabled, created_at, updated_at, category_id, sku
+`
+
+type UpsertCatalogItemParams struct {
+	ID             uuid.UUID          `json:"id"`
+	Title          string             `json:"title"`
+	Description    pgtype.Text        `json:"description"`
+	Disabled       bool               `json:"disabled"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	SearchLanguage string             `json:"search_language"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	Sku            pgtype.Text        `json:"sku"`
+}
+
+type UpsertCatalogItemRow struct {
+	ID          uuid.UUID          `json:"id"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	Disabled    bool               `json:"disabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CategoryID  pgtype.UUID        `json:"category_id"`
+	Sku         pgtype.Text        `json:"sku"`
+}
+
+// Writes an item under a known id. created_at is kept for existing items and defaults to now for new ones.
+// A NULL sku keeps the sku of an existing item. A deleted item is restored.
+func (q *Queries) UpsertCatalogItem(ctx context.Context, arg UpsertCatalogItemParams) (UpsertCatalogItemRow, error) {
+	row := q.db.QueryRow(ctx, upsertCatalogItem,
+		arg.ID,
+		arg.Title,
+		arg.Description,
+		arg.Disabled,
+		arg.CategoryID,
+		arg.SearchLanguage,
+		arg.CreatedAt,
+		arg.Sku,
+	)
+	var i UpsertCatalogItemRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Disabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CategoryID,
+		&i.Sku,
+	)
+	return i, err
+}
+
+const upsertCatalogTags = `-- name: UpsertCatalogTags :exec
+INSERT INTO catalog_tags (name)
+SELECT unnest($1::text[])
+ON CONFLICT (name) DO NOTHING
+`
+
+func (q *Queries) UpsertCatalogTags(ctx context.Context, names []string) error {
+	_, err := q.db.Exec(ctx, upsertCatalogTags, names)
+	return err
+}
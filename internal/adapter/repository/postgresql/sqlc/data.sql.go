@@ -7,19 +7,533 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const dataKeyIsLive = `-- name: DataKeyIsLive :one
+SELECT EXISTS (
+    SELECT 1 FROM (SELECT expires_at, deleted_at FROM data WHERE key = $1 ORDER BY id DESC LIMIT 1) cur
+    WHERE cur.deleted_at IS NULL AND (cur.expires_at IS NULL OR cur.expires_at > NOW()))
+`
+
+func (q *Queries) DataKeyIsLive(ctx context.Context, key string) (bool, error) {
+	row := q.db.QueryRow(ctx, dataKeyIsLive, key)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const deleteDataByKeys = `-- name: DeleteDataByKeys :execrows
+UPDATE data
+SET deleted_at = NOW()
+WHERE key = ANY($1::text[])
+  AND deleted_at IS NULL
+`
+
+// Soft deletes every version of the keys.
+func (q *Queries) DeleteDataByKeys(ctx context.Context, keys []string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteDataByKeys, keys)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExpiredData = `-- name: DeleteExpiredData :execrows
+WITH expired AS (
+    SELECT id, key
+    FROM data
+    WHERE expires_at <= NOW()
+    ORDER BY expires_at
+    LIMIT $1::int
+    FOR UPDATE SKIP LOCKED
 )
+DELETE FROM data d
+USING expired e
+WHERE d.key = e.key
+  AND (d.id = e.id OR (d.id < e.id AND d.deleted_at IS NULL))
+`
+
+// Removes a batch of expired versions together with the older versions of their keys,
+// which they hide for good. Soft deleted versions are kept for restores.
+func (q *Queries) DeleteExpiredData(ctx context.Context, batchSize int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredData, batchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getData = `-- name: GetData :one
+SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+FROM (
+    SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+    FROM data
+    WHERE key = $1
+    ORDER BY id DESC
+    LIMIT 1
+) cur
+WHERE (cur.expires_at IS NULL OR cur.expires_at > NOW())
+  AND cur.deleted_at IS NULL
+`
+
+// The newest version of a key, unless it expired or was deleted: an expired newest
+// version hides the older ones.
+func (q *Queries) GetData(ctx context.Context, key string) (Datum, error) {
+	row := q.db.QueryRow(ctx, getData, key)
+	var i Datum
+	err := row.Scan(
+		&i.ID,
+		&i.Key,
+		&i.Value,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.ValueCiphertext,
+		&i.ValueDataKey,
+		&i.ValueKeyID,
+		&i.OwnerID,
+		&i.ValueSize,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getDataByID = `-- name: GetDataByID :one
+SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+FROM data
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) GetDataByID(ctx context.Context, id int32) (Datum, error) {
+	row := q.db.QueryRow(ctx, getDataByID, id)
+	var i Datum
+	err := row.Scan(
+		&i.ID,
+		&i.Key,
+		&i.Value,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.ValueCiphertext,
+		&i.ValueDataKey,
+		&i.ValueKeyID,
+		&i.OwnerID,
+		&i.ValueSize,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getDataUsage = `-- name: GetDataUsage :one
+SELECT COUNT(*)::bigint AS key_count,
+       COALESCE(SUM(cur.value_size), 0)::bigint AS total_bytes
+FROM (
+    SELECT DISTINCT ON (key) key, owner_id, value_size, expires_at, deleted_at
+    FROM data
+    WHERE key IN (SELECT key FROM data WHERE owner_id = $1)
+    ORDER BY key, id DESC
+) cur
+WHERE cur.owner_id = $1
+  AND cur.key <> ALL($2::text[])
+  AND (cur.expires_at IS NULL OR cur.expires_at > NOW())
+  AND cur.deleted_at IS NULL
+`
+
+type GetDataUsageParams struct {
+	OwnerID     pgtype.UUID `json:"owner_id"`
+	ExcludeKeys []string    `json:"exclude_keys"`
+}
+
+type GetDataUsageRow struct {
+	KeyCount   int64 `json:"key_count"`
+	TotalBytes int64 `json:"total_bytes"`
+}
+
+// Usage of the owner's live keys other than exclude_keys. A key counts for the owner
+// of its current value, i.e. its newest version, unless that expired or was deleted.
+func (q *Queries) GetDataUsage(ctx context.Context, arg GetDataUsageParams) (GetDataUsageRow, error) {
+	row := q.db.QueryRow(ctx, getDataUsage, arg.OwnerID, arg.ExcludeKeys)
+	var i GetDataUsageRow
+	err := row.Scan(&i.KeyCount, &i.TotalBytes)
+	return i, err
+}
+
+const getLiveDataKeys = `-- name: GetLiveDataKeys :many
+SELECT key
+FROM (
+    SELECT DISTINCT ON (key) key, expires_at, deleted_at
+    FROM data
+    WHERE key = ANY($1::text[])
+    ORDER BY key, id DESC
+) cur
+WHERE (cur.expires_at IS NULL OR cur.expires_at > NOW())
+  AND cur.deleted_at IS NULL
+`
+
+func (q *Queries) GetLiveDataKeys(ctx context.Context, keys []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, getLiveDataKeys, keys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		items = append(items, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDataChangesAfterID = `-- name: ListDataChangesAfterID :many
+SELECT d.id, d.key, d.value, d.created_at, d.expires_at, d.value_ciphertext, d.value_data_key, d.value_key_id, d.owner_id, d.value_size,
+       EXISTS (SELECT 1 FROM data p WHERE p.key = d.key AND p.id < d.id) AS is_update
+FROM data d
+WHERE d.id > $1::int
+  AND starts_with(d.key, $2::text)
+  AND d.deleted_at IS NULL
+ORDER BY d.id
+LIMIT $3::int
+`
+
+type ListDataChangesAfterIDParams struct {
+	AfterID  int32  `json:"after_id"`
+	Prefix   string `json:"prefix"`
+	PageSize int32  `json:"page_size"`
+}
+
+type ListDataChangesAfterIDRow struct {
+	ID              int32              `json:"id"`
+	Key             string             `json:"key"`
+	Value           []byte             `json:"value"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+	ValueCiphertext []byte             `json:"value_ciphertext"`
+	ValueDataKey    []byte             `json:"value_data_key"`
+	ValueKeyID      pgtype.Text        `json:"value_key_id"`
+	OwnerID         pgtype.UUID        `json:"owner_id"`
+	ValueSize       int32              `json:"value_size"`
+	IsUpdate        bool               `json:"is_update"`
+}
+
+func (q *Queries) ListDataChangesAfterID(ctx context.Context, arg ListDataChangesAfterIDParams) ([]ListDataChangesAfterIDRow, error) {
+	rows, err := q.db.Query(ctx, listDataChangesAfterID, arg.AfterID, arg.Prefix, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDataChangesAfterIDRow
+	for rows.Next() {
+		var i ListDataChangesAfterIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Key,
+			&i.Value,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.ValueCiphertext,
+			&i.ValueDataKey,
+			&i.ValueKeyID,
+			&i.OwnerID,
+			&i.ValueSize,
+			&i.IsUpdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDataForRotation = `-- name: ListDataForRotation :many
+SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+FROM data
+WHERE id > $1::int
+  AND value_key_id IS DISTINCT FROM $2::text
+ORDER BY id
+LIMIT $3::int
+FOR UPDATE
+`
+
+type ListDataForRotationParams struct {
+	AfterID     int32  `json:"after_id"`
+	ActiveKeyID string `json:"active_key_id"`
+	BatchSize   int32  `json:"batch_size"`
+}
+
+// Soft deleted versions are re-encrypted as well, since they may be restored.
+func (q *Queries) ListDataForRotation(ctx context.Context, arg ListDataForRotationParams) ([]Datum, error) {
+	rows, err := q.db.Query(ctx, listDataForRotation, arg.AfterID, arg.ActiveKeyID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Datum
+	for rows.Next() {
+		var i Datum
+		if err := rows.Scan(
+			&i.ID,
+			&i.Key,
+			&i.Value,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.ValueCiphertext,
+			&i.ValueDataKey,
+			&i.ValueKeyID,
+			&i.OwnerID,
+			&i.ValueSize,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeletedData = `-- name: ListDeletedData :many
+SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+FROM (
+    SELECT DISTINCT ON (d.key) d.id, d.key, d.value, d.created_at, d.expires_at, d.value_ciphertext, d.value_data_key,
+           d.value_key_id, d.owner_id, d.value_size, d.deleted_at
+    FROM data d
+    WHERE d.deleted_at IS NOT NULL
+      AND NOT EXISTS (
+          SELECT 1 FROM (SELECT expires_at, deleted_at FROM data WHERE key = d.key ORDER BY id DESC LIMIT 1) cur
+          WHERE cur.deleted_at IS NULL AND (cur.expires_at IS NULL OR cur.expires_at > NOW()))
+    ORDER BY d.key, d.id DESC
+) deleted
+ORDER BY deleted_at DESC, key
+LIMIT $1::int
+`
+
+// The latest version of each deleted key that has no live version, most recently deleted first.
+func (q *Queries) ListDeletedData(ctx context.Context, pageSize int32) ([]Datum, error) {
+	rows, err := q.db.Query(ctx, listDeletedData, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Datum
+	for rows.Next() {
+		var i Datum
+		if err := rows.Scan(
+			&i.ID,
+			&i.Key,
+			&i.Value,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.ValueCiphertext,
+			&i.ValueDataKey,
+			&i.ValueKeyID,
+			&i.OwnerID,
+			&i.ValueSize,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLiveDataAfterKey = `-- name: ListLiveDataAfterKey :many
+SELECT id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+FROM (
+    SELECT DISTINCT ON (key) id, key, value, created_at, expires_at, value_ciphertext, value_data_key, value_key_id, owner_id, value_size, deleted_at
+    FROM data
+    WHERE key > $1::text
+    ORDER BY key, id DESC
+) cur
+WHERE (cur.expires_at IS NULL OR cur.expires_at > NOW())
+  AND cur.deleted_at IS NULL
+ORDER BY key
+LIMIT $2::int
+`
+
+type ListLiveDataAfterKeyParams struct {
+	AfterKey string `json:"after_key"`
+	PageSize int32  `json:"page_size"`
+}
+
+func (q *Queries) ListLiveDataAfterKey(ctx context.Context, arg ListLiveDataAfterKeyParams) ([]Datum, error) {
+	rows, err := q.db.Query(ctx, listLiveDataAfterKey, arg.AfterKey, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Datum
+	for rows.Next() {
+		var i Datum
+		if err := rows.Scan(
+			&i.ID,
+			&i.Key,
+			&i.Value,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.ValueCiphertext,
+			&i.ValueDataKey,
+			&i.ValueKeyID,
+			&i.OwnerID,
+			&i.ValueSize,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDeletedData = `-- name: PurgeDeletedData :execrows
+DELETE FROM data
+WHERE id IN (
+    SELECT id
+    FROM data
+    WHERE deleted_at < $1::timestamptz
+    ORDER BY deleted_at
+    LIMIT $2::int
+    FOR UPDATE SKIP LOCKED
+)
+`
+
+type PurgeDeletedDataParams struct {
+	Cutoff    pgtype.Timestamptz `json:"cutoff"`
+	BatchSize int32              `json:"batch_size"`
+}
+
+// Permanently removes a batch of versions deleted before the cutoff.
+func (q *Queries) PurgeDeletedData(ctx context.Context, arg PurgeDeletedDataParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedData, arg.Cutoff, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreData = `-- name: RestoreData :execrows
+WITH dead AS (
+    DELETE FROM data
+    WHERE key = $1
+      AND deleted_at IS NULL
+)
+UPDATE data
+SET deleted_at = NULL
+WHERE key = $1
+  AND deleted_at IS NOT NULL
+`
+
+// Restores every deleted version of a key. Callers check that the key has no live version,
+// so its versions written after the deletion are expired or hidden by an expired one; they
+// are removed first, as they would hide the restored versions.
+func (q *Queries) RestoreData(ctx context.Context, key string) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreData, key)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
 
 const saveData = `-- name: SaveData :exec
-INSERT INTO data (key, value)
-VALUES ($1, $2)
+INSERT INTO data (key, value, value_ciphertext, value_data_key, value_key_id, expires_at, owner_id, value_size)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
+type CopyDataParams struct {
+	Key             string             `json:"key"`
+	Value           []byte             `json:"value"`
+	ValueCiphertext []byte             `json:"value_ciphertext"`
+	ValueDataKey    []byte             `json:"value_data_key"`
+	ValueKeyID      pgtype.Text        `json:"value_key_id"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+	OwnerID         pgtype.UUID        `json:"owner_id"`
+	ValueSize       int32              `json:"value_size"`
+}
+
 type SaveDataParams struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key             string             `json:"key"`
+	Value           []byte             `json:"value"`
+	ValueCiphertext []byte             `json:"value_ciphertext"`
+	ValueDataKey    []byte             `json:"value_data_key"`
+	ValueKeyID      pgtype.Text        `json:"value_key_id"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+	OwnerID         pgtype.UUID        `json:"owner_id"`
+	ValueSize       int32              `json:"value_size"`
 }
 
 func (q *Queries) SaveData(ctx context.Context, arg SaveDataParams) error {
-	_, err := q.db.Exec(ctx, saveData, arg.Key, arg.Value)
+	_, err := q.db.Exec(ctx, saveData,
+		arg.Key,
+		arg.Value,
+		arg.ValueCiphertext,
+		arg.ValueDataKey,
+		arg.ValueKeyID,
+		arg.ExpiresAt,
+		arg.OwnerID,
+		arg.ValueSize,
+	)
+	return err
+}
+
+const softDeleteData = `-- name: SoftDeleteData :execrows
+UPDATE data
+SET deleted_at = NOW()
+WHERE key = $1
+  AND deleted_at IS NULL
+  AND EXISTS (
+      SELECT 1 FROM (SELECT expires_at FROM data WHERE key = $1 ORDER BY id DESC LIMIT 1) cur
+      WHERE cur.expires_at IS NULL OR cur.expires_at > NOW())
+`
+
+// Stamps every version of a live key as deleted. Keys whose newest version expired are left alone.
+func (q *Queries) SoftDeleteData(ctx context.Context, key string) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteData, key)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateDataEncryption = `-- name: UpdateDataEncryption :exec
+UPDATE data
+SET value = NULL,
+    value_ciphertext = $2,
+    value_data_key = $3,
+    value_key_id = $4
+WHERE id = $1
+`
+
+type UpdateDataEncryptionParams struct {
+	ID              int32       `json:"id"`
+	ValueCiphertext []byte      `json:"value_ciphertext"`
+	ValueDataKey    []byte      `json:"value_data_key"`
+	ValueKeyID      pgtype.Text `json:"value_key_id"`
+}
+
+func (q *Queries) UpdateDataEncryption(ctx context.Context, arg UpdateDataEncryptionParams) error {
+	_, err := q.db.Exec(ctx, updateDataEncryption,
+		arg.ID,
+		arg.ValueCiphertext,
+		arg.ValueDataKey,
+		arg.ValueKeyID,
+	)
 	return err
 }
@@ -9,18 +9,168 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Blob struct {
+	Digest     string             `json:"digest"`
+	Size       int64              `json:"size"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	LastUsedAt pgtype.Timestamptz `json:"last_used_at"`
+}
+
 type Catalog struct {
-	ID          uuid.UUID   `json:"id"`
-	Title       string      `json:"title"`
-	Description pgtype.Text `json:"description"`
-	Disabled    bool        `json:"disabled"`
+	ID             uuid.UUID          `json:"id"`
+	Title          string             `json:"title"`
+	Description    pgtype.Text        `json:"description"`
+	Disabled       bool               `json:"disabled"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	SearchLanguage interface{}        `json:"search_language"`
+	SearchVector   interface{}        `json:"search_vector"`
+	CategoryID     pgtype.UUID        `json:"category_id"`
+	Sku            pgtype.Text        `json:"sku"`
+	DeletedAt      pgtype.Timestamptz `json:"deleted_at"`
 }
 
-type Datum struct {
-	ID        int32              `json:"id"`
-	Key       string             `json:"key"`
-	Value     string             `json:"value"`
+type CatalogCategory struct {
+	ID        uuid.UUID          `json:"id"`
+	ParentID  pgtype.UUID        `json:"parent_id"`
+	Slug      string             `json:"slug"`
+	Name      string             `json:"name"`
+	Path      string             `json:"path"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CatalogChangeset struct {
+	ID           uuid.UUID          `json:"id"`
+	Title        string             `json:"title"`
+	Status       string             `json:"status"`
+	CreatedBy    pgtype.UUID        `json:"created_by"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	PublishedBy  pgtype.UUID        `json:"published_by"`
+	PublishedAt  pgtype.Timestamptz `json:"published_at"`
+	RolledBackBy pgtype.UUID        `json:"rolled_back_by"`
+	RolledBackAt pgtype.Timestamptz `json:"rolled_back_at"`
+}
+
+type CatalogDraft struct {
+	ChangesetID   uuid.UUID          `json:"changeset_id"`
+	ItemID        uuid.UUID          `json:"item_id"`
+	Action        string             `json:"action"`
+	Title         pgtype.Text        `json:"title"`
+	Description   pgtype.Text        `json:"description"`
+	Disabled      bool               `json:"disabled"`
+	Tags          []string           `json:"tags"`
+	CategoryID    pgtype.UUID        `json:"category_id"`
+	BaseUpdatedAt pgtype.Timestamptz `json:"base_updated_at"`
+	Before        []byte             `json:"before"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CatalogImage struct {
+	ID          uuid.UUID          `json:"id"`
+	ItemID      uuid.UUID          `json:"item_id"`
+	ContentType string             `json:"content_type"`
+	Width       int32              `json:"width"`
+	Height      int32              `json:"height"`
+	Size        int64              `json:"size"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type CatalogInventory struct {
+	ItemID        uuid.UUID          `json:"item_id"`
+	Price         pgtype.Numeric     `json:"price"`
+	Currency      pgtype.Text        `json:"currency"`
+	StockQuantity pgtype.Int8        `json:"stock_quantity"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CatalogItemTag struct {
+	ItemID uuid.UUID `json:"item_id"`
+	Tag    string    `json:"tag"`
+}
+
+type CatalogReservation struct {
+	ID        uuid.UUID          `json:"id"`
+	ItemID    uuid.UUID          `json:"item_id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	Quantity  int64              `json:"quantity"`
+	UnitPrice pgtype.Numeric     `json:"unit_price"`
+	Currency  pgtype.Text        `json:"currency"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
+type CatalogReview struct {
+	ID        uuid.UUID          `json:"id"`
+	ItemID    uuid.UUID          `json:"item_id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	Rating    int16              `json:"rating"`
+	Body      string             `json:"body"`
+	Status    string             `json:"status"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CatalogReviewStat struct {
+	ItemID      uuid.UUID `json:"item_id"`
+	ReviewCount int64     `json:"review_count"`
+	RatingSum   int64     `json:"rating_sum"`
+}
+
+type CatalogTag struct {
+	Name string `json:"name"`
+}
+
+type CatalogTranslation struct {
+	ItemID      uuid.UUID          `json:"item_id"`
+	Locale      string             `json:"locale"`
+	Title       string             `json:"title"`
+	Description pgtype.Text        `json:"description"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type DataAttachment struct {
+	ID          uuid.UUID          `json:"id"`
+	Key         string             `json:"key"`
+	Name        string             `json:"name"`
+	ContentType string             `json:"content_type"`
+	Size        int64              `json:"size"`
+	Digest      string             `json:"digest"`
+	CreatedBy   pgtype.UUID        `json:"created_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type DataSchema struct {
+	Prefix    string             `json:"prefix"`
+	Schema    []byte             `json:"schema"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Datum struct {
+	ID              int32              `json:"id"`
+	Key             string             `json:"key"`
+	Value           []byte             `json:"value"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+	ValueCiphertext []byte             `json:"value_ciphertext"`
+	ValueDataKey    []byte             `json:"value_data_key"`
+	ValueKeyID      pgtype.Text        `json:"value_key_id"`
+	OwnerID         pgtype.UUID        `json:"owner_id"`
+	ValueSize       int32              `json:"value_size"`
+	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
+}
+
+type Outbox struct {
+	ID            int64              `json:"id"`
+	EventType     string             `json:"event_type"`
+	Payload       []byte             `json:"payload"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	PublishedAt   pgtype.Timestamptz `json:"published_at"`
+	Attempts      int32              `json:"attempts"`
+	LastAttemptAt pgtype.Timestamptz `json:"last_attempt_at"`
+	LastError     pgtype.Text        `json:"last_error"`
 }
 
 type User struct {
@@ -28,4 +178,49 @@ type User struct {
 	Email        string             `json:"email"`
 	PasswordHash string             `json:"password_hash"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	Role         string             `json:"role"`
+	Locale       pgtype.Text        `json:"locale"`
+}
+
+type UserCatalogFavorite struct {
+	UserID    uuid.UUID          `json:"user_id"`
+	ItemID    uuid.UUID          `json:"item_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserCatalogView struct {
+	UserID   uuid.UUID          `json:"user_id"`
+	ItemID   uuid.UUID          `json:"item_id"`
+	ViewedAt pgtype.Timestamptz `json:"viewed_at"`
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID          `json:"id"`
+	EndpointID     uuid.UUID          `json:"endpoint_id"`
+	EventID        int64              `json:"event_id"`
+	EventType      string             `json:"event_type"`
+	Payload        []byte             `json:"payload"`
+	EventCreatedAt pgtype.Timestamptz `json:"event_created_at"`
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	LastAttemptAt  pgtype.Timestamptz `json:"last_attempt_at"`
+	ResponseStatus pgtype.Int4        `json:"response_status"`
+	LastError      pgtype.Text        `json:"last_error"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type WebhookEndpoint struct {
+	ID                  uuid.UUID          `json:"id"`
+	Url                 string             `json:"url"`
+	Secret              pgtype.Text        `json:"secret"`
+	SecretCiphertext    []byte             `json:"secret_ciphertext"`
+	SecretDataKey       []byte             `json:"secret_data_key"`
+	SecretKeyID         pgtype.Text        `json:"secret_key_id"`
+	EventTypes          []string           `json:"event_types"`
+	Enabled             bool               `json:"enabled"`
+	ConsecutiveFailures int32              `json:"consecutive_failures"`
+	DisabledAt          pgtype.Timestamptz `json:"disabled_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
 }
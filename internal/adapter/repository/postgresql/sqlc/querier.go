@@ -6,12 +6,212 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/google/uuid"
 )
 
 type Querier interface {
-	GetCatalogItems(ctx context.Context) ([]Catalog, error)
+	// Affects no row if the item does not exist or is deleted. Adding a favorite again keeps it
+	// unchanged but still counts as one row.
+	AddCatalogFavorite(ctx context.Context, arg AddCatalogFavoriteParams) (int64, error)
+	AddCatalogItemTags(ctx context.Context, arg AddCatalogItemTagsParams) error
+	// Adds the deltas to the running totals of an item.
+	AddCatalogReviewStats(ctx context.Context, arg AddCatalogReviewStatsParams) error
+	// Counts a failure in a row and disables the endpoint when it reaches disable_after.
+	AddWebhookEndpointFailure(ctx context.Context, arg AddWebhookEndpointFailureParams) (AddWebhookEndpointFailureRow, error)
+	AdvisoryUnlock(ctx context.Context, lockID int64) (bool, error)
+	AdvisoryXactLock(ctx context.Context, lockKey string) error
+	BlobExists(ctx context.Context, digest string) (bool, error)
+	CatalogImageExists(ctx context.Context, id uuid.UUID) (bool, error)
+	// Claims due deliveries of enabled endpoints by moving their next attempt to lease_until, so
+	// other replicas skip them while they are sent.
+	ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error)
+	CopyData(ctx context.Context, arg []CopyDataParams) (int64, error)
+	CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (DataAttachment, error)
+	CreateCatalogCategory(ctx context.Context, arg CreateCatalogCategoryParams) (CatalogCategory, error)
+	CreateCatalogChangeset(ctx context.Context, arg CreateCatalogChangesetParams) (CatalogChangeset, error)
+	CreateCatalogImage(ctx context.Context, arg CreateCatalogImageParams) (CatalogImage, error)
+	CreateCatalogItem(ctx context.Context, arg CreateCatalogItemParams) (CreateCatalogItemRow, error)
+	CreateCatalogReservation(ctx context.Context, arg CreateCatalogReservationParams) (CatalogReservation, error)
+	// Returns no row if the item does not exist or is deleted.
+	CreateCatalogReview(ctx context.Context, arg CreateCatalogReviewParams) (CatalogReview, error)
+	CreateCatalogTag(ctx context.Context, name string) (int64, error)
+	CreateOutboxEvents(ctx context.Context, arg []CreateOutboxEventsParams) (int64, error)
+	// Fans an event out to the endpoints subscribed to its type. Deliveries of a disabled endpoint
+	// wait until it is enabled again. An event handed over again is not delivered twice.
+	CreateWebhookDeliveries(ctx context.Context, arg CreateWebhookDeliveriesParams) (int64, error)
+	CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error)
+	DataKeyIsLive(ctx context.Context, key string) (bool, error)
+	// Attachments of a soft deleted key are hidden, so they cannot be deleted either.
+	DeleteAttachment(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteCatalogCategory(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteCatalogChangeset(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteCatalogDraft(ctx context.Context, arg DeleteCatalogDraftParams) (int64, error)
+	DeleteCatalogFavorite(ctx context.Context, arg DeleteCatalogFavoriteParams) (int64, error)
+	DeleteCatalogImage(ctx context.Context, arg DeleteCatalogImageParams) (int64, error)
+	// Soft deletes an item; PurgeDeletedCatalogItems removes it for good.
+	DeleteCatalogItem(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteCatalogItemTags(ctx context.Context, itemID uuid.UUID) error
+	// Soft deletes the items with other skus and returns their ids. Items without a sku are kept.
+	DeleteCatalogItemsNotInSKUs(ctx context.Context, skus []string) ([]uuid.UUID, error)
+	DeleteCatalogReservation(ctx context.Context, arg DeleteCatalogReservationParams) (int64, error)
+	DeleteCatalogReview(ctx context.Context, id uuid.UUID) error
+	DeleteCatalogTag(ctx context.Context, name string) (int64, error)
+	DeleteCatalogTranslation(ctx context.Context, arg DeleteCatalogTranslationParams) (int64, error)
+	DeleteDanglingAttachments(ctx context.Context) (int64, error)
+	// Soft deletes every version of the keys.
+	DeleteDataByKeys(ctx context.Context, keys []string) (int64, error)
+	DeleteDataSchema(ctx context.Context, prefix string) (int64, error)
+	DeleteExpiredCatalogReservations(ctx context.Context, itemID uuid.UUID) error
+	DeleteExpiredData(ctx context.Context, batchSize int32) (int64, error)
+	DeleteFinishedWebhookDeliveries(ctx context.Context, arg DeleteFinishedWebhookDeliveriesParams) (int64, error)
+	DeletePublishedOutboxEvents(ctx context.Context, arg DeletePublishedOutboxEventsParams) (int64, error)
+	// Blobs locked by an upload in progress are skipped; the upload refreshes last_used_at.
+	DeleteUnusedBlobs(ctx context.Context, arg DeleteUnusedBlobsParams) ([]string, error)
+	DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) (int64, error)
+	// Attachments of a soft deleted key are hidden until the key is restored.
+	GetAttachment(ctx context.Context, id uuid.UUID) (DataAttachment, error)
+	GetCatalogCategory(ctx context.Context, id uuid.UUID) (CatalogCategory, error)
+	GetCatalogCategoryForUpdate(ctx context.Context, id uuid.UUID) (CatalogCategory, error)
+	GetCatalogChangeset(ctx context.Context, id uuid.UUID) (CatalogChangeset, error)
+	GetCatalogChangesetForUpdate(ctx context.Context, id uuid.UUID) (CatalogChangeset, error)
+	// Images of deleted items are not found.
+	GetCatalogImage(ctx context.Context, id uuid.UUID) (CatalogImage, error)
+	// Locks the inventory row of an item, so reservations of the item run one at a time.
+	GetCatalogInventoryForUpdate(ctx context.Context, itemID uuid.UUID) (GetCatalogInventoryForUpdateRow, error)
+	GetCatalogItem(ctx context.Context, id uuid.UUID) (GetCatalogItemRow, error)
+	GetCatalogItemForUpdate(ctx context.Context, id uuid.UUID) (GetCatalogItemForUpdateRow, error)
+	// category is a category path; it matches items in that category and all categories below it.
+	GetCatalogItems(ctx context.Context, category string) ([]GetCatalogItemsRow, error)
+	GetCatalogReview(ctx context.Context, arg GetCatalogReviewParams) (CatalogReview, error)
+	GetCatalogReviewByIDForUpdate(ctx context.Context, id uuid.UUID) (CatalogReview, error)
+	GetCatalogReviewForUpdate(ctx context.Context, arg GetCatalogReviewForUpdateParams) (CatalogReview, error)
+	GetCatalogTag(ctx context.Context, name string) (GetCatalogTagRow, error)
+	GetData(ctx context.Context, key string) (Datum, error)
+	GetDataByID(ctx context.Context, id int32) (Datum, error)
+	GetDataSchemaForKey(ctx context.Context, key string) (DataSchema, error)
+	// Usage of the owner's live keys other than exclude_key. A key counts for the owner
+	// of its current value, i.e. the newest unexpired version.
+	GetDataUsage(ctx context.Context, arg GetDataUsageParams) (GetDataUsageRow, error)
+	GetLiveDataKeys(ctx context.Context, keys []string) ([]string, error)
+	GetReservedQuantity(ctx context.Context, itemID uuid.UUID) (int64, error)
 	GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error)
+	GetWebhookDelivery(ctx context.Context, id uuid.UUID) (WebhookDelivery, error)
+	GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (WebhookEndpoint, error)
+	// Attachments of a soft deleted key are hidden until the key is restored.
+	ListAttachments(ctx context.Context, key string) ([]DataAttachment, error)
+	ListCatalogCategories(ctx context.Context) ([]CatalogCategory, error)
+	ListCatalogCategoryPaths(ctx context.Context, ids []uuid.UUID) ([]ListCatalogCategoryPathsRow, error)
+	ListCatalogChangesets(ctx context.Context) ([]CatalogChangeset, error)
+	ListCatalogDrafts(ctx context.Context, changesetID uuid.UUID) ([]CatalogDraft, error)
+	// The subset of item_ids that the user has favorited.
+	ListCatalogFavoriteIDs(ctx context.Context, arg ListCatalogFavoriteIDsParams) ([]uuid.UUID, error)
+	// One page of favorites, most recently added first. The after_* arguments are the last row of
+	// the previous page, or NULL for the first one.
+	ListCatalogFavoriteItems(ctx context.Context, arg ListCatalogFavoriteItemsParams) ([]ListCatalogFavoriteItemsRow, error)
+	ListCatalogImagesForItems(ctx context.Context, itemIds []uuid.UUID) ([]CatalogImage, error)
+	// reserved_quantity only counts unexpired reservations.
+	ListCatalogInventory(ctx context.Context, itemIds []uuid.UUID) ([]ListCatalogInventoryRow, error)
+	// Locks the items with the given skus for the rest of the transaction.
+	ListCatalogItemIDsBySKU(ctx context.Context, skus []string) ([]ListCatalogItemIDsBySKURow, error)
+	ListCatalogItemTags(ctx context.Context, itemIds []uuid.UUID) ([]CatalogItemTag, error)
+	// Keyset page ordered by (created_at, id). after_created_at and after_id are the last row of the previous page.
+	ListCatalogItemsByCreatedAt(ctx context.Context, arg ListCatalogItemsByCreatedAtParams) ([]ListCatalogItemsByCreatedAtRow, error)
+	// Keyset page ordered by (created_at, id) descending, see ListCatalogItemsByCreatedAt.
+	ListCatalogItemsByCreatedAtDesc(ctx context.Context, arg ListCatalogItemsByCreatedAtDescParams) ([]ListCatalogItemsByCreatedAtDescRow, error)
+	// Keyset page ordered by (title, id). after_title and after_id are the last row of the previous page.
+	ListCatalogItemsByTitle(ctx context.Context, arg ListCatalogItemsByTitleParams) ([]ListCatalogItemsByTitleRow, error)
+	// Keyset page ordered by (title, id) descending, see ListCatalogItemsByTitle.
+	ListCatalogItemsByTitleDesc(ctx context.Context, arg ListCatalogItemsByTitleDescParams) ([]ListCatalogItemsByTitleDescRow, error)
+	ListCatalogReviewStats(ctx context.Context, itemIds []uuid.UUID) ([]CatalogReviewStat, error)
+	// Reviews of an item with the given status, newest first.
+	ListCatalogReviews(ctx context.Context, arg ListCatalogReviewsParams) ([]CatalogReview, error)
+	// Reviews of all items with the given status, oldest first, for moderation.
+	ListCatalogReviewsByStatus(ctx context.Context, arg ListCatalogReviewsByStatusParams) ([]CatalogReview, error)
+	// item_count only counts items that are not deleted.
+	ListCatalogTags(ctx context.Context) ([]ListCatalogTagsRow, error)
+	ListCatalogTranslations(ctx context.Context, itemID uuid.UUID) ([]CatalogTranslation, error)
+	ListCatalogTranslationsForItems(ctx context.Context, arg ListCatalogTranslationsForItemsParams) ([]CatalogTranslation, error)
+	ListDataChangesAfterID(ctx context.Context, arg ListDataChangesAfterIDParams) ([]ListDataChangesAfterIDRow, error)
+	// Soft deleted versions are re-encrypted as well, since they may be restored.
+	ListDataForRotation(ctx context.Context, arg ListDataForRotationParams) ([]Datum, error)
+	ListDataSchemas(ctx context.Context) ([]DataSchema, error)
+	ListDeletedCatalogItems(ctx context.Context, pageSize int32) ([]ListDeletedCatalogItemsRow, error)
+	// The latest version of each deleted key that has no live version, most recently deleted first.
+	ListDeletedData(ctx context.Context, pageSize int32) ([]Datum, error)
+	ListLiveDataAfterKey(ctx context.Context, arg ListLiveDataAfterKeyParams) ([]Datum, error)
+	ListPendingOutboxEvents(ctx context.Context, batchSize int32) ([]Outbox, error)
+	ListRecentlyViewedCatalogItems(ctx context.Context, userID uuid.UUID) ([]ListRecentlyViewedCatalogItemsRow, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookEndpoints(ctx context.Context) ([]WebhookEndpoint, error)
+	// There are few endpoints, so their secrets are re-encrypted in a single transaction.
+	ListWebhookEndpointsForRotation(ctx context.Context, activeKeyID string) ([]WebhookEndpoint, error)
+	// Creating a subcategory locks its parent, so this keeps new categories out of the subtree
+	// until the transaction ends.
+	LockCatalogCategoryDescendants(ctx context.Context, path string) error
+	MarkCatalogChangesetPublished(ctx context.Context, arg MarkCatalogChangesetPublishedParams) (CatalogChangeset, error)
+	MarkCatalogChangesetRolledBack(ctx context.Context, arg MarkCatalogChangesetRolledBackParams) (CatalogChangeset, error)
+	MarkOutboxEventsPublished(ctx context.Context, ids []int64) error
+	// Replaces the old_path prefix of every category below old_path after a rename or move.
+	MoveCatalogCategoryDescendants(ctx context.Context, arg MoveCatalogCategoryDescendantsParams) (int64, error)
+	// Permanently removes a batch of items deleted before the cutoff, together with their tags,
+	// translations, images, favorites and views.
+	PurgeDeletedCatalogItems(ctx context.Context, arg PurgeDeletedCatalogItemsParams) (int64, error)
+	// Permanently removes a batch of versions deleted before the cutoff.
+	PurgeDeletedData(ctx context.Context, arg PurgeDeletedDataParams) (int64, error)
+	// Affects no row if the item does not exist or is deleted.
+	RecordCatalogView(ctx context.Context, arg RecordCatalogViewParams) (int64, error)
+	RecordOutboxFailure(ctx context.Context, arg RecordOutboxFailureParams) error
+	// Queues a delivery again with a fresh retry schedule. The outcome of the last attempt is
+	// kept until the next one.
+	RedeliverWebhookDelivery(ctx context.Context, id uuid.UUID) (WebhookDelivery, error)
+	// Item assignments follow through ON UPDATE CASCADE.
+	RenameCatalogTag(ctx context.Context, arg RenameCatalogTagParams) (int64, error)
+	ResetWebhookEndpointFailures(ctx context.Context, id uuid.UUID) error
+	RestoreCatalogItem(ctx context.Context, id uuid.UUID) (RestoreCatalogItemRow, error)
+	// Restores every deleted version of a key. Callers check that the key has no live version.
+	RestoreData(ctx context.Context, key string) (int64, error)
 	SaveData(ctx context.Context, arg SaveDataParams) error
+	// Full-text matches ranked by ts_rank. Highlights mark matches with \x01 (start) and \x02 (stop).
+	SearchCatalogItems(ctx context.Context, arg SearchCatalogItemsParams) ([]SearchCatalogItemsRow, error)
+	// Typo-tolerant title matches for queries without full-text results. The match threshold is
+	// pg_trgm.word_similarity_threshold, see SetWordSimilarityThreshold.
+	SearchCatalogItemsFuzzy(ctx context.Context, arg SearchCatalogItemsFuzzyParams) ([]SearchCatalogItemsFuzzyRow, error)
+	SetCatalogDraftBefore(ctx context.Context, arg SetCatalogDraftBeforeParams) error
+	SetCatalogItemDisabled(ctx context.Context, arg SetCatalogItemDisabledParams) (SetCatalogItemDisabledRow, error)
+	SetCatalogReviewStatus(ctx context.Context, arg SetCatalogReviewStatusParams) (CatalogReview, error)
+	SetCatalogSearchLanguage(ctx context.Context, language string) (int64, error)
+	SetUserLocale(ctx context.Context, arg SetUserLocaleParams) (int64, error)
+	// Applies to the current transaction only.
+	SetWordSimilarityThreshold(ctx context.Context, threshold float32) error
+	// Stamps every version of a live key as deleted. Keys whose versions all expired are left alone.
+	SoftDeleteData(ctx context.Context, key string) (int64, error)
+	// Keeps the keep most recent views of the user.
+	TrimCatalogViews(ctx context.Context, arg TrimCatalogViewsParams) error
+	// Session lock, held until AdvisoryUnlock or the end of the connection.
+	TryAdvisoryLock(ctx context.Context, lockID int64) (bool, error)
+	TryAdvisoryXactLock(ctx context.Context, lockID int64) (bool, error)
+	UpdateCatalogCategory(ctx context.Context, arg UpdateCatalogCategoryParams) (CatalogCategory, error)
+	UpdateCatalogItem(ctx context.Context, arg UpdateCatalogItemParams) (UpdateCatalogItemRow, error)
+	// An edit of a rejected review goes back to moderation as pending instead of taking the
+	// status of new reviews.
+	UpdateCatalogReview(ctx context.Context, arg UpdateCatalogReviewParams) (CatalogReview, error)
+	UpdateDataEncryption(ctx context.Context, arg UpdateDataEncryptionParams) error
+	UpdateWebhookDeliveryAttempt(ctx context.Context, arg UpdateWebhookDeliveryAttemptParams) error
+	// Re-enabling an endpoint clears its failure count, so it gets the full number of failures
+	// again before it is disabled.
+	UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (WebhookEndpoint, error)
+	UpdateWebhookEndpointSecretEncryption(ctx context.Context, arg UpdateWebhookEndpointSecretEncryptionParams) error
+	UpsertBlob(ctx context.Context, arg UpsertBlobParams) error
+	UpsertCatalogDraft(ctx context.Context, arg UpsertCatalogDraftParams) (CatalogDraft, error)
+	// Affects no row if the item does not exist or is deleted.
+	UpsertCatalogInventory(ctx context.Context, arg UpsertCatalogInventoryParams) (int64, error)
+	// Writes an item under a known id. created_at is kept for existing items and defaults to now for new ones.
+	// A NULL sku keeps the sku of an existing item. A deleted item is restored.
+	UpsertCatalogItem(ctx context.Context, arg UpsertCatalogItemParams) (UpsertCatalogItemRow, error)
+	UpsertCatalogTags(ctx context.Context, names []string) error
+	UpsertCatalogTranslation(ctx context.Context, arg UpsertCatalogTranslationParams) (CatalogTranslation, error)
+	UpsertDataSchema(ctx context.Context, arg UpsertDataSchemaParams) (DataSchema, error)
 }
 
 var _ Querier = (*Queries)(nil)
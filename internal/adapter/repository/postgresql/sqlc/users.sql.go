@@ -9,23 +9,51 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password_hash
+SELECT id, email, password_hash, role, locale
 FROM users
 WHERE email = $1
 `
 
 type GetUserByEmailRow struct {
-	ID           uuid.UUID `json:"id"`
-	Email        string    `json:"email"`
-	PasswordHash string    `json:"password_hash"`
+	ID           uuid.UUID   `json:"id"`
+	Email        string      `json:"email"`
+	PasswordHash string      `json:"password_hash"`
+	Role         string      `json:"role"`
+	Locale       pgtype.Text `json:"locale"`
 }
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
 	row := q.db.QueryRow(ctx, getUserByEmail, email)
 	var i GetUserByEmailRow
-	err := row.Scan(&i.ID, &i.Email, &i.PasswordHash)
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Role,
+		&i.Locale,
+	)
 	return i, err
 }
+
+const setUserLocale = `-- name: SetUserLocale :execrows
+UPDATE users
+SET locale = $2
+WHERE id = $1
+`
+
+type SetUserLocaleParams struct {
+	ID     uuid.UUID   `json:"id"`
+	Locale pgtype.Text `json:"locale"`
+}
+
+func (q *Queries) SetUserLocale(ctx context.Context, arg SetUserLocaleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserLocale, arg.ID, arg.Locale)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
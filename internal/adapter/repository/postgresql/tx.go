@@ -0,0 +1,152 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"base_app/internal/entity"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+// txKey is the context key of the transaction of the current unit of work.
+type txKey struct{}
+
+// txFromContext returns the transaction of the unit of work ctx runs in, if any.
+func txFromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+	return tx, ok
+}
+
+// ctxConn runs every statement in the transaction carried by the context, and on the pool
+// outside of a unit of work. Repository methods that begin a transaction of their own get a
+// savepoint of the unit of work instead.
+type ctxConn struct {
+	pool *pgxpool.Pool
+}
+
+func (c ctxConn) conn(ctx context.Context) conn {
+	if tx, ok := txFromContext(ctx); ok {
+		return tx
+	}
+	return c.pool
+}
+
+func (c ctxConn) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return c.conn(ctx).Exec(ctx, sql, args...)
+}
+
+func (c ctxConn) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return c.conn(ctx).Query(ctx, sql, args...)
+}
+
+func (c ctxConn) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return c.conn(ctx).QueryRow(ctx, sql, args...)
+}
+
+func (c ctxConn) CopyFrom(ctx context.Context, table pgx.Identifier, columns []string, src pgx.CopyFromSource) (int64, error) {
+	return c.conn(ctx).CopyFrom(ctx, table, columns, src)
+}
+
+func (c ctxConn) Begin(ctx context.Context) (pgx.Tx, error) {
+	return c.conn(ctx).Begin(ctx)
+}
+
+// txBeginner starts the transactions of units of work; implemented by *pgxpool.Pool.
+type txBeginner interface {
+	BeginTx(ctx context.Context, options pgx.TxOptions) (pgx.Tx, error)
+}
+
+// TxManager runs units of work that span several repository calls in one transaction.
+type TxManager struct {
+	pool        txBeginner
+	options     pgx.TxOptions
+	retry       entity.RetryPolicy
+	maxAttempts int
+	log         *slog.Logger
+}
+
+// NewTxManager creates a transaction manager. Units of work run at the given isolation level,
+// one of "read committed", "repeatable read" or "serializable", and are attempted up to
+// maxAttempts times, at least 1, when they fail with a serialization failure or a deadlock.
+func NewTxManager(pool *pgxpool.Pool, isolation string, maxAttempts int, retry entity.RetryPolicy, log *slog.Logger) (*TxManager, error) {
+	level := pgx.TxIsoLevel(isolation)
+	switch level {
+	case pgx.ReadCommitted, pgx.RepeatableRead, pgx.Serializable:
+	default:
+		return nil, fmt.Errorf("unsupported transaction isolation level %q", isolation)
+	}
+	if maxAttempts < 1 {
+		return nil, fmt.Errorf("transaction max attempts must be at least 1, got %d", maxAttempts)
+	}
+	return &TxManager{
+		pool:        pool,
+		options:     pgx.TxOptions{IsoLevel: level},
+		retry:       retry,
+		maxAttempts: maxAttempts,
+		log:         log,
+	}, nil
+}
+
+// WithinTx calls fn with a context carrying a transaction; every repository call made with
+// that context runs in it. The transaction is committed when fn returns nil and rolled back
+// otherwise. A serialization failure or deadlock rolls everything back and calls fn again with
+// a fresh transaction, so fn must not have side effects outside the database.
+//
+// Called within another unit of work, WithinTx runs fn in a savepoint of it: an error rolls
+// back fn's writes only, and retrying is left to the outermost unit of work, whose snapshot
+// is the one that failed.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	const op = "adapter.sqlc.WithinTx"
+
+	if tx, ok := txFromContext(ctx); ok {
+		return runTx(ctx, tx.Begin, fn)
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := runTx(ctx, func(ctx context.Context) (pgx.Tx, error) {
+			return m.pool.BeginTx(ctx, m.options)
+		}, fn)
+		if err == nil || !isRetryableTxError(err) || attempt >= m.maxAttempts {
+			return err
+		}
+
+		backoff := m.retry.Backoff(attempt)
+		m.log.Warn("retrying transaction", slog.String("op", op), slog.Int("attempt", attempt),
+			slog.Duration("backoff", backoff), slog.String("error", err.Error()))
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// runTx calls fn in the transaction started by begin and commits it when fn succeeds.
+func runTx(ctx context.Context, begin func(ctx context.Context) (pgx.Tx, error), fn func(ctx context.Context) error) error {
+	tx, err := begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// isRetryableTxError reports whether err aborted a transaction that may succeed when run again.
+func isRetryableTxError(err error) bool {
+	return isPgError(err, pgSerializationFailure) || isPgError(err, pgDeadlockDetected)
+}
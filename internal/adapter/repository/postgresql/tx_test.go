@@ -0,0 +1,266 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"base_app/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeTx records how a transaction or savepoint ends in the journal of its pool.
+type fakeTx struct {
+	pgx.Tx
+	pool      *fakePool
+	name      string
+	savepoint int
+	closed    bool
+	commitErr error
+}
+
+func (tx *fakeTx) Begin(_ context.Context) (pgx.Tx, error) {
+	tx.savepoint++
+	sp := &fakeTx{pool: tx.pool, name: fmt.Sprintf("%s/sp%d", tx.name, tx.savepoint)}
+	tx.pool.record(sp.name + " begin")
+	return sp, nil
+}
+
+func (tx *fakeTx) Commit(_ context.Context) error {
+	if tx.closed {
+		return pgx.ErrTxClosed
+	}
+	tx.closed = true
+	if tx.commitErr != nil {
+		tx.pool.record(tx.name + " commit failed")
+		return tx.commitErr
+	}
+	tx.pool.record(tx.name + " commit")
+	return nil
+}
+
+func (tx *fakeTx) Rollback(_ context.Context) error {
+	if tx.closed {
+		return pgx.ErrTxClosed
+	}
+	tx.closed = true
+	tx.pool.record(tx.name + " rollback")
+	return nil
+}
+
+// fakePool begins fakeTx transactions. The commits of the first transactions fail with
+// commitErrs, in order.
+type fakePool struct {
+	journal    []string
+	begun      int
+	options    []pgx.TxOptions
+	commitErrs []error
+}
+
+func (p *fakePool) BeginTx(_ context.Context, options pgx.TxOptions) (pgx.Tx, error) {
+	p.begun++
+	p.options = append(p.options, options)
+	tx := &fakeTx{pool: p, name: fmt.Sprintf("tx%d", p.begun)}
+	if len(p.commitErrs) > 0 {
+		tx.commitErr, p.commitErrs = p.commitErrs[0], p.commitErrs[1:]
+	}
+	p.record(tx.name + " begin")
+	return tx, nil
+}
+
+func (p *fakePool) record(event string) {
+	p.journal = append(p.journal, event)
+}
+
+func newTestTxManager(pool *fakePool, maxAttempts int) *TxManager {
+	return &TxManager{
+		pool:        pool,
+		options:     pgx.TxOptions{IsoLevel: pgx.Serializable},
+		retry:       entity.RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+		maxAttempts: maxAttempts,
+		log:         slog.New(slog.DiscardHandler),
+	}
+}
+
+var (
+	errSerialization = &pgconn.PgError{Code: pgSerializationFailure}
+	errDeadlock      = &pgconn.PgError{Code: pgDeadlockDetected}
+	errUniqueness    = &pgconn.PgError{Code: pgUniqueViolation}
+	errApp           = errors.New("application error")
+)
+
+func assertJournal(t *testing.T, pool *fakePool, want ...string) {
+	t.Helper()
+	if !slices.Equal(pool.journal, want) {
+		t.Errorf("journal = %q, want %q", pool.journal, want)
+	}
+}
+
+func TestWithinTxCommitsOrRollsBack(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		journal []string
+	}{
+		{"success", nil, []string{"tx1 begin", "tx1 commit"}},
+		{"failure", errApp, []string{"tx1 begin", "tx1 rollback"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := &fakePool{}
+			err := newTestTxManager(pool, 3).WithinTx(context.Background(), func(ctx context.Context) error {
+				if tx, ok := txFromContext(ctx); !ok || tx.(*fakeTx).name != "tx1" {
+					t.Errorf("fn does not run in the transaction")
+				}
+				return tt.err
+			})
+			if !errors.Is(err, tt.err) {
+				t.Errorf("WithinTx() = %v, want %v", err, tt.err)
+			}
+			assertJournal(t, pool, tt.journal...)
+			if pool.options[0].IsoLevel != pgx.Serializable {
+				t.Errorf("isolation = %q, want %q", pool.options[0].IsoLevel, pgx.Serializable)
+			}
+		})
+	}
+}
+
+func TestWithinTxNestsInSavepoints(t *testing.T) {
+	pool := &fakePool{}
+	m := newTestTxManager(pool, 3)
+	err := m.WithinTx(context.Background(), func(ctx context.Context) error {
+		if err := m.WithinTx(ctx, func(ctx context.Context) error {
+			if tx, _ := txFromContext(ctx); tx.(*fakeTx).name != "tx1/sp1" {
+				t.Errorf("nested fn runs in %s, want the savepoint", tx.(*fakeTx).name)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		// A failed nested unit of work only undoes its own writes.
+		if err := m.WithinTx(ctx, func(ctx context.Context) error { return errApp }); !errors.Is(err, errApp) {
+			t.Errorf("nested WithinTx() = %v, want %v", err, errApp)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WithinTx() = %v", err)
+	}
+	assertJournal(t, pool,
+		"tx1 begin",
+		"tx1/sp1 begin", "tx1/sp1 commit",
+		"tx1/sp2 begin", "tx1/sp2 rollback",
+		"tx1 commit")
+}
+
+func TestWithinTxRetriesSerializationFailures(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxAttempts int
+		errs        []error // Returned by the attempts of fn, in order; nil after them
+		commitErrs  []error
+		want        error
+		wantCalls   int
+	}{
+		{"serialization failure", 3, []error{errSerialization, errSerialization}, nil, nil, 3},
+		{"deadlock", 3, []error{errDeadlock}, nil, nil, 2},
+		{"wrapped", 3, []error{fmt.Errorf("save: %w", errSerialization)}, nil, nil, 2},
+		{"failure on commit", 3, nil, []error{errSerialization}, nil, 2},
+		{"attempts used up", 2, []error{errSerialization, errSerialization}, nil, errSerialization, 2},
+		{"single attempt", 0, []error{errSerialization}, nil, errSerialization, 1},
+		{"other database error", 3, []error{errUniqueness}, nil, errUniqueness, 1},
+		{"application error", 3, []error{errApp}, nil, errApp, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := &fakePool{commitErrs: tt.commitErrs}
+			m := newTestTxManager(pool, max(tt.maxAttempts, 1))
+			calls := 0
+			err := m.WithinTx(context.Background(), func(ctx context.Context) error {
+				calls++
+				if calls <= len(tt.errs) {
+					return tt.errs[calls-1]
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+				t.Errorf("WithinTx() = %v, want %v", err, tt.want)
+			}
+			if calls != tt.wantCalls || pool.begun != tt.wantCalls {
+				t.Errorf("fn called %d times in %d transactions, want %d", calls, pool.begun, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestWithinTxLeavesRetryToOutermostUnit(t *testing.T) {
+	pool := &fakePool{}
+	m := newTestTxManager(pool, 3)
+	inner := 0
+	err := m.WithinTx(context.Background(), func(ctx context.Context) error {
+		return m.WithinTx(ctx, func(ctx context.Context) error {
+			inner++
+			if inner == 1 {
+				return errSerialization
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("WithinTx() = %v", err)
+	}
+	assertJournal(t, pool,
+		"tx1 begin", "tx1/sp1 begin", "tx1/sp1 rollback", "tx1 rollback",
+		"tx2 begin", "tx2/sp1 begin", "tx2/sp1 commit", "tx2 commit")
+}
+
+func TestWithinTxStopsRetryingWhenCancelled(t *testing.T) {
+	pool := &fakePool{}
+	m := newTestTxManager(pool, 3)
+	m.retry = entity.RetryPolicy{InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := m.WithinTx(ctx, func(ctx context.Context) error {
+		cancel()
+		return errSerialization
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("WithinTx() = %v, want %v", err, context.Canceled)
+	}
+	if pool.begun != 1 {
+		t.Errorf("began %d transactions, want 1", pool.begun)
+	}
+}
+
+func TestNewTxManagerIsolation(t *testing.T) {
+	tests := []struct {
+		isolation   string
+		maxAttempts int
+		wantErr     bool
+	}{
+		{"read committed", 1, false},
+		{"repeatable read", 5, false},
+		{"serializable", 1, false},
+		{"read uncommitted", 1, true},
+		{"SERIALIZABLE", 1, true},
+		{"", 1, true},
+		{"repeatable read", 0, true},
+		{"repeatable read", -1, true},
+	}
+	for _, tt := range tests {
+		m, err := NewTxManager(nil, tt.isolation, tt.maxAttempts, entity.RetryPolicy{}, slog.New(slog.DiscardHandler))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NewTxManager(%q, %d) error = %v, want error %v", tt.isolation, tt.maxAttempts, err, tt.wantErr)
+			continue
+		}
+		if err == nil && (string(m.options.IsoLevel) != tt.isolation || m.maxAttempts != tt.maxAttempts) {
+			t.Errorf("NewTxManager(%q, %d) = %+v, want those settings", tt.isolation, tt.maxAttempts, m)
+		}
+	}
+}
@@ -0,0 +1,433 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"base_app/internal/adapter/repository/postgresql/sqlc"
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// webhookPurgeLockID serializes purging of finished webhook deliveries across replicas.
+const webhookPurgeLockID int64 = 0x776870726765 // "whprge"
+
+// ListWebhookEndpoints retrieves all webhook endpoints, oldest first.
+func (r *Repo) ListWebhookEndpoints(ctx context.Context) ([]entity.WebhookEndpoint, error) {
+	const op = "adapter.sqlc.ListWebhookEndpoints"
+
+	rows, err := r.Queries.ListWebhookEndpoints(ctx)
+	if err != nil {
+		r.log.Error("failed to list webhook endpoints", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	endpoints := make([]entity.WebhookEndpoint, len(rows))
+	for i, row := range rows {
+		endpoint, err := r.cipher.toWebhookEndpoint(row)
+		if err != nil {
+			r.log.Error("failed to open webhook secret", slog.String("op", op), slog.String("error", err.Error()))
+			return nil, err
+		}
+		endpoints[i] = *endpoint
+	}
+	return endpoints, nil
+}
+
+// GetWebhookEndpoint retrieves a webhook endpoint by id.
+func (r *Repo) GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*entity.WebhookEndpoint, error) {
+	const op = "adapter.sqlc.GetWebhookEndpoint"
+
+	row, err := r.Queries.GetWebhookEndpoint(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get webhook endpoint", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	endpoint, err := r.cipher.toWebhookEndpoint(row)
+	if err != nil {
+		r.log.Error("failed to open webhook secret", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return endpoint, nil
+}
+
+// CreateWebhookEndpoint registers an endpoint and fills in the generated fields. The secret
+// is sealed with the master keys when they are configured.
+func (r *Repo) CreateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	const op = "adapter.sqlc.CreateWebhookEndpoint"
+
+	// The id is the additional authenticated data of the sealed secret, so it is chosen here.
+	id := uuid.New()
+	sealed, err := r.cipher.sealSecret(id, endpoint.Secret)
+	if err != nil {
+		r.log.Error("failed to seal webhook secret", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	row, err := r.Queries.CreateWebhookEndpoint(ctx, sqlc.CreateWebhookEndpointParams{
+		ID:               id,
+		Url:              endpoint.URL,
+		Secret:           sealed.text(),
+		SecretCiphertext: sealed.Ciphertext,
+		SecretDataKey:    sealed.DataKey,
+		SecretKeyID:      sealed.KeyID,
+		EventTypes:       fromEventTypes(endpoint.EventTypes),
+		Enabled:          endpoint.Enabled,
+	})
+	if err != nil {
+		r.log.Error("failed to create webhook endpoint", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	*endpoint = *toWebhookEndpoint(row, endpoint.Secret)
+	return nil
+}
+
+// UpdateWebhookEndpoint replaces the settings of an endpoint and fills in the generated
+// fields. It returns entity.ErrNotFound if the endpoint does not exist.
+func (r *Repo) UpdateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	const op = "adapter.sqlc.UpdateWebhookEndpoint"
+
+	sealed, err := r.cipher.sealSecret(endpoint.ID, endpoint.Secret)
+	if err != nil {
+		r.log.Error("failed to seal webhook secret", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	row, err := r.Queries.UpdateWebhookEndpoint(ctx, sqlc.UpdateWebhookEndpointParams{
+		ID:               endpoint.ID,
+		Url:              endpoint.URL,
+		Secret:           sealed.text(),
+		SecretCiphertext: sealed.Ciphertext,
+		SecretDataKey:    sealed.DataKey,
+		SecretKeyID:      sealed.KeyID,
+		EventTypes:       fromEventTypes(endpoint.EventTypes),
+		Enabled:          endpoint.Enabled,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ErrNotFound
+		}
+		r.log.Error("failed to update webhook endpoint", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	*endpoint = *toWebhookEndpoint(row, endpoint.Secret)
+	return nil
+}
+
+// DeleteWebhookEndpoint removes an endpoint together with its delivery log.
+func (r *Repo) DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error {
+	const op = "adapter.sqlc.DeleteWebhookEndpoint"
+
+	n, err := r.Queries.DeleteWebhookEndpoint(ctx, id)
+	if err != nil {
+		r.log.Error("failed to delete webhook endpoint", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n == 0 {
+		return entity.ErrNotFound
+	}
+	return nil
+}
+
+// CreateWebhookDeliveries queues event for every endpoint subscribed to its type, enabled or
+// not, and returns the number of new deliveries. Queuing the same event again adds nothing.
+func (r *Repo) CreateWebhookDeliveries(ctx context.Context, event entity.OutboxEvent) (int64, error) {
+	const op = "adapter.sqlc.CreateWebhookDeliveries"
+
+	n, err := r.Queries.CreateWebhookDeliveries(ctx, sqlc.CreateWebhookDeliveriesParams{
+		EventID:        event.ID,
+		EventType:      string(event.Type),
+		Payload:        event.Payload,
+		EventCreatedAt: pgtype.Timestamptz{Time: event.CreatedAt, Valid: true},
+	})
+	if err != nil {
+		r.log.Error("failed to create webhook deliveries", slog.String("op", op), slog.String("error", err.Error()))
+		return 0, err
+	}
+	return n, nil
+}
+
+// ClaimDueWebhookDeliveries claims up to limit due deliveries of enabled endpoints until
+// leaseUntil. Claimed deliveries that are not recorded by then become due again.
+func (r *Repo) ClaimDueWebhookDeliveries(ctx context.Context, leaseUntil time.Time, limit int32) ([]entity.WebhookDispatch, error) {
+	const op = "adapter.sqlc.ClaimDueWebhookDeliveries"
+
+	rows, err := r.Queries.ClaimDueWebhookDeliveries(ctx, sqlc.ClaimDueWebhookDeliveriesParams{
+		LeaseUntil: pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+		BatchSize:  limit,
+	})
+	if err != nil {
+		r.log.Error("failed to claim webhook deliveries", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	dispatches := make([]entity.WebhookDispatch, len(rows))
+	for i, row := range rows {
+		secret, err := r.cipher.openSecret(row.EndpointID, sealedValue{
+			Value:      []byte(row.Secret.String),
+			Ciphertext: row.SecretCiphertext,
+			DataKey:    row.SecretDataKey,
+			KeyID:      row.SecretKeyID,
+		})
+		if err != nil {
+			// The claim is not undone; the deliveries become due again when the lease ends.
+			r.log.Error("failed to open webhook secret", slog.String("op", op), slog.String("error", err.Error()))
+			return nil, err
+		}
+		dispatches[i] = entity.WebhookDispatch{
+			WebhookDelivery: *toWebhookDelivery(sqlc.WebhookDelivery{
+				ID:             row.ID,
+				EndpointID:     row.EndpointID,
+				EventID:        row.EventID,
+				EventType:      row.EventType,
+				Payload:        row.Payload,
+				EventCreatedAt: row.EventCreatedAt,
+				Status:         row.Status,
+				Attempts:       row.Attempts,
+				NextAttemptAt:  row.NextAttemptAt,
+				LastAttemptAt:  row.LastAttemptAt,
+				ResponseStatus: row.ResponseStatus,
+				LastError:      row.LastError,
+				CreatedAt:      row.CreatedAt,
+			}),
+			URL:    row.Url,
+			Secret: secret,
+		}
+	}
+	return dispatches, nil
+}
+
+// RecordWebhookAttempt stores the outcome of an attempt set on delivery and updates the
+// failure count of its endpoint in the same transaction. A success clears the count; a
+// failure that brings it to disableAfter disables the endpoint, which is then reported.
+func (r *Repo) RecordWebhookAttempt(ctx context.Context, delivery *entity.WebhookDelivery, disableAfter int) (bool, error) {
+	const op = "adapter.sqlc.RecordWebhookAttempt"
+
+	var disabled bool
+	err := r.InTx(ctx, func(tx *Repo) error {
+		err := tx.Queries.UpdateWebhookDeliveryAttempt(ctx, sqlc.UpdateWebhookDeliveryAttemptParams{
+			ID:             delivery.ID,
+			Status:         string(delivery.Status),
+			Attempts:       int32(delivery.Attempts),
+			NextAttemptAt:  pgtype.Timestamptz{Time: delivery.NextAttemptAt, Valid: true},
+			LastAttemptAt:  toTimestamptz(delivery.LastAttemptAt),
+			ResponseStatus: pgtype.Int4{Int32: int32(delivery.ResponseStatus), Valid: delivery.ResponseStatus != 0},
+			LastError:      toText(delivery.LastError),
+		})
+		if err != nil {
+			return err
+		}
+		if delivery.Status == entity.WebhookDeliverySucceeded {
+			return tx.Queries.ResetWebhookEndpointFailures(ctx, delivery.EndpointID)
+		}
+		row, err := tx.Queries.AddWebhookEndpointFailure(ctx, sqlc.AddWebhookEndpointFailureParams{
+			ID:           delivery.EndpointID,
+			DisableAfter: int32(disableAfter),
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			// The endpoint was deleted while the delivery was sent.
+			return nil
+		}
+		disabled = !row.Enabled && int(row.ConsecutiveFailures) == disableAfter
+		return err
+	})
+	if err != nil {
+		r.log.Error("failed to record webhook attempt", slog.String("op", op), slog.String("error", err.Error()))
+		return false, err
+	}
+	return disabled, nil
+}
+
+// GetWebhookDelivery retrieves a webhook delivery by id.
+func (r *Repo) GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	const op = "adapter.sqlc.GetWebhookDelivery"
+
+	row, err := r.Queries.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to get webhook delivery", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return toWebhookDelivery(row), nil
+}
+
+// ListWebhookDeliveries retrieves up to limit deliveries to an endpoint, newest first. An
+// empty status lists deliveries of every status.
+func (r *Repo) ListWebhookDeliveries(ctx context.Context, endpointID uuid.UUID, status entity.WebhookDeliveryStatus, limit int32) ([]entity.WebhookDelivery, error) {
+	const op = "adapter.sqlc.ListWebhookDeliveries"
+
+	rows, err := r.Queries.ListWebhookDeliveries(ctx, sqlc.ListWebhookDeliveriesParams{
+		EndpointID: endpointID,
+		Status:     toText(string(status)),
+		PageSize:   limit,
+	})
+	if err != nil {
+		r.log.Error("failed to list webhook deliveries", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	deliveries := make([]entity.WebhookDelivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = *toWebhookDelivery(row)
+	}
+	return deliveries, nil
+}
+
+// RedeliverWebhookDelivery makes a delivery due at once with a fresh retry schedule.
+func (r *Repo) RedeliverWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	const op = "adapter.sqlc.RedeliverWebhookDelivery"
+
+	row, err := r.Queries.RedeliverWebhookDelivery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		r.log.Error("failed to redeliver webhook delivery", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return toWebhookDelivery(row), nil
+}
+
+// PurgeWebhookDeliveries removes finished deliveries created before cutoff in batches of
+// batchSize and returns the number of removed deliveries.
+func (r *Repo) PurgeWebhookDeliveries(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error) {
+	const op = "adapter.sqlc.PurgeWebhookDeliveries"
+
+	n, err := r.purgeInBatches(ctx, webhookPurgeLockID, func(q *sqlc.Queries) (int64, error) {
+		return q.DeleteFinishedWebhookDeliveries(ctx, sqlc.DeleteFinishedWebhookDeliveriesParams{
+			Cutoff:    pgtype.Timestamptz{Time: cutoff, Valid: true},
+			BatchSize: batchSize,
+		})
+	}, batchSize)
+	if err != nil {
+		r.log.Error("failed to purge webhook deliveries", slog.String("op", op), slog.String("error", err.Error()))
+	}
+	return n, err
+}
+
+// RotateWebhookSecrets re-encrypts every endpoint secret not sealed with the active master
+// key, including plaintext secrets, and returns the number of secrets rewritten.
+func (r *Repo) RotateWebhookSecrets(ctx context.Context) (int64, error) {
+	const op = "adapter.sqlc.RotateWebhookSecrets"
+
+	if r.cipher.keyring == nil {
+		return 0, errEncryptionDisabled
+	}
+
+	var n int64
+	err := r.InTx(ctx, func(tx *Repo) error {
+		rows, err := tx.Queries.ListWebhookEndpointsForRotation(ctx, r.cipher.keyring.ActiveKeyID())
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			endpoint, err := r.cipher.toWebhookEndpoint(row)
+			if err != nil {
+				return err
+			}
+			sealed, err := r.cipher.sealSecret(row.ID, endpoint.Secret)
+			if err != nil {
+				return err
+			}
+			if err := tx.Queries.UpdateWebhookEndpointSecretEncryption(ctx, sqlc.UpdateWebhookEndpointSecretEncryptionParams{
+				ID:               row.ID,
+				SecretCiphertext: sealed.Ciphertext,
+				SecretDataKey:    sealed.DataKey,
+				SecretKeyID:      sealed.KeyID,
+			}); err != nil {
+				return err
+			}
+		}
+		n = int64(len(rows))
+		return nil
+	})
+	if err != nil {
+		r.log.Error("failed to rotate webhook secrets", slog.String("op", op), slog.String("error", err.Error()))
+		return 0, err
+	}
+	return n, nil
+}
+
+// webhookSecretKey is the additional authenticated data of a sealed endpoint secret, so the
+// secret cannot be moved to another endpoint or to a data value.
+func webhookSecretKey(id uuid.UUID) string {
+	return "webhook_endpoint:" + id.String()
+}
+
+func (c valueCipher) sealSecret(id uuid.UUID, secret string) (sealedValue, error) {
+	return c.seal(webhookSecretKey(id), []byte(secret))
+}
+
+func (c valueCipher) openSecret(id uuid.UUID, v sealedValue) (string, error) {
+	secret, err := c.openSealed(webhookSecretKey(id), v)
+	return string(secret), err
+}
+
+// text returns the plaintext column of a value that is not encrypted.
+func (v sealedValue) text() pgtype.Text {
+	return pgtype.Text{String: string(v.Value), Valid: !v.KeyID.Valid}
+}
+
+func (c valueCipher) toWebhookEndpoint(row sqlc.WebhookEndpoint) (*entity.WebhookEndpoint, error) {
+	secret, err := c.openSecret(row.ID, sealedValue{
+		Value:      []byte(row.Secret.String),
+		Ciphertext: row.SecretCiphertext,
+		DataKey:    row.SecretDataKey,
+		KeyID:      row.SecretKeyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toWebhookEndpoint(row, secret), nil
+}
+
+func fromEventTypes(types []entity.OutboxEventType) []string {
+	s := make([]string, len(types))
+	for i, t := range types {
+		s[i] = string(t)
+	}
+	return s
+}
+
+func toWebhookEndpoint(row sqlc.WebhookEndpoint, secret string) *entity.WebhookEndpoint {
+	types := make([]entity.OutboxEventType, len(row.EventTypes))
+	for i, t := range row.EventTypes {
+		types[i] = entity.OutboxEventType(t)
+	}
+	return &entity.WebhookEndpoint{
+		ID:                  row.ID,
+		URL:                 row.Url,
+		Secret:              secret,
+		EventTypes:          types,
+		Enabled:             row.Enabled,
+		ConsecutiveFailures: int(row.ConsecutiveFailures),
+		DisabledAt:          toTimePtr(row.DisabledAt),
+		CreatedAt:           row.CreatedAt.Time,
+		UpdatedAt:           row.UpdatedAt.Time,
+	}
+}
+
+func toWebhookDelivery(row sqlc.WebhookDelivery) *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:         row.ID,
+		EndpointID: row.EndpointID,
+		Event: entity.OutboxEvent{
+			ID:        row.EventID,
+			Type:      entity.OutboxEventType(row.EventType),
+			Payload:   row.Payload,
+			CreatedAt: row.EventCreatedAt.Time,
+		},
+		Status:         entity.WebhookDeliveryStatus(row.Status),
+		Attempts:       int(row.Attempts),
+		NextAttemptAt:  row.NextAttemptAt.Time,
+		LastAttemptAt:  toTimePtr(row.LastAttemptAt),
+		ResponseStatus: int(row.ResponseStatus.Int32),
+		LastError:      row.LastError.String,
+		CreatedAt:      row.CreatedAt.Time,
+	}
+}
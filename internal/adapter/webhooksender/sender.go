@@ -0,0 +1,77 @@
+package webhooksender
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"base_app/internal/entity"
+)
+
+// maxErrorBody bounds how much of a failed response is kept in the error.
+const maxErrorBody = 512
+
+// Sender implements usecase.WebhookSender over HTTP.
+//
+// Every request carries the header X-Webhook-Signature: t=<unix seconds>,v1=<hex>, where the
+// hex value is the HMAC-SHA256 of "<t>.<body>" keyed with the endpoint secret. Receivers
+// recompute it and reject old timestamps to stop replays.
+type Sender struct {
+	client *http.Client
+}
+
+// New creates a sender giving up on a request after timeout.
+func New(timeout time.Duration) *Sender {
+	return &Sender{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Send posts the event of delivery as JSON and returns the response status.
+func (s *Sender) Send(ctx context.Context, url, secret string, delivery entity.WebhookDelivery) (int, error) {
+	body, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", delivery.ID.String())
+	req.Header.Set("X-Event-Id", strconv.FormatInt(delivery.Event.ID, 10))
+	req.Header.Set("X-Event-Type", string(delivery.Event.Type))
+	req.Header.Set("X-Webhook-Signature", Sign(secret, time.Now(), body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
+
+// Sign returns the X-Webhook-Signature header value for body sent at t.
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
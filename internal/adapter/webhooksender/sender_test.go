@@ -0,0 +1,162 @@
+package webhooksender
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const testSecret = "0123456789abcdef"
+
+// verify checks a signature header the way a receiver does and returns why it is invalid.
+func verify(secret, header string, body []byte, now time.Time) string {
+	ts, sig, ok := strings.Cut(header, ",")
+	ts, okT := strings.CutPrefix(ts, "t=")
+	sig, okV := strings.CutPrefix(sig, "v1=")
+	if !ok || !okT || !okV {
+		return "malformed header " + header
+	}
+	sec, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return "malformed timestamp " + ts
+	}
+	if d := now.Sub(time.Unix(sec, 0)); d < -time.Minute || d > 5*time.Minute {
+		return "stale timestamp " + ts
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "." + string(body)))
+	want := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(sig), []byte(want)) {
+		return "signature mismatch"
+	}
+	return ""
+}
+
+func testDelivery() entity.WebhookDelivery {
+	return entity.WebhookDelivery{
+		ID: uuid.New(),
+		Event: entity.OutboxEvent{
+			ID:        42,
+			Type:      entity.EventDataSaved,
+			Payload:   json.RawMessage(`{"key":"k"}`),
+			CreatedAt: time.Date(2025, 12, 19, 10, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestSendSignsEvent(t *testing.T) {
+	delivery := testDelivery()
+	received := make(chan *http.Request, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if reason := verify(testSecret, r.Header.Get("X-Webhook-Signature"), body, time.Now()); reason != "" {
+			t.Errorf("receiver rejects the signature: %s", reason)
+		}
+		var event entity.OutboxEvent
+		if err := json.Unmarshal(body, &event); err != nil || event.ID != delivery.Event.ID || string(event.Payload) != string(delivery.Event.Payload) {
+			t.Errorf("body = %s, want the event", body)
+		}
+		received <- r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	status, err := New(time.Second).Send(context.Background(), srv.URL, testSecret, delivery)
+	if err != nil || status != http.StatusNoContent {
+		t.Fatalf("Send() = %d, %v; want %d", status, err, http.StatusNoContent)
+	}
+
+	r := <-received
+	headers := map[string]string{
+		"Content-Type": "application/json",
+		"X-Webhook-Id": delivery.ID.String(),
+		"X-Event-Id":   "42",
+		"X-Event-Type": "data.saved",
+	}
+	for name, want := range headers {
+		if got := r.Header.Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if r.Method != http.MethodPost {
+		t.Errorf("method = %s, want POST", r.Method)
+	}
+}
+
+func TestSendReturnsErrorStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+	}{
+		{http.StatusMovedPermanently, ""}, // Without a Location, the redirect is not followed
+		{http.StatusBadRequest, "bad payload"},
+		{http.StatusInternalServerError, strings.Repeat("x", 2*maxErrorBody)},
+		{http.StatusServiceUnavailable, ""},
+	}
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.status), func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, tt.body, tt.status)
+			}))
+			defer srv.Close()
+
+			status, err := New(time.Second).Send(context.Background(), srv.URL, testSecret, testDelivery())
+			if status != tt.status {
+				t.Errorf("status = %d, want %d", status, tt.status)
+			}
+			if err == nil || !strings.Contains(err.Error(), strconv.Itoa(tt.status)) {
+				t.Fatalf("error = %v, want one naming status %d", err, tt.status)
+			}
+			if len(err.Error()) > maxErrorBody+100 {
+				t.Errorf("error holds %d bytes, want the body cut at %d", len(err.Error()), maxErrorBody)
+			}
+		})
+	}
+}
+
+func TestSendFailsWithoutResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	status, err := New(50*time.Millisecond).Send(context.Background(), srv.URL, testSecret, testDelivery())
+	if err == nil || status != 0 {
+		t.Errorf("Send() = %d, %v; want a timeout without status", status, err)
+	}
+}
+
+func TestSign(t *testing.T) {
+	at := time.Unix(1700000000, 0)
+	body := []byte(`{"id":1}`)
+
+	sig := Sign(testSecret, at, body)
+	if !strings.HasPrefix(sig, "t=1700000000,v1=") {
+		t.Errorf("Sign() = %q, want the timestamp first", sig)
+	}
+	if reason := verify(testSecret, sig, body, at); reason != "" {
+		t.Errorf("signature does not verify: %s", reason)
+	}
+	if reason := verify("another secret!!", sig, body, at); reason == "" {
+		t.Errorf("signature verifies with another secret")
+	}
+	if reason := verify(testSecret, sig, []byte(`{"id":2}`), at); reason == "" {
+		t.Errorf("signature verifies for another body")
+	}
+	if Sign(testSecret, at.Add(time.Second), body) == sig {
+		t.Errorf("signature does not depend on the timestamp")
+	}
+}
@@ -10,16 +10,37 @@ import (
 
 type Config struct {
 	HTTP        HTTPConfig        `yaml:"http"`
+	Metrics     MetricsConfig     `yaml:"metrics"`
 	Auth        AuthConfig        `yaml:"auth"`
+	Storage     StorageConfig     `yaml:"storage"`
 	Logger      LoggerConfig      `yaml:"logger"`
 	Postgres    PostgresConfig    `yaml:"postgres"`
 	Redis       RedisConfig       `yaml:"redis"`
+	Data        DataConfig        `yaml:"data"`
+	Attachments AttachmentsConfig `yaml:"attachments"`
+	Catalog     CatalogConfig     `yaml:"catalog"`
+	SoftDelete  SoftDeleteConfig  `yaml:"soft_delete"`
+	Outbox      OutboxConfig      `yaml:"outbox"`
+	Webhooks    WebhooksConfig    `yaml:"webhooks"`
+	Idempotency IdempotencyConfig `yaml:"idempotency"`
 	Pushgateway PushgatewayConfig `yaml:"pushgateway"`
 	Sentry      SentryConfig      `yaml:"sentry"`
 }
 
 type AuthConfig struct {
-	Provider string `yaml:"provider" env-default:"inmemory"`
+	Provider string          `yaml:"provider" env-default:"inmemory"`
+	Admin    AuthAdminConfig `yaml:"admin"`
+}
+
+// AuthAdminConfig holds the admin account of the inmemory auth provider. Without it the
+// provider has no admin account.
+type AuthAdminConfig struct {
+	Email        string `yaml:"email" env:"AUTH_ADMIN_EMAIL"`
+	PasswordHash string `yaml:"password_hash" env:"AUTH_ADMIN_PASSWORD_HASH"`
+}
+
+type StorageConfig struct {
+	Driver string `yaml:"driver" env:"STORAGE_DRIVER" env-default:"postgres"`
 }
 
 type HTTPConfig struct {
@@ -30,6 +51,14 @@ type HTTPConfig struct {
 	IdleTimeout  time.Duration `yaml:"idle_timeout" env-default:"60s"`
 }
 
+// MetricsConfig holds the listener of the Prometheus /metrics endpoint. It is kept apart from
+// the public HTTP port so that metrics are only reachable where the scraper can reach them.
+type MetricsConfig struct {
+	Enabled bool   `yaml:"enabled" env-default:"true"`
+	Host    string `yaml:"host" env:"METRICS_HOST" env-default:"localhost"`
+	Port    string `yaml:"port" env:"METRICS_PORT" env-default:"9100"`
+}
+
 type LoggerConfig struct {
 	Enabled     bool   `yaml:"enabled" env-default:"true"`
 	Level       string `yaml:"level" env:"LOG_LEVEL" env-default:"info"`
@@ -37,12 +66,20 @@ type LoggerConfig struct {
 }
 
 type PostgresConfig struct {
-	Host     string `yaml:"host" env:"PG_HOST"`
-	Port     string `yaml:"port" env:"PG_PORT"`
-	User     string `yaml:"user" env:"PG_USER"`
-	Password string `yaml:"password" env:"PG_PASSWORD"`
-	DBName   string `yaml:"dbname" env:"PG_DBNAME"`
-	SSLMode  string `yaml:"sslmode" env:"PG_SSLMODE"`
+	Host     string   `yaml:"host" env:"PG_HOST"`
+	Port     string   `yaml:"port" env:"PG_PORT"`
+	User     string   `yaml:"user" env:"PG_USER"`
+	Password string   `yaml:"password" env:"PG_PASSWORD"`
+	DBName   string   `yaml:"dbname" env:"PG_DBNAME"`
+	SSLMode  string   `yaml:"sslmode" env:"PG_SSLMODE"`
+	Tx       TxConfig `yaml:"tx"`
+}
+
+type TxConfig struct {
+	Isolation      string        `yaml:"isolation" env:"PG_TX_ISOLATION" env-default:"repeatable read"`
+	MaxAttempts    int           `yaml:"max_attempts" env-default:"5"`
+	InitialBackoff time.Duration `yaml:"initial_backoff" env-default:"10ms"`
+	MaxBackoff     time.Duration `yaml:"max_backoff" env-default:"200ms"`
 }
 
 func (p *PostgresConfig) DSN() string {
@@ -58,6 +95,148 @@ type RedisConfig struct {
 	DB       int    `yaml:"db" env:"REDIS_DB"`
 }
 
+type DataConfig struct {
+	Reaper     ReaperConfig     `yaml:"reaper"`
+	Watch      WatchConfig      `yaml:"watch"`
+	Encryption EncryptionConfig `yaml:"encryption"`
+	Quota      QuotaConfig      `yaml:"quota"`
+}
+
+type ReaperConfig struct {
+	Enabled   bool          `yaml:"enabled" env-default:"true"`
+	Interval  time.Duration `yaml:"interval" env-default:"1m"`
+	BatchSize int32         `yaml:"batch_size" env-default:"1000"`
+}
+
+type WatchConfig struct {
+	Buffer int `yaml:"buffer" env-default:"64"`
+}
+
+type EncryptionConfig struct {
+	Enabled bool `yaml:"enabled" env:"DATA_ENCRYPTION_ENABLED" env-default:"false"`
+	// MasterKeys takes precedence over MasterKeysFile. Both hold "id:base64key" entries.
+	MasterKeys      string `yaml:"-" env:"DATA_MASTER_KEYS"`
+	MasterKeysFile  string `yaml:"master_keys_file" env:"DATA_MASTER_KEYS_FILE"`
+	ActiveKeyID     string `yaml:"active_key_id" env:"DATA_ACTIVE_KEY_ID"`
+	RotateBatchSize int32  `yaml:"rotate_batch_size" env-default:"500"`
+}
+
+type QuotaConfig struct {
+	MaxKeys       int64 `yaml:"max_keys" env:"DATA_QUOTA_MAX_KEYS" env-default:"0"`
+	MaxValueBytes int64 `yaml:"max_value_bytes" env:"DATA_QUOTA_MAX_VALUE_BYTES" env-default:"0"`
+	MaxTotalBytes int64 `yaml:"max_total_bytes" env:"DATA_QUOTA_MAX_TOTAL_BYTES" env-default:"0"`
+}
+
+type AttachmentsConfig struct {
+	Storage         string        `yaml:"storage" env-default:"local"`
+	Root            string        `yaml:"root" env:"ATTACHMENTS_ROOT" env-default:"./var/attachments"`
+	MaxSize         int64         `yaml:"max_size" env-default:"10485760"`
+	AllowedTypes    []string      `yaml:"allowed_types"`
+	TransferTimeout time.Duration `yaml:"transfer_timeout" env-default:"10m"`
+	GC              BlobGCConfig  `yaml:"gc"`
+}
+
+type BlobGCConfig struct {
+	Enabled  bool          `yaml:"enabled" env-default:"true"`
+	Interval time.Duration `yaml:"interval" env-default:"1h"`
+	Grace    time.Duration `yaml:"grace" env-default:"1h"`
+}
+
+type CatalogConfig struct {
+	Search              CatalogSearchConfig `yaml:"search"`
+	Cache               CatalogCacheConfig  `yaml:"cache"`
+	Images              CatalogImagesConfig `yaml:"images"`
+	Reservations        ReservationsConfig  `yaml:"reservations"`
+	Reviews             ReviewsConfig       `yaml:"reviews"`
+	DefaultLocale       string              `yaml:"default_locale" env:"CATALOG_DEFAULT_LOCALE" env-default:"en"`
+	RecentlyViewedLimit int                 `yaml:"recently_viewed_limit" env-default:"50"`
+}
+
+type CatalogSearchConfig struct {
+	Language       string  `yaml:"language" env:"CATALOG_SEARCH_LANGUAGE" env-default:"english"`
+	FuzzyThreshold float32 `yaml:"fuzzy_threshold" env-default:"0.3"`
+}
+
+type CatalogCacheConfig struct {
+	Enabled bool          `yaml:"enabled" env-default:"true"`
+	ItemTTL time.Duration `yaml:"item_ttl" env-default:"10m"`
+	ListTTL time.Duration `yaml:"list_ttl" env-default:"1m"`
+}
+
+type CatalogImagesConfig struct {
+	Storage        string       `yaml:"storage" env-default:"local"`
+	Root           string       `yaml:"root" env:"CATALOG_IMAGES_ROOT" env-default:"./var/catalog-images"`
+	MaxSize        int64        `yaml:"max_size" env-default:"10485760"`
+	ThumbnailSizes []int        `yaml:"thumbnail_sizes" env-default:"160,480"`
+	GC             BlobGCConfig `yaml:"gc"`
+}
+
+type ReservationsConfig struct {
+	TTL    time.Duration `yaml:"ttl" env-default:"15m"`
+	MaxTTL time.Duration `yaml:"max_ttl" env-default:"24h"`
+}
+
+type ReviewsConfig struct {
+	RequireApproval bool `yaml:"require_approval" env:"CATALOG_REVIEWS_REQUIRE_APPROVAL" env-default:"false"`
+	MaxBodyLength   int  `yaml:"max_body_length" env-default:"5000"`
+}
+
+type SoftDeleteConfig struct {
+	Retention time.Duration      `yaml:"retention" env:"SOFT_DELETE_RETENTION" env-default:"720h"`
+	Purge     DeletedPurgeConfig `yaml:"purge"`
+}
+
+type DeletedPurgeConfig struct {
+	Enabled   bool          `yaml:"enabled" env-default:"true"`
+	Interval  time.Duration `yaml:"interval" env-default:"1h"`
+	BatchSize int32         `yaml:"batch_size" env-default:"1000"`
+}
+
+type OutboxConfig struct {
+	Enabled       bool                `yaml:"enabled" env:"OUTBOX_ENABLED" env-default:"true"`
+	Interval      time.Duration       `yaml:"interval" env-default:"1s"`
+	BatchSize     int32               `yaml:"batch_size" env-default:"100"`
+	Retry         RetryConfig         `yaml:"retry"`
+	Retention     time.Duration       `yaml:"retention" env-default:"168h"`
+	PurgeInterval time.Duration       `yaml:"purge_interval" env-default:"1h"`
+	Sinks         []string            `yaml:"sinks" env:"OUTBOX_SINKS"`
+	Webhook       OutboxWebhookConfig `yaml:"webhook"`
+	File          OutboxFileConfig    `yaml:"file"`
+}
+
+type RetryConfig struct {
+	InitialBackoff time.Duration `yaml:"initial_backoff" env-default:"1s"`
+	MaxBackoff     time.Duration `yaml:"max_backoff" env-default:"5m"`
+}
+
+type OutboxWebhookConfig struct {
+	URL     string        `yaml:"url" env:"OUTBOX_WEBHOOK_URL"`
+	Timeout time.Duration `yaml:"timeout" env-default:"10s"`
+}
+
+type OutboxFileConfig struct {
+	Path string `yaml:"path" env:"OUTBOX_FILE_PATH" env-default:"./var/outbox.ndjson"`
+}
+
+type WebhooksConfig struct {
+	Enabled       bool          `yaml:"enabled" env:"WEBHOOKS_ENABLED" env-default:"true"`
+	Interval      time.Duration `yaml:"interval" env-default:"1s"`
+	BatchSize     int32         `yaml:"batch_size" env-default:"20"`
+	Timeout       time.Duration `yaml:"timeout" env-default:"10s"`
+	MaxAttempts   int           `yaml:"max_attempts" env-default:"10"`
+	DisableAfter  int           `yaml:"disable_after" env-default:"50"`
+	Retry         RetryConfig   `yaml:"retry"`
+	Retention     time.Duration `yaml:"retention" env-default:"720h"`
+	PurgeInterval time.Duration `yaml:"purge_interval" env-default:"1h"`
+}
+
+type IdempotencyConfig struct {
+	Enabled      bool          `yaml:"enabled" env-default:"true"`
+	TTL          time.Duration `yaml:"ttl" env-default:"24h"`
+	LockTimeout  time.Duration `yaml:"lock_timeout" env-default:"1m"`
+	MaxBodyBytes int64         `yaml:"max_body_bytes" env-default:"1048576"`
+}
+
 type PushgatewayConfig struct {
 	Enabled bool   `yaml:"enabled" env-default:"true"`
 	URL     string `yaml:"url" env:"PUSHGATEWAY_URL"`
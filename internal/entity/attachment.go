@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrTooLarge is returned when uploaded content exceeds the configured size limit.
+var ErrTooLarge = errors.New("content is too large")
+
+// ErrBlobMissing is returned when blob content vanished while an attachment to it was being created.
+// The upload can simply be retried.
+var ErrBlobMissing = errors.New("blob content is missing")
+
+// Attachment is a file attached to a data key. Its content is stored once per Digest.
+type Attachment struct {
+	ID          uuid.UUID `json:"id"`
+	Key         string    `json:"key"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Digest      string    `json:"digest"` // Hex encoded SHA-256 of the content
+	CreatedBy   uuid.UUID `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// AttachmentPolicy restricts what may be attached to data keys.
+type AttachmentPolicy struct {
+	MaxSize      int64    // In bytes
+	AllowedTypes []string // Media types such as "application/pdf" or "image/*"; empty allows all
+}
+
+// BlobGCResult summarizes a garbage collection run over blob content.
+type BlobGCResult struct {
+	Attachments int // Attachments removed because their data key no longer exists
+	Blobs       int // Unreferenced blobs removed together with their content
+	Orphans     int // Stored content without metadata, left behind by failed uploads
+}
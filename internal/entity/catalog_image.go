@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CatalogImage is an image of a catalog item. Its content never changes; replacing an image
+// means uploading a new one and deleting the old one.
+type CatalogImage struct {
+	ID          uuid.UUID `json:"id"`
+	ItemID      uuid.UUID `json:"item_id"`
+	ContentType string    `json:"content_type"`
+	Width       int       `json:"width"`
+	Height      int       `json:"height"`
+	Size        int64     `json:"size"` // In bytes
+	CreatedAt   time.Time `json:"created_at"`
+	// Thumbnails lists one thumbnail per configured size; it is filled in by the usecase.
+	Thumbnails []CatalogThumbnail `json:"thumbnails"`
+}
+
+// CatalogThumbnail is a scaled-down copy of a catalog image that fits within Size×Size pixels.
+// Images are never scaled up, so a thumbnail of a small image has the image's dimensions.
+type CatalogThumbnail struct {
+	Size        int    `json:"size"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ContentType string `json:"content_type"`
+}
+
+// CatalogImagePolicy restricts uploaded catalog images and sets their thumbnail sizes.
+type CatalogImagePolicy struct {
+	MaxSize        int64 // In bytes
+	ThumbnailSizes []int // Bounding box edges in pixels
+}
@@ -0,0 +1,45 @@
+package entity
+
+// CatalogImportAction is what an import row does to the item with its SKU.
+type CatalogImportAction string
+
+const (
+	CatalogImportUpsert CatalogImportAction = "upsert"
+	CatalogImportDelete CatalogImportAction = "delete"
+)
+
+// CatalogImportOptions configures a bulk catalog import.
+type CatalogImportOptions struct {
+	Format DataFormat // DataFormatCSV or DataFormatJSON
+	DryRun bool
+	Prune  bool // Delete items whose SKU is not in the input; items without a SKU are kept
+}
+
+// CatalogImportRow is a validated import row. Item.SKU identifies the item; the category
+// of upserted items is already resolved to its ID.
+type CatalogImportRow struct {
+	Line   int
+	Action CatalogImportAction
+	Item   CatalogItem
+}
+
+// CatalogImportResult is the outcome of applying validated rows.
+type CatalogImportResult struct {
+	Created int
+	Updated int
+	Deleted int      // Including pruned items
+	Missing []string // SKUs of delete rows without an item; nothing is applied when set
+}
+
+// CatalogImportReport summarizes a bulk catalog import. Rows are applied only if all of them
+// are valid, and then all together. For JSON input Line is the position in the array.
+type CatalogImportReport struct {
+	DryRun    bool              `json:"dry_run"`
+	Committed bool              `json:"committed"`
+	Total     int               `json:"total"`
+	Created   int               `json:"created"`
+	Updated   int               `json:"updated"`
+	Deleted   int               `json:"deleted"`
+	Failed    int               `json:"failed"`
+	Errors    []ImportLineError `json:"errors"` // Key is the SKU of the row
+}
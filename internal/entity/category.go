@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CatalogCategory is a node of the catalog category tree.
+// Path joins the slugs from the root down to the category with "/", e.g. "electronics/phones".
+// It is unique and changes when the category or one of its ancestors is renamed or moved.
+type CatalogCategory struct {
+	ID        uuid.UUID `json:"id"`
+	ParentID  uuid.UUID `json:"parent_id"` // uuid.Nil for root categories
+	Slug      string    `json:"slug"`
+	Name      string    `json:"name"`
+	Path      string    `json:"path"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CatalogTag is a tag together with the number of items carrying it.
+type CatalogTag struct {
+	Name  string `json:"name"`
+	Items int64  `json:"items"`
+}
@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CatalogChangesetStatus is the life cycle stage of a changeset.
+type CatalogChangesetStatus string
+
+const (
+	CatalogChangesetDraft      CatalogChangesetStatus = "draft"
+	CatalogChangesetPublished  CatalogChangesetStatus = "published"
+	CatalogChangesetRolledBack CatalogChangesetStatus = "rolled_back"
+)
+
+// CatalogChangeset groups staged catalog edits. Drafts are collected while it is a draft,
+// applied together when it is published, and undone together when it is rolled back.
+type CatalogChangeset struct {
+	ID           uuid.UUID              `json:"id"`
+	Title        string                 `json:"title"`
+	Status       CatalogChangesetStatus `json:"status"`
+	CreatedBy    uuid.UUID              `json:"created_by"`
+	CreatedAt    time.Time              `json:"created_at"`
+	PublishedBy  uuid.UUID              `json:"published_by"`
+	PublishedAt  *time.Time             `json:"published_at,omitempty"`
+	RolledBackBy uuid.UUID              `json:"rolled_back_by"`
+	RolledBackAt *time.Time             `json:"rolled_back_at,omitempty"`
+	Drafts       []CatalogDraft         `json:"drafts,omitempty"` // Only loaded for a single changeset
+}
+
+// CatalogDraftAction is what publishing a draft does to its item.
+type CatalogDraftAction string
+
+const (
+	CatalogDraftUpsert CatalogDraftAction = "upsert"
+	CatalogDraftDelete CatalogDraftAction = "delete"
+)
+
+// CatalogDraft is the staged next version of one catalog item, or its deletion.
+type CatalogDraft struct {
+	Action CatalogDraftAction `json:"action"`
+	// Item is the version to publish. Only Item.ID is meaningful for deletions.
+	Item CatalogItem `json:"item"`
+	// BaseUpdatedAt is the version of the item the draft was staged against, nil for new items.
+	// Publishing fails if the item changed since.
+	BaseUpdatedAt *time.Time `json:"base_updated_at,omitempty"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
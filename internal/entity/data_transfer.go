@@ -0,0 +1,56 @@
+package entity
+
+import "github.com/google/uuid"
+
+// DataFormat is a serialization format for bulk data import and export.
+type DataFormat string
+
+const (
+	DataFormatNDJSON DataFormat = "ndjson"
+	DataFormatCSV    DataFormat = "csv"
+	DataFormatJSON   DataFormat = "json" // A single JSON array; only catalog imports accept it
+)
+
+// ConflictMode controls what an import does with keys that already exist.
+type ConflictMode string
+
+const (
+	ConflictUpsert ConflictMode = "upsert"
+	ConflictSkip   ConflictMode = "skip"
+	ConflictFail   ConflictMode = "fail"
+)
+
+// ImportOptions configures a bulk data import.
+type ImportOptions struct {
+	OwnerID    uuid.UUID // Recorded as the owner of every imported entry
+	Format     DataFormat
+	OnConflict ConflictMode
+	DryRun     bool
+	ChunkSize  int // Zero runs the whole import in a single transaction
+}
+
+// ImportChunkResult is the outcome of writing one chunk of imported data.
+type ImportChunkResult struct {
+	Created   int
+	Updated   int
+	Conflicts []string // Existing keys that were skipped or caused the chunk to fail
+}
+
+// ImportLineError describes why a single input record was not imported.
+type ImportLineError struct {
+	Line    int    `json:"line"`
+	Key     string `json:"key,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportReport summarizes a bulk data import.
+type ImportReport struct {
+	DryRun  bool              `json:"dry_run"`
+	Aborted bool              `json:"aborted"`
+	Total   int               `json:"total"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Skipped int               `json:"skipped"`
+	Failed  int               `json:"failed"`
+	Errors  []ImportLineError `json:"errors"`
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNotFound is returned when the requested record does not exist.
+var ErrNotFound = errors.New("not found")
+
+// ErrConflict is returned when a write clashes with existing records, e.g. a duplicate name.
+var ErrConflict = errors.New("conflict")
+
+// ValidationDetail points to a single invalid part of the input.
+type ValidationDetail struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// ValidationError is returned when user input is rejected by business rules.
+type ValidationError struct {
+	Message string             `json:"message"`
+	Details []ValidationDetail `json:"details,omitempty"`
+}
+
+// NewValidationError creates a ValidationError with optional details.
+func NewValidationError(message string, details ...ValidationDetail) *ValidationError {
+	return &ValidationError{
+		Message: message,
+		Details: details,
+	}
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Details) == 0 {
+		return e.Message
+	}
+	parts := make([]string, len(e.Details))
+	for i, d := range e.Details {
+		parts[i] = d.Message
+		if d.Path != "" {
+			parts[i] = d.Path + ": " + d.Message
+		}
+	}
+	return e.Message + ": " + strings.Join(parts, "; ")
+}
@@ -0,0 +1,11 @@
+package entity
+
+// IdempotencyRecord is the stored outcome of a request made with an Idempotency-Key.
+// A record that is not Completed marks a request that is still being processed.
+type IdempotencyRecord struct {
+	RequestHash string `json:"request_hash"`
+	Completed   bool   `json:"completed"`
+	StatusCode  int    `json:"status_code,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
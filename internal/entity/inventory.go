@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CatalogAvailability combines the disabled flag of a catalog item with its stock.
+type CatalogAvailability string
+
+const (
+	CatalogInStock    CatalogAvailability = "in_stock"
+	CatalogOutOfStock CatalogAvailability = "out_of_stock"
+	CatalogDisabled   CatalogAvailability = "disabled"
+)
+
+// AvailableQuantity returns the units on hand that are not reserved, or nil when the stock of
+// the item is not tracked. It is never negative, even when the stock was lowered below the
+// reserved quantity.
+func (i *CatalogItem) AvailableQuantity() *int64 {
+	if i.StockQuantity == nil {
+		return nil
+	}
+	available := max(*i.StockQuantity-i.ReservedQuantity, 0)
+	return &available
+}
+
+// Availability tells whether the item can be ordered. Items without tracked stock are in
+// stock as long as they are enabled.
+func (i *CatalogItem) Availability() CatalogAvailability {
+	if i.Disabled {
+		return CatalogDisabled
+	}
+	if available := i.AvailableQuantity(); available != nil && *available == 0 {
+		return CatalogOutOfStock
+	}
+	return CatalogInStock
+}
+
+// CatalogReservation holds Quantity units of an item for a user until ExpiresAt. Expired
+// reservations no longer count against the stock.
+type CatalogReservation struct {
+	ID       uuid.UUID `json:"id"`
+	ItemID   uuid.UUID `json:"item_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	Quantity int64     `json:"quantity"`
+	// UnitPrice is the item price when the reservation was made; nil for items without a price.
+	UnitPrice *Money    `json:"unit_price,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Total returns the price of all reserved units, or nil when the item had no price.
+func (r *CatalogReservation) Total() (*Money, error) {
+	if r.UnitPrice == nil {
+		return nil, nil
+	}
+	total, err := r.UnitPrice.Mul(r.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	return &total, nil
+}
+
+// CatalogReservationPolicy sets how long reservations last.
+type CatalogReservationPolicy struct {
+	DefaultTTL time.Duration // Used when the client asks for no particular TTL
+	MaxTTL     time.Duration
+}
+
+// CatalogInventoryUpdate replaces the price and stock of a catalog item.
+type CatalogInventoryUpdate struct {
+	PriceAmount   string // Decimal in major units, e.g. "19.99"; empty removes the price
+	Currency      string // ISO 4217 code; required with PriceAmount
+	StockQuantity *int64 // Nil stops tracking the stock
+}
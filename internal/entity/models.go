@@ -1,26 +1,173 @@
 package entity
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
 type User struct {
 	ID        uuid.UUID `json:"id"`
 	Email     string    `json:"email"`
 	Password  string    `json:"-"` // The password hash, ignored by json marshalling
+	Role      string    `json:"role"`
+	Locale    string    `json:"locale,omitempty"` // Preferred locale for localized content, empty if unset
 	CreatedAt time.Time `json:"created_at"`
 }
 
 type Data struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	OwnerID   uuid.UUID       `json:"-"` // User who wrote this version; counted against their quota
+	Key       string          `json:"key"`
+	Value     json.RawMessage `json:"value"`
+	TTL       time.Duration   `json:"-"` // Relative lifetime, converted to ExpiresAt on save
+	ExpiresAt *time.Time      `json:"expires_at,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+	DeletedAt *time.Time      `json:"deleted_at,omitempty"` // Only set on reads of deleted keys
+}
+
+// DataEventType is the kind of change a DataEvent describes.
+type DataEventType string
+
+const (
+	DataEventCreated DataEventType = "create"
+	DataEventUpdated DataEventType = "update"
+	DataEventDeleted DataEventType = "delete"
+)
+
+// DataEvent describes a change of a data key.
+// ID is the serial id of the affected row; it is only meaningful for resuming
+// a feed after creates and updates, so it is zero for deletions.
+type DataEvent struct {
+	ID   int64         `json:"-"`
+	Type DataEventType `json:"type"`
+	Key  string        `json:"key"`
+	Data *Data         `json:"data,omitempty"` // Nil for deletions
+}
+
+// DataSchema is a JSON Schema that values of all keys starting with Prefix must satisfy.
+type DataSchema struct {
+	Prefix    string          `json:"prefix"`
+	Schema    json.RawMessage `json:"schema"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
 }
 
 type CatalogItem struct {
 	ID          uuid.UUID `json:"id"`
+	SKU         string    `json:"sku,omitempty"` // External key of imported items; empty for items created through the API
 	Title       string    `json:"title"`
 	Description string    `json:"description"`
 	Disabled    bool      `json:"disabled"`
+	Tags        []string  `json:"tags"`
+	CategoryID  uuid.UUID `json:"category_id"` // uuid.Nil when the item is not categorized
+	// CategoryPath is the path of the item's category; it is filled in by the repository.
+	CategoryPath string    `json:"category_path,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	// SearchLanguage is the text search configuration the item is indexed with. It is set on writes only.
+	SearchLanguage string `json:"-"`
+	// Locale is the locale of Title and Description. It is only set on localized reads.
+	Locale string `json:"locale,omitempty"`
+	// Images are ordered by upload time; they are filled in by the repository.
+	Images []CatalogImage `json:"images,omitempty"`
+	// IsFavorite tells whether the reading user favorited the item. It is only set on reads
+	// for a user and never stored or cached with the item.
+	IsFavorite bool `json:"-"`
+	// Price is nil for items without a price.
+	Price *Money `json:"price,omitempty"`
+	// StockQuantity is the number of units on hand, nil when the stock is not tracked.
+	// ReservedQuantity counts the units held by unexpired reservations; it is filled in by the repository.
+	StockQuantity    *int64 `json:"stock_quantity,omitempty"`
+	ReservedQuantity int64  `json:"reserved_quantity,omitempty"`
+	// ReviewCount and RatingSum are the running totals of the approved reviews of the item;
+	// they are filled in by the repository.
+	ReviewCount int64 `json:"review_count,omitempty"`
+	RatingSum   int64 `json:"rating_sum,omitempty"`
+	// DeletedAt is only set on reads of deleted items.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// CatalogSort orders a catalog listing. A leading "-" sorts descending; ties are broken by id.
+type CatalogSort string
+
+const (
+	CatalogSortTitle         CatalogSort = "title"
+	CatalogSortTitleDesc     CatalogSort = "-title"
+	CatalogSortCreatedAt     CatalogSort = "created_at"
+	CatalogSortCreatedAtDesc CatalogSort = "-created_at"
+)
+
+// CatalogQuery filters and orders a page of catalog items. Zero values do not filter.
+type CatalogQuery struct {
+	Disabled    *bool
+	TitlePrefix string
+	Tag         string
+	Category    string // Category path; matches the whole subtree
+	Sort        CatalogSort
+	Limit       int
+	After       *CatalogCursor
+}
+
+// CatalogCursor identifies the last item of a page, so the next page starts right after it.
+type CatalogCursor struct {
+	Sort      CatalogSort `json:"s"`
+	ID        uuid.UUID   `json:"id"`
+	Title     string      `json:"t,omitempty"`
+	CreatedAt time.Time   `json:"c,omitzero"`
+}
+
+// CatalogFavorite is a favorite catalog item of a user.
+type CatalogFavorite struct {
+	Item    CatalogItem
+	AddedAt time.Time
+}
+
+// CatalogFavoriteCursor identifies the last favorite of a page, so the next page starts right
+// after it.
+type CatalogFavoriteCursor struct {
+	ItemID  uuid.UUID `json:"id"`
+	AddedAt time.Time `json:"a"`
+}
+
+// CatalogSearchSettings configures catalog search.
+type CatalogSearchSettings struct {
+	Language       string  // PostgreSQL text search configuration, e.g. "english" or "simple"
+	FuzzyThreshold float32 // Minimum word similarity (0..1) for typo-tolerant matches
+}
+
+// CatalogSearchQuery is a full-text query over catalog titles and descriptions.
+type CatalogSearchQuery struct {
+	Query          string
+	Disabled       *bool
+	Limit          int
+	Language       string
+	FuzzyThreshold float32
+}
+
+// CatalogSearchHit is a matching item. The highlights are HTML-escaped text in which
+// matched words are wrapped in <mark> elements.
+type CatalogSearchHit struct {
+	Item                 CatalogItem
+	Score                float32
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+// CatalogSearchResult lists hits by descending score. Fuzzy is set when no item matched the
+// full-text query and the hits are typo-tolerant title matches instead.
+type CatalogSearchResult struct {
+	Hits  []CatalogSearchHit
+	Fuzzy bool
+}
+
+// CatalogPage is one page of catalog items. NextCursor is empty on the last page.
+type CatalogPage struct {
+	Items      []CatalogItem
+	NextCursor string
 }
@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+// ErrMoneyOverflow is returned when an amount does not fit into Money.
+var ErrMoneyOverflow = errors.New("amount out of range")
+
+// MaxMoneyIntegerDigits bounds the integer part of parsed amounts, so they fit the
+// NUMERIC(19, 4) price columns.
+const MaxMoneyIntegerDigits = 15
+
+// Money is an amount in the minor units of an ISO 4217 currency, e.g. cents of EUR, so
+// amounts are exact and never rounded. Amounts of different currencies are never mixed.
+type Money struct {
+	Amount   int64  `json:"amount"`   // In minor units of Currency
+	Currency string `json:"currency"` // Upper case ISO 4217 code
+}
+
+// ParseMoney parses a non-negative decimal amount such as "19.99" in the currency with the
+// given ISO 4217 code. The amount may not have more fraction digits than the currency has,
+// nor more than MaxMoneyIntegerDigits integer digits.
+func ParseMoney(amount, code string) (Money, error) {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return Money{}, fmt.Errorf("unknown currency %q", code)
+	}
+	scale := CurrencyScale(unit.String())
+
+	whole, frac, _ := strings.Cut(amount, ".")
+	frac = strings.TrimRight(frac, "0")
+	if whole == "" || !isDigits(whole) || !isDigits(frac) || strings.HasSuffix(amount, ".") {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	if len(frac) > scale {
+		return Money{}, fmt.Errorf("%s amounts have at most %d fraction digits", unit, scale)
+	}
+	if len(strings.TrimLeft(whole, "0")) > MaxMoneyIntegerDigits {
+		return Money{}, fmt.Errorf("amounts have at most %d integer digits", MaxMoneyIntegerDigits)
+	}
+
+	minor, err := strconv.ParseInt(whole+frac+strings.Repeat("0", scale-len(frac)), 10, 64)
+	if err != nil {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: minor, Currency: unit.String()}, nil
+}
+
+// CurrencyScale returns the number of fraction digits of a currency, e.g. 2 for EUR and 0 for JPY.
+func CurrencyScale(code string) int {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return 0
+	}
+	scale, _ := currency.Standard.Rounding(unit)
+	return scale
+}
+
+// Mul returns m times n. It fails instead of wrapping around on overflow.
+func (m Money) Mul(n int64) (Money, error) {
+	amount := m.Amount * n
+	// MinInt64 * -1 wraps around to itself, so dividing back does not reveal that overflow.
+	if n != 0 && (amount/n != m.Amount || n == -1 && m.Amount == math.MinInt64) {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: amount, Currency: m.Currency}, nil
+}
+
+// Decimal formats the amount in major units with all fraction digits of the currency, e.g. "19.90".
+func (m Money) Decimal() string {
+	scale := CurrencyScale(m.Currency)
+	digits := strconv.FormatInt(m.Amount, 10)
+	sign := ""
+	if m.Amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
+
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		amount  string
+		code    string
+		want    Money
+		wantErr bool
+	}{
+		{"19.99", "EUR", Money{Amount: 1999, Currency: "EUR"}, false},
+		{"19.9", "eur", Money{Amount: 1990, Currency: "EUR"}, false},
+		{"19", "USD", Money{Amount: 1900, Currency: "USD"}, false},
+		{"0.01", "USD", Money{Amount: 1, Currency: "USD"}, false},
+		{"19.900", "EUR", Money{Amount: 1990, Currency: "EUR"}, false}, // Trailing zeros are not precision
+		{"007", "EUR", Money{Amount: 700, Currency: "EUR"}, false},
+		{"1500", "JPY", Money{Amount: 1500, Currency: "JPY"}, false},
+		{"1.5", "JPY", Money{}, true},
+		{"1.001", "EUR", Money{}, true},
+		{"1.234", "BHD", Money{Amount: 1234, Currency: "BHD"}, false},
+		{"999999999999999.99", "EUR", Money{Amount: 99999999999999999, Currency: "EUR"}, false},
+		{"1000000000000000", "EUR", Money{}, true}, // 16 integer digits do not fit NUMERIC(19, 4)
+		{"000999999999999999", "JPY", Money{Amount: 999999999999999, Currency: "JPY"}, false},
+		{"999999999999999", "BHD", Money{Amount: 999999999999999000, Currency: "BHD"}, false},
+		{"92233720368547758.07", "EUR", Money{}, true},
+		{"", "EUR", Money{}, true},
+		{".5", "EUR", Money{}, true},
+		{"5.", "EUR", Money{}, true},
+		{"-5", "EUR", Money{}, true},
+		{"+5", "EUR", Money{}, true},
+		{"1,50", "EUR", Money{}, true},
+		{"1.5.0", "EUR", Money{}, true},
+		{" 1", "EUR", Money{}, true},
+		{"1", "XYZ", Money{}, true},
+		{"1", "", Money{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.amount+" "+tt.code, func(t *testing.T) {
+			got, err := ParseMoney(tt.amount, tt.code)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseMoney(%q, %q) = %v, %v; want %v, error %v", tt.amount, tt.code, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMoneyDecimal(t *testing.T) {
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{Money{Amount: 1999, Currency: "EUR"}, "19.99 EUR"},
+		{Money{Amount: 5, Currency: "EUR"}, "0.05 EUR"},
+		{Money{Amount: 0, Currency: "EUR"}, "0.00 EUR"},
+		{Money{Amount: -5, Currency: "EUR"}, "-0.05 EUR"},
+		{Money{Amount: -1999, Currency: "EUR"}, "-19.99 EUR"},
+		{Money{Amount: 1500, Currency: "JPY"}, "1500 JPY"},
+		{Money{Amount: 1, Currency: "BHD"}, "0.001 BHD"},
+		{Money{Amount: math.MinInt64, Currency: "EUR"}, "-92233720368547758.08 EUR"},
+	}
+	for _, tt := range tests {
+		if got := tt.m.String(); got != tt.want {
+			t.Errorf("%#v.String() = %q, want %q", tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyDecimalRoundTrip(t *testing.T) {
+	for _, amount := range []int64{0, 1, 10, 99, 100, 1999, 999999999999999} {
+		for _, code := range []string{"EUR", "JPY", "BHD"} {
+			m := Money{Amount: amount, Currency: code}
+			got, err := ParseMoney(m.Decimal(), code)
+			if err != nil || got != m {
+				t.Errorf("ParseMoney(%q, %q) = %v, %v; want %v", m.Decimal(), code, got, err, m)
+			}
+		}
+	}
+}
+
+func TestMoneyMul(t *testing.T) {
+	tests := []struct {
+		amount  int64
+		n       int64
+		want    int64
+		wantErr bool
+	}{
+		{1999, 3, 5997, false},
+		{1999, 0, 0, false},
+		{0, math.MaxInt64, 0, false},
+		{1999, -3, -5997, false},
+		{-1999, 3, -5997, false},
+		{-1999, -3, 5997, false},
+		{math.MaxInt64, 1, math.MaxInt64, false},
+		{math.MaxInt64, -1, -math.MaxInt64, false},
+		{math.MinInt64, 1, math.MinInt64, false},
+		{-1, math.MaxInt64, -math.MaxInt64, false},
+		{math.MaxInt64 / 2, 2, math.MaxInt64 - 1, false},
+		{math.MaxInt64/2 + 1, 2, 0, true},
+		{math.MinInt64 / 2, 2, math.MinInt64, false},
+		{math.MinInt64/2 - 1, 2, 0, true},
+		{math.MaxInt64, -2, 0, true},
+		{math.MinInt64, -1, 0, true},
+		{-1, math.MinInt64, 0, true},
+		{math.MinInt64 / 2, -2, 0, true},
+		{-(math.MaxInt64 / 2), -2, math.MaxInt64 - 1, false},
+		{2, math.MinInt64 / 2, math.MinInt64, false},
+		{-2, math.MinInt64 / 2, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := Money{Amount: tt.amount, Currency: "EUR"}.Mul(tt.n)
+		switch {
+		case tt.wantErr && !errors.Is(err, ErrMoneyOverflow):
+			t.Errorf("Money{%d}.Mul(%d) = %v, %v; want ErrMoneyOverflow", tt.amount, tt.n, got, err)
+		case !tt.wantErr && (err != nil || got != Money{Amount: tt.want, Currency: "EUR"}):
+			t.Errorf("Money{%d}.Mul(%d) = %v, %v; want %d", tt.amount, tt.n, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEventType names a domain event. Events are thin: their payload identifies what
+// changed, and consumers read the current state through the API.
+type OutboxEventType string
+
+const (
+	EventDataSaved    OutboxEventType = "data.saved"
+	EventDataDeleted  OutboxEventType = "data.deleted"
+	EventDataRestored OutboxEventType = "data.restored"
+
+	EventCatalogCreated  OutboxEventType = "catalog.created"
+	EventCatalogUpdated  OutboxEventType = "catalog.updated"
+	EventCatalogDeleted  OutboxEventType = "catalog.deleted"
+	EventCatalogRestored OutboxEventType = "catalog.restored"
+
+	EventUserCreated OutboxEventType = "user.created"
+)
+
+// Valid reports whether t is a known event type.
+func (t OutboxEventType) Valid() bool {
+	switch t {
+	case EventDataSaved, EventDataDeleted, EventDataRestored,
+		EventCatalogCreated, EventCatalogUpdated, EventCatalogDeleted, EventCatalogRestored,
+		EventUserCreated:
+		return true
+	}
+	return false
+}
+
+// OutboxEvent is a domain event stored in the transaction of the change it describes.
+// Events are delivered at least once, so consumers must ignore ids they have already seen.
+type OutboxEvent struct {
+	ID        int64           `json:"id"`
+	Type      OutboxEventType `json:"type"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+	// Attempts counts the failed deliveries so far; LastAttemptAt is the time of the last one.
+	Attempts      int       `json:"-"`
+	LastAttemptAt time.Time `json:"-"`
+}
+
+// DataEventPayload is the payload of data.* events. Values are left out, so events never
+// carry data that is encrypted at rest.
+type DataEventPayload struct {
+	Key string `json:"key"`
+}
+
+// CatalogEventPayload is the payload of catalog.* events.
+type CatalogEventPayload struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// UserEventPayload is the payload of user.* events.
+type UserEventPayload struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// RetryPolicy sets how long to wait before retrying a failed delivery. The wait doubles with
+// every failure, starting at InitialBackoff, up to MaxBackoff.
+type RetryPolicy struct {
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff returns the wait after the given number of failed attempts.
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+	backoff := p.InitialBackoff
+	for i := 1; i < attempts && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, p.MaxBackoff)
+}
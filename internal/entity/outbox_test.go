@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, 0},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 8 * time.Second},
+		{5, 10 * time.Second},
+		{1000, 10 * time.Second}, // Doubling stops at the cap, so it never overflows
+	}
+	for _, tt := range tests {
+		if got := p.Backoff(tt.attempts); got != tt.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package entity
+
+import "fmt"
+
+// DataQuota limits what a single user may store. A zero limit is disabled.
+type DataQuota struct {
+	MaxKeys       int64 `json:"max_keys"`
+	MaxValueBytes int64 `json:"max_value_bytes"`
+	MaxTotalBytes int64 `json:"max_total_bytes"`
+}
+
+// DataUsage is the storage consumed by the live keys a user wrote last.
+type DataUsage struct {
+	KeyCount   int64     `json:"key_count"`
+	TotalBytes int64     `json:"total_bytes"`
+	Quota      DataQuota `json:"quota"`
+}
+
+// QuotaKind names the limit a QuotaError refers to.
+type QuotaKind string
+
+const (
+	QuotaKeys       QuotaKind = "keys"
+	QuotaValueSize  QuotaKind = "value_size"
+	QuotaTotalBytes QuotaKind = "total_bytes"
+)
+
+// QuotaError is returned when a write would exceed a DataQuota limit.
+type QuotaError struct {
+	Kind      QuotaKind
+	Limit     int64
+	Requested int64 // Usage the write would have resulted in
+}
+
+func (e *QuotaError) Error() string {
+	switch e.Kind {
+	case QuotaKeys:
+		return fmt.Sprintf("key quota exceeded: %d of %d keys", e.Requested, e.Limit)
+	case QuotaValueSize:
+		return fmt.Sprintf("value is too large: %d bytes, at most %d allowed", e.Requested, e.Limit)
+	default:
+		return fmt.Sprintf("storage quota exceeded: %d of %d bytes", e.Requested, e.Limit)
+	}
+}
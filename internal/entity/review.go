@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CatalogReviewStatus is the moderation state of a review. Only approved reviews are shown
+// to other users and count towards the rating of an item.
+type CatalogReviewStatus string
+
+const (
+	CatalogReviewPending  CatalogReviewStatus = "pending"
+	CatalogReviewApproved CatalogReviewStatus = "approved"
+	CatalogReviewRejected CatalogReviewStatus = "rejected"
+)
+
+// Valid tells whether s is one of the known statuses.
+func (s CatalogReviewStatus) Valid() bool {
+	switch s {
+	case CatalogReviewPending, CatalogReviewApproved, CatalogReviewRejected:
+		return true
+	}
+	return false
+}
+
+const (
+	MinCatalogRating = 1
+	MaxCatalogRating = 5
+)
+
+// CatalogReview is the rating and review text a user left for a catalog item. A user has at
+// most one review per item.
+type CatalogReview struct {
+	ID        uuid.UUID           `json:"id"`
+	ItemID    uuid.UUID           `json:"item_id"`
+	UserID    uuid.UUID           `json:"user_id"`
+	Rating    int                 `json:"rating"`
+	Body      string              `json:"body"`
+	Status    CatalogReviewStatus `json:"status"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// CatalogReviewPolicy sets how reviews are moderated.
+type CatalogReviewPolicy struct {
+	RequireApproval bool // New and edited reviews stay pending until an admin approves them
+	MaxBodyLength   int  // In characters
+}
+
+// AverageRating returns the mean rating of the approved reviews of the item, or 0 if it has none.
+func (i *CatalogItem) AverageRating() float64 {
+	if i.ReviewCount == 0 {
+		return 0
+	}
+	return float64(i.RatingSum) / float64(i.ReviewCount)
+}
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CatalogTranslation is the title and description of a catalog item in one locale other
+// than the default locale, whose text is stored on the item itself.
+type CatalogTranslation struct {
+	ItemID      uuid.UUID `json:"item_id"`
+	Locale      string    `json:"locale"` // Canonical BCP 47 tag, e.g. "de" or "pt-BR"
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// LocalePreference is what a reader asked for. Profile wins over AcceptLanguage; the
+// default locale is used when neither has a translation.
+type LocalePreference struct {
+	Profile        string // The user's profile setting, empty if unset
+	AcceptLanguage string // Raw Accept-Language header value
+}
@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEndpoint is a URL registered by an admin to receive events of the subscribed types.
+type WebhookEndpoint struct {
+	ID  uuid.UUID
+	URL string
+	// Secret signs every delivery; receivers verify the X-Webhook-Signature header with it.
+	Secret     string
+	EventTypes []OutboxEventType
+	// Enabled is cleared by an admin or after too many failed deliveries in a row.
+	Enabled             bool
+	ConsecutiveFailures int
+	DisabledAt          *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// WebhookDeliveryStatus is the state of the delivery of one event to one endpoint.
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryFailed means every attempt failed; only a redelivery sends it again.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// Valid reports whether s is a known status.
+func (s WebhookDeliveryStatus) Valid() bool {
+	switch s {
+	case WebhookDeliveryPending, WebhookDeliverySucceeded, WebhookDeliveryFailed:
+		return true
+	}
+	return false
+}
+
+// WebhookDelivery is the delivery of one event to one endpoint together with the outcome of
+// its latest attempt.
+type WebhookDelivery struct {
+	ID            uuid.UUID
+	EndpointID    uuid.UUID
+	Event         OutboxEvent
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastAttemptAt *time.Time
+	// ResponseStatus is the HTTP status of the latest attempt, or 0 if no response arrived.
+	ResponseStatus int
+	LastError      string
+	CreatedAt      time.Time
+}
+
+// WebhookDispatch is a due delivery with the endpoint it goes to.
+type WebhookDispatch struct {
+	WebhookDelivery
+	URL    string
+	Secret string
+}
+
+// WebhookPolicy limits webhook deliveries. A delivery is given up after MaxAttempts attempts,
+// and an endpoint is disabled after DisableAfter failed attempts in a row.
+type WebhookPolicy struct {
+	Retry        RetryPolicy
+	MaxAttempts  int
+	DisableAfter int
+	// Timeout bounds a single attempt.
+	Timeout time.Duration
+}
+
+// WebhookDeliveryResult summarizes the attempts made in one round of webhook deliveries.
+type WebhookDeliveryResult struct {
+	Succeeded int // Deliveries accepted by their endpoint
+	Failed    int // Failed attempts that will be retried
+	Abandoned int // Failed attempts that used up the attempts of their delivery
+}
+
+// Attempts returns the number of attempts made.
+func (r *WebhookDeliveryResult) Attempts() int {
+	return r.Succeeded + r.Failed + r.Abandoned
+}
@@ -0,0 +1,157 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"base_app/internal/entity"
+	v1 "base_app/internal/handler/http/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// attachmentFormField is the multipart field that carries the uploaded file.
+const attachmentFormField = "file"
+
+// UploadAttachment attaches a file to a data key.
+//
+// POST /api/v1/data/attachments?key=<key> (multipart/form-data with a "file" field)
+//
+// The file is streamed into the blob store part by part, so uploads are never buffered in
+// memory. It is served outside the ogen router because the generated decoder parses the whole
+// form up front and the server timeouts are too short for large files.
+func (h *Handler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := h.userID(ctx)
+	if userID == uuid.Nil {
+		writeError(w, http.StatusUnauthorized, http.StatusText(http.StatusUnauthorized))
+		return
+	}
+	h.extendDeadlines(w)
+
+	mr, err := r.MultipartReader()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "request must be multipart/form-data")
+		return
+	}
+	part, err := mr.NextPart()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "malformed multipart body")
+		return
+	}
+	defer part.Close()
+	if part.FormName() != attachmentFormField {
+		writeError(w, http.StatusUnprocessableEntity, `the first form field must be "`+attachmentFormField+`"`)
+		return
+	}
+
+	att, err := h.attachmentUsecase.UploadAttachment(ctx, r.URL.Query().Get("key"), part.FileName(), userID, part)
+	if err != nil {
+		writeAttachmentError(w, err)
+		return
+	}
+
+	body, err := toAttachment(att).MarshalJSON()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/api/v1/data/attachments/"+att.ID.String()+"/content")
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(body)
+}
+
+// DownloadAttachment streams the content of an attachment.
+//
+// GET /api/v1/data/attachments/{id}/content
+//
+// Range and conditional requests are supported when the blob store returns a seekable reader.
+// The digest is the ETag, since content never changes under an attachment id.
+func (h *Handler) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if h.userID(ctx) == uuid.Nil {
+		writeError(w, http.StatusUnauthorized, http.StatusText(http.StatusUnauthorized))
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, http.StatusText(http.StatusNotFound))
+		return
+	}
+	h.extendDeadlines(w)
+
+	att, content, err := h.attachmentUsecase.OpenAttachment(ctx, id)
+	if err != nil {
+		writeAttachmentError(w, err)
+		return
+	}
+	defer content.Close()
+
+	header := w.Header()
+	header.Set("Content-Type", att.ContentType)
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": att.Name}))
+	header.Set("X-Content-Type-Options", "nosniff")
+	header.Set("ETag", `"`+att.Digest+`"`)
+	header.Set("Cache-Control", "private, no-cache")
+
+	if rs, ok := content.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, att.Name, att.CreatedAt, rs)
+		return
+	}
+	header.Set("Content-Length", strconv.FormatInt(att.Size, 10))
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		_, _ = io.Copy(w, content)
+	}
+}
+
+// extendDeadlines replaces the server read and write timeouts, which are sized for small
+// JSON requests, with the attachment transfer timeout.
+func (h *Handler) extendDeadlines(w http.ResponseWriter) {
+	rc := http.NewResponseController(w)
+	deadline := time.Now().Add(h.transferTimeout)
+	_ = rc.SetReadDeadline(deadline)
+	_ = rc.SetWriteDeadline(deadline)
+}
+
+func writeAttachmentError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		writeError(w, http.StatusNotFound, http.StatusText(http.StatusNotFound))
+	case errors.Is(err, entity.ErrTooLarge):
+		writeError(w, http.StatusRequestEntityTooLarge, err.Error())
+	case errors.Is(err, entity.ErrBlobMissing):
+		// Garbage collection removed identical content mid-upload; sending it again succeeds.
+		w.Header().Set("Retry-After", "1")
+		writeError(w, http.StatusServiceUnavailable, "upload raced with cleanup, please retry")
+	default:
+		if resp, ok := validationError(err); ok {
+			body, mErr := resp.MarshalJSON()
+			if mErr == nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write(body)
+				return
+			}
+		}
+		writeError(w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+	}
+}
+
+func toAttachment(att *entity.Attachment) *v1.Attachment {
+	return &v1.Attachment{
+		ID:          att.ID,
+		Key:         att.Key,
+		Name:        att.Name,
+		ContentType: att.ContentType,
+		Size:        att.Size,
+		Digest:      att.Digest,
+		CreatedAt:   att.CreatedAt,
+	}
+}
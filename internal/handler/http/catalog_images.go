@@ -0,0 +1,181 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"base_app/internal/entity"
+	v1 "base_app/internal/handler/http/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// catalogImageCacheControl lets clients keep image files for good: the content under an
+// image URL never changes, since replacing an image gives it a new id.
+const catalogImageCacheControl = "private, max-age=31536000, immutable"
+
+// UploadCatalogImage adds an image to a catalog item. Admin only.
+//
+// POST /api/v1/catalog/{id}/images (multipart/form-data with a "file" field)
+//
+// It is served outside the ogen router because the generated decoder parses the whole form
+// up front and the server timeouts are too short for large files.
+func (h *Handler) UploadCatalogImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if h.userID(ctx) == uuid.Nil {
+		writeError(w, http.StatusUnauthorized, http.StatusText(http.StatusUnauthorized))
+		return
+	}
+	if !h.isAdmin(ctx) {
+		writeError(w, http.StatusForbidden, http.StatusText(http.StatusForbidden))
+		return
+	}
+
+	itemID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, http.StatusText(http.StatusNotFound))
+		return
+	}
+	h.extendDeadlines(w)
+
+	mr, err := r.MultipartReader()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "request must be multipart/form-data")
+		return
+	}
+	part, err := mr.NextPart()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "malformed multipart body")
+		return
+	}
+	defer part.Close()
+	if part.FormName() != attachmentFormField {
+		writeError(w, http.StatusUnprocessableEntity, `the first form field must be "`+attachmentFormField+`"`)
+		return
+	}
+
+	img, err := h.catalogUsecase.UploadCatalogImage(ctx, itemID, part)
+	if err != nil {
+		writeAttachmentError(w, err)
+		return
+	}
+
+	resp := toCatalogImage(img)
+	body, err := resp.MarshalJSON()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", resp.URL)
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(body)
+}
+
+// DownloadCatalogImage streams the original of a catalog image.
+//
+// GET /api/v1/catalog/images/{image_id}
+func (h *Handler) DownloadCatalogImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if h.userID(ctx) == uuid.Nil {
+		writeError(w, http.StatusUnauthorized, http.StatusText(http.StatusUnauthorized))
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "image_id"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, http.StatusText(http.StatusNotFound))
+		return
+	}
+	h.extendDeadlines(w)
+
+	img, content, err := h.catalogUsecase.OpenCatalogImage(ctx, id)
+	if err != nil {
+		writeAttachmentError(w, err)
+		return
+	}
+	defer content.Close()
+
+	serveCatalogImage(w, r, img.ContentType, img.ID.String(), img.CreatedAt, img.Size, content)
+}
+
+// DownloadCatalogThumbnail streams a thumbnail of a catalog image, rendering it on first use.
+//
+// GET /api/v1/catalog/images/{image_id}/thumbnails/{size}
+func (h *Handler) DownloadCatalogThumbnail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if h.userID(ctx) == uuid.Nil {
+		writeError(w, http.StatusUnauthorized, http.StatusText(http.StatusUnauthorized))
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "image_id"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, http.StatusText(http.StatusNotFound))
+		return
+	}
+	size, err := strconv.Atoi(chi.URLParam(r, "size"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, http.StatusText(http.StatusNotFound))
+		return
+	}
+	h.extendDeadlines(w)
+
+	thumb, content, err := h.catalogUsecase.OpenCatalogThumbnail(ctx, id, size)
+	if err != nil {
+		writeAttachmentError(w, err)
+		return
+	}
+	defer content.Close()
+
+	serveCatalogImage(w, r, thumb.ContentType, id.String()+"_"+strconv.Itoa(size), time.Time{}, -1, content)
+}
+
+// serveCatalogImage writes image content with headers that let clients cache it forever.
+// etag identifies the content; a zero modTime is not sent. size is only used when content
+// cannot seek, -1 if unknown.
+func serveCatalogImage(w http.ResponseWriter, r *http.Request, contentType, etag string, modTime time.Time, size int64, content io.ReadCloser) {
+	header := w.Header()
+	header.Set("Content-Type", contentType)
+	header.Set("X-Content-Type-Options", "nosniff")
+	header.Set("ETag", `"`+etag+`"`)
+	header.Set("Cache-Control", catalogImageCacheControl)
+
+	if rs, ok := content.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, "", modTime, rs)
+		return
+	}
+	if size >= 0 {
+		header.Set("Content-Length", strconv.FormatInt(size, 10))
+	}
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		_, _ = io.Copy(w, content)
+	}
+}
+
+func toCatalogImage(img *entity.CatalogImage) *v1.CatalogImage {
+	url := "/api/v1/catalog/images/" + img.ID.String()
+	resp := &v1.CatalogImage{
+		ID:          img.ID,
+		URL:         url,
+		ContentType: img.ContentType,
+		Width:       img.Width,
+		Height:      img.Height,
+		Size:        img.Size,
+		Thumbnails:  make([]v1.CatalogThumbnail, len(img.Thumbnails)),
+		CreatedAt:   img.CreatedAt,
+	}
+	for i, t := range img.Thumbnails {
+		resp.Thumbnails[i] = v1.CatalogThumbnail{
+			Size:        t.Size,
+			URL:         url + "/thumbnails/" + strconv.Itoa(t.Size),
+			ContentType: t.ContentType,
+			Width:       t.Width,
+			Height:      t.Height,
+		}
+	}
+	return resp
+}
@@ -1,27 +1,37 @@
 package http
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"io/fs"
 	"net/http"
 	"strings"
+	"time"
 
 	"base_app/internal/entity"
 	v1 "base_app/internal/handler/http/v1"
 	"base_app/internal/usecase"
 	"github.com/alexedwards/scs/v2"
+	"github.com/go-faster/jx"
 	"github.com/google/uuid"
 )
 
 // Handler is the implementation of the ogen-generated interface.
 // It connects the generated server with the application's use cases.
 type Handler struct {
-	authUsecase    usecase.AuthUsecase
-	dataUsecase    usecase.DataUsecase
-	catalogUsecase usecase.CatalogUsecase
-	sessionManager *scs.SessionManager
-	contentFS      fs.FS
+	authUsecase       usecase.AuthUsecase
+	dataUsecase       usecase.DataUsecase
+	catalogUsecase    usecase.CatalogUsecase
+	attachmentUsecase usecase.AttachmentUsecase
+	webhookUsecase    usecase.WebhookUsecase
+	sessionManager    *scs.SessionManager
+	contentFS         fs.FS
+	// transferTimeout bounds attachment transfers and data imports and exports instead of the
+	// server timeouts.
+	transferTimeout time.Duration
 }
 
 // NewHandler creates a new handler implementation.
@@ -29,15 +39,21 @@ func NewHandler(
 	authUC usecase.AuthUsecase,
 	dataUC usecase.DataUsecase,
 	catalogUC usecase.CatalogUsecase,
+	attachmentUC usecase.AttachmentUsecase,
+	webhookUC usecase.WebhookUsecase,
 	sm *scs.SessionManager,
 	contentFS fs.FS,
+	transferTimeout time.Duration,
 ) *Handler {
 	return &Handler{
-		authUsecase:    authUC,
-		dataUsecase:    dataUC,
-		catalogUsecase: catalogUC,
-		sessionManager: sm,
-		contentFS:      contentFS,
+		authUsecase:       authUC,
+		dataUsecase:       dataUC,
+		catalogUsecase:    catalogUC,
+		attachmentUsecase: attachmentUC,
+		webhookUsecase:    webhookUC,
+		sessionManager:    sm,
+		contentFS:         contentFS,
+		transferTimeout:   transferTimeout,
 	}
 }
 
@@ -56,13 +72,19 @@ func (h *Handler) Login(ctx context.Context, req *v1.LoginRequest) (v1.LoginRes,
 
 	h.sessionManager.Put(ctx, "userID", user.ID.String())
 	h.sessionManager.Put(ctx, "userEmail", user.Email)
+	h.sessionManager.Put(ctx, "userRole", user.Role)
+	h.sessionManager.Put(ctx, "userLocale", user.Locale)
 
 	// Convert entity.User to v1.User
 	response := &v1.User{
 		ID:        v1.NewOptUUID(user.ID),
 		Email:     v1.NewOptString(user.Email),
+		Role:      v1.NewOptString(user.Role),
 		CreatedAt: v1.NewOptDateTime(user.CreatedAt),
 	}
+	if user.Locale != "" {
+		response.Locale = v1.NewOptString(user.Locale)
+	}
 	return response, nil
 }
 
@@ -82,76 +104,1680 @@ func (h *Handler) GetMe(ctx context.Context) (v1.GetMeRes, error) {
 	}
 
 	userEmail := h.sessionManager.GetString(ctx, "userEmail")
+	userRole := h.sessionManager.GetString(ctx, "userRole")
 	parsedID, _ := uuid.Parse(userID)
 
 	response := &v1.User{
 		ID:    v1.NewOptUUID(parsedID),
 		Email: v1.NewOptString(userEmail),
+		Role:  v1.NewOptString(userRole),
 		// CreatedAt is not available in session, so it's omitted
 	}
+	if locale := h.sessionManager.GetString(ctx, "userLocale"); locale != "" {
+		response.Locale = v1.NewOptString(locale)
+	}
 	return response, nil
 }
 
+// SetMyLocale implements setMyLocale operation.
+func (h *Handler) SetMyLocale(ctx context.Context, req *v1.UserLocaleRequest) (v1.SetMyLocaleRes, error) {
+	locale, err := h.authUsecase.SetLocale(ctx, h.userID(ctx), req.Locale)
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.SetMyLocaleUnauthorized{}, nil
+		}
+		return nil, err
+	}
+
+	h.sessionManager.Put(ctx, "userLocale", locale)
+	return &v1.UserLocaleRequest{Locale: locale}, nil
+}
+
 // PostData implements postData operation.
 func (h *Handler) PostData(ctx context.Context, req *v1.DataRequest) (v1.PostDataRes, error) {
 	data := &entity.Data{
-		Key:   req.Key,
-		Value: req.Value,
+		OwnerID: h.userID(ctx),
+		Key:     req.Key,
+		Value:   json.RawMessage(req.Value),
+	}
+	if ttl, ok := req.TTL.Get(); ok {
+		data.TTL = time.Duration(ttl) * time.Second
+	}
+	if expiresAt, ok := req.ExpiresAt.Get(); ok {
+		data.ExpiresAt = &expiresAt
 	}
 	if err := h.dataUsecase.SaveData(ctx, data); err != nil {
+		if resp, ok := validationError(err); ok {
+			return (*v1.PostDataUnprocessableEntity)(resp), nil
+		}
+		var qErr *entity.QuotaError
+		if errors.As(err, &qErr) {
+			if qErr.Kind == entity.QuotaValueSize {
+				return &v1.PostDataRequestEntityTooLarge{Code: http.StatusRequestEntityTooLarge, Message: qErr.Error()}, nil
+			}
+			return &v1.PostDataTooManyRequests{Code: http.StatusTooManyRequests, Message: qErr.Error()}, nil
+		}
 		return nil, err
 	}
 	return &v1.PostDataCreated{}, nil
 }
 
+// GetData implements getData operation.
+func (h *Handler) GetData(ctx context.Context, params v1.GetDataParams) (v1.GetDataRes, error) {
+	data, err := h.dataUsecase.GetData(ctx, params.Key)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.GetDataNotFound{}, nil
+		}
+		return nil, err
+	}
+	return toDataEntry(data), nil
+}
+
+// DeleteData implements deleteData operation. Any user may delete a key, just as any user may
+// write one; only restoring it is reserved to admins, see RestoreData.
+func (h *Handler) DeleteData(ctx context.Context, params v1.DeleteDataParams) (v1.DeleteDataRes, error) {
+	if err := h.dataUsecase.DeleteData(ctx, params.Key); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.DeleteDataNotFound{}, nil
+		}
+		return nil, err
+	}
+	return &v1.DeleteDataNoContent{}, nil
+}
+
+// ListDeletedData implements listDeletedData operation.
+func (h *Handler) ListDeletedData(ctx context.Context, params v1.ListDeletedDataParams) (v1.ListDeletedDataRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.ListDeletedDataForbidden{}, nil
+	}
+
+	entries, err := h.dataUsecase.ListDeletedData(ctx, params.Limit.Or(0))
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(v1.ListDeletedDataOKApplicationJSON, len(entries))
+	for i := range entries {
+		response[i] = *toDataEntry(&entries[i])
+	}
+	return &response, nil
+}
+
+// RestoreData implements restoreData operation.
+func (h *Handler) RestoreData(ctx context.Context, params v1.RestoreDataParams) (v1.RestoreDataRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.RestoreDataForbidden{}, nil
+	}
+
+	data, err := h.dataUsecase.RestoreData(ctx, params.Key)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.RestoreDataNotFound{}, nil
+		}
+		if errors.Is(err, entity.ErrConflict) {
+			return conflictError(err), nil
+		}
+		return nil, err
+	}
+	return toDataEntry(data), nil
+}
+
+// GetDataUsage implements getDataUsage operation.
+func (h *Handler) GetDataUsage(ctx context.Context) (v1.GetDataUsageRes, error) {
+	usage, err := h.dataUsecase.GetDataUsage(ctx, h.userID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.DataUsage{
+		KeyCount:      usage.KeyCount,
+		TotalBytes:    usage.TotalBytes,
+		MaxKeys:       usage.Quota.MaxKeys,
+		MaxValueBytes: usage.Quota.MaxValueBytes,
+		MaxTotalBytes: usage.Quota.MaxTotalBytes,
+	}, nil
+}
+
+// ImportData implements importData operation.
+func (h *Handler) ImportData(ctx context.Context, req v1.ImportDataReq, params v1.ImportDataParams) (v1.ImportDataRes, error) {
+	opts := entity.ImportOptions{
+		OwnerID:    h.userID(ctx),
+		OnConflict: entity.ConflictMode(params.OnConflict.Or(v1.ImportDataOnConflictFail)),
+		DryRun:     params.DryRun.Or(false),
+		ChunkSize:  int(params.ChunkSize.Or(0)),
+	}
+
+	var body io.Reader
+	switch r := req.(type) {
+	case *v1.ImportDataReqApplicationXNdjson:
+		opts.Format = entity.DataFormatNDJSON
+		body = r.Data
+	case *v1.ImportDataReqTextCsv:
+		opts.Format = entity.DataFormatCSV
+		body = r.Data
+	default:
+		return nil, errors.New("unsupported import content type")
+	}
+
+	report, err := h.dataUsecase.ImportData(ctx, body, opts)
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	lineErrors := make([]v1.ImportLineError, len(report.Errors))
+	for i, e := range report.Errors {
+		lineErrors[i] = v1.ImportLineError{
+			Line:    e.Line,
+			Message: e.Message,
+		}
+		if e.Key != "" {
+			lineErrors[i].Key = v1.NewOptString(e.Key)
+		}
+	}
+	return &v1.ImportReport{
+		DryRun:  report.DryRun,
+		Aborted: report.Aborted,
+		Total:   report.Total,
+		Created: report.Created,
+		Updated: report.Updated,
+		Skipped: report.Skipped,
+		Failed:  report.Failed,
+		Errors:  lineErrors,
+	}, nil
+}
+
+// ExportData implements exportData operation.
+// Entries are streamed through a pipe, so the response is written while rows are read.
+func (h *Handler) ExportData(ctx context.Context, params v1.ExportDataParams) (v1.ExportDataRes, error) {
+	format := entity.DataFormat(params.Format.Or(v1.ExportDataFormatNdjson))
+
+	pr, pw := io.Pipe()
+	// Unblock the writer if the client goes away before the export is finished.
+	context.AfterFunc(ctx, func() { _ = pr.CloseWithError(ctx.Err()) })
+	go func() {
+		_ = pw.CloseWithError(h.dataUsecase.ExportData(ctx, pw, format))
+	}()
+
+	if format == entity.DataFormatCSV {
+		return &v1.ExportDataOKTextCsv{Data: pr}, nil
+	}
+	return &v1.ExportDataOKApplicationXNdjson{Data: pr}, nil
+}
+
+// TransferDeadlines wraps the ogen routes of data imports and exports, whose bodies can be far
+// larger than the server timeouts allow, and gives them the transfer timeout instead.
+func (h *Handler) TransferDeadlines(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.extendDeadlines(w)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ListDataSchemas implements listDataSchemas operation.
+func (h *Handler) ListDataSchemas(ctx context.Context) (v1.ListDataSchemasRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.ListDataSchemasForbidden{}, nil
+	}
+
+	schemas, err := h.dataUsecase.ListDataSchemas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(v1.ListDataSchemasOKApplicationJSON, len(schemas))
+	for i := range schemas {
+		item, err := toDataSchema(&schemas[i])
+		if err != nil {
+			return nil, err
+		}
+		response[i] = *item
+	}
+	return &response, nil
+}
+
+// PutDataSchema implements putDataSchema operation.
+func (h *Handler) PutDataSchema(ctx context.Context, req *v1.DataSchemaRequest) (v1.PutDataSchemaRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.PutDataSchemaForbidden{}, nil
+	}
+
+	raw, err := req.Schema.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &entity.DataSchema{
+		Prefix: req.Prefix,
+		Schema: raw,
+	}
+	if err := h.dataUsecase.SaveDataSchema(ctx, schema); err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return toDataSchema(schema)
+}
+
+// DeleteDataSchema implements deleteDataSchema operation.
+func (h *Handler) DeleteDataSchema(ctx context.Context, params v1.DeleteDataSchemaParams) (v1.DeleteDataSchemaRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.DeleteDataSchemaForbidden{}, nil
+	}
+
+	if err := h.dataUsecase.DeleteDataSchema(ctx, params.Prefix); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.DeleteDataSchemaNotFound{}, nil
+		}
+		return nil, err
+	}
+	return &v1.DeleteDataSchemaNoContent{}, nil
+}
+
+// ListAttachments implements listAttachments operation.
+func (h *Handler) ListAttachments(ctx context.Context, params v1.ListAttachmentsParams) (v1.ListAttachmentsRes, error) {
+	attachments, err := h.attachmentUsecase.ListAttachments(ctx, params.Key)
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	response := make(v1.ListAttachmentsOKApplicationJSON, len(attachments))
+	for i := range attachments {
+		response[i] = *toAttachment(&attachments[i])
+	}
+	return &response, nil
+}
+
+// DeleteAttachment implements deleteAttachment operation.
+func (h *Handler) DeleteAttachment(ctx context.Context, params v1.DeleteAttachmentParams) (v1.DeleteAttachmentRes, error) {
+	if err := h.attachmentUsecase.DeleteAttachment(ctx, params.ID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.DeleteAttachmentNotFound{}, nil
+		}
+		return nil, err
+	}
+	return &v1.DeleteAttachmentNoContent{}, nil
+}
+
 // GetCatalog implements getCatalog operation.
-func (h *Handler) GetCatalog(ctx context.Context) (v1.GetCatalogRes, error) {
-	items, err := h.catalogUsecase.GetCatalogItems(ctx)
+func (h *Handler) GetCatalog(ctx context.Context, params v1.GetCatalogParams) (v1.GetCatalogRes, error) {
+	items, err := h.catalogUsecase.GetCatalogItems(ctx, params.Category.Or(""), h.userID(ctx), h.localePreference(ctx, params.AcceptLanguage))
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert []entity.CatalogItem to v1.GetCatalogOKApplicationJSON
 	response := make(v1.GetCatalogOKApplicationJSON, len(items))
-	for i, item := range items {
-		response[i] = v1.CatalogItem{
-			ID:          v1.NewOptUUID(item.ID),
-			Title:       v1.NewOptString(item.Title),
-			Description: v1.NewOptString(item.Description),
-			Disabled:    v1.NewOptBool(item.Disabled),
+	for i := range items {
+		response[i] = *toCatalogItem(&items[i])
+	}
+	return &response, nil
+}
+
+// GetCatalogV2 implements getCatalogV2 operation.
+func (h *Handler) GetCatalogV2(ctx context.Context, params v1.GetCatalogV2Params) (v1.GetCatalogV2Res, error) {
+	q := entity.CatalogQuery{
+		TitlePrefix: params.TitlePrefix.Or(""),
+		Tag:         params.Tag.Or(""),
+		Category:    params.Category.Or(""),
+		Sort:        entity.CatalogSort(params.Sort.Or(v1.GetCatalogV2SortTitle)),
+		Limit:       params.Limit.Or(0),
+	}
+	if disabled, ok := params.Disabled.Get(); ok {
+		q.Disabled = &disabled
+	}
+
+	page, err := h.catalogUsecase.ListCatalogItems(ctx, q, params.Cursor.Or(""), h.userID(ctx), h.localePreference(ctx, params.AcceptLanguage))
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	response := &v1.CatalogPage{
+		Items: make([]v1.CatalogItem, len(page.Items)),
+	}
+	for i := range page.Items {
+		response.Items[i] = *toCatalogItem(&page.Items[i])
+	}
+	if page.NextCursor != "" {
+		response.NextCursor = v1.NewOptString(page.NextCursor)
+	}
+	return response, nil
+}
+
+// SearchCatalog implements searchCatalog operation.
+func (h *Handler) SearchCatalog(ctx context.Context, params v1.SearchCatalogParams) (v1.SearchCatalogRes, error) {
+	q := entity.CatalogSearchQuery{
+		Query: params.Q,
+		Limit: params.Limit.Or(0),
+	}
+	if disabled, ok := params.Disabled.Get(); ok {
+		q.Disabled = &disabled
+	}
+
+	res, err := h.catalogUsecase.SearchCatalog(ctx, q)
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	response := &v1.CatalogSearchResult{
+		Items: make([]v1.CatalogSearchHit, len(res.Hits)),
+		Fuzzy: res.Fuzzy,
+	}
+	for i, hit := range res.Hits {
+		response.Items[i] = v1.CatalogSearchHit{
+			Item:                 *toCatalogItem(&hit.Item),
+			Score:                hit.Score,
+			TitleHighlight:       hit.TitleHighlight,
+			DescriptionHighlight: hit.DescriptionHighlight,
+		}
+	}
+	return response, nil
+}
+
+// GetCatalogItem implements getCatalogItem operation.
+func (h *Handler) GetCatalogItem(ctx context.Context, params v1.GetCatalogItemParams) (v1.GetCatalogItemRes, error) {
+	item, err := h.catalogUsecase.GetCatalogItem(ctx, params.ID, h.userID(ctx), h.localePreference(ctx, params.AcceptLanguage))
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.GetCatalogItemNotFound{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogItem(item), nil
+}
+
+// CreateCatalogItem implements createCatalogItem operation.
+func (h *Handler) CreateCatalogItem(ctx context.Context, req *v1.CatalogItemRequest) (v1.CreateCatalogItemRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.CreateCatalogItemForbidden{}, nil
+	}
+
+	item := &entity.CatalogItem{
+		Title:       req.Title,
+		Description: req.Description.Or(""),
+		Disabled:    req.Disabled.Or(false),
+		Tags:        req.Tags,
+		CategoryID:  req.CategoryID.Or(uuid.Nil),
+	}
+	if err := h.catalogUsecase.CreateCatalogItem(ctx, item); err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return toCatalogItem(item), nil
+}
+
+// UpdateCatalogItem implements updateCatalogItem operation.
+func (h *Handler) UpdateCatalogItem(ctx context.Context, req *v1.CatalogItemRequest, params v1.UpdateCatalogItemParams) (v1.UpdateCatalogItemRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.UpdateCatalogItemForbidden{}, nil
+	}
+
+	item := &entity.CatalogItem{
+		ID:          params.ID,
+		Title:       req.Title,
+		Description: req.Description.Or(""),
+		Disabled:    req.Disabled.Or(false),
+		Tags:        req.Tags,
+		CategoryID:  req.CategoryID.Or(uuid.Nil),
+	}
+	if err := h.catalogUsecase.UpdateCatalogItem(ctx, item); err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.UpdateCatalogItemNotFound{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogItem(item), nil
+}
+
+// SetCatalogItemDisabled implements setCatalogItemDisabled operation.
+func (h *Handler) SetCatalogItemDisabled(ctx context.Context, req *v1.CatalogItemDisabledRequest, params v1.SetCatalogItemDisabledParams) (v1.SetCatalogItemDisabledRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.SetCatalogItemDisabledForbidden{}, nil
+	}
+
+	item, err := h.catalogUsecase.SetCatalogItemDisabled(ctx, params.ID, req.Disabled)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.SetCatalogItemDisabledNotFound{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogItem(item), nil
+}
+
+// DeleteCatalogItem implements deleteCatalogItem operation.
+func (h *Handler) DeleteCatalogItem(ctx context.Context, params v1.DeleteCatalogItemParams) (v1.DeleteCatalogItemRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.DeleteCatalogItemForbidden{}, nil
+	}
+
+	if err := h.catalogUsecase.DeleteCatalogItem(ctx, params.ID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.DeleteCatalogItemNotFound{}, nil
 		}
+		return nil, err
+	}
+	return &v1.DeleteCatalogItemNoContent{}, nil
+}
+
+// ListDeletedCatalogItems implements listDeletedCatalogItems operation.
+func (h *Handler) ListDeletedCatalogItems(ctx context.Context, params v1.ListDeletedCatalogItemsParams) (v1.ListDeletedCatalogItemsRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.ListDeletedCatalogItemsForbidden{}, nil
+	}
+
+	items, err := h.catalogUsecase.ListDeletedCatalogItems(ctx, params.Limit.Or(0))
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(v1.ListDeletedCatalogItemsOKApplicationJSON, len(items))
+	for i := range items {
+		response[i] = *toCatalogItem(&items[i])
 	}
 	return &response, nil
 }
 
-// --- Security Handler ---
+// RestoreCatalogItem implements restoreCatalogItem operation.
+func (h *Handler) RestoreCatalogItem(ctx context.Context, params v1.RestoreCatalogItemParams) (v1.RestoreCatalogItemRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.RestoreCatalogItemForbidden{}, nil
+	}
 
-// HandleCookieAuth implements cookieAuth security scheme.
-func (h *Handler) HandleCookieAuth(ctx context.Context, operationName string, t v1.CookieAuth) (context.Context, error) {
-	userID := h.sessionManager.GetString(ctx, "userID")
-	if userID == "" {
-		// Return a standard error that ogen will interpret as a 401 Unauthorized.
-		return ctx, errors.New("unauthorized")
+	item, err := h.catalogUsecase.RestoreCatalogItem(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.RestoreCatalogItemNotFound{}, nil
+		}
+		if errors.Is(err, entity.ErrConflict) {
+			return conflictError(err), nil
+		}
+		return nil, err
 	}
-	return ctx, nil
+	return toCatalogItem(item), nil
 }
 
-// --- Static File Server ---
+// ListCatalogCategories implements listCatalogCategories operation.
+func (h *Handler) ListCatalogCategories(ctx context.Context) (v1.ListCatalogCategoriesRes, error) {
+	categories, err := h.catalogUsecase.ListCatalogCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-// ServeHTTP serves the frontend files.
-func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	p := r.URL.Path
-	if strings.HasSuffix(p, "/") {
-		p = strings.TrimSuffix(p, "/") + "/index.html"
+	response := make(v1.ListCatalogCategoriesOKApplicationJSON, len(categories))
+	for i := range categories {
+		response[i] = *toCatalogCategory(&categories[i])
 	}
-	p = strings.TrimPrefix(p, "/")
+	return &response, nil
+}
 
-	f, err := h.contentFS.Open(p)
+// GetCatalogCategory implements getCatalogCategory operation.
+func (h *Handler) GetCatalogCategory(ctx context.Context, params v1.GetCatalogCategoryParams) (v1.GetCatalogCategoryRes, error) {
+	category, err := h.catalogUsecase.GetCatalogCategory(ctx, params.ID)
 	if err != nil {
-		http.ServeFileFS(w, r, h.contentFS, "index.html")
-		return
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.GetCatalogCategoryNotFound{}, nil
+		}
+		return nil, err
 	}
-	_ = f.Close()
+	return toCatalogCategory(category), nil
+}
 
-	http.FileServer(http.FS(h.contentFS)).ServeHTTP(w, r)
+// CreateCatalogCategory implements createCatalogCategory operation.
+func (h *Handler) CreateCatalogCategory(ctx context.Context, req *v1.CatalogCategoryRequest) (v1.CreateCatalogCategoryRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.CreateCatalogCategoryForbidden{}, nil
+	}
+
+	category := &entity.CatalogCategory{
+		ParentID: req.ParentID.Or(uuid.Nil),
+		Slug:     req.Slug,
+		Name:     req.Name,
+	}
+	if err := h.catalogUsecase.CreateCatalogCategory(ctx, category); err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		if errors.Is(err, entity.ErrConflict) {
+			return &v1.CreateCatalogCategoryConflict{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogCategory(category), nil
+}
+
+// UpdateCatalogCategory implements updateCatalogCategory operation.
+func (h *Handler) UpdateCatalogCategory(ctx context.Context, req *v1.CatalogCategoryRequest, params v1.UpdateCatalogCategoryParams) (v1.UpdateCatalogCategoryRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.UpdateCatalogCategoryForbidden{}, nil
+	}
+
+	category := &entity.CatalogCategory{
+		ID:       params.ID,
+		ParentID: req.ParentID.Or(uuid.Nil),
+		Slug:     req.Slug,
+		Name:     req.Name,
+	}
+	if err := h.catalogUsecase.UpdateCatalogCategory(ctx, category); err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return &v1.UpdateCatalogCategoryNotFound{}, nil
+		case errors.Is(err, entity.ErrConflict):
+			return &v1.UpdateCatalogCategoryConflict{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogCategory(category), nil
+}
+
+// DeleteCatalogCategory implements deleteCatalogCategory operation.
+func (h *Handler) DeleteCatalogCategory(ctx context.Context, params v1.DeleteCatalogCategoryParams) (v1.DeleteCatalogCategoryRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.DeleteCatalogCategoryForbidden{}, nil
+	}
+
+	if err := h.catalogUsecase.DeleteCatalogCategory(ctx, params.ID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return &v1.DeleteCatalogCategoryNotFound{}, nil
+		case errors.Is(err, entity.ErrConflict):
+			return &v1.DeleteCatalogCategoryConflict{}, nil
+		}
+		return nil, err
+	}
+	return &v1.DeleteCatalogCategoryNoContent{}, nil
+}
+
+// ListCatalogTags implements listCatalogTags operation.
+func (h *Handler) ListCatalogTags(ctx context.Context) (v1.ListCatalogTagsRes, error) {
+	tags, err := h.catalogUsecase.ListCatalogTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(v1.ListCatalogTagsOKApplicationJSON, len(tags))
+	for i, tag := range tags {
+		response[i] = v1.CatalogTag{Name: tag.Name, Items: tag.Items}
+	}
+	return &response, nil
+}
+
+// CreateCatalogTag implements createCatalogTag operation.
+func (h *Handler) CreateCatalogTag(ctx context.Context, req *v1.CatalogTagRequest) (v1.CreateCatalogTagRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.CreateCatalogTagForbidden{}, nil
+	}
+
+	tag, err := h.catalogUsecase.CreateCatalogTag(ctx, req.Name)
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		if errors.Is(err, entity.ErrConflict) {
+			return &v1.CreateCatalogTagConflict{}, nil
+		}
+		return nil, err
+	}
+	return &v1.CatalogTag{Name: tag.Name, Items: tag.Items}, nil
+}
+
+// RenameCatalogTag implements renameCatalogTag operation.
+func (h *Handler) RenameCatalogTag(ctx context.Context, req *v1.CatalogTagRequest, params v1.RenameCatalogTagParams) (v1.RenameCatalogTagRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.RenameCatalogTagForbidden{}, nil
+	}
+
+	tag, err := h.catalogUsecase.RenameCatalogTag(ctx, params.Name, req.Name)
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return &v1.RenameCatalogTagNotFound{}, nil
+		case errors.Is(err, entity.ErrConflict):
+			return &v1.RenameCatalogTagConflict{}, nil
+		}
+		return nil, err
+	}
+	return &v1.CatalogTag{Name: tag.Name, Items: tag.Items}, nil
+}
+
+// DeleteCatalogTag implements deleteCatalogTag operation.
+func (h *Handler) DeleteCatalogTag(ctx context.Context, params v1.DeleteCatalogTagParams) (v1.DeleteCatalogTagRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.DeleteCatalogTagForbidden{}, nil
+	}
+
+	if err := h.catalogUsecase.DeleteCatalogTag(ctx, params.Name); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.DeleteCatalogTagNotFound{}, nil
+		}
+		return nil, err
+	}
+	return &v1.DeleteCatalogTagNoContent{}, nil
+}
+
+// ListCatalogChangesets implements listCatalogChangesets operation.
+func (h *Handler) ListCatalogChangesets(ctx context.Context) (v1.ListCatalogChangesetsRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.ListCatalogChangesetsForbidden{}, nil
+	}
+
+	changesets, err := h.catalogUsecase.ListCatalogChangesets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(v1.ListCatalogChangesetsOKApplicationJSON, len(changesets))
+	for i := range changesets {
+		response[i] = *toCatalogChangeset(&changesets[i])
+	}
+	return &response, nil
+}
+
+// GetCatalogChangeset implements getCatalogChangeset operation.
+func (h *Handler) GetCatalogChangeset(ctx context.Context, params v1.GetCatalogChangesetParams) (v1.GetCatalogChangesetRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.GetCatalogChangesetForbidden{}, nil
+	}
+
+	changeset, err := h.catalogUsecase.GetCatalogChangeset(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.GetCatalogChangesetNotFound{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogChangeset(changeset), nil
+}
+
+// CreateCatalogChangeset implements createCatalogChangeset operation.
+func (h *Handler) CreateCatalogChangeset(ctx context.Context, req *v1.CatalogChangesetRequest) (v1.CreateCatalogChangesetRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.CreateCatalogChangesetForbidden{}, nil
+	}
+
+	changeset := &entity.CatalogChangeset{
+		Title:     req.Title,
+		CreatedBy: h.userID(ctx),
+	}
+	if err := h.catalogUsecase.CreateCatalogChangeset(ctx, changeset); err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return toCatalogChangeset(changeset), nil
+}
+
+// DeleteCatalogChangeset implements deleteCatalogChangeset operation.
+func (h *Handler) DeleteCatalogChangeset(ctx context.Context, params v1.DeleteCatalogChangesetParams) (v1.DeleteCatalogChangesetRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.DeleteCatalogChangesetForbidden{}, nil
+	}
+
+	if err := h.catalogUsecase.DeleteCatalogChangeset(ctx, params.ID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return &v1.DeleteCatalogChangesetNotFound{}, nil
+		case errors.Is(err, entity.ErrConflict):
+			return &v1.DeleteCatalogChangesetConflict{}, nil
+		}
+		return nil, err
+	}
+	return &v1.DeleteCatalogChangesetNoContent{}, nil
+}
+
+// CreateCatalogDraft implements createCatalogDraft operation.
+func (h *Handler) CreateCatalogDraft(ctx context.Context, req *v1.CatalogItemRequest, params v1.CreateCatalogDraftParams) (v1.CreateCatalogDraftRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.CreateCatalogDraftForbidden{}, nil
+	}
+
+	draft := &entity.CatalogDraft{
+		Action: entity.CatalogDraftUpsert,
+		Item: entity.CatalogItem{
+			Title:       req.Title,
+			Description: req.Description.Or(""),
+			Disabled:    req.Disabled.Or(false),
+			Tags:        req.Tags,
+			CategoryID:  req.CategoryID.Or(uuid.Nil),
+		},
+	}
+	if err := h.catalogUsecase.StageCatalogDraft(ctx, params.ID, draft); err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return &v1.CreateCatalogDraftNotFound{}, nil
+		case errors.Is(err, entity.ErrConflict):
+			return &v1.CreateCatalogDraftConflict{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogDraft(draft), nil
+}
+
+// PutCatalogDraft implements putCatalogDraft operation.
+func (h *Handler) PutCatalogDraft(ctx context.Context, req *v1.CatalogDraftRequest, params v1.PutCatalogDraftParams) (v1.PutCatalogDraftRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.PutCatalogDraftForbidden{}, nil
+	}
+
+	draft := &entity.CatalogDraft{
+		Action: entity.CatalogDraftAction(req.Action.Or(v1.CatalogDraftRequestActionUpsert)),
+		Item:   entity.CatalogItem{ID: params.ItemID},
+	}
+	if item, ok := req.Item.Get(); ok {
+		draft.Item.Title = item.Title
+		draft.Item.Description = item.Description.Or("")
+		draft.Item.Disabled = item.Disabled.Or(false)
+		draft.Item.Tags = item.Tags
+		draft.Item.CategoryID = item.CategoryID.Or(uuid.Nil)
+	} else if draft.Action == entity.CatalogDraftUpsert {
+		resp, _ := validationError(entity.NewValidationError("item is required unless action is delete"))
+		return resp, nil
+	}
+	if err := h.catalogUsecase.StageCatalogDraft(ctx, params.ID, draft); err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return &v1.PutCatalogDraftNotFound{}, nil
+		case errors.Is(err, entity.ErrConflict):
+			return &v1.PutCatalogDraftConflict{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogDraft(draft), nil
+}
+
+// DeleteCatalogDraft implements deleteCatalogDraft operation.
+func (h *Handler) DeleteCatalogDraft(ctx context.Context, params v1.DeleteCatalogDraftParams) (v1.DeleteCatalogDraftRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.DeleteCatalogDraftForbidden{}, nil
+	}
+
+	if err := h.catalogUsecase.UnstageCatalogDraft(ctx, params.ID, params.ItemID); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return &v1.DeleteCatalogDraftNotFound{}, nil
+		case errors.Is(err, entity.ErrConflict):
+			return &v1.DeleteCatalogDraftConflict{}, nil
+		}
+		return nil, err
+	}
+	return &v1.DeleteCatalogDraftNoContent{}, nil
+}
+
+// PreviewCatalogChangeset implements previewCatalogChangeset operation.
+func (h *Handler) PreviewCatalogChangeset(ctx context.Context, params v1.PreviewCatalogChangesetParams) (v1.PreviewCatalogChangesetRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.PreviewCatalogChangesetForbidden{}, nil
+	}
+
+	items, err := h.catalogUsecase.PreviewCatalogChangeset(ctx, params.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return &v1.PreviewCatalogChangesetNotFound{}, nil
+		case errors.Is(err, entity.ErrConflict):
+			return &v1.PreviewCatalogChangesetConflict{}, nil
+		}
+		return nil, err
+	}
+
+	response := make(v1.PreviewCatalogChangesetOKApplicationJSON, len(items))
+	for i := range items {
+		response[i] = *toCatalogItem(&items[i])
+	}
+	return &response, nil
+}
+
+// PublishCatalogChangeset implements publishCatalogChangeset operation.
+func (h *Handler) PublishCatalogChangeset(ctx context.Context, params v1.PublishCatalogChangesetParams) (v1.PublishCatalogChangesetRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.PublishCatalogChangesetForbidden{}, nil
+	}
+
+	changeset, err := h.catalogUsecase.PublishCatalogChangeset(ctx, params.ID, h.userID(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return &v1.PublishCatalogChangesetNotFound{}, nil
+		case errors.Is(err, entity.ErrConflict):
+			return conflictError(err), nil
+		}
+		return nil, err
+	}
+	return toCatalogChangeset(changeset), nil
+}
+
+// RollbackCatalogChangeset implements rollbackCatalogChangeset operation.
+func (h *Handler) RollbackCatalogChangeset(ctx context.Context, params v1.RollbackCatalogChangesetParams) (v1.RollbackCatalogChangesetRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.RollbackCatalogChangesetForbidden{}, nil
+	}
+
+	changeset, err := h.catalogUsecase.RollbackCatalogChangeset(ctx, params.ID, h.userID(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return &v1.RollbackCatalogChangesetNotFound{}, nil
+		case errors.Is(err, entity.ErrConflict):
+			return conflictError(err), nil
+		}
+		return nil, err
+	}
+	return toCatalogChangeset(changeset), nil
+}
+
+// ListCatalogTranslations implements listCatalogTranslations operation.
+func (h *Handler) ListCatalogTranslations(ctx context.Context, params v1.ListCatalogTranslationsParams) (v1.ListCatalogTranslationsRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.ListCatalogTranslationsForbidden{}, nil
+	}
+
+	translations, err := h.catalogUsecase.ListCatalogTranslations(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.ListCatalogTranslationsNotFound{}, nil
+		}
+		return nil, err
+	}
+
+	response := make(v1.ListCatalogTranslationsOKApplicationJSON, len(translations))
+	for i := range translations {
+		response[i] = *toCatalogTranslation(&translations[i])
+	}
+	return &response, nil
+}
+
+// PutCatalogTranslation implements putCatalogTranslation operation.
+func (h *Handler) PutCatalogTranslation(ctx context.Context, req *v1.CatalogTranslationRequest, params v1.PutCatalogTranslationParams) (v1.PutCatalogTranslationRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.PutCatalogTranslationForbidden{}, nil
+	}
+
+	translation := &entity.CatalogTranslation{
+		ItemID:      params.ID,
+		Locale:      params.Locale,
+		Title:       req.Title,
+		Description: req.Description.Or(""),
+	}
+	if err := h.catalogUsecase.SaveCatalogTranslation(ctx, translation); err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.PutCatalogTranslationNotFound{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogTranslation(translation), nil
+}
+
+// DeleteCatalogTranslation implements deleteCatalogTranslation operation.
+func (h *Handler) DeleteCatalogTranslation(ctx context.Context, params v1.DeleteCatalogTranslationParams) (v1.DeleteCatalogTranslationRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.DeleteCatalogTranslationForbidden{}, nil
+	}
+
+	if err := h.catalogUsecase.DeleteCatalogTranslation(ctx, params.ID, params.Locale); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.DeleteCatalogTranslationNotFound{}, nil
+		}
+		return nil, err
+	}
+	return &v1.DeleteCatalogTranslationNoContent{}, nil
+}
+
+// DeleteCatalogImage implements deleteCatalogImage operation.
+func (h *Handler) DeleteCatalogImage(ctx context.Context, params v1.DeleteCatalogImageParams) (v1.DeleteCatalogImageRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.DeleteCatalogImageForbidden{}, nil
+	}
+
+	if err := h.catalogUsecase.DeleteCatalogImage(ctx, params.ID, params.ImageID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.DeleteCatalogImageNotFound{}, nil
+		}
+		return nil, err
+	}
+	return &v1.DeleteCatalogImageNoContent{}, nil
+}
+
+// ListCatalogFavorites implements listCatalogFavorites operation.
+func (h *Handler) ListCatalogFavorites(ctx context.Context, params v1.ListCatalogFavoritesParams) (v1.ListCatalogFavoritesRes, error) {
+	page, err := h.catalogUsecase.ListCatalogFavorites(ctx, h.userID(ctx), params.Cursor.Or(""), params.Limit.Or(0), h.localePreference(ctx, params.AcceptLanguage))
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	response := &v1.CatalogPage{
+		Items: make([]v1.CatalogItem, len(page.Items)),
+	}
+	for i := range page.Items {
+		response.Items[i] = *toCatalogItem(&page.Items[i])
+	}
+	if page.NextCursor != "" {
+		response.NextCursor = v1.NewOptString(page.NextCursor)
+	}
+	return response, nil
+}
+
+// AddCatalogFavorite implements addCatalogFavorite operation.
+func (h *Handler) AddCatalogFavorite(ctx context.Context, params v1.AddCatalogFavoriteParams) (v1.AddCatalogFavoriteRes, error) {
+	if err := h.catalogUsecase.AddCatalogFavorite(ctx, h.userID(ctx), params.ID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.AddCatalogFavoriteNotFound{}, nil
+		}
+		return nil, err
+	}
+	return &v1.AddCatalogFavoriteNoContent{}, nil
+}
+
+// RemoveCatalogFavorite implements removeCatalogFavorite operation.
+func (h *Handler) RemoveCatalogFavorite(ctx context.Context, params v1.RemoveCatalogFavoriteParams) (v1.RemoveCatalogFavoriteRes, error) {
+	if err := h.catalogUsecase.RemoveCatalogFavorite(ctx, h.userID(ctx), params.ID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.RemoveCatalogFavoriteNotFound{}, nil
+		}
+		return nil, err
+	}
+	return &v1.RemoveCatalogFavoriteNoContent{}, nil
+}
+
+// RecordCatalogView implements recordCatalogView operation.
+func (h *Handler) RecordCatalogView(ctx context.Context, params v1.RecordCatalogViewParams) (v1.RecordCatalogViewRes, error) {
+	if err := h.catalogUsecase.RecordCatalogView(ctx, h.userID(ctx), params.ID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.RecordCatalogViewNotFound{}, nil
+		}
+		return nil, err
+	}
+	return &v1.RecordCatalogViewNoContent{}, nil
+}
+
+// ListRecentlyViewedCatalogItems implements listRecentlyViewedCatalogItems operation.
+func (h *Handler) ListRecentlyViewedCatalogItems(ctx context.Context, params v1.ListRecentlyViewedCatalogItemsParams) (v1.ListRecentlyViewedCatalogItemsRes, error) {
+	items, err := h.catalogUsecase.ListRecentlyViewedCatalogItems(ctx, h.userID(ctx), h.localePreference(ctx, params.AcceptLanguage))
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(v1.ListRecentlyViewedCatalogItemsOKApplicationJSON, len(items))
+	for i := range items {
+		response[i] = *toCatalogItem(&items[i])
+	}
+	return &response, nil
+}
+
+// SetCatalogInventory implements setCatalogInventory operation.
+func (h *Handler) SetCatalogInventory(ctx context.Context, req *v1.CatalogInventoryRequest, params v1.SetCatalogInventoryParams) (v1.SetCatalogInventoryRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.SetCatalogInventoryForbidden{}, nil
+	}
+
+	var update entity.CatalogInventoryUpdate
+	if price, ok := req.Price.Get(); ok {
+		update.PriceAmount = price.Amount
+		update.Currency = price.Currency
+	}
+	if stock, ok := req.StockQuantity.Get(); ok {
+		update.StockQuantity = &stock
+	}
+	item, err := h.catalogUsecase.SetCatalogInventory(ctx, params.ID, update)
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.SetCatalogInventoryNotFound{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogItem(item), nil
+}
+
+// ReserveCatalogItem implements reserveCatalogItem operation.
+func (h *Handler) ReserveCatalogItem(ctx context.Context, req *v1.CatalogReservationRequest, params v1.ReserveCatalogItemParams) (v1.ReserveCatalogItemRes, error) {
+	ttl := time.Duration(req.TTL.Or(0)) * time.Second
+	res, err := h.catalogUsecase.ReserveCatalogItem(ctx, params.ID, h.userID(ctx), req.Quantity, ttl)
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return (*v1.ReserveCatalogItemUnprocessableEntity)(resp), nil
+		}
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.ReserveCatalogItemNotFound{}, nil
+		}
+		if errors.Is(err, entity.ErrConflict) {
+			return (*v1.ReserveCatalogItemConflict)(conflictError(err)), nil
+		}
+		return nil, err
+	}
+
+	resp := &v1.CatalogReservation{
+		ID:        res.ID,
+		ItemID:    res.ItemID,
+		Quantity:  res.Quantity,
+		CreatedAt: res.CreatedAt,
+		ExpiresAt: res.ExpiresAt,
+	}
+	if res.UnitPrice != nil {
+		total, err := res.Total()
+		if err != nil {
+			return nil, err
+		}
+		resp.UnitPrice = v1.NewOptMoney(toMoney(*res.UnitPrice))
+		resp.Total = v1.NewOptMoney(toMoney(*total))
+	}
+	return resp, nil
+}
+
+// ReleaseCatalogReservation implements releaseCatalogReservation operation.
+func (h *Handler) ReleaseCatalogReservation(ctx context.Context, req *v1.CatalogReleaseRequest, params v1.ReleaseCatalogReservationParams) (v1.ReleaseCatalogReservationRes, error) {
+	if err := h.catalogUsecase.ReleaseCatalogReservation(ctx, params.ID, req.ReservationID, h.userID(ctx)); err != nil {
+		return nil, err
+	}
+	return &v1.ReleaseCatalogReservationNoContent{}, nil
+}
+
+// ListCatalogReviews implements listCatalogReviews operation.
+func (h *Handler) ListCatalogReviews(ctx context.Context, params v1.ListCatalogReviewsParams) (v1.ListCatalogReviewsRes, error) {
+	reviews, err := h.catalogUsecase.ListCatalogReviews(ctx, params.ID, params.Limit.Or(0))
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.ListCatalogReviewsNotFound{}, nil
+		}
+		return nil, err
+	}
+
+	response := make(v1.ListCatalogReviewsOKApplicationJSON, len(reviews))
+	for i := range reviews {
+		response[i] = *toCatalogReview(&reviews[i])
+	}
+	return &response, nil
+}
+
+// GetCatalogReview implements getCatalogReview operation.
+func (h *Handler) GetCatalogReview(ctx context.Context, params v1.GetCatalogReviewParams) (v1.GetCatalogReviewRes, error) {
+	review, err := h.catalogUsecase.GetCatalogReview(ctx, params.ID, h.userID(ctx))
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.GetCatalogReviewNotFound{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogReview(review), nil
+}
+
+// SaveCatalogReview implements saveCatalogReview operation.
+func (h *Handler) SaveCatalogReview(ctx context.Context, req *v1.CatalogReviewRequest, params v1.SaveCatalogReviewParams) (v1.SaveCatalogReviewRes, error) {
+	review, err := h.catalogUsecase.SaveCatalogReview(ctx, params.ID, h.userID(ctx), req.Rating, req.Body.Or(""))
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return (*v1.SaveCatalogReviewUnprocessableEntity)(resp), nil
+		}
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.SaveCatalogReviewNotFound{}, nil
+		}
+		if errors.Is(err, entity.ErrConflict) {
+			return (*v1.SaveCatalogReviewConflict)(conflictError(err)), nil
+		}
+		return nil, err
+	}
+	return toCatalogReview(review), nil
+}
+
+// DeleteCatalogReview implements deleteCatalogReview operation.
+func (h *Handler) DeleteCatalogReview(ctx context.Context, params v1.DeleteCatalogReviewParams) (v1.DeleteCatalogReviewRes, error) {
+	if err := h.catalogUsecase.DeleteCatalogReview(ctx, params.ID, h.userID(ctx)); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.DeleteCatalogReviewNotFound{}, nil
+		}
+		return nil, err
+	}
+	return &v1.DeleteCatalogReviewNoContent{}, nil
+}
+
+// ListCatalogReviewsByStatus implements listCatalogReviewsByStatus operation.
+func (h *Handler) ListCatalogReviewsByStatus(ctx context.Context, params v1.ListCatalogReviewsByStatusParams) (v1.ListCatalogReviewsByStatusRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.ListCatalogReviewsByStatusForbidden{}, nil
+	}
+
+	status := entity.CatalogReviewStatus(params.Status.Or(v1.CatalogReviewStatusPending))
+	reviews, err := h.catalogUsecase.ListCatalogReviewsByStatus(ctx, status, params.Limit.Or(0))
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(v1.ListCatalogReviewsByStatusOKApplicationJSON, len(reviews))
+	for i := range reviews {
+		response[i] = *toCatalogReview(&reviews[i])
+	}
+	return &response, nil
+}
+
+// SetCatalogReviewStatus implements setCatalogReviewStatus operation.
+func (h *Handler) SetCatalogReviewStatus(ctx context.Context, req *v1.CatalogReviewStatusRequest, params v1.SetCatalogReviewStatusParams) (v1.SetCatalogReviewStatusRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.SetCatalogReviewStatusForbidden{}, nil
+	}
+
+	review, err := h.catalogUsecase.SetCatalogReviewStatus(ctx, params.ReviewID, entity.CatalogReviewStatus(req.Status))
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.SetCatalogReviewStatusNotFound{}, nil
+		}
+		return nil, err
+	}
+	return toCatalogReview(review), nil
+}
+
+// ImportCatalog implements importCatalog operation.
+func (h *Handler) ImportCatalog(ctx context.Context, req v1.ImportCatalogReq, params v1.ImportCatalogParams) (v1.ImportCatalogRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.ImportCatalogForbidden{}, nil
+	}
+
+	opts := entity.CatalogImportOptions{
+		DryRun: params.DryRun.Or(false),
+		Prune:  params.Prune.Or(false),
+	}
+
+	var body io.Reader
+	switch r := req.(type) {
+	case *v1.ImportCatalogReqTextCsv:
+		opts.Format = entity.DataFormatCSV
+		body = r.Data
+	case *v1.ImportCatalogReqApplicationJSON:
+		opts.Format = entity.DataFormatJSON
+		body = bytes.NewReader(*r)
+	default:
+		return nil, errors.New("unsupported import content type")
+	}
+
+	report, err := h.catalogUsecase.ImportCatalogItems(ctx, body, opts)
+	if err != nil {
+		if resp, ok := validationError(err); ok {
+			return (*v1.ImportCatalogUnprocessableEntity)(resp), nil
+		}
+		if errors.Is(err, entity.ErrConflict) {
+			return (*v1.ImportCatalogConflict)(conflictError(err)), nil
+		}
+		return nil, err
+	}
+
+	lineErrors := make([]v1.ImportLineError, len(report.Errors))
+	for i, e := range report.Errors {
+		lineErrors[i] = v1.ImportLineError{
+			Line:    e.Line,
+			Message: e.Message,
+		}
+		if e.Key != "" {
+			lineErrors[i].Key = v1.NewOptString(e.Key)
+		}
+	}
+	return &v1.CatalogImportReport{
+		DryRun:    report.DryRun,
+		Committed: report.Committed,
+		Total:     report.Total,
+		Created:   report.Created,
+		Updated:   report.Updated,
+		Deleted:   report.Deleted,
+		Failed:    report.Failed,
+		Errors:    lineErrors,
+	}, nil
+}
+
+// ListWebhookEndpoints implements listWebhookEndpoints operation.
+func (h *Handler) ListWebhookEndpoints(ctx context.Context) (v1.ListWebhookEndpointsRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.ListWebhookEndpointsForbidden{}, nil
+	}
+
+	endpoints, err := h.webhookUsecase.ListWebhookEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(v1.ListWebhookEndpointsOKApplicationJSON, len(endpoints))
+	for i := range endpoints {
+		response[i] = *toWebhookEndpoint(&endpoints[i], false)
+	}
+	return &response, nil
+}
+
+// GetWebhookEndpoint implements getWebhookEndpoint operation.
+func (h *Handler) GetWebhookEndpoint(ctx context.Context, params v1.GetWebhookEndpointParams) (v1.GetWebhookEndpointRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.GetWebhookEndpointForbidden{}, nil
+	}
+
+	endpoint, err := h.webhookUsecase.GetWebhookEndpoint(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.GetWebhookEndpointNotFound{}, nil
+		}
+		return nil, err
+	}
+	return toWebhookEndpoint(endpoint, false), nil
+}
+
+// CreateWebhookEndpoint implements createWebhookEndpoint operation.
+func (h *Handler) CreateWebhookEndpoint(ctx context.Context, req *v1.WebhookEndpointRequest) (v1.CreateWebhookEndpointRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.CreateWebhookEndpointForbidden{}, nil
+	}
+
+	endpoint := fromWebhookEndpointRequest(req)
+	if err := h.webhookUsecase.CreateWebhookEndpoint(ctx, endpoint); err != nil {
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return toWebhookEndpoint(endpoint, true), nil
+}
+
+// UpdateWebhookEndpoint implements updateWebhookEndpoint operation.
+func (h *Handler) UpdateWebhookEndpoint(ctx context.Context, req *v1.WebhookEndpointRequest, params v1.UpdateWebhookEndpointParams) (v1.UpdateWebhookEndpointRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.UpdateWebhookEndpointForbidden{}, nil
+	}
+
+	endpoint := fromWebhookEndpointRequest(req)
+	endpoint.ID = params.ID
+	if err := h.webhookUsecase.UpdateWebhookEndpoint(ctx, endpoint); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.UpdateWebhookEndpointNotFound{}, nil
+		}
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return toWebhookEndpoint(endpoint, req.Secret.IsSet()), nil
+}
+
+// DeleteWebhookEndpoint implements deleteWebhookEndpoint operation.
+func (h *Handler) DeleteWebhookEndpoint(ctx context.Context, params v1.DeleteWebhookEndpointParams) (v1.DeleteWebhookEndpointRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.DeleteWebhookEndpointForbidden{}, nil
+	}
+
+	if err := h.webhookUsecase.DeleteWebhookEndpoint(ctx, params.ID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.DeleteWebhookEndpointNotFound{}, nil
+		}
+		return nil, err
+	}
+	return &v1.DeleteWebhookEndpointNoContent{}, nil
+}
+
+// ListWebhookDeliveries implements listWebhookDeliveries operation.
+func (h *Handler) ListWebhookDeliveries(ctx context.Context, params v1.ListWebhookDeliveriesParams) (v1.ListWebhookDeliveriesRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.ListWebhookDeliveriesForbidden{}, nil
+	}
+
+	status := entity.WebhookDeliveryStatus(params.Status.Or(""))
+	deliveries, err := h.webhookUsecase.ListWebhookDeliveries(ctx, params.ID, status, params.Limit.Or(0))
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.ListWebhookDeliveriesNotFound{}, nil
+		}
+		if resp, ok := validationError(err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	response := make(v1.ListWebhookDeliveriesOKApplicationJSON, len(deliveries))
+	for i := range deliveries {
+		response[i] = *toWebhookDelivery(&deliveries[i])
+	}
+	return &response, nil
+}
+
+// RedeliverWebhook implements redeliverWebhook operation.
+func (h *Handler) RedeliverWebhook(ctx context.Context, params v1.RedeliverWebhookParams) (v1.RedeliverWebhookRes, error) {
+	if !h.isAdmin(ctx) {
+		return &v1.RedeliverWebhookForbidden{}, nil
+	}
+
+	delivery, err := h.webhookUsecase.RedeliverWebhook(ctx, params.DeliveryID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return &v1.RedeliverWebhookNotFound{}, nil
+		}
+		return nil, err
+	}
+	return toWebhookDelivery(delivery), nil
+}
+
+// --- Helpers ---
+
+// userID returns the id of the session user, or uuid.Nil without a session.
+func (h *Handler) userID(ctx context.Context) uuid.UUID {
+	id, _ := uuid.Parse(h.sessionManager.GetString(ctx, "userID"))
+	return id
+}
+
+// localePreference combines the session user's profile locale with the Accept-Language header.
+func (h *Handler) localePreference(ctx context.Context, acceptLanguage v1.OptString) entity.LocalePreference {
+	return entity.LocalePreference{
+		Profile:        h.sessionManager.GetString(ctx, "userLocale"),
+		AcceptLanguage: acceptLanguage.Or(""),
+	}
+}
+
+// isAdmin reports whether the session user has the admin role.
+func (h *Handler) isAdmin(ctx context.Context) bool {
+	return h.sessionManager.GetString(ctx, "userRole") == entity.RoleAdmin
+}
+
+// validationError converts a usecase validation error into the API error schema.
+func validationError(err error) (*v1.Error, bool) {
+	var vErr *entity.ValidationError
+	if !errors.As(err, &vErr) {
+		return nil, false
+	}
+
+	details := make([]v1.ErrorDetail, len(vErr.Details))
+	for i, d := range vErr.Details {
+		details[i] = v1.ErrorDetail{Path: d.Path, Message: d.Message}
+	}
+	return &v1.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Message: vErr.Message,
+		Details: details,
+	}, true
+}
+
+func toCatalogItem(item *entity.CatalogItem) *v1.CatalogItem {
+	resp := &v1.CatalogItem{
+		ID:          v1.NewOptUUID(item.ID),
+		Title:       v1.NewOptString(item.Title),
+		Description: v1.NewOptString(item.Description),
+		Disabled:    v1.NewOptBool(item.Disabled),
+		Tags:        item.Tags,
+		CreatedAt:   v1.NewOptDateTime(item.CreatedAt),
+		IsFavorite:  v1.NewOptBool(item.IsFavorite),
+		UpdatedAt:   v1.NewOptDateTime(item.UpdatedAt),
+	}
+	if item.CategoryID != uuid.Nil {
+		resp.CategoryID = v1.NewOptUUID(item.CategoryID)
+		resp.CategoryPath = v1.NewOptString(item.CategoryPath)
+	}
+	if item.SKU != "" {
+		resp.Sku = v1.NewOptString(item.SKU)
+	}
+	if item.Locale != "" {
+		resp.Locale = v1.NewOptString(item.Locale)
+	}
+	for i := range item.Images {
+		resp.Images = append(resp.Images, *toCatalogImage(&item.Images[i]))
+	}
+	if item.Price != nil {
+		resp.Price = v1.NewOptMoney(toMoney(*item.Price))
+	}
+	if item.StockQuantity != nil {
+		resp.StockQuantity = v1.NewOptInt64(*item.StockQuantity)
+		resp.AvailableQuantity = v1.NewOptInt64(*item.AvailableQuantity())
+	}
+	resp.Availability = v1.NewOptCatalogItemAvailability(v1.CatalogItemAvailability(item.Availability()))
+	resp.RatingAverage = v1.NewOptFloat64(item.AverageRating())
+	resp.ReviewCount = v1.NewOptInt64(item.ReviewCount)
+	if item.DeletedAt != nil {
+		resp.DeletedAt = v1.NewOptDateTime(*item.DeletedAt)
+	}
+	return resp
+}
+
+func toMoney(m entity.Money) v1.Money {
+	return v1.Money{Amount: m.Decimal(), Currency: m.Currency}
+}
+
+func toCatalogReview(review *entity.CatalogReview) *v1.CatalogReview {
+	return &v1.CatalogReview{
+		ID:        review.ID,
+		ItemID:    review.ItemID,
+		UserID:    review.UserID,
+		Rating:    review.Rating,
+		Body:      review.Body,
+		Status:    v1.CatalogReviewStatus(review.Status),
+		CreatedAt: review.CreatedAt,
+		UpdatedAt: review.UpdatedAt,
+	}
+}
+
+func toDataEntry(data *entity.Data) *v1.DataEntry {
+	resp := &v1.DataEntry{
+		Key:       data.Key,
+		Value:     jx.Raw(data.Value),
+		CreatedAt: v1.NewOptDateTime(data.CreatedAt),
+	}
+	if data.ExpiresAt != nil {
+		resp.ExpiresAt = v1.NewOptDateTime(*data.ExpiresAt)
+	}
+	if data.DeletedAt != nil {
+		resp.DeletedAt = v1.NewOptDateTime(*data.DeletedAt)
+	}
+	return resp
+}
+
+func toCatalogTranslation(t *entity.CatalogTranslation) *v1.CatalogTranslation {
+	return &v1.CatalogTranslation{
+		Locale:      t.Locale,
+		Title:       t.Title,
+		Description: t.Description,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+func toCatalogCategory(category *entity.CatalogCategory) *v1.CatalogCategory {
+	resp := &v1.CatalogCategory{
+		ID:        category.ID,
+		Slug:      category.Slug,
+		Name:      category.Name,
+		Path:      category.Path,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+	if category.ParentID != uuid.Nil {
+		resp.ParentID = v1.NewOptUUID(category.ParentID)
+	}
+	return resp
+}
+
+// conflictError reports an entity.ErrConflict error with its explanation, e.g. which item
+// blocks a changeset.
+func conflictError(err error) *v1.Error {
+	return &v1.Error{
+		Code:    http.StatusConflict,
+		Message: err.Error(),
+	}
+}
+
+func toCatalogChangeset(changeset *entity.CatalogChangeset) *v1.CatalogChangeset {
+	resp := &v1.CatalogChangeset{
+		ID:        changeset.ID,
+		Title:     changeset.Title,
+		Status:    v1.CatalogChangesetStatus(changeset.Status),
+		CreatedAt: changeset.CreatedAt,
+	}
+	if changeset.CreatedBy != uuid.Nil {
+		resp.CreatedBy = v1.NewOptUUID(changeset.CreatedBy)
+	}
+	if changeset.PublishedAt != nil {
+		resp.PublishedBy = v1.NewOptUUID(changeset.PublishedBy)
+		resp.PublishedAt = v1.NewOptDateTime(*changeset.PublishedAt)
+	}
+	if changeset.RolledBackAt != nil {
+		resp.RolledBackBy = v1.NewOptUUID(changeset.RolledBackBy)
+		resp.RolledBackAt = v1.NewOptDateTime(*changeset.RolledBackAt)
+	}
+	if changeset.Drafts != nil {
+		resp.Drafts = make([]v1.CatalogDraft, len(changeset.Drafts))
+		for i := range changeset.Drafts {
+			resp.Drafts[i] = *toCatalogDraft(&changeset.Drafts[i])
+		}
+	}
+	return resp
+}
+
+func toCatalogDraft(draft *entity.CatalogDraft) *v1.CatalogDraft {
+	resp := &v1.CatalogDraft{
+		Action:    v1.CatalogDraftAction(draft.Action),
+		UpdatedAt: draft.UpdatedAt,
+	}
+	if draft.Action == entity.CatalogDraftDelete {
+		resp.Item = v1.CatalogItem{ID: v1.NewOptUUID(draft.Item.ID)}
+	} else {
+		resp.Item = *toCatalogItem(&draft.Item)
+	}
+	if draft.BaseUpdatedAt != nil {
+		resp.BaseUpdatedAt = v1.NewOptDateTime(*draft.BaseUpdatedAt)
+	}
+	return resp
+}
+
+func toDataSchema(schema *entity.DataSchema) (*v1.DataSchema, error) {
+	var doc v1.DataSchemaSchema
+	if err := doc.UnmarshalJSON(schema.Schema); err != nil {
+		return nil, err
+	}
+	return &v1.DataSchema{
+		Prefix:    schema.Prefix,
+		Schema:    doc,
+		CreatedAt: v1.NewOptDateTime(schema.CreatedAt),
+		UpdatedAt: v1.NewOptDateTime(schema.UpdatedAt),
+	}, nil
+}
+
+// --- Security Handler ---
+
+// HandleCookieAuth implements cookieAuth security scheme.
+func (h *Handler) HandleCookieAuth(ctx context.Context, operationName string, t v1.CookieAuth) (context.Context, error) {
+	userID := h.sessionManager.GetString(ctx, "userID")
+	if userID == "" {
+		// Return a standard error that ogen will interpret as a 401 Unauthorized.
+		return ctx, errors.New("unauthorized")
+	}
+	return ctx, nil
+}
+
+// --- Static File Server ---
+
+// ServeHTTP serves the frontend files.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p := r.URL.Path
+	if strings.HasSuffix(p, "/") {
+		p = strings.TrimSuffix(p, "/") + "/index.html"
+	}
+	p = strings.TrimPrefix(p, "/")
+
+	f, err := h.contentFS.Open(p)
+	if err != nil {
+		http.ServeFileFS(w, r, h.contentFS, "index.html")
+		return
+	}
+	_ = f.Close()
+
+	http.FileServer(http.FS(h.contentFS)).ServeHTTP(w, r)
+}
+
+func fromWebhookEndpointRequest(req *v1.WebhookEndpointRequest) *entity.WebhookEndpoint {
+	types := make([]entity.OutboxEventType, len(req.EventTypes))
+	for i, t := range req.EventTypes {
+		types[i] = entity.OutboxEventType(t)
+	}
+	return &entity.WebhookEndpoint{
+		URL:        req.URL,
+		Secret:     req.Secret.Or(""),
+		EventTypes: types,
+		Enabled:    req.Enabled.Or(true),
+	}
+}
+
+// toWebhookEndpoint converts an endpoint for the API. The secret is only included when
+// withSecret is set, right after the admin set it or it was generated.
+func toWebhookEndpoint(endpoint *entity.WebhookEndpoint, withSecret bool) *v1.WebhookEndpoint {
+	types := make([]v1.WebhookEventType, len(endpoint.EventTypes))
+	for i, t := range endpoint.EventTypes {
+		types[i] = v1.WebhookEventType(t)
+	}
+	resp := &v1.WebhookEndpoint{
+		ID:                  endpoint.ID,
+		URL:                 endpoint.URL,
+		EventTypes:          types,
+		Enabled:             endpoint.Enabled,
+		ConsecutiveFailures: endpoint.ConsecutiveFailures,
+		CreatedAt:           endpoint.CreatedAt,
+		UpdatedAt:           endpoint.UpdatedAt,
+	}
+	if withSecret {
+		resp.Secret = v1.NewOptString(endpoint.Secret)
+	}
+	if endpoint.DisabledAt != nil {
+		resp.DisabledAt = v1.NewOptDateTime(*endpoint.DisabledAt)
+	}
+	return resp
+}
+
+func toWebhookDelivery(delivery *entity.WebhookDelivery) *v1.WebhookDelivery {
+	resp := &v1.WebhookDelivery{
+		ID:            delivery.ID,
+		EndpointID:    delivery.EndpointID,
+		EventID:       delivery.Event.ID,
+		EventType:     v1.WebhookEventType(delivery.Event.Type),
+		Status:        v1.WebhookDeliveryStatus(delivery.Status),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		CreatedAt:     delivery.CreatedAt,
+	}
+	if delivery.LastAttemptAt != nil {
+		resp.LastAttemptAt = v1.NewOptDateTime(*delivery.LastAttemptAt)
+	}
+	if delivery.ResponseStatus != 0 {
+		resp.ResponseStatus = v1.NewOptInt(delivery.ResponseStatus)
+	}
+	if delivery.LastError != "" {
+		resp.LastError = v1.NewOptString(delivery.LastError)
+	}
+	return resp
 }
@@ -0,0 +1,201 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"base_app/internal/config"
+	"base_app/internal/entity"
+	v1 "base_app/internal/handler/http/v1"
+	"base_app/internal/usecase"
+	"github.com/alexedwards/scs/v2"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client-chosen key of a mutating request.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// idempotentReplayedHeader marks responses served from the idempotency store.
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	// maxIdempotencyKeyLength bounds the size of stored keys.
+	maxIdempotencyKeyLength = 255
+)
+
+// Idempotency returns middleware that makes mutating requests carrying an
+// Idempotency-Key header safe to retry.
+//
+// The first response (status and body) is stored per user and key. A retry with the
+// same method, URL and body within cfg.TTL gets the stored response back, a different
+// request under the same key gets 422, and a retry while the first request is still
+// running gets 409. Server errors are not stored, so they can be retried.
+// Requests without the header or without a session pass through unchanged.
+func Idempotency(store usecase.IdempotencyStore, sm *scs.SessionManager, cfg config.IdempotencyConfig, log *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const op = "handler.Idempotency"
+
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || !isMutating(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ctx := r.Context()
+			userID := sm.GetString(ctx, "userID")
+			if userID == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				writeError(w, http.StatusBadRequest, "idempotency key is too long")
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, cfg.MaxBodyBytes+1))
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "failed to read request body")
+				return
+			}
+			if int64(len(body)) > cfg.MaxBodyBytes {
+				writeError(w, http.StatusRequestEntityTooLarge, "request body is too large for an idempotent request")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			storeKey := userID + ":" + key
+			requestHash := hashRequest(r, body)
+
+			existing, reserved, err := store.Reserve(ctx, storeKey, requestHash, cfg.LockTimeout)
+			if err != nil {
+				log.Error("failed to reserve idempotency key", slog.String("op", op), slog.String("error", err.Error()))
+				writeError(w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+				return
+			}
+			if !reserved {
+				switch {
+				case existing.RequestHash != requestHash:
+					writeError(w, http.StatusUnprocessableEntity, "idempotency key was already used for a different request")
+				case !existing.Completed:
+					w.Header().Set("Retry-After", "1")
+					writeError(w, http.StatusConflict, "a request with this idempotency key is still in progress")
+				default:
+					replay(w, existing)
+				}
+				return
+			}
+
+			rec := &recordingWriter{ResponseWriter: w, limit: cfg.MaxBodyBytes}
+			// Store writes must not be lost when the client goes away mid-request.
+			storeCtx := context.WithoutCancel(ctx)
+			completed := false
+			defer func() {
+				// Also runs on panic, so a crashed request does not hold the key until LockTimeout.
+				if completed {
+					return
+				}
+				if err := store.Release(storeCtx, storeKey); err != nil {
+					log.Error("failed to release idempotency key", slog.String("op", op), slog.String("error", err.Error()))
+				}
+			}()
+
+			next.ServeHTTP(rec, r)
+
+			if rec.status >= http.StatusInternalServerError || rec.overflow {
+				return
+			}
+			if err := store.Complete(storeCtx, storeKey, &entity.IdempotencyRecord{
+				RequestHash: requestHash,
+				Completed:   true,
+				StatusCode:  rec.statusCode(),
+				ContentType: w.Header().Get("Content-Type"),
+				Body:        rec.body.Bytes(),
+			}, cfg.TTL); err != nil {
+				log.Error("failed to store idempotent response", slog.String("op", op), slog.String("error", err.Error()))
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// hashRequest fingerprints everything that makes two requests the same operation.
+func hashRequest(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + "\n" + r.URL.RequestURI() + "\n" + r.Header.Get("Content-Type") + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func replay(w http.ResponseWriter, rec *entity.IdempotencyRecord) {
+	if rec.ContentType != "" {
+		w.Header().Set("Content-Type", rec.ContentType)
+	}
+	w.Header().Set(idempotentReplayedHeader, "true")
+	w.WriteHeader(rec.StatusCode)
+	_, _ = w.Write(rec.Body)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	body, err := (&v1.Error{Code: int32(code), Message: message}).MarshalJSON()
+	if err != nil {
+		http.Error(w, message, code)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(body)
+}
+
+// recordingWriter passes the response through while keeping a copy of it.
+type recordingWriter struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	limit    int64
+	overflow bool // the body exceeded limit and was not kept
+}
+
+func (rw *recordingWriter) WriteHeader(code int) {
+	if rw.status == 0 {
+		rw.status = code
+	}
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *recordingWriter) Write(p []byte) (int, error) {
+	if rw.status == 0 {
+		rw.status = http.StatusOK
+	}
+	if !rw.overflow {
+		if int64(rw.body.Len()+len(p)) > rw.limit {
+			rw.overflow = true
+			rw.body.Reset()
+		} else {
+			rw.body.Write(p)
+		}
+	}
+	return rw.ResponseWriter.Write(p)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (rw *recordingWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+func (rw *recordingWriter) statusCode() int {
+	if rw.status == 0 {
+		return http.StatusOK
+	}
+	return rw.status
+}
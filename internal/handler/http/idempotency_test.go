@@ -0,0 +1,200 @@
+package http
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"base_app/internal/adapter/idempotency"
+	"base_app/internal/config"
+	"github.com/alexedwards/scs/v2"
+)
+
+var testIdempotencyConfig = config.IdempotencyConfig{
+	Enabled:      true,
+	TTL:          time.Hour,
+	LockTimeout:  time.Minute,
+	MaxBodyBytes: 1 << 10,
+}
+
+// idempotencyServer wraps next in the middleware with a fresh store and returns a function
+// sending requests as a logged-in user.
+func idempotencyServer(t *testing.T, next http.Handler) func(method, body string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+
+	sm := scs.New()
+	h := Idempotency(idempotency.NewInMemoryStore(), sm, testIdempotencyConfig, slog.New(slog.DiscardHandler))(next)
+	return func(method, body string, header http.Header) *httptest.ResponseRecorder {
+		ctx, err := sm.Load(context.Background(), "")
+		if err != nil {
+			t.Fatalf("load session: %v", err)
+		}
+		sm.Put(ctx, "userID", "user-1")
+
+		r := httptest.NewRequestWithContext(ctx, method, "/api/v1/data", strings.NewReader(body))
+		for k, v := range header {
+			r.Header[k] = v
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+}
+
+func withKey(key string) http.Header {
+	return http.Header{IdempotencyKeyHeader: {key}}
+}
+
+func TestIdempotencyReplaysCompletedResponse(t *testing.T) {
+	var calls atomic.Int32
+	send := idempotencyServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"echo":` + string(body) + `}`))
+	}))
+
+	first := send(http.MethodPost, `1`, withKey("k"))
+	second := send(http.MethodPost, `1`, withKey("k"))
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("handler calls = %d, want 1", got)
+	}
+	if second.Code != http.StatusCreated || second.Body.String() != first.Body.String() {
+		t.Errorf("replay = %d %q, want %d %q", second.Code, second.Body, first.Code, first.Body)
+	}
+	if got := second.Header().Get(idempotentReplayedHeader); got != "true" {
+		t.Errorf("%s = %q, want true", idempotentReplayedHeader, got)
+	}
+	if got := second.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+	if first.Header().Get(idempotentReplayedHeader) != "" {
+		t.Errorf("first response is marked as replayed")
+	}
+}
+
+func TestIdempotencyRejectsDifferentRequestUnderSameKey(t *testing.T) {
+	send := idempotencyServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	send(http.MethodPost, `1`, withKey("k"))
+	tests := []struct {
+		name   string
+		method string
+		body   string
+	}{
+		{"other body", http.MethodPost, `2`},
+		{"other method", http.MethodPut, `1`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := send(tt.method, tt.body, withKey("k")); w.Code != http.StatusUnprocessableEntity {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusUnprocessableEntity)
+			}
+		})
+	}
+}
+
+func TestIdempotencyConflictsWhileInProgress(t *testing.T) {
+	started, finish := make(chan struct{}), make(chan struct{})
+	send := idempotencyServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-finish
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- send(http.MethodPost, `1`, withKey("k")) }()
+	<-started
+
+	w := send(http.MethodPost, `1`, withKey("k"))
+	if w.Code != http.StatusConflict {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusConflict)
+	}
+	if w.Header().Get("Retry-After") == "" {
+		t.Errorf("Retry-After is missing")
+	}
+
+	close(finish)
+	if first := <-done; first.Code != http.StatusOK {
+		t.Errorf("first status = %d, want %d", first.Code, http.StatusOK)
+	}
+}
+
+func TestIdempotencyDoesNotStoreServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	send := idempotencyServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	if w := send(http.MethodPost, `1`, withKey("k")); w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("first status = %d, want %d", w.Code, http.StatusServiceUnavailable)
+	}
+	w := send(http.MethodPost, `1`, withKey("k"))
+	if w.Code != http.StatusOK || w.Header().Get(idempotentReplayedHeader) != "" {
+		t.Errorf("retry = %d replayed=%q, want a fresh %d", w.Code, w.Header().Get(idempotentReplayedHeader), http.StatusOK)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("handler calls = %d, want 2", got)
+	}
+}
+
+func TestIdempotencyPassesThrough(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		header http.Header
+	}{
+		{"no key", http.MethodPost, nil},
+		{"safe method", http.MethodGet, withKey("k")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			send := idempotencyServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+			}))
+			send(tt.method, `1`, tt.header)
+			send(tt.method, `1`, tt.header)
+			if got := calls.Load(); got != 2 {
+				t.Errorf("handler calls = %d, want 2", got)
+			}
+		})
+	}
+}
+
+func TestIdempotencyRejectsOversizedRequests(t *testing.T) {
+	send := idempotencyServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler called")
+	}))
+
+	tests := []struct {
+		name   string
+		body   string
+		header http.Header
+		want   int
+	}{
+		{"long key", `1`, withKey(strings.Repeat("k", maxIdempotencyKeyLength+1)), http.StatusBadRequest},
+		{"large body", strings.Repeat("1", int(testIdempotencyConfig.MaxBodyBytes)+1), withKey("k"), http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := send(http.MethodPost, tt.body, tt.header); w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
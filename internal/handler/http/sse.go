@@ -0,0 +1,119 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"base_app/internal/entity"
+)
+
+// sseHeartbeatInterval keeps idle streams alive through proxies and detects gone clients.
+const sseHeartbeatInterval = 15 * time.Second
+
+// WatchData streams changes of data keys as Server-Sent Events.
+//
+// GET /api/v1/data/watch?prefix=<prefix>
+//
+// Each event carries the row id as its SSE id, so a reconnecting EventSource resumes via
+// the Last-Event-ID header (or the last_event_id query parameter). Deletions have no id.
+// It is served outside the ogen router because the generated code can neither flush
+// partial responses nor lift the server write timeout.
+func (h *Handler) WatchData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if h.sessionManager.GetString(ctx, "userID") == "" {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	lastEventID, err := parseLastEventID(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	// The server-wide WriteTimeout would cut the stream off; streams end when the client leaves.
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	events, err := h.dataUsecase.WatchData(ctx, r.URL.Query().Get("prefix"), lastEventID)
+	if err != nil {
+		var vErr *entity.ValidationError
+		if errors.As(err, &vErr) {
+			http.Error(w, vErr.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := writeDataEvent(w, ev); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeDataEvent(w http.ResponseWriter, ev entity.DataEvent) error {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	if ev.ID != 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", ev.ID); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Type, payload)
+	return err
+}
+
+func parseLastEventID(r *http.Request) (int64, error) {
+	raw := r.Header.Get("Last-Event-ID")
+	if raw == "" {
+		raw = r.URL.Query().Get("last_event_id")
+	}
+	if raw == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id < 0 {
+		return 0, errors.New("invalid last event id")
+	}
+	return id, nil
+}
@@ -0,0 +1,27 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package v1
+
+// setDefaults set default value of fields.
+func (s *CatalogDraftRequest) setDefaults() {
+	{
+		val := CatalogDraftRequestAction("upsert")
+		s.Action.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CatalogItemRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.Disabled.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *WebhookEndpointRequest) setDefaults() {
+	{
+		val := bool(true)
+		s.Enabled.SetTo(val)
+	}
+}
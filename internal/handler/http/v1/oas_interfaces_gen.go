@@ -1,14 +1,198 @@
 // Code generated by ogen, DO NOT EDIT.
 package v1
 
+type AddCatalogFavoriteRes interface {
+	addCatalogFavoriteRes()
+}
+
+type CreateCatalogCategoryRes interface {
+	createCatalogCategoryRes()
+}
+
+type CreateCatalogChangesetRes interface {
+	createCatalogChangesetRes()
+}
+
+type CreateCatalogDraftRes interface {
+	createCatalogDraftRes()
+}
+
+type CreateCatalogItemRes interface {
+	createCatalogItemRes()
+}
+
+type CreateCatalogTagRes interface {
+	createCatalogTagRes()
+}
+
+type CreateWebhookEndpointRes interface {
+	createWebhookEndpointRes()
+}
+
+type DeleteAttachmentRes interface {
+	deleteAttachmentRes()
+}
+
+type DeleteCatalogCategoryRes interface {
+	deleteCatalogCategoryRes()
+}
+
+type DeleteCatalogChangesetRes interface {
+	deleteCatalogChangesetRes()
+}
+
+type DeleteCatalogDraftRes interface {
+	deleteCatalogDraftRes()
+}
+
+type DeleteCatalogImageRes interface {
+	deleteCatalogImageRes()
+}
+
+type DeleteCatalogItemRes interface {
+	deleteCatalogItemRes()
+}
+
+type DeleteCatalogReviewRes interface {
+	deleteCatalogReviewRes()
+}
+
+type DeleteCatalogTagRes interface {
+	deleteCatalogTagRes()
+}
+
+type DeleteCatalogTranslationRes interface {
+	deleteCatalogTranslationRes()
+}
+
+type DeleteDataRes interface {
+	deleteDataRes()
+}
+
+type DeleteDataSchemaRes interface {
+	deleteDataSchemaRes()
+}
+
+type DeleteWebhookEndpointRes interface {
+	deleteWebhookEndpointRes()
+}
+
+type ExportDataRes interface {
+	exportDataRes()
+}
+
+type GetCatalogCategoryRes interface {
+	getCatalogCategoryRes()
+}
+
+type GetCatalogChangesetRes interface {
+	getCatalogChangesetRes()
+}
+
+type GetCatalogItemRes interface {
+	getCatalogItemRes()
+}
+
 type GetCatalogRes interface {
 	getCatalogRes()
 }
 
+type GetCatalogReviewRes interface {
+	getCatalogReviewRes()
+}
+
+type GetCatalogV2Res interface {
+	getCatalogV2Res()
+}
+
+type GetDataRes interface {
+	getDataRes()
+}
+
+type GetDataUsageRes interface {
+	getDataUsageRes()
+}
+
 type GetMeRes interface {
 	getMeRes()
 }
 
+type GetWebhookEndpointRes interface {
+	getWebhookEndpointRes()
+}
+
+type ImportCatalogReq interface {
+	importCatalogReq()
+}
+
+type ImportCatalogRes interface {
+	importCatalogRes()
+}
+
+type ImportDataReq interface {
+	importDataReq()
+}
+
+type ImportDataRes interface {
+	importDataRes()
+}
+
+type ListAttachmentsRes interface {
+	listAttachmentsRes()
+}
+
+type ListCatalogCategoriesRes interface {
+	listCatalogCategoriesRes()
+}
+
+type ListCatalogChangesetsRes interface {
+	listCatalogChangesetsRes()
+}
+
+type ListCatalogFavoritesRes interface {
+	listCatalogFavoritesRes()
+}
+
+type ListCatalogReviewsByStatusRes interface {
+	listCatalogReviewsByStatusRes()
+}
+
+type ListCatalogReviewsRes interface {
+	listCatalogReviewsRes()
+}
+
+type ListCatalogTagsRes interface {
+	listCatalogTagsRes()
+}
+
+type ListCatalogTranslationsRes interface {
+	listCatalogTranslationsRes()
+}
+
+type ListDataSchemasRes interface {
+	listDataSchemasRes()
+}
+
+type ListDeletedCatalogItemsRes interface {
+	listDeletedCatalogItemsRes()
+}
+
+type ListDeletedDataRes interface {
+	listDeletedDataRes()
+}
+
+type ListRecentlyViewedCatalogItemsRes interface {
+	listRecentlyViewedCatalogItemsRes()
+}
+
+type ListWebhookDeliveriesRes interface {
+	listWebhookDeliveriesRes()
+}
+
+type ListWebhookEndpointsRes interface {
+	listWebhookEndpointsRes()
+}
+
 type LoginRes interface {
 	loginRes()
 }
@@ -20,3 +204,95 @@ type LogoutRes interface {
 type PostDataRes interface {
 	postDataRes()
 }
+
+type PreviewCatalogChangesetRes interface {
+	previewCatalogChangesetRes()
+}
+
+type PublishCatalogChangesetRes interface {
+	publishCatalogChangesetRes()
+}
+
+type PutCatalogDraftRes interface {
+	putCatalogDraftRes()
+}
+
+type PutCatalogTranslationRes interface {
+	putCatalogTranslationRes()
+}
+
+type PutDataSchemaRes interface {
+	putDataSchemaRes()
+}
+
+type RecordCatalogViewRes interface {
+	recordCatalogViewRes()
+}
+
+type RedeliverWebhookRes interface {
+	redeliverWebhookRes()
+}
+
+type ReleaseCatalogReservationRes interface {
+	releaseCatalogReservationRes()
+}
+
+type RemoveCatalogFavoriteRes interface {
+	removeCatalogFavoriteRes()
+}
+
+type RenameCatalogTagRes interface {
+	renameCatalogTagRes()
+}
+
+type ReserveCatalogItemRes interface {
+	reserveCatalogItemRes()
+}
+
+type RestoreCatalogItemRes interface {
+	restoreCatalogItemRes()
+}
+
+type RestoreDataRes interface {
+	restoreDataRes()
+}
+
+type RollbackCatalogChangesetRes interface {
+	rollbackCatalogChangesetRes()
+}
+
+type SaveCatalogReviewRes interface {
+	saveCatalogReviewRes()
+}
+
+type SearchCatalogRes interface {
+	searchCatalogRes()
+}
+
+type SetCatalogInventoryRes interface {
+	setCatalogInventoryRes()
+}
+
+type SetCatalogItemDisabledRes interface {
+	setCatalogItemDisabledRes()
+}
+
+type SetCatalogReviewStatusRes interface {
+	setCatalogReviewStatusRes()
+}
+
+type SetMyLocaleRes interface {
+	setMyLocaleRes()
+}
+
+type UpdateCatalogCategoryRes interface {
+	updateCatalogCategoryRes()
+}
+
+type UpdateCatalogItemRes interface {
+	updateCatalogItemRes()
+}
+
+type UpdateWebhookEndpointRes interface {
+	updateWebhookEndpointRes()
+}
@@ -6,9 +6,76 @@ package v1
 type OperationName = string
 
 const (
-	GetCatalogOperation OperationName = "GetCatalog"
-	GetMeOperation      OperationName = "GetMe"
-	LoginOperation      OperationName = "Login"
-	LogoutOperation     OperationName = "Logout"
-	PostDataOperation   OperationName = "PostData"
+	AddCatalogFavoriteOperation             OperationName = "AddCatalogFavorite"
+	CreateCatalogCategoryOperation          OperationName = "CreateCatalogCategory"
+	CreateCatalogChangesetOperation         OperationName = "CreateCatalogChangeset"
+	CreateCatalogDraftOperation             OperationName = "CreateCatalogDraft"
+	CreateCatalogItemOperation              OperationName = "CreateCatalogItem"
+	CreateCatalogTagOperation               OperationName = "CreateCatalogTag"
+	CreateWebhookEndpointOperation          OperationName = "CreateWebhookEndpoint"
+	DeleteAttachmentOperation               OperationName = "DeleteAttachment"
+	DeleteCatalogCategoryOperation          OperationName = "DeleteCatalogCategory"
+	DeleteCatalogChangesetOperation         OperationName = "DeleteCatalogChangeset"
+	DeleteCatalogDraftOperation             OperationName = "DeleteCatalogDraft"
+	DeleteCatalogImageOperation             OperationName = "DeleteCatalogImage"
+	DeleteCatalogItemOperation              OperationName = "DeleteCatalogItem"
+	DeleteCatalogReviewOperation            OperationName = "DeleteCatalogReview"
+	DeleteCatalogTagOperation               OperationName = "DeleteCatalogTag"
+	DeleteCatalogTranslationOperation       OperationName = "DeleteCatalogTranslation"
+	DeleteDataOperation                     OperationName = "DeleteData"
+	DeleteDataSchemaOperation               OperationName = "DeleteDataSchema"
+	DeleteWebhookEndpointOperation          OperationName = "DeleteWebhookEndpoint"
+	ExportDataOperation                     OperationName = "ExportData"
+	GetCatalogOperation                     OperationName = "GetCatalog"
+	GetCatalogCategoryOperation             OperationName = "GetCatalogCategory"
+	GetCatalogChangesetOperation            OperationName = "GetCatalogChangeset"
+	GetCatalogItemOperation                 OperationName = "GetCatalogItem"
+	GetCatalogReviewOperation               OperationName = "GetCatalogReview"
+	GetCatalogV2Operation                   OperationName = "GetCatalogV2"
+	GetDataOperation                        OperationName = "GetData"
+	GetDataUsageOperation                   OperationName = "GetDataUsage"
+	GetMeOperation                          OperationName = "GetMe"
+	GetWebhookEndpointOperation             OperationName = "GetWebhookEndpoint"
+	ImportCatalogOperation                  OperationName = "ImportCatalog"
+	ImportDataOperation                     OperationName = "ImportData"
+	ListAttachmentsOperation                OperationName = "ListAttachments"
+	ListCatalogCategoriesOperation          OperationName = "ListCatalogCategories"
+	ListCatalogChangesetsOperation          OperationName = "ListCatalogChangesets"
+	ListCatalogFavoritesOperation           OperationName = "ListCatalogFavorites"
+	ListCatalogReviewsOperation             OperationName = "ListCatalogReviews"
+	ListCatalogReviewsByStatusOperation     OperationName = "ListCatalogReviewsByStatus"
+	ListCatalogTagsOperation                OperationName = "ListCatalogTags"
+	ListCatalogTranslationsOperation        OperationName = "ListCatalogTranslations"
+	ListDataSchemasOperation                OperationName = "ListDataSchemas"
+	ListDeletedCatalogItemsOperation        OperationName = "ListDeletedCatalogItems"
+	ListDeletedDataOperation                OperationName = "ListDeletedData"
+	ListRecentlyViewedCatalogItemsOperation OperationName = "ListRecentlyViewedCatalogItems"
+	ListWebhookDeliveriesOperation          OperationName = "ListWebhookDeliveries"
+	ListWebhookEndpointsOperation           OperationName = "ListWebhookEndpoints"
+	LoginOperation                          OperationName = "Login"
+	LogoutOperation                         OperationName = "Logout"
+	PostDataOperation                       OperationName = "PostData"
+	PreviewCatalogChangesetOperation        OperationName = "PreviewCatalogChangeset"
+	PublishCatalogChangesetOperation        OperationName = "PublishCatalogChangeset"
+	PutCatalogDraftOperation                OperationName = "PutCatalogDraft"
+	PutCatalogTranslationOperation          OperationName = "PutCatalogTranslation"
+	PutDataSchemaOperation                  OperationName = "PutDataSchema"
+	RecordCatalogViewOperation              OperationName = "RecordCatalogView"
+	RedeliverWebhookOperation               OperationName = "RedeliverWebhook"
+	ReleaseCatalogReservationOperation      OperationName = "ReleaseCatalogReservation"
+	RemoveCatalogFavoriteOperation          OperationName = "RemoveCatalogFavorite"
+	RenameCatalogTagOperation               OperationName = "RenameCatalogTag"
+	ReserveCatalogItemOperation             OperationName = "ReserveCatalogItem"
+	RestoreCatalogItemOperation             OperationName = "RestoreCatalogItem"
+	RestoreDataOperation                    OperationName = "RestoreData"
+	RollbackCatalogChangesetOperation       OperationName = "RollbackCatalogChangeset"
+	SaveCatalogReviewOperation              OperationName = "SaveCatalogReview"
+	SearchCatalogOperation                  OperationName = "SearchCatalog"
+	SetCatalogInventoryOperation            OperationName = "SetCatalogInventory"
+	SetCatalogItemDisabledOperation         OperationName = "SetCatalogItemDisabled"
+	SetCatalogReviewStatusOperation         OperationName = "SetCatalogReviewStatus"
+	SetMyLocaleOperation                    OperationName = "SetMyLocale"
+	UpdateCatalogCategoryOperation          OperationName = "UpdateCatalogCategory"
+	UpdateCatalogItemOperation              OperationName = "UpdateCatalogItem"
+	UpdateWebhookEndpointOperation          OperationName = "UpdateWebhookEndpoint"
 )
@@ -40,6 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -48,9 +49,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/v1/"
+		case '/': // Prefix: "/api/v"
 
-			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
+			if l := len("/api/v"); len(elem) >= l && elem[0:l] == "/api/v" {
 				elem = elem[l:]
 			} else {
 				break
@@ -60,9 +61,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "auth/"
+			case '1': // Prefix: "1/"
 
-				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+				if l := len("1/"); len(elem) >= l && elem[0:l] == "1/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -72,9 +73,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'l': // Prefix: "log"
+				case 'a': // Prefix: "auth/"
 
-					if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+					if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -84,29 +85,1044 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'i': // Prefix: "in"
+					case 'l': // Prefix: "log"
 
-						if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+						if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'i': // Prefix: "in"
+
+							if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleLoginRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'o': // Prefix: "out"
+
+							if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleLogoutRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					case 'm': // Prefix: "me"
+
+						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
-							case "POST":
-								s.handleLoginRequest([0]string{}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetMeRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "POST")
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/locale"
+
+							if l := len("/locale"); len(elem) >= l && elem[0:l] == "/locale" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "PUT":
+									s.handleSetMyLocaleRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "PUT")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 'c': // Prefix: "catalog"
+
+					if l := len("catalog"); len(elem) >= l && elem[0:l] == "catalog" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetCatalogRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateCatalogItemRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "c"
+							origElem := elem
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ategories"
+
+								if l := len("ategories"); len(elem) >= l && elem[0:l] == "ategories" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListCatalogCategoriesRequest([0]string{}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleCreateCatalogCategoryRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteCatalogCategoryRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetCatalogCategoryRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleUpdateCatalogCategoryRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET,PUT")
+										}
+
+										return
+									}
+
+								}
+
+							case 'h': // Prefix: "hangesets"
+
+								if l := len("hangesets"); len(elem) >= l && elem[0:l] == "hangesets" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListCatalogChangesetsRequest([0]string{}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleCreateCatalogChangesetRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteCatalogChangesetRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetCatalogChangesetRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'i': // Prefix: "items"
+
+											if l := len("items"); len(elem) >= l && elem[0:l] == "items" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "POST":
+													s.handleCreateCatalogDraftRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "item_id"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[1] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "DELETE":
+														s.handleDeleteCatalogDraftRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													case "PUT":
+														s.handlePutCatalogDraftRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "DELETE,PUT")
+													}
+
+													return
+												}
+
+											}
+
+										case 'p': // Prefix: "p"
+
+											if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'r': // Prefix: "review"
+
+												if l := len("review"); len(elem) >= l && elem[0:l] == "review" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handlePreviewCatalogChangesetRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+
+											case 'u': // Prefix: "ublish"
+
+												if l := len("ublish"); len(elem) >= l && elem[0:l] == "ublish" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handlePublishCatalogChangesetRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "POST")
+													}
+
+													return
+												}
+
+											}
+
+										case 'r': // Prefix: "rollback"
+
+											if l := len("rollback"); len(elem) >= l && elem[0:l] == "rollback" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleRollbackCatalogChangesetRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						case 'd': // Prefix: "deleted"
+							origElem := elem
+							if l := len("deleted"); len(elem) >= l && elem[0:l] == "deleted" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleListDeletedCatalogItemsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'f': // Prefix: "favorites"
+							origElem := elem
+							if l := len("favorites"); len(elem) >= l && elem[0:l] == "favorites" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleListCatalogFavoritesRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'r': // Prefix: "re"
+							origElem := elem
+							if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cently-viewed"
+
+								if l := len("cently-viewed"); len(elem) >= l && elem[0:l] == "cently-viewed" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleListRecentlyViewedCatalogItemsRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'v': // Prefix: "views"
+
+								if l := len("views"); len(elem) >= l && elem[0:l] == "views" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListCatalogReviewsByStatusRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "review_id"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/status"
+
+										if l := len("/status"); len(elem) >= l && elem[0:l] == "/status" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "PUT":
+												s.handleSetCatalogReviewStatusRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "PUT")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						case 's': // Prefix: "search"
+							origElem := elem
+							if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleSearchCatalogRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 't': // Prefix: "tags"
+							origElem := elem
+							if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListCatalogTagsRequest([0]string{}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleCreateCatalogTagRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,POST")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "name"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleDeleteCatalogTagRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleRenameCatalogTagRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,PUT")
+									}
+
+									return
+								}
+
+							}
+
+							elem = origElem
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteCatalogItemRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetCatalogItemRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUpdateCatalogItemRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PUT")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'd': // Prefix: "disabled"
+
+								if l := len("disabled"); len(elem) >= l && elem[0:l] == "disabled" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "PUT":
+										s.handleSetCatalogItemDisabledRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "PUT")
+									}
+
+									return
+								}
+
+							case 'f': // Prefix: "favorite"
+
+								if l := len("favorite"); len(elem) >= l && elem[0:l] == "favorite" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleRemoveCatalogFavoriteRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleAddCatalogFavoriteRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,PUT")
+									}
+
+									return
+								}
+
+							case 'i': // Prefix: "i"
+
+								if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'm': // Prefix: "mages/"
+
+									if l := len("mages/"); len(elem) >= l && elem[0:l] == "mages/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "image_id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteCatalogImageRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+								case 'n': // Prefix: "nventory"
+
+									if l := len("nventory"); len(elem) >= l && elem[0:l] == "nventory" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "PUT":
+											s.handleSetCatalogInventoryRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "PUT")
+										}
+
+										return
+									}
+
+								}
+
+							case 'r': // Prefix: "re"
+
+								if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'l': // Prefix: "lease"
+
+									if l := len("lease"); len(elem) >= l && elem[0:l] == "lease" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleReleaseCatalogReservationRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 's': // Prefix: "s"
+
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'e': // Prefix: "erve"
+
+										if l := len("erve"); len(elem) >= l && elem[0:l] == "erve" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleReserveCatalogItemRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									case 't': // Prefix: "tore"
+
+										if l := len("tore"); len(elem) >= l && elem[0:l] == "tore" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleRestoreCatalogItemRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								case 'v': // Prefix: "view"
+
+									if l := len("view"); len(elem) >= l && elem[0:l] == "view" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteCatalogReviewRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetCatalogReviewRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleSaveCatalogReviewRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET,PUT")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case 's': // Prefix: "s"
+
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleListCatalogReviewsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							case 't': // Prefix: "translations"
+
+								if l := len("translations"); len(elem) >= l && elem[0:l] == "translations" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListCatalogTranslationsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "locale"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteCatalogTranslationRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handlePutCatalogTranslationRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,PUT")
+										}
+
+										return
+									}
+
+								}
+
+							case 'v': // Prefix: "views"
+
+								if l := len("views"); len(elem) >= l && elem[0:l] == "views" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleRecordCatalogViewRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
 
-					case 'o': // Prefix: "out"
+					case ':': // Prefix: ":import"
 
-						if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+						if l := len(":import"); len(elem) >= l && elem[0:l] == ":import" {
 							elem = elem[l:]
 						} else {
 							break
@@ -116,7 +1132,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "POST":
-								s.handleLogoutRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleImportCatalogRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "POST")
 							}
@@ -126,51 +1142,376 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					}
 
-				case 'm': // Prefix: "me"
+				case 'd': // Prefix: "data"
 
-					if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+					if l := len("data"); len(elem) >= l && elem[0:l] == "data" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
+						case "DELETE":
+							s.handleDeleteDataRequest([0]string{}, elemIsEscaped, w, r)
 						case "GET":
-							s.handleGetMeRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleGetDataRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handlePostDataRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET")
+							s.notAllowed(w, r, "DELETE,GET,POST")
 						}
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-				}
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "attachments"
 
-			case 'c': // Prefix: "catalog"
+							if l := len("attachments"); len(elem) >= l && elem[0:l] == "attachments" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-				if l := len("catalog"); len(elem) >= l && elem[0:l] == "catalog" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListAttachmentsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleDeleteAttachmentRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+
+							}
+
+						case 'd': // Prefix: "deleted"
+
+							if l := len("deleted"); len(elem) >= l && elem[0:l] == "deleted" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleListDeletedDataRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 's': // Prefix: "schemas"
+
+							if l := len("schemas"); len(elem) >= l && elem[0:l] == "schemas" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleDeleteDataSchemaRequest([0]string{}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleListDataSchemasRequest([0]string{}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handlePutDataSchemaRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,PUT")
+								}
+
+								return
+							}
+
+						case 'u': // Prefix: "usage"
+
+							if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetDataUsageRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					case ':': // Prefix: ":"
+
+						if l := len(":"); len(elem) >= l && elem[0:l] == ":" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "export"
+
+							if l := len("export"); len(elem) >= l && elem[0:l] == "export" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleExportDataRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 'i': // Prefix: "import"
+
+							if l := len("import"); len(elem) >= l && elem[0:l] == "import" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleImportDataRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'r': // Prefix: "restore"
+
+							if l := len("restore"); len(elem) >= l && elem[0:l] == "restore" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleRestoreDataRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 'w': // Prefix: "webhooks"
+
+					if l := len("webhooks"); len(elem) >= l && elem[0:l] == "webhooks" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleListWebhookEndpointsRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateWebhookEndpointRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'd': // Prefix: "deliveries/"
+							origElem := elem
+							if l := len("deliveries/"); len(elem) >= l && elem[0:l] == "deliveries/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "delivery_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/redeliver"
+
+								if l := len("/redeliver"); len(elem) >= l && elem[0:l] == "/redeliver" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleRedeliverWebhookRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+							elem = origElem
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteWebhookEndpointRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetWebhookEndpointRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUpdateWebhookEndpointRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/deliveries"
+
+							if l := len("/deliveries"); len(elem) >= l && elem[0:l] == "/deliveries" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleListWebhookDeliveriesRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleGetCatalogRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
 					}
 
-					return
 				}
 
-			case 'd': // Prefix: "data"
+			case '2': // Prefix: "2/catalog"
 
-				if l := len("data"); len(elem) >= l && elem[0:l] == "data" {
+				if l := len("2/catalog"); len(elem) >= l && elem[0:l] == "2/catalog" {
 					elem = elem[l:]
 				} else {
 					break
@@ -179,10 +1520,10 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				if len(elem) == 0 {
 					// Leaf node.
 					switch r.Method {
-					case "POST":
-						s.handlePostDataRequest([0]string{}, elemIsEscaped, w, r)
+					case "GET":
+						s.handleGetCatalogV2Request([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "POST")
+						s.notAllowed(w, r, "GET")
 					}
 
 					return
@@ -203,7 +1544,7 @@ type Route struct {
 	operationGroup string
 	pathPattern    string
 	count          int
-	args           [0]string
+	args           [2]string
 }
 
 // Name returns ogen operation name.
@@ -276,9 +1617,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/v1/"
+		case '/': // Prefix: "/api/v"
 
-			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
+			if l := len("/api/v"); len(elem) >= l && elem[0:l] == "/api/v" {
 				elem = elem[l:]
 			} else {
 				break
@@ -288,9 +1629,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "auth/"
+			case '1': // Prefix: "1/"
 
-				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+				if l := len("1/"); len(elem) >= l && elem[0:l] == "1/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -300,9 +1641,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'l': // Prefix: "log"
+				case 'a': // Prefix: "auth/"
 
-					if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+					if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -312,23 +1653,86 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'i': // Prefix: "in"
+					case 'l': // Prefix: "log"
 
-						if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+						if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'i': // Prefix: "in"
+
+							if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = LoginOperation
+									r.summary = "Authenticate user"
+									r.operationID = "login"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/login"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'o': // Prefix: "out"
+
+							if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = LogoutOperation
+									r.summary = "Log out user"
+									r.operationID = "logout"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/logout"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'm': // Prefix: "me"
+
+						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch method {
-							case "POST":
-								r.name = LoginOperation
-								r.summary = "Authenticate user"
-								r.operationID = "login"
+							case "GET":
+								r.name = GetMeOperation
+								r.summary = "Get current user info"
+								r.operationID = "getMe"
 								r.operationGroup = ""
-								r.pathPattern = "/api/v1/auth/login"
+								r.pathPattern = "/api/v1/auth/me"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -336,10 +1740,1166 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/locale"
+
+							if l := len("/locale"); len(elem) >= l && elem[0:l] == "/locale" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-					case 'o': // Prefix: "out"
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "PUT":
+									r.name = SetMyLocaleOperation
+									r.summary = "Set the preferred locale of the current user"
+									r.operationID = "setMyLocale"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/me/locale"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
 
-						if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+						}
+
+					}
+
+				case 'c': // Prefix: "catalog"
+
+					if l := len("catalog"); len(elem) >= l && elem[0:l] == "catalog" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetCatalogOperation
+							r.summary = "Get catalog items"
+							r.operationID = "getCatalog"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/catalog"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateCatalogItemOperation
+							r.summary = "Create a catalog item (admin only)"
+							r.operationID = "createCatalogItem"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/catalog"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "c"
+							origElem := elem
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ategories"
+
+								if l := len("ategories"); len(elem) >= l && elem[0:l] == "ategories" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListCatalogCategoriesOperation
+										r.summary = "List catalog categories"
+										r.operationID = "listCatalogCategories"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/categories"
+										r.args = args
+										r.count = 0
+										return r, true
+									case "POST":
+										r.name = CreateCatalogCategoryOperation
+										r.summary = "Create a catalog category (admin only)"
+										r.operationID = "createCatalogCategory"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/categories"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteCatalogCategoryOperation
+											r.summary = "Delete a catalog category (admin only)"
+											r.operationID = "deleteCatalogCategory"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/categories/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = GetCatalogCategoryOperation
+											r.summary = "Get a catalog category"
+											r.operationID = "getCatalogCategory"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/categories/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PUT":
+											r.name = UpdateCatalogCategoryOperation
+											r.summary = "Rename or move a catalog category (admin only)"
+											r.operationID = "updateCatalogCategory"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/categories/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'h': // Prefix: "hangesets"
+
+								if l := len("hangesets"); len(elem) >= l && elem[0:l] == "hangesets" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListCatalogChangesetsOperation
+										r.summary = "List catalog changesets (admin only)"
+										r.operationID = "listCatalogChangesets"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/changesets"
+										r.args = args
+										r.count = 0
+										return r, true
+									case "POST":
+										r.name = CreateCatalogChangesetOperation
+										r.summary = "Create a catalog changeset (admin only)"
+										r.operationID = "createCatalogChangeset"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/changesets"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch method {
+										case "DELETE":
+											r.name = DeleteCatalogChangesetOperation
+											r.summary = "Discard a draft catalog changeset (admin only)"
+											r.operationID = "deleteCatalogChangeset"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/changesets/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = GetCatalogChangesetOperation
+											r.summary = "Get a catalog changeset with its drafts (admin only)"
+											r.operationID = "getCatalogChangeset"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/changesets/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'i': // Prefix: "items"
+
+											if l := len("items"); len(elem) >= l && elem[0:l] == "items" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch method {
+												case "POST":
+													r.name = CreateCatalogDraftOperation
+													r.summary = "Stage a new catalog item (admin only)"
+													r.operationID = "createCatalogDraft"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/catalog/changesets/{id}/items"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "item_id"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[1] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "DELETE":
+														r.name = DeleteCatalogDraftOperation
+														r.summary = "Unstage the draft of a catalog item (admin only)"
+														r.operationID = "deleteCatalogDraft"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/catalog/changesets/{id}/items/{item_id}"
+														r.args = args
+														r.count = 2
+														return r, true
+													case "PUT":
+														r.name = PutCatalogDraftOperation
+														r.summary = "Stage a change or deletion of a catalog item (admin only)"
+														r.operationID = "putCatalogDraft"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/catalog/changesets/{id}/items/{item_id}"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										case 'p': // Prefix: "p"
+
+											if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'r': // Prefix: "review"
+
+												if l := len("review"); len(elem) >= l && elem[0:l] == "review" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = PreviewCatalogChangesetOperation
+														r.summary = "Preview the catalog with a changeset applied (admin only)"
+														r.operationID = "previewCatalogChangeset"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/catalog/changesets/{id}/preview"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+
+											case 'u': // Prefix: "ublish"
+
+												if l := len("ublish"); len(elem) >= l && elem[0:l] == "ublish" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = PublishCatalogChangesetOperation
+														r.summary = "Publish a catalog changeset (admin only)"
+														r.operationID = "publishCatalogChangeset"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/catalog/changesets/{id}/publish"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										case 'r': // Prefix: "rollback"
+
+											if l := len("rollback"); len(elem) >= l && elem[0:l] == "rollback" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = RollbackCatalogChangesetOperation
+													r.summary = "Roll back a published catalog changeset (admin only)"
+													r.operationID = "rollbackCatalogChangeset"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/catalog/changesets/{id}/rollback"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						case 'd': // Prefix: "deleted"
+							origElem := elem
+							if l := len("deleted"); len(elem) >= l && elem[0:l] == "deleted" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ListDeletedCatalogItemsOperation
+									r.summary = "List deleted catalog items (admin only)"
+									r.operationID = "listDeletedCatalogItems"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/catalog/deleted"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'f': // Prefix: "favorites"
+							origElem := elem
+							if l := len("favorites"); len(elem) >= l && elem[0:l] == "favorites" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ListCatalogFavoritesOperation
+									r.summary = "List the favorite catalog items of the session user"
+									r.operationID = "listCatalogFavorites"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/catalog/favorites"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'r': // Prefix: "re"
+							origElem := elem
+							if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cently-viewed"
+
+								if l := len("cently-viewed"); len(elem) >= l && elem[0:l] == "cently-viewed" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = ListRecentlyViewedCatalogItemsOperation
+										r.summary = "List the catalog items the session user viewed last"
+										r.operationID = "listRecentlyViewedCatalogItems"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/recently-viewed"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'v': // Prefix: "views"
+
+								if l := len("views"); len(elem) >= l && elem[0:l] == "views" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListCatalogReviewsByStatusOperation
+										r.summary = "List catalog reviews for moderation (admin only)"
+										r.operationID = "listCatalogReviewsByStatus"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/reviews"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "review_id"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/status"
+
+										if l := len("/status"); len(elem) >= l && elem[0:l] == "/status" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "PUT":
+												r.name = SetCatalogReviewStatusOperation
+												r.summary = "Moderate a catalog review (admin only)"
+												r.operationID = "setCatalogReviewStatus"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/catalog/reviews/{review_id}/status"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						case 's': // Prefix: "search"
+							origElem := elem
+							if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = SearchCatalogOperation
+									r.summary = "Search catalog items"
+									r.operationID = "searchCatalog"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/catalog/search"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 't': // Prefix: "tags"
+							origElem := elem
+							if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = ListCatalogTagsOperation
+									r.summary = "List catalog tags"
+									r.operationID = "listCatalogTags"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/catalog/tags"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "POST":
+									r.name = CreateCatalogTagOperation
+									r.summary = "Create a catalog tag (admin only)"
+									r.operationID = "createCatalogTag"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/catalog/tags"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "name"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = DeleteCatalogTagOperation
+										r.summary = "Delete a catalog tag (admin only)"
+										r.operationID = "deleteCatalogTag"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/tags/{name}"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = RenameCatalogTagOperation
+										r.summary = "Rename a catalog tag (admin only)"
+										r.operationID = "renameCatalogTag"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/tags/{name}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+							elem = origElem
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = DeleteCatalogItemOperation
+								r.summary = "Delete a catalog item (admin only)"
+								r.operationID = "deleteCatalogItem"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/catalog/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetCatalogItemOperation
+								r.summary = "Get a catalog item"
+								r.operationID = "getCatalogItem"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/catalog/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = UpdateCatalogItemOperation
+								r.summary = "Replace a catalog item (admin only)"
+								r.operationID = "updateCatalogItem"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/catalog/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'd': // Prefix: "disabled"
+
+								if l := len("disabled"); len(elem) >= l && elem[0:l] == "disabled" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "PUT":
+										r.name = SetCatalogItemDisabledOperation
+										r.summary = "Enable or disable a catalog item (admin only)"
+										r.operationID = "setCatalogItemDisabled"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/{id}/disabled"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'f': // Prefix: "favorite"
+
+								if l := len("favorite"); len(elem) >= l && elem[0:l] == "favorite" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = RemoveCatalogFavoriteOperation
+										r.summary = "Remove a catalog item from the favorites of the session user"
+										r.operationID = "removeCatalogFavorite"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/{id}/favorite"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = AddCatalogFavoriteOperation
+										r.summary = "Add a catalog item to the favorites of the session user"
+										r.operationID = "addCatalogFavorite"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/{id}/favorite"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'i': // Prefix: "i"
+
+								if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'm': // Prefix: "mages/"
+
+									if l := len("mages/"); len(elem) >= l && elem[0:l] == "mages/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "image_id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteCatalogImageOperation
+											r.summary = "Remove an image from a catalog item (admin only)"
+											r.operationID = "deleteCatalogImage"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/{id}/images/{image_id}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'n': // Prefix: "nventory"
+
+									if l := len("nventory"); len(elem) >= l && elem[0:l] == "nventory" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "PUT":
+											r.name = SetCatalogInventoryOperation
+											r.summary = "Set the price and stock of a catalog item (admin only)"
+											r.operationID = "setCatalogInventory"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/{id}/inventory"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'r': // Prefix: "re"
+
+								if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'l': // Prefix: "lease"
+
+									if l := len("lease"); len(elem) >= l && elem[0:l] == "lease" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = ReleaseCatalogReservationOperation
+											r.summary = "Release a reservation of a catalog item"
+											r.operationID = "releaseCatalogReservation"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/{id}/release"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 's': // Prefix: "s"
+
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'e': // Prefix: "erve"
+
+										if l := len("erve"); len(elem) >= l && elem[0:l] == "erve" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = ReserveCatalogItemOperation
+												r.summary = "Reserve stock of a catalog item"
+												r.operationID = "reserveCatalogItem"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/catalog/{id}/reserve"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 't': // Prefix: "tore"
+
+										if l := len("tore"); len(elem) >= l && elem[0:l] == "tore" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = RestoreCatalogItemOperation
+												r.summary = "Restore a deleted catalog item (admin only)"
+												r.operationID = "restoreCatalogItem"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/catalog/{id}/restore"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'v': // Prefix: "view"
+
+									if l := len("view"); len(elem) >= l && elem[0:l] == "view" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "DELETE":
+											r.name = DeleteCatalogReviewOperation
+											r.summary = "Delete the review of a catalog item left by the session user"
+											r.operationID = "deleteCatalogReview"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/{id}/review"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = GetCatalogReviewOperation
+											r.summary = "Get the review of a catalog item left by the session user"
+											r.operationID = "getCatalogReview"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/{id}/review"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PUT":
+											r.name = SaveCatalogReviewOperation
+											r.summary = "Rate and review a catalog item"
+											r.operationID = "saveCatalogReview"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/{id}/review"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case 's': // Prefix: "s"
+
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = ListCatalogReviewsOperation
+												r.summary = "List the approved reviews of a catalog item"
+												r.operationID = "listCatalogReviews"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/catalog/{id}/reviews"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							case 't': // Prefix: "translations"
+
+								if l := len("translations"); len(elem) >= l && elem[0:l] == "translations" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListCatalogTranslationsOperation
+										r.summary = "List the translations of a catalog item (admin only)"
+										r.operationID = "listCatalogTranslations"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/{id}/translations"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "locale"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteCatalogTranslationOperation
+											r.summary = "Delete a translation of a catalog item (admin only)"
+											r.operationID = "deleteCatalogTranslation"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/{id}/translations/{locale}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "PUT":
+											r.name = PutCatalogTranslationOperation
+											r.summary = "Create or replace a translation of a catalog item (admin only)"
+											r.operationID = "putCatalogTranslation"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/catalog/{id}/translations/{locale}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'v': // Prefix: "views"
+
+								if l := len("views"); len(elem) >= l && elem[0:l] == "views" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = RecordCatalogViewOperation
+										r.summary = "Record that the session user viewed a catalog item"
+										r.operationID = "recordCatalogView"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/catalog/{id}/views"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case ':': // Prefix: ":import"
+
+						if l := len(":import"); len(elem) >= l && elem[0:l] == ":import" {
 							elem = elem[l:]
 						} else {
 							break
@@ -349,11 +2909,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							// Leaf node.
 							switch method {
 							case "POST":
-								r.name = LogoutOperation
-								r.summary = "Log out user"
-								r.operationID = "logout"
+								r.name = ImportCatalogOperation
+								r.summary = "Import catalog items from a CSV or JSON file (admin only)"
+								r.operationID = "importCatalog"
 								r.operationGroup = ""
-								r.pathPattern = "/api/v1/auth/logout"
+								r.pathPattern = "/api/v1/catalog:import"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -364,23 +2924,40 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					}
 
-				case 'm': // Prefix: "me"
+				case 'd': // Prefix: "data"
 
-					if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+					if l := len("data"); len(elem) >= l && elem[0:l] == "data" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
+						case "DELETE":
+							r.name = DeleteDataOperation
+							r.summary = "Delete a data key"
+							r.operationID = "deleteData"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/data"
+							r.args = args
+							r.count = 0
+							return r, true
 						case "GET":
-							r.name = GetMeOperation
-							r.summary = "Get current user info"
-							r.operationID = "getMe"
+							r.name = GetDataOperation
+							r.summary = "Get the current value of a data key"
+							r.operationID = "getData"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/data"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = PostDataOperation
+							r.summary = "Post some data"
+							r.operationID = "postData"
 							r.operationGroup = ""
-							r.pathPattern = "/api/v1/auth/me"
+							r.pathPattern = "/api/v1/data"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -388,37 +2965,437 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-				}
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-			case 'c': // Prefix: "catalog"
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "attachments"
 
-				if l := len("catalog"); len(elem) >= l && elem[0:l] == "catalog" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+							if l := len("attachments"); len(elem) >= l && elem[0:l] == "attachments" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = ListAttachmentsOperation
+									r.summary = "List the files attached to a data key"
+									r.operationID = "listAttachments"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/data/attachments"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = DeleteAttachmentOperation
+										r.summary = "Remove an attachment"
+										r.operationID = "deleteAttachment"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/data/attachments/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 'd': // Prefix: "deleted"
+
+							if l := len("deleted"); len(elem) >= l && elem[0:l] == "deleted" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ListDeletedDataOperation
+									r.summary = "List deleted data keys (admin only)"
+									r.operationID = "listDeletedData"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/data/deleted"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 's': // Prefix: "schemas"
+
+							if l := len("schemas"); len(elem) >= l && elem[0:l] == "schemas" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = DeleteDataSchemaOperation
+									r.summary = "Remove the JSON Schema for a data key prefix"
+									r.operationID = "deleteDataSchema"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/data/schemas"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "GET":
+									r.name = ListDataSchemasOperation
+									r.summary = "List JSON Schemas registered for data key prefixes"
+									r.operationID = "listDataSchemas"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/data/schemas"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "PUT":
+									r.name = PutDataSchemaOperation
+									r.summary = "Register or replace the JSON Schema for a data key prefix"
+									r.operationID = "putDataSchema"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/data/schemas"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'u': // Prefix: "usage"
+
+							if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetDataUsageOperation
+									r.summary = "Get the storage used by the current user and their quota"
+									r.operationID = "getDataUsage"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/data/usage"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case ':': // Prefix: ":"
+
+						if l := len(":"); len(elem) >= l && elem[0:l] == ":" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "export"
+
+							if l := len("export"); len(elem) >= l && elem[0:l] == "export" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ExportDataOperation
+									r.summary = "Export all live data entries as an NDJSON or CSV stream"
+									r.operationID = "exportData"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/data:export"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'i': // Prefix: "import"
+
+							if l := len("import"); len(elem) >= l && elem[0:l] == "import" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ImportDataOperation
+									r.summary = "Import data entries from an NDJSON or CSV stream"
+									r.operationID = "importData"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/data:import"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'r': // Prefix: "restore"
+
+							if l := len("restore"); len(elem) >= l && elem[0:l] == "restore" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = RestoreDataOperation
+									r.summary = "Restore a deleted data key (admin only)"
+									r.operationID = "restoreData"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/data:restore"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "GET":
-						r.name = GetCatalogOperation
-						r.summary = "Get catalog items"
-						r.operationID = "getCatalog"
-						r.operationGroup = ""
-						r.pathPattern = "/api/v1/catalog"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
 					}
+
+				case 'w': // Prefix: "webhooks"
+
+					if l := len("webhooks"); len(elem) >= l && elem[0:l] == "webhooks" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListWebhookEndpointsOperation
+							r.summary = "List webhook endpoints (admin only)"
+							r.operationID = "listWebhookEndpoints"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/webhooks"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateWebhookEndpointOperation
+							r.summary = "Register a webhook endpoint (admin only)"
+							r.operationID = "createWebhookEndpoint"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/webhooks"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'd': // Prefix: "deliveries/"
+							origElem := elem
+							if l := len("deliveries/"); len(elem) >= l && elem[0:l] == "deliveries/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "delivery_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/redeliver"
+
+								if l := len("/redeliver"); len(elem) >= l && elem[0:l] == "/redeliver" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = RedeliverWebhookOperation
+										r.summary = "Send a webhook delivery again (admin only)"
+										r.operationID = "redeliverWebhook"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/webhooks/deliveries/{delivery_id}/redeliver"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+							elem = origElem
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = DeleteWebhookEndpointOperation
+								r.summary = "Remove a webhook endpoint and its delivery log (admin only)"
+								r.operationID = "deleteWebhookEndpoint"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/webhooks/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetWebhookEndpointOperation
+								r.summary = "Get a webhook endpoint (admin only)"
+								r.operationID = "getWebhookEndpoint"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/webhooks/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = UpdateWebhookEndpointOperation
+								r.summary = "Replace the settings of a webhook endpoint (admin only)"
+								r.operationID = "updateWebhookEndpoint"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/webhooks/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/deliveries"
+
+							if l := len("/deliveries"); len(elem) >= l && elem[0:l] == "/deliveries" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ListWebhookDeliveriesOperation
+									r.summary = "List the delivery log of a webhook endpoint (admin only)"
+									r.operationID = "listWebhookDeliveries"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/webhooks/{id}/deliveries"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
 				}
 
-			case 'd': // Prefix: "data"
+			case '2': // Prefix: "2/catalog"
 
-				if l := len("data"); len(elem) >= l && elem[0:l] == "data" {
+				if l := len("2/catalog"); len(elem) >= l && elem[0:l] == "2/catalog" {
 					elem = elem[l:]
 				} else {
 					break
@@ -427,12 +3404,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					// Leaf node.
 					switch method {
-					case "POST":
-						r.name = PostDataOperation
-						r.summary = "Post some data"
-						r.operationID = "postData"
+					case "GET":
+						r.name = GetCatalogV2Operation
+						r.summary = "Get a page of catalog items"
+						r.operationID = "getCatalogV2"
 						r.operationGroup = ""
-						r.pathPattern = "/api/v1/data"
+						r.pathPattern = "/api/v2/catalog"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -3,308 +3,4140 @@
 package v1
 
 import (
+	"io"
 	"time"
 
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 	"github.com/google/uuid"
 )
 
-// Ref: #/components/schemas/CatalogItem
-type CatalogItem struct {
-	ID          OptUUID   `json:"id"`
-	Title       OptString `json:"title"`
-	Description OptString `json:"description"`
-	Disabled    OptBool   `json:"disabled"`
+// AddCatalogFavoriteInternalServerError is response for AddCatalogFavorite operation.
+type AddCatalogFavoriteInternalServerError struct{}
+
+func (*AddCatalogFavoriteInternalServerError) addCatalogFavoriteRes() {}
+
+// AddCatalogFavoriteNoContent is response for AddCatalogFavorite operation.
+type AddCatalogFavoriteNoContent struct{}
+
+func (*AddCatalogFavoriteNoContent) addCatalogFavoriteRes() {}
+
+// AddCatalogFavoriteNotFound is response for AddCatalogFavorite operation.
+type AddCatalogFavoriteNotFound struct{}
+
+func (*AddCatalogFavoriteNotFound) addCatalogFavoriteRes() {}
+
+// AddCatalogFavoriteUnauthorized is response for AddCatalogFavorite operation.
+type AddCatalogFavoriteUnauthorized struct{}
+
+func (*AddCatalogFavoriteUnauthorized) addCatalogFavoriteRes() {}
+
+// Ref: #/components/schemas/Attachment
+type Attachment struct {
+	ID          uuid.UUID `json:"id"`
+	Key         string    `json:"key"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	// Hex encoded SHA-256 of the content.
+	Digest    string    `json:"digest"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 // GetID returns the value of ID.
-func (s *CatalogItem) GetID() OptUUID {
+func (s *Attachment) GetID() uuid.UUID {
 	return s.ID
 }
 
-// GetTitle returns the value of Title.
-func (s *CatalogItem) GetTitle() OptString {
-	return s.Title
+// GetKey returns the value of Key.
+func (s *Attachment) GetKey() string {
+	return s.Key
 }
 
-// GetDescription returns the value of Description.
-func (s *CatalogItem) GetDescription() OptString {
-	return s.Description
+// GetName returns the value of Name.
+func (s *Attachment) GetName() string {
+	return s.Name
 }
 
-// GetDisabled returns the value of Disabled.
-func (s *CatalogItem) GetDisabled() OptBool {
-	return s.Disabled
+// GetContentType returns the value of ContentType.
+func (s *Attachment) GetContentType() string {
+	return s.ContentType
 }
 
-// SetID sets the value of ID.
-func (s *CatalogItem) SetID(val OptUUID) {
-	s.ID = val
+// GetSize returns the value of Size.
+func (s *Attachment) GetSize() int64 {
+	return s.Size
 }
 
-// SetTitle sets the value of Title.
-func (s *CatalogItem) SetTitle(val OptString) {
-	s.Title = val
+// GetDigest returns the value of Digest.
+func (s *Attachment) GetDigest() string {
+	return s.Digest
 }
 
-// SetDescription sets the value of Description.
-func (s *CatalogItem) SetDescription(val OptString) {
-	s.Description = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Attachment) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetDisabled sets the value of Disabled.
-func (s *CatalogItem) SetDisabled(val OptBool) {
-	s.Disabled = val
+// SetID sets the value of ID.
+func (s *Attachment) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-type CookieAuth struct {
-	APIKey string
-	Roles  []string
+// SetKey sets the value of Key.
+func (s *Attachment) SetKey(val string) {
+	s.Key = val
 }
 
-// GetAPIKey returns the value of APIKey.
-func (s *CookieAuth) GetAPIKey() string {
-	return s.APIKey
+// SetName sets the value of Name.
+func (s *Attachment) SetName(val string) {
+	s.Name = val
 }
 
-// GetRoles returns the value of Roles.
-func (s *CookieAuth) GetRoles() []string {
-	return s.Roles
+// SetContentType sets the value of ContentType.
+func (s *Attachment) SetContentType(val string) {
+	s.ContentType = val
 }
 
-// SetAPIKey sets the value of APIKey.
-func (s *CookieAuth) SetAPIKey(val string) {
-	s.APIKey = val
+// SetSize sets the value of Size.
+func (s *Attachment) SetSize(val int64) {
+	s.Size = val
 }
 
-// SetRoles sets the value of Roles.
-func (s *CookieAuth) SetRoles(val []string) {
-	s.Roles = val
+// SetDigest sets the value of Digest.
+func (s *Attachment) SetDigest(val string) {
+	s.Digest = val
 }
 
-// Ref: #/components/schemas/DataRequest
-type DataRequest struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Attachment) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// GetKey returns the value of Key.
-func (s *DataRequest) GetKey() string {
-	return s.Key
+// Ref: #/components/schemas/CatalogCategory
+type CatalogCategory struct {
+	ID uuid.UUID `json:"id"`
+	// Omitted for root categories.
+	ParentID OptUUID `json:"parent_id"`
+	Slug     string  `json:"slug"`
+	Name     string  `json:"name"`
+	// Slugs from the root down to this category joined with "/".
+	Path      string    `json:"path"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// GetValue returns the value of Value.
-func (s *DataRequest) GetValue() string {
-	return s.Value
+// GetID returns the value of ID.
+func (s *CatalogCategory) GetID() uuid.UUID {
+	return s.ID
 }
 
-// SetKey sets the value of Key.
-func (s *DataRequest) SetKey(val string) {
-	s.Key = val
+// GetParentID returns the value of ParentID.
+func (s *CatalogCategory) GetParentID() OptUUID {
+	return s.ParentID
 }
 
-// SetValue sets the value of Value.
-func (s *DataRequest) SetValue(val string) {
-	s.Value = val
+// GetSlug returns the value of Slug.
+func (s *CatalogCategory) GetSlug() string {
+	return s.Slug
 }
 
-// GetCatalogInternalServerError is response for GetCatalog operation.
-type GetCatalogInternalServerError struct{}
+// GetName returns the value of Name.
+func (s *CatalogCategory) GetName() string {
+	return s.Name
+}
 
-func (*GetCatalogInternalServerError) getCatalogRes() {}
+// GetPath returns the value of Path.
+func (s *CatalogCategory) GetPath() string {
+	return s.Path
+}
 
-type GetCatalogOKApplicationJSON []CatalogItem
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CatalogCategory) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
 
-func (*GetCatalogOKApplicationJSON) getCatalogRes() {}
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *CatalogCategory) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
 
-// GetCatalogUnauthorized is response for GetCatalog operation.
-type GetCatalogUnauthorized struct{}
+// SetID sets the value of ID.
+func (s *CatalogCategory) SetID(val uuid.UUID) {
+	s.ID = val
+}
 
-func (*GetCatalogUnauthorized) getCatalogRes() {}
+// SetParentID sets the value of ParentID.
+func (s *CatalogCategory) SetParentID(val OptUUID) {
+	s.ParentID = val
+}
 
-// GetMeInternalServerError is response for GetMe operation.
-type GetMeInternalServerError struct{}
+// SetSlug sets the value of Slug.
+func (s *CatalogCategory) SetSlug(val string) {
+	s.Slug = val
+}
 
-func (*GetMeInternalServerError) getMeRes() {}
+// SetName sets the value of Name.
+func (s *CatalogCategory) SetName(val string) {
+	s.Name = val
+}
 
-// GetMeUnauthorized is response for GetMe operation.
-type GetMeUnauthorized struct{}
+// SetPath sets the value of Path.
+func (s *CatalogCategory) SetPath(val string) {
+	s.Path = val
+}
 
-func (*GetMeUnauthorized) getMeRes() {}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CatalogCategory) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
 
-// LoginInternalServerError is response for Login operation.
-type LoginInternalServerError struct{}
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *CatalogCategory) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
 
-func (*LoginInternalServerError) loginRes() {}
+func (*CatalogCategory) createCatalogCategoryRes() {}
+func (*CatalogCategory) getCatalogCategoryRes()    {}
+func (*CatalogCategory) updateCatalogCategoryRes() {}
 
-// Ref: #/components/schemas/LoginRequest
-type LoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+// Ref: #/components/schemas/CatalogCategoryRequest
+type CatalogCategoryRequest struct {
+	// Lower case letters, digits and single dashes.
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+	// Omit to create or move the category at the root.
+	ParentID OptUUID `json:"parent_id"`
 }
 
-// GetEmail returns the value of Email.
-func (s *LoginRequest) GetEmail() string {
-	return s.Email
+// GetSlug returns the value of Slug.
+func (s *CatalogCategoryRequest) GetSlug() string {
+	return s.Slug
 }
 
-// GetPassword returns the value of Password.
-func (s *LoginRequest) GetPassword() string {
-	return s.Password
+// GetName returns the value of Name.
+func (s *CatalogCategoryRequest) GetName() string {
+	return s.Name
 }
 
-// SetEmail sets the value of Email.
-func (s *LoginRequest) SetEmail(val string) {
-	s.Email = val
+// GetParentID returns the value of ParentID.
+func (s *CatalogCategoryRequest) GetParentID() OptUUID {
+	return s.ParentID
 }
 
-// SetPassword sets the value of Password.
-func (s *LoginRequest) SetPassword(val string) {
-	s.Password = val
+// SetSlug sets the value of Slug.
+func (s *CatalogCategoryRequest) SetSlug(val string) {
+	s.Slug = val
 }
 
-// LoginUnauthorized is response for Login operation.
-type LoginUnauthorized struct{}
+// SetName sets the value of Name.
+func (s *CatalogCategoryRequest) SetName(val string) {
+	s.Name = val
+}
 
-func (*LoginUnauthorized) loginRes() {}
+// SetParentID sets the value of ParentID.
+func (s *CatalogCategoryRequest) SetParentID(val OptUUID) {
+	s.ParentID = val
+}
 
-// LogoutInternalServerError is response for Logout operation.
-type LogoutInternalServerError struct{}
+// Ref: #/components/schemas/CatalogChangeset
+type CatalogChangeset struct {
+	ID           uuid.UUID              `json:"id"`
+	Title        string                 `json:"title"`
+	Status       CatalogChangesetStatus `json:"status"`
+	CreatedBy    OptUUID                `json:"created_by"`
+	CreatedAt    time.Time              `json:"created_at"`
+	PublishedBy  OptUUID                `json:"published_by"`
+	PublishedAt  OptDateTime            `json:"published_at"`
+	RolledBackBy OptUUID                `json:"rolled_back_by"`
+	RolledBackAt OptDateTime            `json:"rolled_back_at"`
+	// Only returned for a single changeset.
+	Drafts []CatalogDraft `json:"drafts"`
+}
 
-func (*LogoutInternalServerError) logoutRes() {}
+// GetID returns the value of ID.
+func (s *CatalogChangeset) GetID() uuid.UUID {
+	return s.ID
+}
 
-// LogoutOK is response for Logout operation.
-type LogoutOK struct{}
+// GetTitle returns the value of Title.
+func (s *CatalogChangeset) GetTitle() string {
+	return s.Title
+}
 
-func (*LogoutOK) logoutRes() {}
+// GetStatus returns the value of Status.
+func (s *CatalogChangeset) GetStatus() CatalogChangesetStatus {
+	return s.Status
+}
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
+// GetCreatedBy returns the value of CreatedBy.
+func (s *CatalogChangeset) GetCreatedBy() OptUUID {
+	return s.CreatedBy
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CatalogChangeset) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// GetPublishedBy returns the value of PublishedBy.
+func (s *CatalogChangeset) GetPublishedBy() OptUUID {
+	return s.PublishedBy
+}
 
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+// GetPublishedAt returns the value of PublishedAt.
+func (s *CatalogChangeset) GetPublishedAt() OptDateTime {
+	return s.PublishedAt
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
+// GetRolledBackBy returns the value of RolledBackBy.
+func (s *CatalogChangeset) GetRolledBackBy() OptUUID {
+	return s.RolledBackBy
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetRolledBackAt returns the value of RolledBackAt.
+func (s *CatalogChangeset) GetRolledBackAt() OptDateTime {
+	return s.RolledBackAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetDrafts returns the value of Drafts.
+func (s *CatalogChangeset) GetDrafts() []CatalogDraft {
+	return s.Drafts
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// SetID sets the value of ID.
+func (s *CatalogChangeset) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// SetTitle sets the value of Title.
+func (s *CatalogChangeset) SetTitle(val string) {
+	s.Title = val
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// SetStatus sets the value of Status.
+func (s *CatalogChangeset) SetStatus(val CatalogChangesetStatus) {
+	s.Status = val
+}
 
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// SetCreatedBy sets the value of CreatedBy.
+func (s *CatalogChangeset) SetCreatedBy(val OptUUID) {
+	s.CreatedBy = val
 }
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CatalogChangeset) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetPublishedBy sets the value of PublishedBy.
+func (s *CatalogChangeset) SetPublishedBy(val OptUUID) {
+	s.PublishedBy = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetPublishedAt sets the value of PublishedAt.
+func (s *CatalogChangeset) SetPublishedAt(val OptDateTime) {
+	s.PublishedAt = val
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// SetRolledBackBy sets the value of RolledBackBy.
+func (s *CatalogChangeset) SetRolledBackBy(val OptUUID) {
+	s.RolledBackBy = val
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// SetRolledBackAt sets the value of RolledBackAt.
+func (s *CatalogChangeset) SetRolledBackAt(val OptDateTime) {
+	s.RolledBackAt = val
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// SetDrafts sets the value of Drafts.
+func (s *CatalogChangeset) SetDrafts(val []CatalogDraft) {
+	s.Drafts = val
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+func (*CatalogChangeset) createCatalogChangesetRes()   {}
+func (*CatalogChangeset) getCatalogChangesetRes()      {}
+func (*CatalogChangeset) publishCatalogChangesetRes()  {}
+func (*CatalogChangeset) rollbackCatalogChangesetRes() {}
+
+// Ref: #/components/schemas/CatalogChangesetRequest
+type CatalogChangesetRequest struct {
+	Title string `json:"title"`
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// GetTitle returns the value of Title.
+func (s *CatalogChangesetRequest) GetTitle() string {
+	return s.Title
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
+// SetTitle sets the value of Title.
+func (s *CatalogChangesetRequest) SetTitle(val string) {
+	s.Title = val
+}
+
+type CatalogChangesetStatus string
+
+const (
+	CatalogChangesetStatusDraft      CatalogChangesetStatus = "draft"
+	CatalogChangesetStatusPublished  CatalogChangesetStatus = "published"
+	CatalogChangesetStatusRolledBack CatalogChangesetStatus = "rolled_back"
+)
+
+// AllValues returns all CatalogChangesetStatus values.
+func (CatalogChangesetStatus) AllValues() []CatalogChangesetStatus {
+	return []CatalogChangesetStatus{
+		CatalogChangesetStatusDraft,
+		CatalogChangesetStatusPublished,
+		CatalogChangesetStatusRolledBack,
 	}
-	return o.Value, true
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CatalogChangesetStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case CatalogChangesetStatusDraft:
+		return []byte(s), nil
+	case CatalogChangesetStatusPublished:
+		return []byte(s), nil
+	case CatalogChangesetStatusRolledBack:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CatalogChangesetStatus) UnmarshalText(data []byte) error {
+	switch CatalogChangesetStatus(data) {
+	case CatalogChangesetStatusDraft:
+		*s = CatalogChangesetStatusDraft
+		return nil
+	case CatalogChangesetStatusPublished:
+		*s = CatalogChangesetStatusPublished
+		return nil
+	case CatalogChangesetStatusRolledBack:
+		*s = CatalogChangesetStatusRolledBack
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CatalogDraft
+type CatalogDraft struct {
+	Action CatalogDraftAction `json:"action"`
+	Item   CatalogItem        `json:"item"`
+	// Version of the item the draft is based on; omitted for new items.
+	BaseUpdatedAt OptDateTime `json:"base_updated_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+// GetAction returns the value of Action.
+func (s *CatalogDraft) GetAction() CatalogDraftAction {
+	return s.Action
+}
+
+// GetItem returns the value of Item.
+func (s *CatalogDraft) GetItem() CatalogItem {
+	return s.Item
+}
+
+// GetBaseUpdatedAt returns the value of BaseUpdatedAt.
+func (s *CatalogDraft) GetBaseUpdatedAt() OptDateTime {
+	return s.BaseUpdatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *CatalogDraft) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetAction sets the value of Action.
+func (s *CatalogDraft) SetAction(val CatalogDraftAction) {
+	s.Action = val
+}
+
+// SetItem sets the value of Item.
+func (s *CatalogDraft) SetItem(val CatalogItem) {
+	s.Item = val
+}
+
+// SetBaseUpdatedAt sets the value of BaseUpdatedAt.
+func (s *CatalogDraft) SetBaseUpdatedAt(val OptDateTime) {
+	s.BaseUpdatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *CatalogDraft) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*CatalogDraft) createCatalogDraftRes() {}
+func (*CatalogDraft) putCatalogDraftRes()    {}
+
+type CatalogDraftAction string
+
+const (
+	CatalogDraftActionUpsert CatalogDraftAction = "upsert"
+	CatalogDraftActionDelete CatalogDraftAction = "delete"
+)
+
+// AllValues returns all CatalogDraftAction values.
+func (CatalogDraftAction) AllValues() []CatalogDraftAction {
+	return []CatalogDraftAction{
+		CatalogDraftActionUpsert,
+		CatalogDraftActionDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CatalogDraftAction) MarshalText() ([]byte, error) {
+	switch s {
+	case CatalogDraftActionUpsert:
+		return []byte(s), nil
+	case CatalogDraftActionDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CatalogDraftAction) UnmarshalText(data []byte) error {
+	switch CatalogDraftAction(data) {
+	case CatalogDraftActionUpsert:
+		*s = CatalogDraftActionUpsert
+		return nil
+	case CatalogDraftActionDelete:
+		*s = CatalogDraftActionDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CatalogDraftRequest
+type CatalogDraftRequest struct {
+	Action OptCatalogDraftRequestAction `json:"action"`
+	// The new version of the item; required unless action is delete.
+	Item OptCatalogItemRequest `json:"item"`
+}
+
+// GetAction returns the value of Action.
+func (s *CatalogDraftRequest) GetAction() OptCatalogDraftRequestAction {
+	return s.Action
+}
+
+// GetItem returns the value of Item.
+func (s *CatalogDraftRequest) GetItem() OptCatalogItemRequest {
+	return s.Item
+}
+
+// SetAction sets the value of Action.
+func (s *CatalogDraftRequest) SetAction(val OptCatalogDraftRequestAction) {
+	s.Action = val
+}
+
+// SetItem sets the value of Item.
+func (s *CatalogDraftRequest) SetItem(val OptCatalogItemRequest) {
+	s.Item = val
+}
+
+type CatalogDraftRequestAction string
+
+const (
+	CatalogDraftRequestActionUpsert CatalogDraftRequestAction = "upsert"
+	CatalogDraftRequestActionDelete CatalogDraftRequestAction = "delete"
+)
+
+// AllValues returns all CatalogDraftRequestAction values.
+func (CatalogDraftRequestAction) AllValues() []CatalogDraftRequestAction {
+	return []CatalogDraftRequestAction{
+		CatalogDraftRequestActionUpsert,
+		CatalogDraftRequestActionDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CatalogDraftRequestAction) MarshalText() ([]byte, error) {
+	switch s {
+	case CatalogDraftRequestActionUpsert:
+		return []byte(s), nil
+	case CatalogDraftRequestActionDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CatalogDraftRequestAction) UnmarshalText(data []byte) error {
+	switch CatalogDraftRequestAction(data) {
+	case CatalogDraftRequestActionUpsert:
+		*s = CatalogDraftRequestActionUpsert
+		return nil
+	case CatalogDraftRequestActionDelete:
+		*s = CatalogDraftRequestActionDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CatalogImage
+type CatalogImage struct {
+	ID uuid.UUID `json:"id"`
+	// Path of the original image.
+	URL         string `json:"url"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	// Size of the original in bytes.
+	Size int64 `json:"size"`
+	// One per configured size, smallest first.
+	Thumbnails []CatalogThumbnail `json:"thumbnails"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *CatalogImage) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetURL returns the value of URL.
+func (s *CatalogImage) GetURL() string {
+	return s.URL
+}
+
+// GetContentType returns the value of ContentType.
+func (s *CatalogImage) GetContentType() string {
+	return s.ContentType
+}
+
+// GetWidth returns the value of Width.
+func (s *CatalogImage) GetWidth() int {
+	return s.Width
+}
+
+// GetHeight returns the value of Height.
+func (s *CatalogImage) GetHeight() int {
+	return s.Height
+}
+
+// GetSize returns the value of Size.
+func (s *CatalogImage) GetSize() int64 {
+	return s.Size
+}
+
+// GetThumbnails returns the value of Thumbnails.
+func (s *CatalogImage) GetThumbnails() []CatalogThumbnail {
+	return s.Thumbnails
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CatalogImage) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *CatalogImage) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetURL sets the value of URL.
+func (s *CatalogImage) SetURL(val string) {
+	s.URL = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *CatalogImage) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetWidth sets the value of Width.
+func (s *CatalogImage) SetWidth(val int) {
+	s.Width = val
+}
+
+// SetHeight sets the value of Height.
+func (s *CatalogImage) SetHeight(val int) {
+	s.Height = val
+}
+
+// SetSize sets the value of Size.
+func (s *CatalogImage) SetSize(val int64) {
+	s.Size = val
+}
+
+// SetThumbnails sets the value of Thumbnails.
+func (s *CatalogImage) SetThumbnails(val []CatalogThumbnail) {
+	s.Thumbnails = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CatalogImage) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/CatalogImportReport
+type CatalogImportReport struct {
+	DryRun bool `json:"dry_run"`
+	// True when the rows were written; false for dry runs and rejected imports.
+	Committed bool `json:"committed"`
+	// Number of rows read from the input.
+	Total   int `json:"total"`
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	// Deleted items, including pruned ones.
+	Deleted int `json:"deleted"`
+	Failed  int `json:"failed"`
+	// Invalid rows. For JSON input the line is the position of the row in the array; the key is its SKU.
+	Errors []ImportLineError `json:"errors"`
+}
+
+// GetDryRun returns the value of DryRun.
+func (s *CatalogImportReport) GetDryRun() bool {
+	return s.DryRun
+}
+
+// GetCommitted returns the value of Committed.
+func (s *CatalogImportReport) GetCommitted() bool {
+	return s.Committed
+}
+
+// GetTotal returns the value of Total.
+func (s *CatalogImportReport) GetTotal() int {
+	return s.Total
+}
+
+// GetCreated returns the value of Created.
+func (s *CatalogImportReport) GetCreated() int {
+	return s.Created
+}
+
+// GetUpdated returns the value of Updated.
+func (s *CatalogImportReport) GetUpdated() int {
+	return s.Updated
+}
+
+// GetDeleted returns the value of Deleted.
+func (s *CatalogImportReport) GetDeleted() int {
+	return s.Deleted
+}
+
+// GetFailed returns the value of Failed.
+func (s *CatalogImportReport) GetFailed() int {
+	return s.Failed
+}
+
+// GetErrors returns the value of Errors.
+func (s *CatalogImportReport) GetErrors() []ImportLineError {
+	return s.Errors
+}
+
+// SetDryRun sets the value of DryRun.
+func (s *CatalogImportReport) SetDryRun(val bool) {
+	s.DryRun = val
+}
+
+// SetCommitted sets the value of Committed.
+func (s *CatalogImportReport) SetCommitted(val bool) {
+	s.Committed = val
+}
+
+// SetTotal sets the value of Total.
+func (s *CatalogImportReport) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetCreated sets the value of Created.
+func (s *CatalogImportReport) SetCreated(val int) {
+	s.Created = val
+}
+
+// SetUpdated sets the value of Updated.
+func (s *CatalogImportReport) SetUpdated(val int) {
+	s.Updated = val
+}
+
+// SetDeleted sets the value of Deleted.
+func (s *CatalogImportReport) SetDeleted(val int) {
+	s.Deleted = val
+}
+
+// SetFailed sets the value of Failed.
+func (s *CatalogImportReport) SetFailed(val int) {
+	s.Failed = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *CatalogImportReport) SetErrors(val []ImportLineError) {
+	s.Errors = val
+}
+
+func (*CatalogImportReport) importCatalogRes() {}
+
+// Ref: #/components/schemas/CatalogInventoryRequest
+type CatalogInventoryRequest struct {
+	Price         OptMoney `json:"price"`
+	StockQuantity OptInt64 `json:"stock_quantity"`
+}
+
+// GetPrice returns the value of Price.
+func (s *CatalogInventoryRequest) GetPrice() OptMoney {
+	return s.Price
+}
+
+// GetStockQuantity returns the value of StockQuantity.
+func (s *CatalogInventoryRequest) GetStockQuantity() OptInt64 {
+	return s.StockQuantity
+}
+
+// SetPrice sets the value of Price.
+func (s *CatalogInventoryRequest) SetPrice(val OptMoney) {
+	s.Price = val
+}
+
+// SetStockQuantity sets the value of StockQuantity.
+func (s *CatalogInventoryRequest) SetStockQuantity(val OptInt64) {
+	s.StockQuantity = val
+}
+
+// Ref: #/components/schemas/CatalogItem
+type CatalogItem struct {
+	ID OptUUID `json:"id"`
+	// External key of imported items; omitted for items created through the API.
+	Sku         OptString `json:"sku"`
+	Title       OptString `json:"title"`
+	Description OptString `json:"description"`
+	Disabled    OptBool   `json:"disabled"`
+	Tags        []string  `json:"tags"`
+	// Omitted for uncategorized items.
+	CategoryID OptUUID `json:"category_id"`
+	// Path of the item's category, e.g. "electronics/phones".
+	CategoryPath OptString `json:"category_path"`
+	// Locale of title and description; only set on reads that honour Accept-Language.
+	Locale OptString `json:"locale"`
+	// Oldest first; omitted for items without images.
+	Images []CatalogImage `json:"images"`
+	// Whether the session user favorited the item.
+	IsFavorite OptBool     `json:"is_favorite"`
+	CreatedAt  OptDateTime `json:"created_at"`
+	UpdatedAt  OptDateTime `json:"updated_at"`
+	Price      OptMoney    `json:"price"`
+	// Units on hand; omitted when the item's stock is not tracked.
+	StockQuantity OptInt64 `json:"stock_quantity"`
+	// Units on hand that are not reserved; omitted when the item's stock is not tracked.
+	AvailableQuantity OptInt64 `json:"available_quantity"`
+	// Disabled items are "disabled"; items with tracked stock are "out_of_stock" when nothing is left to
+	// reserve.
+	Availability OptCatalogItemAvailability `json:"availability"`
+	// Mean rating of the approved reviews; 0 for items without reviews.
+	RatingAverage OptFloat64 `json:"rating_average"`
+	// Number of approved reviews.
+	ReviewCount OptInt64 `json:"review_count"`
+	// Only set for deleted items.
+	DeletedAt OptDateTime `json:"deleted_at"`
+}
+
+// GetID returns the value of ID.
+func (s *CatalogItem) GetID() OptUUID {
+	return s.ID
+}
+
+// GetSku returns the value of Sku.
+func (s *CatalogItem) GetSku() OptString {
+	return s.Sku
+}
+
+// GetTitle returns the value of Title.
+func (s *CatalogItem) GetTitle() OptString {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *CatalogItem) GetDescription() OptString {
+	return s.Description
+}
+
+// GetDisabled returns the value of Disabled.
+func (s *CatalogItem) GetDisabled() OptBool {
+	return s.Disabled
+}
+
+// GetTags returns the value of Tags.
+func (s *CatalogItem) GetTags() []string {
+	return s.Tags
+}
+
+// GetCategoryID returns the value of CategoryID.
+func (s *CatalogItem) GetCategoryID() OptUUID {
+	return s.CategoryID
+}
+
+// GetCategoryPath returns the value of CategoryPath.
+func (s *CatalogItem) GetCategoryPath() OptString {
+	return s.CategoryPath
+}
+
+// GetLocale returns the value of Locale.
+func (s *CatalogItem) GetLocale() OptString {
+	return s.Locale
+}
+
+// GetImages returns the value of Images.
+func (s *CatalogItem) GetImages() []CatalogImage {
+	return s.Images
+}
+
+// GetIsFavorite returns the value of IsFavorite.
+func (s *CatalogItem) GetIsFavorite() OptBool {
+	return s.IsFavorite
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CatalogItem) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *CatalogItem) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// GetPrice returns the value of Price.
+func (s *CatalogItem) GetPrice() OptMoney {
+	return s.Price
+}
+
+// GetStockQuantity returns the value of StockQuantity.
+func (s *CatalogItem) GetStockQuantity() OptInt64 {
+	return s.StockQuantity
+}
+
+// GetAvailableQuantity returns the value of AvailableQuantity.
+func (s *CatalogItem) GetAvailableQuantity() OptInt64 {
+	return s.AvailableQuantity
+}
+
+// GetAvailability returns the value of Availability.
+func (s *CatalogItem) GetAvailability() OptCatalogItemAvailability {
+	return s.Availability
+}
+
+// GetRatingAverage returns the value of RatingAverage.
+func (s *CatalogItem) GetRatingAverage() OptFloat64 {
+	return s.RatingAverage
+}
+
+// GetReviewCount returns the value of ReviewCount.
+func (s *CatalogItem) GetReviewCount() OptInt64 {
+	return s.ReviewCount
+}
+
+// GetDeletedAt returns the value of DeletedAt.
+func (s *CatalogItem) GetDeletedAt() OptDateTime {
+	return s.DeletedAt
+}
+
+// SetID sets the value of ID.
+func (s *CatalogItem) SetID(val OptUUID) {
+	s.ID = val
+}
+
+// SetSku sets the value of Sku.
+func (s *CatalogItem) SetSku(val OptString) {
+	s.Sku = val
+}
+
+// SetTitle sets the value of Title.
+func (s *CatalogItem) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CatalogItem) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetDisabled sets the value of Disabled.
+func (s *CatalogItem) SetDisabled(val OptBool) {
+	s.Disabled = val
+}
+
+// SetTags sets the value of Tags.
+func (s *CatalogItem) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetCategoryID sets the value of CategoryID.
+func (s *CatalogItem) SetCategoryID(val OptUUID) {
+	s.CategoryID = val
+}
+
+// SetCategoryPath sets the value of CategoryPath.
+func (s *CatalogItem) SetCategoryPath(val OptString) {
+	s.CategoryPath = val
+}
+
+// SetLocale sets the value of Locale.
+func (s *CatalogItem) SetLocale(val OptString) {
+	s.Locale = val
+}
+
+// SetImages sets the value of Images.
+func (s *CatalogItem) SetImages(val []CatalogImage) {
+	s.Images = val
+}
+
+// SetIsFavorite sets the value of IsFavorite.
+func (s *CatalogItem) SetIsFavorite(val OptBool) {
+	s.IsFavorite = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CatalogItem) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *CatalogItem) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// SetPrice sets the value of Price.
+func (s *CatalogItem) SetPrice(val OptMoney) {
+	s.Price = val
+}
+
+// SetStockQuantity sets the value of StockQuantity.
+func (s *CatalogItem) SetStockQuantity(val OptInt64) {
+	s.StockQuantity = val
+}
+
+// SetAvailableQuantity sets the value of AvailableQuantity.
+func (s *CatalogItem) SetAvailableQuantity(val OptInt64) {
+	s.AvailableQuantity = val
+}
+
+// SetAvailability sets the value of Availability.
+func (s *CatalogItem) SetAvailability(val OptCatalogItemAvailability) {
+	s.Availability = val
+}
+
+// SetRatingAverage sets the value of RatingAverage.
+func (s *CatalogItem) SetRatingAverage(val OptFloat64) {
+	s.RatingAverage = val
+}
+
+// SetReviewCount sets the value of ReviewCount.
+func (s *CatalogItem) SetReviewCount(val OptInt64) {
+	s.ReviewCount = val
+}
+
+// SetDeletedAt sets the value of DeletedAt.
+func (s *CatalogItem) SetDeletedAt(val OptDateTime) {
+	s.DeletedAt = val
+}
+
+func (*CatalogItem) createCatalogItemRes()      {}
+func (*CatalogItem) getCatalogItemRes()         {}
+func (*CatalogItem) restoreCatalogItemRes()     {}
+func (*CatalogItem) setCatalogInventoryRes()    {}
+func (*CatalogItem) setCatalogItemDisabledRes() {}
+func (*CatalogItem) updateCatalogItemRes()      {}
+
+// Disabled items are "disabled"; items with tracked stock are "out_of_stock" when nothing is left to
+// reserve.
+type CatalogItemAvailability string
+
+const (
+	CatalogItemAvailabilityInStock    CatalogItemAvailability = "in_stock"
+	CatalogItemAvailabilityOutOfStock CatalogItemAvailability = "out_of_stock"
+	CatalogItemAvailabilityDisabled   CatalogItemAvailability = "disabled"
+)
+
+// AllValues returns all CatalogItemAvailability values.
+func (CatalogItemAvailability) AllValues() []CatalogItemAvailability {
+	return []CatalogItemAvailability{
+		CatalogItemAvailabilityInStock,
+		CatalogItemAvailabilityOutOfStock,
+		CatalogItemAvailabilityDisabled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CatalogItemAvailability) MarshalText() ([]byte, error) {
+	switch s {
+	case CatalogItemAvailabilityInStock:
+		return []byte(s), nil
+	case CatalogItemAvailabilityOutOfStock:
+		return []byte(s), nil
+	case CatalogItemAvailabilityDisabled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CatalogItemAvailability) UnmarshalText(data []byte) error {
+	switch CatalogItemAvailability(data) {
+	case CatalogItemAvailabilityInStock:
+		*s = CatalogItemAvailabilityInStock
+		return nil
+	case CatalogItemAvailabilityOutOfStock:
+		*s = CatalogItemAvailabilityOutOfStock
+		return nil
+	case CatalogItemAvailabilityDisabled:
+		*s = CatalogItemAvailabilityDisabled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CatalogItemDisabledRequest
+type CatalogItemDisabledRequest struct {
+	Disabled bool `json:"disabled"`
+}
+
+// GetDisabled returns the value of Disabled.
+func (s *CatalogItemDisabledRequest) GetDisabled() bool {
+	return s.Disabled
+}
+
+// SetDisabled sets the value of Disabled.
+func (s *CatalogItemDisabledRequest) SetDisabled(val bool) {
+	s.Disabled = val
+}
+
+// Ref: #/components/schemas/CatalogItemRequest
+type CatalogItemRequest struct {
+	Title       string    `json:"title"`
+	Description OptString `json:"description"`
+	Disabled    OptBool   `json:"disabled"`
+	// Replaces the item's tags. Tags are case-insensitive.
+	Tags []string `json:"tags"`
+	// Omit to leave the item uncategorized.
+	CategoryID OptUUID `json:"category_id"`
+}
+
+// GetTitle returns the value of Title.
+func (s *CatalogItemRequest) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *CatalogItemRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// GetDisabled returns the value of Disabled.
+func (s *CatalogItemRequest) GetDisabled() OptBool {
+	return s.Disabled
+}
+
+// GetTags returns the value of Tags.
+func (s *CatalogItemRequest) GetTags() []string {
+	return s.Tags
+}
+
+// GetCategoryID returns the value of CategoryID.
+func (s *CatalogItemRequest) GetCategoryID() OptUUID {
+	return s.CategoryID
+}
+
+// SetTitle sets the value of Title.
+func (s *CatalogItemRequest) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CatalogItemRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetDisabled sets the value of Disabled.
+func (s *CatalogItemRequest) SetDisabled(val OptBool) {
+	s.Disabled = val
+}
+
+// SetTags sets the value of Tags.
+func (s *CatalogItemRequest) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetCategoryID sets the value of CategoryID.
+func (s *CatalogItemRequest) SetCategoryID(val OptUUID) {
+	s.CategoryID = val
+}
+
+// Ref: #/components/schemas/CatalogPage
+type CatalogPage struct {
+	Items      []CatalogItem `json:"items"`
+	NextCursor OptString     `json:"next_cursor"`
+}
+
+// GetItems returns the value of Items.
+func (s *CatalogPage) GetItems() []CatalogItem {
+	return s.Items
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *CatalogPage) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetItems sets the value of Items.
+func (s *CatalogPage) SetItems(val []CatalogItem) {
+	s.Items = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *CatalogPage) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*CatalogPage) getCatalogV2Res()         {}
+func (*CatalogPage) listCatalogFavoritesRes() {}
+
+// Ref: #/components/schemas/CatalogReleaseRequest
+type CatalogReleaseRequest struct {
+	ReservationID uuid.UUID `json:"reservation_id"`
+}
+
+// GetReservationID returns the value of ReservationID.
+func (s *CatalogReleaseRequest) GetReservationID() uuid.UUID {
+	return s.ReservationID
+}
+
+// SetReservationID sets the value of ReservationID.
+func (s *CatalogReleaseRequest) SetReservationID(val uuid.UUID) {
+	s.ReservationID = val
+}
+
+// Ref: #/components/schemas/CatalogReservation
+type CatalogReservation struct {
+	ID        uuid.UUID `json:"id"`
+	ItemID    uuid.UUID `json:"item_id"`
+	Quantity  int64     `json:"quantity"`
+	UnitPrice OptMoney  `json:"unit_price"`
+	Total     OptMoney  `json:"total"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// GetID returns the value of ID.
+func (s *CatalogReservation) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetItemID returns the value of ItemID.
+func (s *CatalogReservation) GetItemID() uuid.UUID {
+	return s.ItemID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *CatalogReservation) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *CatalogReservation) GetUnitPrice() OptMoney {
+	return s.UnitPrice
+}
+
+// GetTotal returns the value of Total.
+func (s *CatalogReservation) GetTotal() OptMoney {
+	return s.Total
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CatalogReservation) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *CatalogReservation) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetID sets the value of ID.
+func (s *CatalogReservation) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetItemID sets the value of ItemID.
+func (s *CatalogReservation) SetItemID(val uuid.UUID) {
+	s.ItemID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *CatalogReservation) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *CatalogReservation) SetUnitPrice(val OptMoney) {
+	s.UnitPrice = val
+}
+
+// SetTotal sets the value of Total.
+func (s *CatalogReservation) SetTotal(val OptMoney) {
+	s.Total = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CatalogReservation) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *CatalogReservation) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+func (*CatalogReservation) reserveCatalogItemRes() {}
+
+// Ref: #/components/schemas/CatalogReservationRequest
+type CatalogReservationRequest struct {
+	Quantity int64 `json:"quantity"`
+	// Seconds until the reservation expires; defaults to the configured reservation TTL.
+	TTL OptInt `json:"ttl"`
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *CatalogReservationRequest) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetTTL returns the value of TTL.
+func (s *CatalogReservationRequest) GetTTL() OptInt {
+	return s.TTL
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *CatalogReservationRequest) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetTTL sets the value of TTL.
+func (s *CatalogReservationRequest) SetTTL(val OptInt) {
+	s.TTL = val
+}
+
+// Ref: #/components/schemas/CatalogReview
+type CatalogReview struct {
+	ID        uuid.UUID           `json:"id"`
+	ItemID    uuid.UUID           `json:"item_id"`
+	UserID    uuid.UUID           `json:"user_id"`
+	Rating    int                 `json:"rating"`
+	Body      string              `json:"body"`
+	Status    CatalogReviewStatus `json:"status"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *CatalogReview) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetItemID returns the value of ItemID.
+func (s *CatalogReview) GetItemID() uuid.UUID {
+	return s.ItemID
+}
+
+// GetUserID returns the value of UserID.
+func (s *CatalogReview) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetRating returns the value of Rating.
+func (s *CatalogReview) GetRating() int {
+	return s.Rating
+}
+
+// GetBody returns the value of Body.
+func (s *CatalogReview) GetBody() string {
+	return s.Body
+}
+
+// GetStatus returns the value of Status.
+func (s *CatalogReview) GetStatus() CatalogReviewStatus {
+	return s.Status
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CatalogReview) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *CatalogReview) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *CatalogReview) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetItemID sets the value of ItemID.
+func (s *CatalogReview) SetItemID(val uuid.UUID) {
+	s.ItemID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *CatalogReview) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetRating sets the value of Rating.
+func (s *CatalogReview) SetRating(val int) {
+	s.Rating = val
+}
+
+// SetBody sets the value of Body.
+func (s *CatalogReview) SetBody(val string) {
+	s.Body = val
+}
+
+// SetStatus sets the value of Status.
+func (s *CatalogReview) SetStatus(val CatalogReviewStatus) {
+	s.Status = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CatalogReview) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *CatalogReview) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*CatalogReview) getCatalogReviewRes()       {}
+func (*CatalogReview) saveCatalogReviewRes()      {}
+func (*CatalogReview) setCatalogReviewStatusRes() {}
+
+// Ref: #/components/schemas/CatalogReviewRequest
+type CatalogReviewRequest struct {
+	Rating int `json:"rating"`
+	// Review text; may be empty.
+	Body OptString `json:"body"`
+}
+
+// GetRating returns the value of Rating.
+func (s *CatalogReviewRequest) GetRating() int {
+	return s.Rating
+}
+
+// GetBody returns the value of Body.
+func (s *CatalogReviewRequest) GetBody() OptString {
+	return s.Body
+}
+
+// SetRating sets the value of Rating.
+func (s *CatalogReviewRequest) SetRating(val int) {
+	s.Rating = val
+}
+
+// SetBody sets the value of Body.
+func (s *CatalogReviewRequest) SetBody(val OptString) {
+	s.Body = val
+}
+
+// Ref: #/components/schemas/CatalogReviewStatus
+type CatalogReviewStatus string
+
+const (
+	CatalogReviewStatusPending  CatalogReviewStatus = "pending"
+	CatalogReviewStatusApproved CatalogReviewStatus = "approved"
+	CatalogReviewStatusRejected CatalogReviewStatus = "rejected"
+)
+
+// AllValues returns all CatalogReviewStatus values.
+func (CatalogReviewStatus) AllValues() []CatalogReviewStatus {
+	return []CatalogReviewStatus{
+		CatalogReviewStatusPending,
+		CatalogReviewStatusApproved,
+		CatalogReviewStatusRejected,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CatalogReviewStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case CatalogReviewStatusPending:
+		return []byte(s), nil
+	case CatalogReviewStatusApproved:
+		return []byte(s), nil
+	case CatalogReviewStatusRejected:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CatalogReviewStatus) UnmarshalText(data []byte) error {
+	switch CatalogReviewStatus(data) {
+	case CatalogReviewStatusPending:
+		*s = CatalogReviewStatusPending
+		return nil
+	case CatalogReviewStatusApproved:
+		*s = CatalogReviewStatusApproved
+		return nil
+	case CatalogReviewStatusRejected:
+		*s = CatalogReviewStatusRejected
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CatalogReviewStatusRequest
+type CatalogReviewStatusRequest struct {
+	Status CatalogReviewStatus `json:"status"`
+}
+
+// GetStatus returns the value of Status.
+func (s *CatalogReviewStatusRequest) GetStatus() CatalogReviewStatus {
+	return s.Status
+}
+
+// SetStatus sets the value of Status.
+func (s *CatalogReviewStatusRequest) SetStatus(val CatalogReviewStatus) {
+	s.Status = val
+}
+
+// Ref: #/components/schemas/CatalogSearchHit
+type CatalogSearchHit struct {
+	Item CatalogItem `json:"item"`
+	// Relevance; only comparable within one result.
+	Score                float32 `json:"score"`
+	TitleHighlight       string  `json:"title_highlight"`
+	DescriptionHighlight string  `json:"description_highlight"`
+}
+
+// GetItem returns the value of Item.
+func (s *CatalogSearchHit) GetItem() CatalogItem {
+	return s.Item
+}
+
+// GetScore returns the value of Score.
+func (s *CatalogSearchHit) GetScore() float32 {
+	return s.Score
+}
+
+// GetTitleHighlight returns the value of TitleHighlight.
+func (s *CatalogSearchHit) GetTitleHighlight() string {
+	return s.TitleHighlight
+}
+
+// GetDescriptionHighlight returns the value of DescriptionHighlight.
+func (s *CatalogSearchHit) GetDescriptionHighlight() string {
+	return s.DescriptionHighlight
+}
+
+// SetItem sets the value of Item.
+func (s *CatalogSearchHit) SetItem(val CatalogItem) {
+	s.Item = val
+}
+
+// SetScore sets the value of Score.
+func (s *CatalogSearchHit) SetScore(val float32) {
+	s.Score = val
+}
+
+// SetTitleHighlight sets the value of TitleHighlight.
+func (s *CatalogSearchHit) SetTitleHighlight(val string) {
+	s.TitleHighlight = val
+}
+
+// SetDescriptionHighlight sets the value of DescriptionHighlight.
+func (s *CatalogSearchHit) SetDescriptionHighlight(val string) {
+	s.DescriptionHighlight = val
+}
+
+// Ref: #/components/schemas/CatalogSearchResult
+type CatalogSearchResult struct {
+	Items []CatalogSearchHit `json:"items"`
+	// True when the items are typo-tolerant title matches.
+	Fuzzy bool `json:"fuzzy"`
+}
+
+// GetItems returns the value of Items.
+func (s *CatalogSearchResult) GetItems() []CatalogSearchHit {
+	return s.Items
+}
+
+// GetFuzzy returns the value of Fuzzy.
+func (s *CatalogSearchResult) GetFuzzy() bool {
+	return s.Fuzzy
+}
+
+// SetItems sets the value of Items.
+func (s *CatalogSearchResult) SetItems(val []CatalogSearchHit) {
+	s.Items = val
+}
+
+// SetFuzzy sets the value of Fuzzy.
+func (s *CatalogSearchResult) SetFuzzy(val bool) {
+	s.Fuzzy = val
+}
+
+func (*CatalogSearchResult) searchCatalogRes() {}
+
+// Ref: #/components/schemas/CatalogTag
+type CatalogTag struct {
+	Name string `json:"name"`
+	// Number of items carrying the tag.
+	Items int64 `json:"items"`
+}
+
+// GetName returns the value of Name.
+func (s *CatalogTag) GetName() string {
+	return s.Name
+}
+
+// GetItems returns the value of Items.
+func (s *CatalogTag) GetItems() int64 {
+	return s.Items
+}
+
+// SetName sets the value of Name.
+func (s *CatalogTag) SetName(val string) {
+	s.Name = val
+}
+
+// SetItems sets the value of Items.
+func (s *CatalogTag) SetItems(val int64) {
+	s.Items = val
+}
+
+func (*CatalogTag) createCatalogTagRes() {}
+func (*CatalogTag) renameCatalogTagRes() {}
+
+// Ref: #/components/schemas/CatalogTagRequest
+type CatalogTagRequest struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *CatalogTagRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *CatalogTagRequest) SetName(val string) {
+	s.Name = val
+}
+
+// A copy of the image scaled down to fit within size x size pixels; small images keep their
+// dimensions.
+// Ref: #/components/schemas/CatalogThumbnail
+type CatalogThumbnail struct {
+	Size        int    `json:"size"`
+	URL         string `json:"url"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+}
+
+// GetSize returns the value of Size.
+func (s *CatalogThumbnail) GetSize() int {
+	return s.Size
+}
+
+// GetURL returns the value of URL.
+func (s *CatalogThumbnail) GetURL() string {
+	return s.URL
+}
+
+// GetContentType returns the value of ContentType.
+func (s *CatalogThumbnail) GetContentType() string {
+	return s.ContentType
+}
+
+// GetWidth returns the value of Width.
+func (s *CatalogThumbnail) GetWidth() int {
+	return s.Width
+}
+
+// GetHeight returns the value of Height.
+func (s *CatalogThumbnail) GetHeight() int {
+	return s.Height
+}
+
+// SetSize sets the value of Size.
+func (s *CatalogThumbnail) SetSize(val int) {
+	s.Size = val
+}
+
+// SetURL sets the value of URL.
+func (s *CatalogThumbnail) SetURL(val string) {
+	s.URL = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *CatalogThumbnail) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetWidth sets the value of Width.
+func (s *CatalogThumbnail) SetWidth(val int) {
+	s.Width = val
+}
+
+// SetHeight sets the value of Height.
+func (s *CatalogThumbnail) SetHeight(val int) {
+	s.Height = val
+}
+
+// Ref: #/components/schemas/CatalogTranslation
+type CatalogTranslation struct {
+	Locale      string    `json:"locale"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// GetLocale returns the value of Locale.
+func (s *CatalogTranslation) GetLocale() string {
+	return s.Locale
+}
+
+// GetTitle returns the value of Title.
+func (s *CatalogTranslation) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *CatalogTranslation) GetDescription() string {
+	return s.Description
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CatalogTranslation) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *CatalogTranslation) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetLocale sets the value of Locale.
+func (s *CatalogTranslation) SetLocale(val string) {
+	s.Locale = val
+}
+
+// SetTitle sets the value of Title.
+func (s *CatalogTranslation) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CatalogTranslation) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CatalogTranslation) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *CatalogTranslation) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*CatalogTranslation) putCatalogTranslationRes() {}
+
+// Ref: #/components/schemas/CatalogTranslationRequest
+type CatalogTranslationRequest struct {
+	Title       string    `json:"title"`
+	Description OptString `json:"description"`
+}
+
+// GetTitle returns the value of Title.
+func (s *CatalogTranslationRequest) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *CatalogTranslationRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// SetTitle sets the value of Title.
+func (s *CatalogTranslationRequest) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CatalogTranslationRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
+type CookieAuth struct {
+	APIKey string
+	Roles  []string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *CookieAuth) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetRoles returns the value of Roles.
+func (s *CookieAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *CookieAuth) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *CookieAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// CreateCatalogCategoryConflict is response for CreateCatalogCategory operation.
+type CreateCatalogCategoryConflict struct{}
+
+func (*CreateCatalogCategoryConflict) createCatalogCategoryRes() {}
+
+// CreateCatalogCategoryForbidden is response for CreateCatalogCategory operation.
+type CreateCatalogCategoryForbidden struct{}
+
+func (*CreateCatalogCategoryForbidden) createCatalogCategoryRes() {}
+
+// CreateCatalogCategoryInternalServerError is response for CreateCatalogCategory operation.
+type CreateCatalogCategoryInternalServerError struct{}
+
+func (*CreateCatalogCategoryInternalServerError) createCatalogCategoryRes() {}
+
+// CreateCatalogCategoryUnauthorized is response for CreateCatalogCategory operation.
+type CreateCatalogCategoryUnauthorized struct{}
+
+func (*CreateCatalogCategoryUnauthorized) createCatalogCategoryRes() {}
+
+// CreateCatalogChangesetForbidden is response for CreateCatalogChangeset operation.
+type CreateCatalogChangesetForbidden struct{}
+
+func (*CreateCatalogChangesetForbidden) createCatalogChangesetRes() {}
+
+// CreateCatalogChangesetInternalServerError is response for CreateCatalogChangeset operation.
+type CreateCatalogChangesetInternalServerError struct{}
+
+func (*CreateCatalogChangesetInternalServerError) createCatalogChangesetRes() {}
+
+// CreateCatalogChangesetUnauthorized is response for CreateCatalogChangeset operation.
+type CreateCatalogChangesetUnauthorized struct{}
+
+func (*CreateCatalogChangesetUnauthorized) createCatalogChangesetRes() {}
+
+// CreateCatalogDraftConflict is response for CreateCatalogDraft operation.
+type CreateCatalogDraftConflict struct{}
+
+func (*CreateCatalogDraftConflict) createCatalogDraftRes() {}
+
+// CreateCatalogDraftForbidden is response for CreateCatalogDraft operation.
+type CreateCatalogDraftForbidden struct{}
+
+func (*CreateCatalogDraftForbidden) createCatalogDraftRes() {}
+
+// CreateCatalogDraftInternalServerError is response for CreateCatalogDraft operation.
+type CreateCatalogDraftInternalServerError struct{}
+
+func (*CreateCatalogDraftInternalServerError) createCatalogDraftRes() {}
+
+// CreateCatalogDraftNotFound is response for CreateCatalogDraft operation.
+type CreateCatalogDraftNotFound struct{}
+
+func (*CreateCatalogDraftNotFound) createCatalogDraftRes() {}
+
+// CreateCatalogDraftUnauthorized is response for CreateCatalogDraft operation.
+type CreateCatalogDraftUnauthorized struct{}
+
+func (*CreateCatalogDraftUnauthorized) createCatalogDraftRes() {}
+
+// CreateCatalogItemForbidden is response for CreateCatalogItem operation.
+type CreateCatalogItemForbidden struct{}
+
+func (*CreateCatalogItemForbidden) createCatalogItemRes() {}
+
+// CreateCatalogItemInternalServerError is response for CreateCatalogItem operation.
+type CreateCatalogItemInternalServerError struct{}
+
+func (*CreateCatalogItemInternalServerError) createCatalogItemRes() {}
+
+// CreateCatalogItemUnauthorized is response for CreateCatalogItem operation.
+type CreateCatalogItemUnauthorized struct{}
+
+func (*CreateCatalogItemUnauthorized) createCatalogItemRes() {}
+
+// CreateCatalogTagConflict is response for CreateCatalogTag operation.
+type CreateCatalogTagConflict struct{}
+
+func (*CreateCatalogTagConflict) createCatalogTagRes() {}
+
+// CreateCatalogTagForbidden is response for CreateCatalogTag operation.
+type CreateCatalogTagForbidden struct{}
+
+func (*CreateCatalogTagForbidden) createCatalogTagRes() {}
+
+// CreateCatalogTagInternalServerError is response for CreateCatalogTag operation.
+type CreateCatalogTagInternalServerError struct{}
+
+func (*CreateCatalogTagInternalServerError) createCatalogTagRes() {}
+
+// CreateCatalogTagUnauthorized is response for CreateCatalogTag operation.
+type CreateCatalogTagUnauthorized struct{}
+
+func (*CreateCatalogTagUnauthorized) createCatalogTagRes() {}
+
+// CreateWebhookEndpointForbidden is response for CreateWebhookEndpoint operation.
+type CreateWebhookEndpointForbidden struct{}
+
+func (*CreateWebhookEndpointForbidden) createWebhookEndpointRes() {}
+
+// CreateWebhookEndpointInternalServerError is response for CreateWebhookEndpoint operation.
+type CreateWebhookEndpointInternalServerError struct{}
+
+func (*CreateWebhookEndpointInternalServerError) createWebhookEndpointRes() {}
+
+// CreateWebhookEndpointUnauthorized is response for CreateWebhookEndpoint operation.
+type CreateWebhookEndpointUnauthorized struct{}
+
+func (*CreateWebhookEndpointUnauthorized) createWebhookEndpointRes() {}
+
+// Ref: #/components/schemas/DataEntry
+type DataEntry struct {
+	Key string `json:"key"`
+	// Any JSON value.
+	Value     jx.Raw      `json:"value"`
+	CreatedAt OptDateTime `json:"created_at"`
+	ExpiresAt OptDateTime `json:"expires_at"`
+	// Only set for deleted keys.
+	DeletedAt OptDateTime `json:"deleted_at"`
+}
+
+// GetKey returns the value of Key.
+func (s *DataEntry) GetKey() string {
+	return s.Key
+}
+
+// GetValue returns the value of Value.
+func (s *DataEntry) GetValue() jx.Raw {
+	return s.Value
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DataEntry) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *DataEntry) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// GetDeletedAt returns the value of DeletedAt.
+func (s *DataEntry) GetDeletedAt() OptDateTime {
+	return s.DeletedAt
+}
+
+// SetKey sets the value of Key.
+func (s *DataEntry) SetKey(val string) {
+	s.Key = val
+}
+
+// SetValue sets the value of Value.
+func (s *DataEntry) SetValue(val jx.Raw) {
+	s.Value = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DataEntry) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *DataEntry) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// SetDeletedAt sets the value of DeletedAt.
+func (s *DataEntry) SetDeletedAt(val OptDateTime) {
+	s.DeletedAt = val
+}
+
+func (*DataEntry) getDataRes()     {}
+func (*DataEntry) restoreDataRes() {}
+
+// Ref: #/components/schemas/DataRequest
+type DataRequest struct {
+	Key string `json:"key"`
+	// Any JSON value.
+	Value jx.Raw `json:"value"`
+	// Lifetime in seconds. Mutually exclusive with expires_at.
+	TTL OptInt64 `json:"ttl"`
+	// Absolute expiry time. Mutually exclusive with ttl.
+	ExpiresAt OptDateTime `json:"expires_at"`
+}
+
+// GetKey returns the value of Key.
+func (s *DataRequest) GetKey() string {
+	return s.Key
+}
+
+// GetValue returns the value of Value.
+func (s *DataRequest) GetValue() jx.Raw {
+	return s.Value
+}
+
+// GetTTL returns the value of TTL.
+func (s *DataRequest) GetTTL() OptInt64 {
+	return s.TTL
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *DataRequest) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// SetKey sets the value of Key.
+func (s *DataRequest) SetKey(val string) {
+	s.Key = val
+}
+
+// SetValue sets the value of Value.
+func (s *DataRequest) SetValue(val jx.Raw) {
+	s.Value = val
+}
+
+// SetTTL sets the value of TTL.
+func (s *DataRequest) SetTTL(val OptInt64) {
+	s.TTL = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *DataRequest) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// Ref: #/components/schemas/DataSchema
+type DataSchema struct {
+	Prefix    string           `json:"prefix"`
+	Schema    DataSchemaSchema `json:"schema"`
+	CreatedAt OptDateTime      `json:"created_at"`
+	UpdatedAt OptDateTime      `json:"updated_at"`
+}
+
+// GetPrefix returns the value of Prefix.
+func (s *DataSchema) GetPrefix() string {
+	return s.Prefix
+}
+
+// GetSchema returns the value of Schema.
+func (s *DataSchema) GetSchema() DataSchemaSchema {
+	return s.Schema
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DataSchema) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DataSchema) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *DataSchema) SetPrefix(val string) {
+	s.Prefix = val
+}
+
+// SetSchema sets the value of Schema.
+func (s *DataSchema) SetSchema(val DataSchemaSchema) {
+	s.Schema = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DataSchema) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DataSchema) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+func (*DataSchema) putDataSchemaRes() {}
+
+// Ref: #/components/schemas/DataSchemaRequest
+type DataSchemaRequest struct {
+	// Keys starting with this prefix are validated against the schema.
+	Prefix string `json:"prefix"`
+	// A JSON Schema document.
+	Schema DataSchemaRequestSchema `json:"schema"`
+}
+
+// GetPrefix returns the value of Prefix.
+func (s *DataSchemaRequest) GetPrefix() string {
+	return s.Prefix
+}
+
+// GetSchema returns the value of Schema.
+func (s *DataSchemaRequest) GetSchema() DataSchemaRequestSchema {
+	return s.Schema
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *DataSchemaRequest) SetPrefix(val string) {
+	s.Prefix = val
+}
+
+// SetSchema sets the value of Schema.
+func (s *DataSchemaRequest) SetSchema(val DataSchemaRequestSchema) {
+	s.Schema = val
+}
+
+// A JSON Schema document.
+type DataSchemaRequestSchema map[string]jx.Raw
+
+func (s *DataSchemaRequestSchema) init() DataSchemaRequestSchema {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+type DataSchemaSchema map[string]jx.Raw
+
+func (s *DataSchemaSchema) init() DataSchemaSchema {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Storage used by the live keys the user wrote last. A zero limit means unlimited.
+// Ref: #/components/schemas/DataUsage
+type DataUsage struct {
+	KeyCount      int64 `json:"key_count"`
+	TotalBytes    int64 `json:"total_bytes"`
+	MaxKeys       int64 `json:"max_keys"`
+	MaxValueBytes int64 `json:"max_value_bytes"`
+	MaxTotalBytes int64 `json:"max_total_bytes"`
+}
+
+// GetKeyCount returns the value of KeyCount.
+func (s *DataUsage) GetKeyCount() int64 {
+	return s.KeyCount
+}
+
+// GetTotalBytes returns the value of TotalBytes.
+func (s *DataUsage) GetTotalBytes() int64 {
+	return s.TotalBytes
+}
+
+// GetMaxKeys returns the value of MaxKeys.
+func (s *DataUsage) GetMaxKeys() int64 {
+	return s.MaxKeys
+}
+
+// GetMaxValueBytes returns the value of MaxValueBytes.
+func (s *DataUsage) GetMaxValueBytes() int64 {
+	return s.MaxValueBytes
+}
+
+// GetMaxTotalBytes returns the value of MaxTotalBytes.
+func (s *DataUsage) GetMaxTotalBytes() int64 {
+	return s.MaxTotalBytes
+}
+
+// SetKeyCount sets the value of KeyCount.
+func (s *DataUsage) SetKeyCount(val int64) {
+	s.KeyCount = val
+}
+
+// SetTotalBytes sets the value of TotalBytes.
+func (s *DataUsage) SetTotalBytes(val int64) {
+	s.TotalBytes = val
+}
+
+// SetMaxKeys sets the value of MaxKeys.
+func (s *DataUsage) SetMaxKeys(val int64) {
+	s.MaxKeys = val
+}
+
+// SetMaxValueBytes sets the value of MaxValueBytes.
+func (s *DataUsage) SetMaxValueBytes(val int64) {
+	s.MaxValueBytes = val
+}
+
+// SetMaxTotalBytes sets the value of MaxTotalBytes.
+func (s *DataUsage) SetMaxTotalBytes(val int64) {
+	s.MaxTotalBytes = val
+}
+
+func (*DataUsage) getDataUsageRes() {}
+
+// DeleteAttachmentInternalServerError is response for DeleteAttachment operation.
+type DeleteAttachmentInternalServerError struct{}
+
+func (*DeleteAttachmentInternalServerError) deleteAttachmentRes() {}
+
+// DeleteAttachmentNoContent is response for DeleteAttachment operation.
+type DeleteAttachmentNoContent struct{}
+
+func (*DeleteAttachmentNoContent) deleteAttachmentRes() {}
+
+// DeleteAttachmentNotFound is response for DeleteAttachment operation.
+type DeleteAttachmentNotFound struct{}
+
+func (*DeleteAttachmentNotFound) deleteAttachmentRes() {}
+
+// DeleteAttachmentUnauthorized is response for DeleteAttachment operation.
+type DeleteAttachmentUnauthorized struct{}
+
+func (*DeleteAttachmentUnauthorized) deleteAttachmentRes() {}
+
+// DeleteCatalogCategoryConflict is response for DeleteCatalogCategory operation.
+type DeleteCatalogCategoryConflict struct{}
+
+func (*DeleteCatalogCategoryConflict) deleteCatalogCategoryRes() {}
+
+// DeleteCatalogCategoryForbidden is response for DeleteCatalogCategory operation.
+type DeleteCatalogCategoryForbidden struct{}
+
+func (*DeleteCatalogCategoryForbidden) deleteCatalogCategoryRes() {}
+
+// DeleteCatalogCategoryInternalServerError is response for DeleteCatalogCategory operation.
+type DeleteCatalogCategoryInternalServerError struct{}
+
+func (*DeleteCatalogCategoryInternalServerError) deleteCatalogCategoryRes() {}
+
+// DeleteCatalogCategoryNoContent is response for DeleteCatalogCategory operation.
+type DeleteCatalogCategoryNoContent struct{}
+
+func (*DeleteCatalogCategoryNoContent) deleteCatalogCategoryRes() {}
+
+// DeleteCatalogCategoryNotFound is response for DeleteCatalogCategory operation.
+type DeleteCatalogCategoryNotFound struct{}
+
+func (*DeleteCatalogCategoryNotFound) deleteCatalogCategoryRes() {}
+
+// DeleteCatalogCategoryUnauthorized is response for DeleteCatalogCategory operation.
+type DeleteCatalogCategoryUnauthorized struct{}
+
+func (*DeleteCatalogCategoryUnauthorized) deleteCatalogCategoryRes() {}
+
+// DeleteCatalogChangesetConflict is response for DeleteCatalogChangeset operation.
+type DeleteCatalogChangesetConflict struct{}
+
+func (*DeleteCatalogChangesetConflict) deleteCatalogChangesetRes() {}
+
+// DeleteCatalogChangesetForbidden is response for DeleteCatalogChangeset operation.
+type DeleteCatalogChangesetForbidden struct{}
+
+func (*DeleteCatalogChangesetForbidden) deleteCatalogChangesetRes() {}
+
+// DeleteCatalogChangesetInternalServerError is response for DeleteCatalogChangeset operation.
+type DeleteCatalogChangesetInternalServerError struct{}
+
+func (*DeleteCatalogChangesetInternalServerError) deleteCatalogChangesetRes() {}
+
+// DeleteCatalogChangesetNoContent is response for DeleteCatalogChangeset operation.
+type DeleteCatalogChangesetNoContent struct{}
+
+func (*DeleteCatalogChangesetNoContent) deleteCatalogChangesetRes() {}
+
+// DeleteCatalogChangesetNotFound is response for DeleteCatalogChangeset operation.
+type DeleteCatalogChangesetNotFound struct{}
+
+func (*DeleteCatalogChangesetNotFound) deleteCatalogChangesetRes() {}
+
+// DeleteCatalogChangesetUnauthorized is response for DeleteCatalogChangeset operation.
+type DeleteCatalogChangesetUnauthorized struct{}
+
+func (*DeleteCatalogChangesetUnauthorized) deleteCatalogChangesetRes() {}
+
+// DeleteCatalogDraftConflict is response for DeleteCatalogDraft operation.
+type DeleteCatalogDraftConflict struct{}
+
+func (*DeleteCatalogDraftConflict) deleteCatalogDraftRes() {}
+
+// DeleteCatalogDraftForbidden is response for DeleteCatalogDraft operation.
+type DeleteCatalogDraftForbidden struct{}
+
+func (*DeleteCatalogDraftForbidden) deleteCatalogDraftRes() {}
+
+// DeleteCatalogDraftInternalServerError is response for DeleteCatalogDraft operation.
+type DeleteCatalogDraftInternalServerError struct{}
+
+func (*DeleteCatalogDraftInternalServerError) deleteCatalogDraftRes() {}
+
+// DeleteCatalogDraftNoContent is response for DeleteCatalogDraft operation.
+type DeleteCatalogDraftNoContent struct{}
+
+func (*DeleteCatalogDraftNoContent) deleteCatalogDraftRes() {}
+
+// DeleteCatalogDraftNotFound is response for DeleteCatalogDraft operation.
+type DeleteCatalogDraftNotFound struct{}
+
+func (*DeleteCatalogDraftNotFound) deleteCatalogDraftRes() {}
+
+// DeleteCatalogDraftUnauthorized is response for DeleteCatalogDraft operation.
+type DeleteCatalogDraftUnauthorized struct{}
+
+func (*DeleteCatalogDraftUnauthorized) deleteCatalogDraftRes() {}
+
+// DeleteCatalogImageForbidden is response for DeleteCatalogImage operation.
+type DeleteCatalogImageForbidden struct{}
+
+func (*DeleteCatalogImageForbidden) deleteCatalogImageRes() {}
+
+// DeleteCatalogImageInternalServerError is response for DeleteCatalogImage operation.
+type DeleteCatalogImageInternalServerError struct{}
+
+func (*DeleteCatalogImageInternalServerError) deleteCatalogImageRes() {}
+
+// DeleteCatalogImageNoContent is response for DeleteCatalogImage operation.
+type DeleteCatalogImageNoContent struct{}
+
+func (*DeleteCatalogImageNoContent) deleteCatalogImageRes() {}
+
+// DeleteCatalogImageNotFound is response for DeleteCatalogImage operation.
+type DeleteCatalogImageNotFound struct{}
+
+func (*DeleteCatalogImageNotFound) deleteCatalogImageRes() {}
+
+// DeleteCatalogImageUnauthorized is response for DeleteCatalogImage operation.
+type DeleteCatalogImageUnauthorized struct{}
+
+func (*DeleteCatalogImageUnauthorized) deleteCatalogImageRes() {}
+
+// DeleteCatalogItemForbidden is response for DeleteCatalogItem operation.
+type DeleteCatalogItemForbidden struct{}
+
+func (*DeleteCatalogItemForbidden) deleteCatalogItemRes() {}
+
+// DeleteCatalogItemInternalServerError is response for DeleteCatalogItem operation.
+type DeleteCatalogItemInternalServerError struct{}
+
+func (*DeleteCatalogItemInternalServerError) deleteCatalogItemRes() {}
+
+// DeleteCatalogItemNoContent is response for DeleteCatalogItem operation.
+type DeleteCatalogItemNoContent struct{}
+
+func (*DeleteCatalogItemNoContent) deleteCatalogItemRes() {}
+
+// DeleteCatalogItemNotFound is response for DeleteCatalogItem operation.
+type DeleteCatalogItemNotFound struct{}
+
+func (*DeleteCatalogItemNotFound) deleteCatalogItemRes() {}
+
+// DeleteCatalogItemUnauthorized is response for DeleteCatalogItem operation.
+type DeleteCatalogItemUnauthorized struct{}
+
+func (*DeleteCatalogItemUnauthorized) deleteCatalogItemRes() {}
+
+// DeleteCatalogReviewInternalServerError is response for DeleteCatalogReview operation.
+type DeleteCatalogReviewInternalServerError struct{}
+
+func (*DeleteCatalogReviewInternalServerError) deleteCatalogReviewRes() {}
+
+// DeleteCatalogReviewNoContent is response for DeleteCatalogReview operation.
+type DeleteCatalogReviewNoContent struct{}
+
+func (*DeleteCatalogReviewNoContent) deleteCatalogReviewRes() {}
+
+// DeleteCatalogReviewNotFound is response for DeleteCatalogReview operation.
+type DeleteCatalogReviewNotFound struct{}
+
+func (*DeleteCatalogReviewNotFound) deleteCatalogReviewRes() {}
+
+// DeleteCatalogReviewUnauthorized is response for DeleteCatalogReview operation.
+type DeleteCatalogReviewUnauthorized struct{}
+
+func (*DeleteCatalogReviewUnauthorized) deleteCatalogReviewRes() {}
+
+// DeleteCatalogTagForbidden is response for DeleteCatalogTag operation.
+type DeleteCatalogTagForbidden struct{}
+
+func (*DeleteCatalogTagForbidden) deleteCatalogTagRes() {}
+
+// DeleteCatalogTagInternalServerError is response for DeleteCatalogTag operation.
+type DeleteCatalogTagInternalServerError struct{}
+
+func (*DeleteCatalogTagInternalServerError) deleteCatalogTagRes() {}
+
+// DeleteCatalogTagNoContent is response for DeleteCatalogTag operation.
+type DeleteCatalogTagNoContent struct{}
+
+func (*DeleteCatalogTagNoContent) deleteCatalogTagRes() {}
+
+// DeleteCatalogTagNotFound is response for DeleteCatalogTag operation.
+type DeleteCatalogTagNotFound struct{}
+
+func (*DeleteCatalogTagNotFound) deleteCatalogTagRes() {}
+
+// DeleteCatalogTagUnauthorized is response for DeleteCatalogTag operation.
+type DeleteCatalogTagUnauthorized struct{}
+
+func (*DeleteCatalogTagUnauthorized) deleteCatalogTagRes() {}
+
+// DeleteCatalogTranslationForbidden is response for DeleteCatalogTranslation operation.
+type DeleteCatalogTranslationForbidden struct{}
+
+func (*DeleteCatalogTranslationForbidden) deleteCatalogTranslationRes() {}
+
+// DeleteCatalogTranslationInternalServerError is response for DeleteCatalogTranslation operation.
+type DeleteCatalogTranslationInternalServerError struct{}
+
+func (*DeleteCatalogTranslationInternalServerError) deleteCatalogTranslationRes() {}
+
+// DeleteCatalogTranslationNoContent is response for DeleteCatalogTranslation operation.
+type DeleteCatalogTranslationNoContent struct{}
+
+func (*DeleteCatalogTranslationNoContent) deleteCatalogTranslationRes() {}
+
+// DeleteCatalogTranslationNotFound is response for DeleteCatalogTranslation operation.
+type DeleteCatalogTranslationNotFound struct{}
+
+func (*DeleteCatalogTranslationNotFound) deleteCatalogTranslationRes() {}
+
+// DeleteCatalogTranslationUnauthorized is response for DeleteCatalogTranslation operation.
+type DeleteCatalogTranslationUnauthorized struct{}
+
+func (*DeleteCatalogTranslationUnauthorized) deleteCatalogTranslationRes() {}
+
+// DeleteDataInternalServerError is response for DeleteData operation.
+type DeleteDataInternalServerError struct{}
+
+func (*DeleteDataInternalServerError) deleteDataRes() {}
+
+// DeleteDataNoContent is response for DeleteData operation.
+type DeleteDataNoContent struct{}
+
+func (*DeleteDataNoContent) deleteDataRes() {}
+
+// DeleteDataNotFound is response for DeleteData operation.
+type DeleteDataNotFound struct{}
+
+func (*DeleteDataNotFound) deleteDataRes() {}
+
+// DeleteDataSchemaForbidden is response for DeleteDataSchema operation.
+type DeleteDataSchemaForbidden struct{}
+
+func (*DeleteDataSchemaForbidden) deleteDataSchemaRes() {}
+
+// DeleteDataSchemaInternalServerError is response for DeleteDataSchema operation.
+type DeleteDataSchemaInternalServerError struct{}
+
+func (*DeleteDataSchemaInternalServerError) deleteDataSchemaRes() {}
+
+// DeleteDataSchemaNoContent is response for DeleteDataSchema operation.
+type DeleteDataSchemaNoContent struct{}
+
+func (*DeleteDataSchemaNoContent) deleteDataSchemaRes() {}
+
+// DeleteDataSchemaNotFound is response for DeleteDataSchema operation.
+type DeleteDataSchemaNotFound struct{}
+
+func (*DeleteDataSchemaNotFound) deleteDataSchemaRes() {}
+
+// DeleteDataSchemaUnauthorized is response for DeleteDataSchema operation.
+type DeleteDataSchemaUnauthorized struct{}
+
+func (*DeleteDataSchemaUnauthorized) deleteDataSchemaRes() {}
+
+// DeleteDataUnauthorized is response for DeleteData operation.
+type DeleteDataUnauthorized struct{}
+
+func (*DeleteDataUnauthorized) deleteDataRes() {}
+
+// DeleteWebhookEndpointForbidden is response for DeleteWebhookEndpoint operation.
+type DeleteWebhookEndpointForbidden struct{}
+
+func (*DeleteWebhookEndpointForbidden) deleteWebhookEndpointRes() {}
+
+// DeleteWebhookEndpointInternalServerError is response for DeleteWebhookEndpoint operation.
+type DeleteWebhookEndpointInternalServerError struct{}
+
+func (*DeleteWebhookEndpointInternalServerError) deleteWebhookEndpointRes() {}
+
+// DeleteWebhookEndpointNoContent is response for DeleteWebhookEndpoint operation.
+type DeleteWebhookEndpointNoContent struct{}
+
+func (*DeleteWebhookEndpointNoContent) deleteWebhookEndpointRes() {}
+
+// DeleteWebhookEndpointNotFound is response for DeleteWebhookEndpoint operation.
+type DeleteWebhookEndpointNotFound struct{}
+
+func (*DeleteWebhookEndpointNotFound) deleteWebhookEndpointRes() {}
+
+// DeleteWebhookEndpointUnauthorized is response for DeleteWebhookEndpoint operation.
+type DeleteWebhookEndpointUnauthorized struct{}
+
+func (*DeleteWebhookEndpointUnauthorized) deleteWebhookEndpointRes() {}
+
+// Ref: #/components/schemas/Error
+type Error struct {
+	Code    int32         `json:"code"`
+	Message string        `json:"message"`
+	Details []ErrorDetail `json:"details"`
+}
+
+// GetCode returns the value of Code.
+func (s *Error) GetCode() int32 {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *Error) GetMessage() string {
+	return s.Message
+}
+
+// GetDetails returns the value of Details.
+func (s *Error) GetDetails() []ErrorDetail {
+	return s.Details
+}
+
+// SetCode sets the value of Code.
+func (s *Error) SetCode(val int32) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetDetails sets the value of Details.
+func (s *Error) SetDetails(val []ErrorDetail) {
+	s.Details = val
+}
+
+func (*Error) createCatalogCategoryRes()    {}
+func (*Error) createCatalogChangesetRes()   {}
+func (*Error) createCatalogDraftRes()       {}
+func (*Error) createCatalogItemRes()        {}
+func (*Error) createCatalogTagRes()         {}
+func (*Error) createWebhookEndpointRes()    {}
+func (*Error) getCatalogV2Res()             {}
+func (*Error) importDataRes()               {}
+func (*Error) listAttachmentsRes()          {}
+func (*Error) listCatalogFavoritesRes()     {}
+func (*Error) listWebhookDeliveriesRes()    {}
+func (*Error) publishCatalogChangesetRes()  {}
+func (*Error) putCatalogDraftRes()          {}
+func (*Error) putCatalogTranslationRes()    {}
+func (*Error) putDataSchemaRes()            {}
+func (*Error) renameCatalogTagRes()         {}
+func (*Error) restoreCatalogItemRes()       {}
+func (*Error) restoreDataRes()              {}
+func (*Error) rollbackCatalogChangesetRes() {}
+func (*Error) searchCatalogRes()            {}
+func (*Error) setCatalogInventoryRes()      {}
+func (*Error) setMyLocaleRes()              {}
+func (*Error) updateCatalogCategoryRes()    {}
+func (*Error) updateCatalogItemRes()        {}
+func (*Error) updateWebhookEndpointRes()    {}
+
+// Ref: #/components/schemas/ErrorDetail
+type ErrorDetail struct {
+	// JSON pointer to the invalid part of the input.
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// GetPath returns the value of Path.
+func (s *ErrorDetail) GetPath() string {
+	return s.Path
+}
+
+// GetMessage returns the value of Message.
+func (s *ErrorDetail) GetMessage() string {
+	return s.Message
+}
+
+// SetPath sets the value of Path.
+func (s *ErrorDetail) SetPath(val string) {
+	s.Path = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ErrorDetail) SetMessage(val string) {
+	s.Message = val
+}
+
+type ExportDataFormat string
+
+const (
+	ExportDataFormatNdjson ExportDataFormat = "ndjson"
+	ExportDataFormatCsv    ExportDataFormat = "csv"
+)
+
+// AllValues returns all ExportDataFormat values.
+func (ExportDataFormat) AllValues() []ExportDataFormat {
+	return []ExportDataFormat{
+		ExportDataFormatNdjson,
+		ExportDataFormatCsv,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ExportDataFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case ExportDataFormatNdjson:
+		return []byte(s), nil
+	case ExportDataFormatCsv:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ExportDataFormat) UnmarshalText(data []byte) error {
+	switch ExportDataFormat(data) {
+	case ExportDataFormatNdjson:
+		*s = ExportDataFormatNdjson
+		return nil
+	case ExportDataFormatCsv:
+		*s = ExportDataFormatCsv
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// ExportDataInternalServerError is response for ExportData operation.
+type ExportDataInternalServerError struct{}
+
+func (*ExportDataInternalServerError) exportDataRes() {}
+
+type ExportDataOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ExportDataOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ExportDataOKApplicationXNdjson) exportDataRes() {}
+
+type ExportDataOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ExportDataOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ExportDataOKTextCsv) exportDataRes() {}
+
+// ExportDataUnauthorized is response for ExportData operation.
+type ExportDataUnauthorized struct{}
+
+func (*ExportDataUnauthorized) exportDataRes() {}
+
+// GetCatalogCategoryInternalServerError is response for GetCatalogCategory operation.
+type GetCatalogCategoryInternalServerError struct{}
+
+func (*GetCatalogCategoryInternalServerError) getCatalogCategoryRes() {}
+
+// GetCatalogCategoryNotFound is response for GetCatalogCategory operation.
+type GetCatalogCategoryNotFound struct{}
+
+func (*GetCatalogCategoryNotFound) getCatalogCategoryRes() {}
+
+// GetCatalogCategoryUnauthorized is response for GetCatalogCategory operation.
+type GetCatalogCategoryUnauthorized struct{}
+
+func (*GetCatalogCategoryUnauthorized) getCatalogCategoryRes() {}
+
+// GetCatalogChangesetForbidden is response for GetCatalogChangeset operation.
+type GetCatalogChangesetForbidden struct{}
+
+func (*GetCatalogChangesetForbidden) getCatalogChangesetRes() {}
+
+// GetCatalogChangesetInternalServerError is response for GetCatalogChangeset operation.
+type GetCatalogChangesetInternalServerError struct{}
+
+func (*GetCatalogChangesetInternalServerError) getCatalogChangesetRes() {}
+
+// GetCatalogChangesetNotFound is response for GetCatalogChangeset operation.
+type GetCatalogChangesetNotFound struct{}
+
+func (*GetCatalogChangesetNotFound) getCatalogChangesetRes() {}
+
+// GetCatalogChangesetUnauthorized is response for GetCatalogChangeset operation.
+type GetCatalogChangesetUnauthorized struct{}
+
+func (*GetCatalogChangesetUnauthorized) getCatalogChangesetRes() {}
+
+// GetCatalogInternalServerError is response for GetCatalog operation.
+type GetCatalogInternalServerError struct{}
+
+func (*GetCatalogInternalServerError) getCatalogRes() {}
+
+// GetCatalogItemInternalServerError is response for GetCatalogItem operation.
+type GetCatalogItemInternalServerError struct{}
+
+func (*GetCatalogItemInternalServerError) getCatalogItemRes() {}
+
+// GetCatalogItemNotFound is response for GetCatalogItem operation.
+type GetCatalogItemNotFound struct{}
+
+func (*GetCatalogItemNotFound) getCatalogItemRes() {}
+
+// GetCatalogItemUnauthorized is response for GetCatalogItem operation.
+type GetCatalogItemUnauthorized struct{}
+
+func (*GetCatalogItemUnauthorized) getCatalogItemRes() {}
+
+type GetCatalogOKApplicationJSON []CatalogItem
+
+func (*GetCatalogOKApplicationJSON) getCatalogRes() {}
+
+// GetCatalogReviewInternalServerError is response for GetCatalogReview operation.
+type GetCatalogReviewInternalServerError struct{}
+
+func (*GetCatalogReviewInternalServerError) getCatalogReviewRes() {}
+
+// GetCatalogReviewNotFound is response for GetCatalogReview operation.
+type GetCatalogReviewNotFound struct{}
+
+func (*GetCatalogReviewNotFound) getCatalogReviewRes() {}
+
+// GetCatalogReviewUnauthorized is response for GetCatalogReview operation.
+type GetCatalogReviewUnauthorized struct{}
+
+func (*GetCatalogReviewUnauthorized) getCatalogReviewRes() {}
+
+// GetCatalogUnauthorized is response for GetCatalog operation.
+type GetCatalogUnauthorized struct{}
+
+func (*GetCatalogUnauthorized) getCatalogRes() {}
+
+// GetCatalogV2InternalServerError is response for GetCatalogV2 operation.
+type GetCatalogV2InternalServerError struct{}
+
+func (*GetCatalogV2InternalServerError) getCatalogV2Res() {}
+
+type GetCatalogV2Sort string
+
+const (
+	GetCatalogV2SortTitle          GetCatalogV2Sort = "title"
+	GetCatalogV2SortMinusTitle     GetCatalogV2Sort = "-title"
+	GetCatalogV2SortCreatedAt      GetCatalogV2Sort = "created_at"
+	GetCatalogV2SortMinusCreatedAt GetCatalogV2Sort = "-created_at"
+)
+
+// AllValues returns all GetCatalogV2Sort values.
+func (GetCatalogV2Sort) AllValues() []GetCatalogV2Sort {
+	return []GetCatalogV2Sort{
+		GetCatalogV2SortTitle,
+		GetCatalogV2SortMinusTitle,
+		GetCatalogV2SortCreatedAt,
+		GetCatalogV2SortMinusCreatedAt,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetCatalogV2Sort) MarshalText() ([]byte, error) {
+	switch s {
+	case GetCatalogV2SortTitle:
+		return []byte(s), nil
+	case GetCatalogV2SortMinusTitle:
+		return []byte(s), nil
+	case GetCatalogV2SortCreatedAt:
+		return []byte(s), nil
+	case GetCatalogV2SortMinusCreatedAt:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetCatalogV2Sort) UnmarshalText(data []byte) error {
+	switch GetCatalogV2Sort(data) {
+	case GetCatalogV2SortTitle:
+		*s = GetCatalogV2SortTitle
+		return nil
+	case GetCatalogV2SortMinusTitle:
+		*s = GetCatalogV2SortMinusTitle
+		return nil
+	case GetCatalogV2SortCreatedAt:
+		*s = GetCatalogV2SortCreatedAt
+		return nil
+	case GetCatalogV2SortMinusCreatedAt:
+		*s = GetCatalogV2SortMinusCreatedAt
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// GetCatalogV2Unauthorized is response for GetCatalogV2 operation.
+type GetCatalogV2Unauthorized struct{}
+
+func (*GetCatalogV2Unauthorized) getCatalogV2Res() {}
+
+// GetDataInternalServerError is response for GetData operation.
+type GetDataInternalServerError struct{}
+
+func (*GetDataInternalServerError) getDataRes() {}
+
+// GetDataNotFound is response for GetData operation.
+type GetDataNotFound struct{}
+
+func (*GetDataNotFound) getDataRes() {}
+
+// GetDataUnauthorized is response for GetData operation.
+type GetDataUnauthorized struct{}
+
+func (*GetDataUnauthorized) getDataRes() {}
+
+// GetDataUsageInternalServerError is response for GetDataUsage operation.
+type GetDataUsageInternalServerError struct{}
+
+func (*GetDataUsageInternalServerError) getDataUsageRes() {}
+
+// GetDataUsageUnauthorized is response for GetDataUsage operation.
+type GetDataUsageUnauthorized struct{}
+
+func (*GetDataUsageUnauthorized) getDataUsageRes() {}
+
+// GetMeInternalServerError is response for GetMe operation.
+type GetMeInternalServerError struct{}
+
+func (*GetMeInternalServerError) getMeRes() {}
+
+// GetMeUnauthorized is response for GetMe operation.
+type GetMeUnauthorized struct{}
+
+func (*GetMeUnauthorized) getMeRes() {}
+
+// GetWebhookEndpointForbidden is response for GetWebhookEndpoint operation.
+type GetWebhookEndpointForbidden struct{}
+
+func (*GetWebhookEndpointForbidden) getWebhookEndpointRes() {}
+
+// GetWebhookEndpointInternalServerError is response for GetWebhookEndpoint operation.
+type GetWebhookEndpointInternalServerError struct{}
+
+func (*GetWebhookEndpointInternalServerError) getWebhookEndpointRes() {}
+
+// GetWebhookEndpointNotFound is response for GetWebhookEndpoint operation.
+type GetWebhookEndpointNotFound struct{}
+
+func (*GetWebhookEndpointNotFound) getWebhookEndpointRes() {}
+
+// GetWebhookEndpointUnauthorized is response for GetWebhookEndpoint operation.
+type GetWebhookEndpointUnauthorized struct{}
+
+func (*GetWebhookEndpointUnauthorized) getWebhookEndpointRes() {}
+
+type ImportCatalogConflict Error
+
+func (*ImportCatalogConflict) importCatalogRes() {}
+
+// ImportCatalogForbidden is response for ImportCatalog operation.
+type ImportCatalogForbidden struct{}
+
+func (*ImportCatalogForbidden) importCatalogRes() {}
+
+// ImportCatalogInternalServerError is response for ImportCatalog operation.
+type ImportCatalogInternalServerError struct{}
+
+func (*ImportCatalogInternalServerError) importCatalogRes() {}
+
+type ImportCatalogReqApplicationJSON jx.Raw
+
+func (*ImportCatalogReqApplicationJSON) importCatalogReq() {}
+
+type ImportCatalogReqTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ImportCatalogReqTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ImportCatalogReqTextCsv) importCatalogReq() {}
+
+// ImportCatalogUnauthorized is response for ImportCatalog operation.
+type ImportCatalogUnauthorized struct{}
+
+func (*ImportCatalogUnauthorized) importCatalogRes() {}
+
+type ImportCatalogUnprocessableEntity Error
+
+func (*ImportCatalogUnprocessableEntity) importCatalogRes() {}
+
+// ImportDataInternalServerError is response for ImportData operation.
+type ImportDataInternalServerError struct{}
+
+func (*ImportDataInternalServerError) importDataRes() {}
+
+type ImportDataOnConflict string
+
+const (
+	ImportDataOnConflictUpsert ImportDataOnConflict = "upsert"
+	ImportDataOnConflictSkip   ImportDataOnConflict = "skip"
+	ImportDataOnConflictFail   ImportDataOnConflict = "fail"
+)
+
+// AllValues returns all ImportDataOnConflict values.
+func (ImportDataOnConflict) AllValues() []ImportDataOnConflict {
+	return []ImportDataOnConflict{
+		ImportDataOnConflictUpsert,
+		ImportDataOnConflictSkip,
+		ImportDataOnConflictFail,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ImportDataOnConflict) MarshalText() ([]byte, error) {
+	switch s {
+	case ImportDataOnConflictUpsert:
+		return []byte(s), nil
+	case ImportDataOnConflictSkip:
+		return []byte(s), nil
+	case ImportDataOnConflictFail:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ImportDataOnConflict) UnmarshalText(data []byte) error {
+	switch ImportDataOnConflict(data) {
+	case ImportDataOnConflictUpsert:
+		*s = ImportDataOnConflictUpsert
+		return nil
+	case ImportDataOnConflictSkip:
+		*s = ImportDataOnConflictSkip
+		return nil
+	case ImportDataOnConflictFail:
+		*s = ImportDataOnConflictFail
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ImportDataReqApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ImportDataReqApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ImportDataReqApplicationXNdjson) importDataReq() {}
+
+type ImportDataReqTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ImportDataReqTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ImportDataReqTextCsv) importDataReq() {}
+
+// ImportDataUnauthorized is response for ImportData operation.
+type ImportDataUnauthorized struct{}
+
+func (*ImportDataUnauthorized) importDataRes() {}
+
+// Ref: #/components/schemas/ImportLineError
+type ImportLineError struct {
+	// 1-based line (NDJSON) or record (CSV) number.
+	Line    int       `json:"line"`
+	Key     OptString `json:"key"`
+	Message string    `json:"message"`
+}
+
+// GetLine returns the value of Line.
+func (s *ImportLineError) GetLine() int {
+	return s.Line
+}
+
+// GetKey returns the value of Key.
+func (s *ImportLineError) GetKey() OptString {
+	return s.Key
+}
+
+// GetMessage returns the value of Message.
+func (s *ImportLineError) GetMessage() string {
+	return s.Message
+}
+
+// SetLine sets the value of Line.
+func (s *ImportLineError) SetLine(val int) {
+	s.Line = val
+}
+
+// SetKey sets the value of Key.
+func (s *ImportLineError) SetKey(val OptString) {
+	s.Key = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ImportLineError) SetMessage(val string) {
+	s.Message = val
+}
+
+// Ref: #/components/schemas/ImportReport
+type ImportReport struct {
+	DryRun bool `json:"dry_run"`
+	// True when the import stopped early because of a conflict in fail mode.
+	Aborted bool `json:"aborted"`
+	// Number of entries read from the input.
+	Total   int               `json:"total"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Skipped int               `json:"skipped"`
+	Failed  int               `json:"failed"`
+	Errors  []ImportLineError `json:"errors"`
+}
+
+// GetDryRun returns the value of DryRun.
+func (s *ImportReport) GetDryRun() bool {
+	return s.DryRun
+}
+
+// GetAborted returns the value of Aborted.
+func (s *ImportReport) GetAborted() bool {
+	return s.Aborted
+}
+
+// GetTotal returns the value of Total.
+func (s *ImportReport) GetTotal() int {
+	return s.Total
+}
+
+// GetCreated returns the value of Created.
+func (s *ImportReport) GetCreated() int {
+	return s.Created
+}
+
+// GetUpdated returns the value of Updated.
+func (s *ImportReport) GetUpdated() int {
+	return s.Updated
+}
+
+// GetSkipped returns the value of Skipped.
+func (s *ImportReport) GetSkipped() int {
+	return s.Skipped
+}
+
+// GetFailed returns the value of Failed.
+func (s *ImportReport) GetFailed() int {
+	return s.Failed
+}
+
+// GetErrors returns the value of Errors.
+func (s *ImportReport) GetErrors() []ImportLineError {
+	return s.Errors
+}
+
+// SetDryRun sets the value of DryRun.
+func (s *ImportReport) SetDryRun(val bool) {
+	s.DryRun = val
+}
+
+// SetAborted sets the value of Aborted.
+func (s *ImportReport) SetAborted(val bool) {
+	s.Aborted = val
+}
+
+// SetTotal sets the value of Total.
+func (s *ImportReport) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetCreated sets the value of Created.
+func (s *ImportReport) SetCreated(val int) {
+	s.Created = val
+}
+
+// SetUpdated sets the value of Updated.
+func (s *ImportReport) SetUpdated(val int) {
+	s.Updated = val
+}
+
+// SetSkipped sets the value of Skipped.
+func (s *ImportReport) SetSkipped(val int) {
+	s.Skipped = val
+}
+
+// SetFailed sets the value of Failed.
+func (s *ImportReport) SetFailed(val int) {
+	s.Failed = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *ImportReport) SetErrors(val []ImportLineError) {
+	s.Errors = val
+}
+
+func (*ImportReport) importDataRes() {}
+
+// ListAttachmentsInternalServerError is response for ListAttachments operation.
+type ListAttachmentsInternalServerError struct{}
+
+func (*ListAttachmentsInternalServerError) listAttachmentsRes() {}
+
+type ListAttachmentsOKApplicationJSON []Attachment
+
+func (*ListAttachmentsOKApplicationJSON) listAttachmentsRes() {}
+
+// ListAttachmentsUnauthorized is response for ListAttachments operation.
+type ListAttachmentsUnauthorized struct{}
+
+func (*ListAttachmentsUnauthorized) listAttachmentsRes() {}
+
+// ListCatalogCategoriesInternalServerError is response for ListCatalogCategories operation.
+type ListCatalogCategoriesInternalServerError struct{}
+
+func (*ListCatalogCategoriesInternalServerError) listCatalogCategoriesRes() {}
+
+type ListCatalogCategoriesOKApplicationJSON []CatalogCategory
+
+func (*ListCatalogCategoriesOKApplicationJSON) listCatalogCategoriesRes() {}
+
+// ListCatalogCategoriesUnauthorized is response for ListCatalogCategories operation.
+type ListCatalogCategoriesUnauthorized struct{}
+
+func (*ListCatalogCategoriesUnauthorized) listCatalogCategoriesRes() {}
+
+// ListCatalogChangesetsForbidden is response for ListCatalogChangesets operation.
+type ListCatalogChangesetsForbidden struct{}
+
+func (*ListCatalogChangesetsForbidden) listCatalogChangesetsRes() {}
+
+// ListCatalogChangesetsInternalServerError is response for ListCatalogChangesets operation.
+type ListCatalogChangesetsInternalServerError struct{}
+
+func (*ListCatalogChangesetsInternalServerError) listCatalogChangesetsRes() {}
+
+type ListCatalogChangesetsOKApplicationJSON []CatalogChangeset
+
+func (*ListCatalogChangesetsOKApplicationJSON) listCatalogChangesetsRes() {}
+
+// ListCatalogChangesetsUnauthorized is response for ListCatalogChangesets operation.
+type ListCatalogChangesetsUnauthorized struct{}
+
+func (*ListCatalogChangesetsUnauthorized) listCatalogChangesetsRes() {}
+
+// ListCatalogFavoritesInternalServerError is response for ListCatalogFavorites operation.
+type ListCatalogFavoritesInternalServerError struct{}
+
+func (*ListCatalogFavoritesInternalServerError) listCatalogFavoritesRes() {}
+
+// ListCatalogFavoritesUnauthorized is response for ListCatalogFavorites operation.
+type ListCatalogFavoritesUnauthorized struct{}
+
+func (*ListCatalogFavoritesUnauthorized) listCatalogFavoritesRes() {}
+
+// ListCatalogReviewsByStatusForbidden is response for ListCatalogReviewsByStatus operation.
+type ListCatalogReviewsByStatusForbidden struct{}
+
+func (*ListCatalogReviewsByStatusForbidden) listCatalogReviewsByStatusRes() {}
+
+// ListCatalogReviewsByStatusInternalServerError is response for ListCatalogReviewsByStatus operation.
+type ListCatalogReviewsByStatusInternalServerError struct{}
+
+func (*ListCatalogReviewsByStatusInternalServerError) listCatalogReviewsByStatusRes() {}
+
+type ListCatalogReviewsByStatusOKApplicationJSON []CatalogReview
+
+func (*ListCatalogReviewsByStatusOKApplicationJSON) listCatalogReviewsByStatusRes() {}
+
+// ListCatalogReviewsByStatusUnauthorized is response for ListCatalogReviewsByStatus operation.
+type ListCatalogReviewsByStatusUnauthorized struct{}
+
+func (*ListCatalogReviewsByStatusUnauthorized) listCatalogReviewsByStatusRes() {}
+
+// ListCatalogReviewsInternalServerError is response for ListCatalogReviews operation.
+type ListCatalogReviewsInternalServerError struct{}
+
+func (*ListCatalogReviewsInternalServerError) listCatalogReviewsRes() {}
+
+// ListCatalogReviewsNotFound is response for ListCatalogReviews operation.
+type ListCatalogReviewsNotFound struct{}
+
+func (*ListCatalogReviewsNotFound) listCatalogReviewsRes() {}
+
+type ListCatalogReviewsOKApplicationJSON []CatalogReview
+
+func (*ListCatalogReviewsOKApplicationJSON) listCatalogReviewsRes() {}
+
+// ListCatalogReviewsUnauthorized is response for ListCatalogReviews operation.
+type ListCatalogReviewsUnauthorized struct{}
+
+func (*ListCatalogReviewsUnauthorized) listCatalogReviewsRes() {}
+
+// ListCatalogTagsInternalServerError is response for ListCatalogTags operation.
+type ListCatalogTagsInternalServerError struct{}
+
+func (*ListCatalogTagsInternalServerError) listCatalogTagsRes() {}
+
+type ListCatalogTagsOKApplicationJSON []CatalogTag
+
+func (*ListCatalogTagsOKApplicationJSON) listCatalogTagsRes() {}
+
+// ListCatalogTagsUnauthorized is response for ListCatalogTags operation.
+type ListCatalogTagsUnauthorized struct{}
+
+func (*ListCatalogTagsUnauthorized) listCatalogTagsRes() {}
+
+// ListCatalogTranslationsForbidden is response for ListCatalogTranslations operation.
+type ListCatalogTranslationsForbidden struct{}
+
+func (*ListCatalogTranslationsForbidden) listCatalogTranslationsRes() {}
+
+// ListCatalogTranslationsInternalServerError is response for ListCatalogTranslations operation.
+type ListCatalogTranslationsInternalServerError struct{}
+
+func (*ListCatalogTranslationsInternalServerError) listCatalogTranslationsRes() {}
+
+// ListCatalogTranslationsNotFound is response for ListCatalogTranslations operation.
+type ListCatalogTranslationsNotFound struct{}
+
+func (*ListCatalogTranslationsNotFound) listCatalogTranslationsRes() {}
+
+type ListCatalogTranslationsOKApplicationJSON []CatalogTranslation
+
+func (*ListCatalogTranslationsOKApplicationJSON) listCatalogTranslationsRes() {}
+
+// ListCatalogTranslationsUnauthorized is response for ListCatalogTranslations operation.
+type ListCatalogTranslationsUnauthorized struct{}
+
+func (*ListCatalogTranslationsUnauthorized) listCatalogTranslationsRes() {}
+
+// ListDataSchemasForbidden is response for ListDataSchemas operation.
+type ListDataSchemasForbidden struct{}
+
+func (*ListDataSchemasForbidden) listDataSchemasRes() {}
+
+// ListDataSchemasInternalServerError is response for ListDataSchemas operation.
+type ListDataSchemasInternalServerError struct{}
+
+func (*ListDataSchemasInternalServerError) listDataSchemasRes() {}
+
+type ListDataSchemasOKApplicationJSON []DataSchema
+
+func (*ListDataSchemasOKApplicationJSON) listDataSchemasRes() {}
+
+// ListDataSchemasUnauthorized is response for ListDataSchemas operation.
+type ListDataSchemasUnauthorized struct{}
+
+func (*ListDataSchemasUnauthorized) listDataSchemasRes() {}
+
+// ListDeletedCatalogItemsForbidden is response for ListDeletedCatalogItems operation.
+type ListDeletedCatalogItemsForbidden struct{}
+
+func (*ListDeletedCatalogItemsForbidden) listDeletedCatalogItemsRes() {}
+
+// ListDeletedCatalogItemsInternalServerError is response for ListDeletedCatalogItems operation.
+type ListDeletedCatalogItemsInternalServerError struct{}
+
+func (*ListDeletedCatalogItemsInternalServerError) listDeletedCatalogItemsRes() {}
+
+type ListDeletedCatalogItemsOKApplicationJSON []CatalogItem
+
+func (*ListDeletedCatalogItemsOKApplicationJSON) listDeletedCatalogItemsRes() {}
+
+// ListDeletedCatalogItemsUnauthorized is response for ListDeletedCatalogItems operation.
+type ListDeletedCatalogItemsUnauthorized struct{}
+
+func (*ListDeletedCatalogItemsUnauthorized) listDeletedCatalogItemsRes() {}
+
+// ListDeletedDataForbidden is response for ListDeletedData operation.
+type ListDeletedDataForbidden struct{}
+
+func (*ListDeletedDataForbidden) listDeletedDataRes() {}
+
+// ListDeletedDataInternalServerError is response for ListDeletedData operation.
+type ListDeletedDataInternalServerError struct{}
+
+func (*ListDeletedDataInternalServerError) listDeletedDataRes() {}
+
+type ListDeletedDataOKApplicationJSON []DataEntry
+
+func (*ListDeletedDataOKApplicationJSON) listDeletedDataRes() {}
+
+// ListDeletedDataUnauthorized is response for ListDeletedData operation.
+type ListDeletedDataUnauthorized struct{}
+
+func (*ListDeletedDataUnauthorized) listDeletedDataRes() {}
+
+// ListRecentlyViewedCatalogItemsInternalServerError is response for ListRecentlyViewedCatalogItems operation.
+type ListRecentlyViewedCatalogItemsInternalServerError struct{}
+
+func (*ListRecentlyViewedCatalogItemsInternalServerError) listRecentlyViewedCatalogItemsRes() {}
+
+type ListRecentlyViewedCatalogItemsOKApplicationJSON []CatalogItem
+
+func (*ListRecentlyViewedCatalogItemsOKApplicationJSON) listRecentlyViewedCatalogItemsRes() {}
+
+// ListRecentlyViewedCatalogItemsUnauthorized is response for ListRecentlyViewedCatalogItems operation.
+type ListRecentlyViewedCatalogItemsUnauthorized struct{}
+
+func (*ListRecentlyViewedCatalogItemsUnauthorized) listRecentlyViewedCatalogItemsRes() {}
+
+// ListWebhookDeliveriesForbidden is response for ListWebhookDeliveries operation.
+type ListWebhookDeliveriesForbidden struct{}
+
+func (*ListWebhookDeliveriesForbidden) listWebhookDeliveriesRes() {}
+
+// ListWebhookDeliveriesInternalServerError is response for ListWebhookDeliveries operation.
+type ListWebhookDeliveriesInternalServerError struct{}
+
+func (*ListWebhookDeliveriesInternalServerError) listWebhookDeliveriesRes() {}
+
+// ListWebhookDeliveriesNotFound is response for ListWebhookDeliveries operation.
+type ListWebhookDeliveriesNotFound struct{}
+
+func (*ListWebhookDeliveriesNotFound) listWebhookDeliveriesRes() {}
+
+type ListWebhookDeliveriesOKApplicationJSON []WebhookDelivery
+
+func (*ListWebhookDeliveriesOKApplicationJSON) listWebhookDeliveriesRes() {}
+
+// ListWebhookDeliveriesUnauthorized is response for ListWebhookDeliveries operation.
+type ListWebhookDeliveriesUnauthorized struct{}
+
+func (*ListWebhookDeliveriesUnauthorized) listWebhookDeliveriesRes() {}
+
+// ListWebhookEndpointsForbidden is response for ListWebhookEndpoints operation.
+type ListWebhookEndpointsForbidden struct{}
+
+func (*ListWebhookEndpointsForbidden) listWebhookEndpointsRes() {}
+
+// ListWebhookEndpointsInternalServerError is response for ListWebhookEndpoints operation.
+type ListWebhookEndpointsInternalServerError struct{}
+
+func (*ListWebhookEndpointsInternalServerError) listWebhookEndpointsRes() {}
+
+type ListWebhookEndpointsOKApplicationJSON []WebhookEndpoint
+
+func (*ListWebhookEndpointsOKApplicationJSON) listWebhookEndpointsRes() {}
+
+// ListWebhookEndpointsUnauthorized is response for ListWebhookEndpoints operation.
+type ListWebhookEndpointsUnauthorized struct{}
+
+func (*ListWebhookEndpointsUnauthorized) listWebhookEndpointsRes() {}
+
+// LoginInternalServerError is response for Login operation.
+type LoginInternalServerError struct{}
+
+func (*LoginInternalServerError) loginRes() {}
+
+// Ref: #/components/schemas/LoginRequest
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// GetEmail returns the value of Email.
+func (s *LoginRequest) GetEmail() string {
+	return s.Email
+}
+
+// GetPassword returns the value of Password.
+func (s *LoginRequest) GetPassword() string {
+	return s.Password
+}
+
+// SetEmail sets the value of Email.
+func (s *LoginRequest) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetPassword sets the value of Password.
+func (s *LoginRequest) SetPassword(val string) {
+	s.Password = val
+}
+
+// LoginUnauthorized is response for Login operation.
+type LoginUnauthorized struct{}
+
+func (*LoginUnauthorized) loginRes() {}
+
+// LogoutInternalServerError is response for Logout operation.
+type LogoutInternalServerError struct{}
+
+func (*LogoutInternalServerError) logoutRes() {}
+
+// LogoutOK is response for Logout operation.
+type LogoutOK struct{}
+
+func (*LogoutOK) logoutRes() {}
+
+// Ref: #/components/schemas/Money
+type Money struct {
+	// Decimal amount with at most as many fraction digits as the currency has, e.g. "19.99".
+	Amount string `json:"amount"`
+	// ISO 4217 currency code.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *Money) GetAmount() string {
+	return s.Amount
+}
+
+// GetCurrency returns the value of Currency.
+func (s *Money) GetCurrency() string {
+	return s.Currency
+}
+
+// SetAmount sets the value of Amount.
+func (s *Money) SetAmount(val string) {
+	s.Amount = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *Money) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCatalogDraftRequestAction returns new OptCatalogDraftRequestAction with value set to v.
+func NewOptCatalogDraftRequestAction(v CatalogDraftRequestAction) OptCatalogDraftRequestAction {
+	return OptCatalogDraftRequestAction{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCatalogDraftRequestAction is optional CatalogDraftRequestAction.
+type OptCatalogDraftRequestAction struct {
+	Value CatalogDraftRequestAction
+	Set   bool
+}
+
+// IsSet returns true if OptCatalogDraftRequestAction was set.
+func (o OptCatalogDraftRequestAction) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCatalogDraftRequestAction) Reset() {
+	var v CatalogDraftRequestAction
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCatalogDraftRequestAction) SetTo(v CatalogDraftRequestAction) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCatalogDraftRequestAction) Get() (v CatalogDraftRequestAction, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCatalogDraftRequestAction) Or(d CatalogDraftRequestAction) CatalogDraftRequestAction {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCatalogItemAvailability returns new OptCatalogItemAvailability with value set to v.
+func NewOptCatalogItemAvailability(v CatalogItemAvailability) OptCatalogItemAvailability {
+	return OptCatalogItemAvailability{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCatalogItemAvailability is optional CatalogItemAvailability.
+type OptCatalogItemAvailability struct {
+	Value CatalogItemAvailability
+	Set   bool
+}
+
+// IsSet returns true if OptCatalogItemAvailability was set.
+func (o OptCatalogItemAvailability) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCatalogItemAvailability) Reset() {
+	var v CatalogItemAvailability
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCatalogItemAvailability) SetTo(v CatalogItemAvailability) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCatalogItemAvailability) Get() (v CatalogItemAvailability, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCatalogItemAvailability) Or(d CatalogItemAvailability) CatalogItemAvailability {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCatalogItemRequest returns new OptCatalogItemRequest with value set to v.
+func NewOptCatalogItemRequest(v CatalogItemRequest) OptCatalogItemRequest {
+	return OptCatalogItemRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCatalogItemRequest is optional CatalogItemRequest.
+type OptCatalogItemRequest struct {
+	Value CatalogItemRequest
+	Set   bool
+}
+
+// IsSet returns true if OptCatalogItemRequest was set.
+func (o OptCatalogItemRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCatalogItemRequest) Reset() {
+	var v CatalogItemRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCatalogItemRequest) SetTo(v CatalogItemRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCatalogItemRequest) Get() (v CatalogItemRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCatalogItemRequest) Or(d CatalogItemRequest) CatalogItemRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCatalogReviewStatus returns new OptCatalogReviewStatus with value set to v.
+func NewOptCatalogReviewStatus(v CatalogReviewStatus) OptCatalogReviewStatus {
+	return OptCatalogReviewStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCatalogReviewStatus is optional CatalogReviewStatus.
+type OptCatalogReviewStatus struct {
+	Value CatalogReviewStatus
+	Set   bool
+}
+
+// IsSet returns true if OptCatalogReviewStatus was set.
+func (o OptCatalogReviewStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCatalogReviewStatus) Reset() {
+	var v CatalogReviewStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCatalogReviewStatus) SetTo(v CatalogReviewStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCatalogReviewStatus) Get() (v CatalogReviewStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCatalogReviewStatus) Or(d CatalogReviewStatus) CatalogReviewStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptExportDataFormat returns new OptExportDataFormat with value set to v.
+func NewOptExportDataFormat(v ExportDataFormat) OptExportDataFormat {
+	return OptExportDataFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptExportDataFormat is optional ExportDataFormat.
+type OptExportDataFormat struct {
+	Value ExportDataFormat
+	Set   bool
+}
+
+// IsSet returns true if OptExportDataFormat was set.
+func (o OptExportDataFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptExportDataFormat) Reset() {
+	var v ExportDataFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptExportDataFormat) SetTo(v ExportDataFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptExportDataFormat) Get() (v ExportDataFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptExportDataFormat) Or(d ExportDataFormat) ExportDataFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetCatalogV2Sort returns new OptGetCatalogV2Sort with value set to v.
+func NewOptGetCatalogV2Sort(v GetCatalogV2Sort) OptGetCatalogV2Sort {
+	return OptGetCatalogV2Sort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetCatalogV2Sort is optional GetCatalogV2Sort.
+type OptGetCatalogV2Sort struct {
+	Value GetCatalogV2Sort
+	Set   bool
+}
+
+// IsSet returns true if OptGetCatalogV2Sort was set.
+func (o OptGetCatalogV2Sort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetCatalogV2Sort) Reset() {
+	var v GetCatalogV2Sort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetCatalogV2Sort) SetTo(v GetCatalogV2Sort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetCatalogV2Sort) Get() (v GetCatalogV2Sort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetCatalogV2Sort) Or(d GetCatalogV2Sort) GetCatalogV2Sort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptImportDataOnConflict returns new OptImportDataOnConflict with value set to v.
+func NewOptImportDataOnConflict(v ImportDataOnConflict) OptImportDataOnConflict {
+	return OptImportDataOnConflict{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptImportDataOnConflict is optional ImportDataOnConflict.
+type OptImportDataOnConflict struct {
+	Value ImportDataOnConflict
+	Set   bool
+}
+
+// IsSet returns true if OptImportDataOnConflict was set.
+func (o OptImportDataOnConflict) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptImportDataOnConflict) Reset() {
+	var v ImportDataOnConflict
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptImportDataOnConflict) SetTo(v ImportDataOnConflict) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptImportDataOnConflict) Get() (v ImportDataOnConflict, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptImportDataOnConflict) Or(d ImportDataOnConflict) ImportDataOnConflict {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMoney returns new OptMoney with value set to v.
+func NewOptMoney(v Money) OptMoney {
+	return OptMoney{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMoney is optional Money.
+type OptMoney struct {
+	Value Money
+	Set   bool
+}
+
+// IsSet returns true if OptMoney was set.
+func (o OptMoney) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMoney) Reset() {
+	var v Money
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMoney) SetTo(v Money) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMoney) Get() (v Money, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMoney) Or(d Money) Money {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
@@ -315,71 +4147,592 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
-// NewOptUUID returns new OptUUID with value set to v.
-func NewOptUUID(v uuid.UUID) OptUUID {
-	return OptUUID{
-		Value: v,
-		Set:   true,
-	}
-}
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptWebhookDeliveryStatus returns new OptWebhookDeliveryStatus with value set to v.
+func NewOptWebhookDeliveryStatus(v WebhookDeliveryStatus) OptWebhookDeliveryStatus {
+	return OptWebhookDeliveryStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptWebhookDeliveryStatus is optional WebhookDeliveryStatus.
+type OptWebhookDeliveryStatus struct {
+	Value WebhookDeliveryStatus
+	Set   bool
+}
+
+// IsSet returns true if OptWebhookDeliveryStatus was set.
+func (o OptWebhookDeliveryStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptWebhookDeliveryStatus) Reset() {
+	var v WebhookDeliveryStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptWebhookDeliveryStatus) SetTo(v WebhookDeliveryStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptWebhookDeliveryStatus) Get() (v WebhookDeliveryStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptWebhookDeliveryStatus) Or(d WebhookDeliveryStatus) WebhookDeliveryStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// PostDataCreated is response for PostData operation.
+type PostDataCreated struct{}
+
+func (*PostDataCreated) postDataRes() {}
+
+// PostDataInternalServerError is response for PostData operation.
+type PostDataInternalServerError struct{}
+
+func (*PostDataInternalServerError) postDataRes() {}
+
+type PostDataRequestEntityTooLarge Error
+
+func (*PostDataRequestEntityTooLarge) postDataRes() {}
+
+type PostDataTooManyRequests Error
+
+func (*PostDataTooManyRequests) postDataRes() {}
+
+// PostDataUnauthorized is response for PostData operation.
+type PostDataUnauthorized struct{}
+
+func (*PostDataUnauthorized) postDataRes() {}
+
+type PostDataUnprocessableEntity Error
+
+func (*PostDataUnprocessableEntity) postDataRes() {}
+
+// PreviewCatalogChangesetConflict is response for PreviewCatalogChangeset operation.
+type PreviewCatalogChangesetConflict struct{}
+
+func (*PreviewCatalogChangesetConflict) previewCatalogChangesetRes() {}
+
+// PreviewCatalogChangesetForbidden is response for PreviewCatalogChangeset operation.
+type PreviewCatalogChangesetForbidden struct{}
+
+func (*PreviewCatalogChangesetForbidden) previewCatalogChangesetRes() {}
+
+// PreviewCatalogChangesetInternalServerError is response for PreviewCatalogChangeset operation.
+type PreviewCatalogChangesetInternalServerError struct{}
+
+func (*PreviewCatalogChangesetInternalServerError) previewCatalogChangesetRes() {}
+
+// PreviewCatalogChangesetNotFound is response for PreviewCatalogChangeset operation.
+type PreviewCatalogChangesetNotFound struct{}
+
+func (*PreviewCatalogChangesetNotFound) previewCatalogChangesetRes() {}
+
+type PreviewCatalogChangesetOKApplicationJSON []CatalogItem
+
+func (*PreviewCatalogChangesetOKApplicationJSON) previewCatalogChangesetRes() {}
+
+// PreviewCatalogChangesetUnauthorized is response for PreviewCatalogChangeset operation.
+type PreviewCatalogChangesetUnauthorized struct{}
+
+func (*PreviewCatalogChangesetUnauthorized) previewCatalogChangesetRes() {}
+
+// PublishCatalogChangesetForbidden is response for PublishCatalogChangeset operation.
+type PublishCatalogChangesetForbidden struct{}
+
+func (*PublishCatalogChangesetForbidden) publishCatalogChangesetRes() {}
+
+// PublishCatalogChangesetInternalServerError is response for PublishCatalogChangeset operation.
+type PublishCatalogChangesetInternalServerError struct{}
+
+func (*PublishCatalogChangesetInternalServerError) publishCatalogChangesetRes() {}
+
+// PublishCatalogChangesetNotFound is response for PublishCatalogChangeset operation.
+type PublishCatalogChangesetNotFound struct{}
+
+func (*PublishCatalogChangesetNotFound) publishCatalogChangesetRes() {}
+
+// PublishCatalogChangesetUnauthorized is response for PublishCatalogChangeset operation.
+type PublishCatalogChangesetUnauthorized struct{}
+
+func (*PublishCatalogChangesetUnauthorized) publishCatalogChangesetRes() {}
+
+// PutCatalogDraftConflict is response for PutCatalogDraft operation.
+type PutCatalogDraftConflict struct{}
+
+func (*PutCatalogDraftConflict) putCatalogDraftRes() {}
+
+// PutCatalogDraftForbidden is response for PutCatalogDraft operation.
+type PutCatalogDraftForbidden struct{}
+
+func (*PutCatalogDraftForbidden) putCatalogDraftRes() {}
+
+// PutCatalogDraftInternalServerError is response for PutCatalogDraft operation.
+type PutCatalogDraftInternalServerError struct{}
+
+func (*PutCatalogDraftInternalServerError) putCatalogDraftRes() {}
+
+// PutCatalogDraftNotFound is response for PutCatalogDraft operation.
+type PutCatalogDraftNotFound struct{}
+
+func (*PutCatalogDraftNotFound) putCatalogDraftRes() {}
+
+// PutCatalogDraftUnauthorized is response for PutCatalogDraft operation.
+type PutCatalogDraftUnauthorized struct{}
+
+func (*PutCatalogDraftUnauthorized) putCatalogDraftRes() {}
+
+// PutCatalogTranslationForbidden is response for PutCatalogTranslation operation.
+type PutCatalogTranslationForbidden struct{}
+
+func (*PutCatalogTranslationForbidden) putCatalogTranslationRes() {}
+
+// PutCatalogTranslationInternalServerError is response for PutCatalogTranslation operation.
+type PutCatalogTranslationInternalServerError struct{}
+
+func (*PutCatalogTranslationInternalServerError) putCatalogTranslationRes() {}
+
+// PutCatalogTranslationNotFound is response for PutCatalogTranslation operation.
+type PutCatalogTranslationNotFound struct{}
+
+func (*PutCatalogTranslationNotFound) putCatalogTranslationRes() {}
+
+// PutCatalogTranslationUnauthorized is response for PutCatalogTranslation operation.
+type PutCatalogTranslationUnauthorized struct{}
+
+func (*PutCatalogTranslationUnauthorized) putCatalogTranslationRes() {}
+
+// PutDataSchemaForbidden is response for PutDataSchema operation.
+type PutDataSchemaForbidden struct{}
+
+func (*PutDataSchemaForbidden) putDataSchemaRes() {}
+
+// PutDataSchemaInternalServerError is response for PutDataSchema operation.
+type PutDataSchemaInternalServerError struct{}
+
+func (*PutDataSchemaInternalServerError) putDataSchemaRes() {}
+
+// PutDataSchemaUnauthorized is response for PutDataSchema operation.
+type PutDataSchemaUnauthorized struct{}
+
+func (*PutDataSchemaUnauthorized) putDataSchemaRes() {}
+
+// RecordCatalogViewInternalServerError is response for RecordCatalogView operation.
+type RecordCatalogViewInternalServerError struct{}
+
+func (*RecordCatalogViewInternalServerError) recordCatalogViewRes() {}
+
+// RecordCatalogViewNoContent is response for RecordCatalogView operation.
+type RecordCatalogViewNoContent struct{}
+
+func (*RecordCatalogViewNoContent) recordCatalogViewRes() {}
+
+// RecordCatalogViewNotFound is response for RecordCatalogView operation.
+type RecordCatalogViewNotFound struct{}
+
+func (*RecordCatalogViewNotFound) recordCatalogViewRes() {}
+
+// RecordCatalogViewUnauthorized is response for RecordCatalogView operation.
+type RecordCatalogViewUnauthorized struct{}
+
+func (*RecordCatalogViewUnauthorized) recordCatalogViewRes() {}
+
+// RedeliverWebhookForbidden is response for RedeliverWebhook operation.
+type RedeliverWebhookForbidden struct{}
+
+func (*RedeliverWebhookForbidden) redeliverWebhookRes() {}
+
+// RedeliverWebhookInternalServerError is response for RedeliverWebhook operation.
+type RedeliverWebhookInternalServerError struct{}
+
+func (*RedeliverWebhookInternalServerError) redeliverWebhookRes() {}
+
+// RedeliverWebhookNotFound is response for RedeliverWebhook operation.
+type RedeliverWebhookNotFound struct{}
+
+func (*RedeliverWebhookNotFound) redeliverWebhookRes() {}
+
+// RedeliverWebhookUnauthorized is response for RedeliverWebhook operation.
+type RedeliverWebhookUnauthorized struct{}
+
+func (*RedeliverWebhookUnauthorized) redeliverWebhookRes() {}
+
+// ReleaseCatalogReservationInternalServerError is response for ReleaseCatalogReservation operation.
+type ReleaseCatalogReservationInternalServerError struct{}
+
+func (*ReleaseCatalogReservationInternalServerError) releaseCatalogReservationRes() {}
+
+// ReleaseCatalogReservationNoContent is response for ReleaseCatalogReservation operation.
+type ReleaseCatalogReservationNoContent struct{}
+
+func (*ReleaseCatalogReservationNoContent) releaseCatalogReservationRes() {}
+
+// ReleaseCatalogReservationUnauthorized is response for ReleaseCatalogReservation operation.
+type ReleaseCatalogReservationUnauthorized struct{}
+
+func (*ReleaseCatalogReservationUnauthorized) releaseCatalogReservationRes() {}
+
+// RemoveCatalogFavoriteInternalServerError is response for RemoveCatalogFavorite operation.
+type RemoveCatalogFavoriteInternalServerError struct{}
+
+func (*RemoveCatalogFavoriteInternalServerError) removeCatalogFavoriteRes() {}
+
+// RemoveCatalogFavoriteNoContent is response for RemoveCatalogFavorite operation.
+type RemoveCatalogFavoriteNoContent struct{}
+
+func (*RemoveCatalogFavoriteNoContent) removeCatalogFavoriteRes() {}
+
+// RemoveCatalogFavoriteNotFound is response for RemoveCatalogFavorite operation.
+type RemoveCatalogFavoriteNotFound struct{}
+
+func (*RemoveCatalogFavoriteNotFound) removeCatalogFavoriteRes() {}
+
+// RemoveCatalogFavoriteUnauthorized is response for RemoveCatalogFavorite operation.
+type RemoveCatalogFavoriteUnauthorized struct{}
+
+func (*RemoveCatalogFavoriteUnauthorized) removeCatalogFavoriteRes() {}
+
+// RenameCatalogTagConflict is response for RenameCatalogTag operation.
+type RenameCatalogTagConflict struct{}
+
+func (*RenameCatalogTagConflict) renameCatalogTagRes() {}
+
+// RenameCatalogTagForbidden is response for RenameCatalogTag operation.
+type RenameCatalogTagForbidden struct{}
+
+func (*RenameCatalogTagForbidden) renameCatalogTagRes() {}
+
+// RenameCatalogTagInternalServerError is response for RenameCatalogTag operation.
+type RenameCatalogTagInternalServerError struct{}
+
+func (*RenameCatalogTagInternalServerError) renameCatalogTagRes() {}
+
+// RenameCatalogTagNotFound is response for RenameCatalogTag operation.
+type RenameCatalogTagNotFound struct{}
+
+func (*RenameCatalogTagNotFound) renameCatalogTagRes() {}
+
+// RenameCatalogTagUnauthorized is response for RenameCatalogTag operation.
+type RenameCatalogTagUnauthorized struct{}
+
+func (*RenameCatalogTagUnauthorized) renameCatalogTagRes() {}
+
+type ReserveCatalogItemConflict Error
+
+func (*ReserveCatalogItemConflict) reserveCatalogItemRes() {}
+
+// ReserveCatalogItemInternalServerError is response for ReserveCatalogItem operation.
+type ReserveCatalogItemInternalServerError struct{}
+
+func (*ReserveCatalogItemInternalServerError) reserveCatalogItemRes() {}
+
+// ReserveCatalogItemNotFound is response for ReserveCatalogItem operation.
+type ReserveCatalogItemNotFound struct{}
+
+func (*ReserveCatalogItemNotFound) reserveCatalogItemRes() {}
+
+// ReserveCatalogItemUnauthorized is response for ReserveCatalogItem operation.
+type ReserveCatalogItemUnauthorized struct{}
+
+func (*ReserveCatalogItemUnauthorized) reserveCatalogItemRes() {}
+
+type ReserveCatalogItemUnprocessableEntity Error
+
+func (*ReserveCatalogItemUnprocessableEntity) reserveCatalogItemRes() {}
+
+// RestoreCatalogItemForbidden is response for RestoreCatalogItem operation.
+type RestoreCatalogItemForbidden struct{}
+
+func (*RestoreCatalogItemForbidden) restoreCatalogItemRes() {}
+
+// RestoreCatalogItemInternalServerError is response for RestoreCatalogItem operation.
+type RestoreCatalogItemInternalServerError struct{}
+
+func (*RestoreCatalogItemInternalServerError) restoreCatalogItemRes() {}
+
+// RestoreCatalogItemNotFound is response for RestoreCatalogItem operation.
+type RestoreCatalogItemNotFound struct{}
+
+func (*RestoreCatalogItemNotFound) restoreCatalogItemRes() {}
+
+// RestoreCatalogItemUnauthorized is response for RestoreCatalogItem operation.
+type RestoreCatalogItemUnauthorized struct{}
+
+func (*RestoreCatalogItemUnauthorized) restoreCatalogItemRes() {}
+
+// RestoreDataForbidden is response for RestoreData operation.
+type RestoreDataForbidden struct{}
+
+func (*RestoreDataForbidden) restoreDataRes() {}
+
+// RestoreDataInternalServerError is response for RestoreData operation.
+type RestoreDataInternalServerError struct{}
+
+func (*RestoreDataInternalServerError) restoreDataRes() {}
+
+// RestoreDataNotFound is response for RestoreData operation.
+type RestoreDataNotFound struct{}
+
+func (*RestoreDataNotFound) restoreDataRes() {}
+
+// RestoreDataUnauthorized is response for RestoreData operation.
+type RestoreDataUnauthorized struct{}
+
+func (*RestoreDataUnauthorized) restoreDataRes() {}
+
+// RollbackCatalogChangesetForbidden is response for RollbackCatalogChangeset operation.
+type RollbackCatalogChangesetForbidden struct{}
+
+func (*RollbackCatalogChangesetForbidden) rollbackCatalogChangesetRes() {}
+
+// RollbackCatalogChangesetInternalServerError is response for RollbackCatalogChangeset operation.
+type RollbackCatalogChangesetInternalServerError struct{}
+
+func (*RollbackCatalogChangesetInternalServerError) rollbackCatalogChangesetRes() {}
+
+// RollbackCatalogChangesetNotFound is response for RollbackCatalogChangeset operation.
+type RollbackCatalogChangesetNotFound struct{}
+
+func (*RollbackCatalogChangesetNotFound) rollbackCatalogChangesetRes() {}
+
+// RollbackCatalogChangesetUnauthorized is response for RollbackCatalogChangeset operation.
+type RollbackCatalogChangesetUnauthorized struct{}
+
+func (*RollbackCatalogChangesetUnauthorized) rollbackCatalogChangesetRes() {}
+
+type SaveCatalogReviewConflict Error
+
+func (*SaveCatalogReviewConflict) saveCatalogReviewRes() {}
+
+// SaveCatalogReviewInternalServerError is response for SaveCatalogReview operation.
+type SaveCatalogReviewInternalServerError struct{}
+
+func (*SaveCatalogReviewInternalServerError) saveCatalogReviewRes() {}
+
+// SaveCatalogReviewNotFound is response for SaveCatalogReview operation.
+type SaveCatalogReviewNotFound struct{}
+
+func (*SaveCatalogReviewNotFound) saveCatalogReviewRes() {}
+
+// SaveCatalogReviewUnauthorized is response for SaveCatalogReview operation.
+type SaveCatalogReviewUnauthorized struct{}
 
-// OptUUID is optional uuid.UUID.
-type OptUUID struct {
-	Value uuid.UUID
-	Set   bool
-}
+func (*SaveCatalogReviewUnauthorized) saveCatalogReviewRes() {}
 
-// IsSet returns true if OptUUID was set.
-func (o OptUUID) IsSet() bool { return o.Set }
+type SaveCatalogReviewUnprocessableEntity Error
 
-// Reset unsets value.
-func (o *OptUUID) Reset() {
-	var v uuid.UUID
-	o.Value = v
-	o.Set = false
-}
+func (*SaveCatalogReviewUnprocessableEntity) saveCatalogReviewRes() {}
 
-// SetTo sets value to v.
-func (o *OptUUID) SetTo(v uuid.UUID) {
-	o.Set = true
-	o.Value = v
-}
+// SearchCatalogInternalServerError is response for SearchCatalog operation.
+type SearchCatalogInternalServerError struct{}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUUID) Get() (v uuid.UUID, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
-}
+func (*SearchCatalogInternalServerError) searchCatalogRes() {}
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
-}
+// SearchCatalogUnauthorized is response for SearchCatalog operation.
+type SearchCatalogUnauthorized struct{}
 
-// PostDataCreated is response for PostData operation.
-type PostDataCreated struct{}
+func (*SearchCatalogUnauthorized) searchCatalogRes() {}
 
-func (*PostDataCreated) postDataRes() {}
+// SetCatalogInventoryForbidden is response for SetCatalogInventory operation.
+type SetCatalogInventoryForbidden struct{}
 
-// PostDataInternalServerError is response for PostData operation.
-type PostDataInternalServerError struct{}
+func (*SetCatalogInventoryForbidden) setCatalogInventoryRes() {}
 
-func (*PostDataInternalServerError) postDataRes() {}
+// SetCatalogInventoryInternalServerError is response for SetCatalogInventory operation.
+type SetCatalogInventoryInternalServerError struct{}
 
-// PostDataUnauthorized is response for PostData operation.
-type PostDataUnauthorized struct{}
+func (*SetCatalogInventoryInternalServerError) setCatalogInventoryRes() {}
 
-func (*PostDataUnauthorized) postDataRes() {}
+// SetCatalogInventoryNotFound is response for SetCatalogInventory operation.
+type SetCatalogInventoryNotFound struct{}
+
+func (*SetCatalogInventoryNotFound) setCatalogInventoryRes() {}
+
+// SetCatalogInventoryUnauthorized is response for SetCatalogInventory operation.
+type SetCatalogInventoryUnauthorized struct{}
+
+func (*SetCatalogInventoryUnauthorized) setCatalogInventoryRes() {}
+
+// SetCatalogItemDisabledForbidden is response for SetCatalogItemDisabled operation.
+type SetCatalogItemDisabledForbidden struct{}
+
+func (*SetCatalogItemDisabledForbidden) setCatalogItemDisabledRes() {}
+
+// SetCatalogItemDisabledInternalServerError is response for SetCatalogItemDisabled operation.
+type SetCatalogItemDisabledInternalServerError struct{}
+
+func (*SetCatalogItemDisabledInternalServerError) setCatalogItemDisabledRes() {}
+
+// SetCatalogItemDisabledNotFound is response for SetCatalogItemDisabled operation.
+type SetCatalogItemDisabledNotFound struct{}
+
+func (*SetCatalogItemDisabledNotFound) setCatalogItemDisabledRes() {}
+
+// SetCatalogItemDisabledUnauthorized is response for SetCatalogItemDisabled operation.
+type SetCatalogItemDisabledUnauthorized struct{}
+
+func (*SetCatalogItemDisabledUnauthorized) setCatalogItemDisabledRes() {}
+
+// SetCatalogReviewStatusForbidden is response for SetCatalogReviewStatus operation.
+type SetCatalogReviewStatusForbidden struct{}
+
+func (*SetCatalogReviewStatusForbidden) setCatalogReviewStatusRes() {}
+
+// SetCatalogReviewStatusInternalServerError is response for SetCatalogReviewStatus operation.
+type SetCatalogReviewStatusInternalServerError struct{}
+
+func (*SetCatalogReviewStatusInternalServerError) setCatalogReviewStatusRes() {}
+
+// SetCatalogReviewStatusNotFound is response for SetCatalogReviewStatus operation.
+type SetCatalogReviewStatusNotFound struct{}
+
+func (*SetCatalogReviewStatusNotFound) setCatalogReviewStatusRes() {}
+
+// SetCatalogReviewStatusUnauthorized is response for SetCatalogReviewStatus operation.
+type SetCatalogReviewStatusUnauthorized struct{}
+
+func (*SetCatalogReviewStatusUnauthorized) setCatalogReviewStatusRes() {}
+
+// SetMyLocaleInternalServerError is response for SetMyLocale operation.
+type SetMyLocaleInternalServerError struct{}
+
+func (*SetMyLocaleInternalServerError) setMyLocaleRes() {}
+
+// SetMyLocaleUnauthorized is response for SetMyLocale operation.
+type SetMyLocaleUnauthorized struct{}
+
+func (*SetMyLocaleUnauthorized) setMyLocaleRes() {}
+
+// UpdateCatalogCategoryConflict is response for UpdateCatalogCategory operation.
+type UpdateCatalogCategoryConflict struct{}
+
+func (*UpdateCatalogCategoryConflict) updateCatalogCategoryRes() {}
+
+// UpdateCatalogCategoryForbidden is response for UpdateCatalogCategory operation.
+type UpdateCatalogCategoryForbidden struct{}
+
+func (*UpdateCatalogCategoryForbidden) updateCatalogCategoryRes() {}
+
+// UpdateCatalogCategoryInternalServerError is response for UpdateCatalogCategory operation.
+type UpdateCatalogCategoryInternalServerError struct{}
+
+func (*UpdateCatalogCategoryInternalServerError) updateCatalogCategoryRes() {}
+
+// UpdateCatalogCategoryNotFound is response for UpdateCatalogCategory operation.
+type UpdateCatalogCategoryNotFound struct{}
+
+func (*UpdateCatalogCategoryNotFound) updateCatalogCategoryRes() {}
+
+// UpdateCatalogCategoryUnauthorized is response for UpdateCatalogCategory operation.
+type UpdateCatalogCategoryUnauthorized struct{}
+
+func (*UpdateCatalogCategoryUnauthorized) updateCatalogCategoryRes() {}
+
+// UpdateCatalogItemForbidden is response for UpdateCatalogItem operation.
+type UpdateCatalogItemForbidden struct{}
+
+func (*UpdateCatalogItemForbidden) updateCatalogItemRes() {}
+
+// UpdateCatalogItemInternalServerError is response for UpdateCatalogItem operation.
+type UpdateCatalogItemInternalServerError struct{}
+
+func (*UpdateCatalogItemInternalServerError) updateCatalogItemRes() {}
+
+// UpdateCatalogItemNotFound is response for UpdateCatalogItem operation.
+type UpdateCatalogItemNotFound struct{}
+
+func (*UpdateCatalogItemNotFound) updateCatalogItemRes() {}
+
+// UpdateCatalogItemUnauthorized is response for UpdateCatalogItem operation.
+type UpdateCatalogItemUnauthorized struct{}
+
+func (*UpdateCatalogItemUnauthorized) updateCatalogItemRes() {}
+
+// UpdateWebhookEndpointForbidden is response for UpdateWebhookEndpoint operation.
+type UpdateWebhookEndpointForbidden struct{}
+
+func (*UpdateWebhookEndpointForbidden) updateWebhookEndpointRes() {}
+
+// UpdateWebhookEndpointInternalServerError is response for UpdateWebhookEndpoint operation.
+type UpdateWebhookEndpointInternalServerError struct{}
+
+func (*UpdateWebhookEndpointInternalServerError) updateWebhookEndpointRes() {}
+
+// UpdateWebhookEndpointNotFound is response for UpdateWebhookEndpoint operation.
+type UpdateWebhookEndpointNotFound struct{}
+
+func (*UpdateWebhookEndpointNotFound) updateWebhookEndpointRes() {}
+
+// UpdateWebhookEndpointUnauthorized is response for UpdateWebhookEndpoint operation.
+type UpdateWebhookEndpointUnauthorized struct{}
+
+func (*UpdateWebhookEndpointUnauthorized) updateWebhookEndpointRes() {}
 
 // Ref: #/components/schemas/User
 type User struct {
-	ID        OptUUID     `json:"id"`
-	Email     OptString   `json:"email"`
+	ID    OptUUID   `json:"id"`
+	Email OptString `json:"email"`
+	Role  OptString `json:"role"`
+	// Preferred locale for localized content; omitted if unset.
+	Locale    OptString   `json:"locale"`
 	CreatedAt OptDateTime `json:"created_at"`
 }
 
@@ -393,6 +4746,16 @@ func (s *User) GetEmail() OptString {
 	return s.Email
 }
 
+// GetRole returns the value of Role.
+func (s *User) GetRole() OptString {
+	return s.Role
+}
+
+// GetLocale returns the value of Locale.
+func (s *User) GetLocale() OptString {
+	return s.Locale
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *User) GetCreatedAt() OptDateTime {
 	return s.CreatedAt
@@ -408,6 +4771,16 @@ func (s *User) SetEmail(val OptString) {
 	s.Email = val
 }
 
+// SetRole sets the value of Role.
+func (s *User) SetRole(val OptString) {
+	s.Role = val
+}
+
+// SetLocale sets the value of Locale.
+func (s *User) SetLocale(val OptString) {
+	s.Locale = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *User) SetCreatedAt(val OptDateTime) {
 	s.CreatedAt = val
@@ -415,3 +4788,440 @@ func (s *User) SetCreatedAt(val OptDateTime) {
 
 func (*User) getMeRes() {}
 func (*User) loginRes() {}
+
+// Ref: #/components/schemas/UserLocaleRequest
+type UserLocaleRequest struct {
+	// BCP 47 language tag, e.g. "de" or "pt-BR"; empty to clear.
+	Locale string `json:"locale"`
+}
+
+// GetLocale returns the value of Locale.
+func (s *UserLocaleRequest) GetLocale() string {
+	return s.Locale
+}
+
+// SetLocale sets the value of Locale.
+func (s *UserLocaleRequest) SetLocale(val string) {
+	s.Locale = val
+}
+
+func (*UserLocaleRequest) setMyLocaleRes() {}
+
+// Ref: #/components/schemas/WebhookDelivery
+type WebhookDelivery struct {
+	ID            uuid.UUID             `json:"id"`
+	EndpointID    uuid.UUID             `json:"endpoint_id"`
+	EventID       int64                 `json:"event_id"`
+	EventType     WebhookEventType      `json:"event_type"`
+	Status        WebhookDeliveryStatus `json:"status"`
+	Attempts      int                   `json:"attempts"`
+	NextAttemptAt time.Time             `json:"next_attempt_at"`
+	LastAttemptAt OptDateTime           `json:"last_attempt_at"`
+	// HTTP status of the latest attempt; absent when no response arrived.
+	ResponseStatus OptInt    `json:"response_status"`
+	LastError      OptString `json:"last_error"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *WebhookDelivery) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetEndpointID returns the value of EndpointID.
+func (s *WebhookDelivery) GetEndpointID() uuid.UUID {
+	return s.EndpointID
+}
+
+// GetEventID returns the value of EventID.
+func (s *WebhookDelivery) GetEventID() int64 {
+	return s.EventID
+}
+
+// GetEventType returns the value of EventType.
+func (s *WebhookDelivery) GetEventType() WebhookEventType {
+	return s.EventType
+}
+
+// GetStatus returns the value of Status.
+func (s *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	return s.Status
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *WebhookDelivery) GetAttempts() int {
+	return s.Attempts
+}
+
+// GetNextAttemptAt returns the value of NextAttemptAt.
+func (s *WebhookDelivery) GetNextAttemptAt() time.Time {
+	return s.NextAttemptAt
+}
+
+// GetLastAttemptAt returns the value of LastAttemptAt.
+func (s *WebhookDelivery) GetLastAttemptAt() OptDateTime {
+	return s.LastAttemptAt
+}
+
+// GetResponseStatus returns the value of ResponseStatus.
+func (s *WebhookDelivery) GetResponseStatus() OptInt {
+	return s.ResponseStatus
+}
+
+// GetLastError returns the value of LastError.
+func (s *WebhookDelivery) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookDelivery) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *WebhookDelivery) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetEndpointID sets the value of EndpointID.
+func (s *WebhookDelivery) SetEndpointID(val uuid.UUID) {
+	s.EndpointID = val
+}
+
+// SetEventID sets the value of EventID.
+func (s *WebhookDelivery) SetEventID(val int64) {
+	s.EventID = val
+}
+
+// SetEventType sets the value of EventType.
+func (s *WebhookDelivery) SetEventType(val WebhookEventType) {
+	s.EventType = val
+}
+
+// SetStatus sets the value of Status.
+func (s *WebhookDelivery) SetStatus(val WebhookDeliveryStatus) {
+	s.Status = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *WebhookDelivery) SetAttempts(val int) {
+	s.Attempts = val
+}
+
+// SetNextAttemptAt sets the value of NextAttemptAt.
+func (s *WebhookDelivery) SetNextAttemptAt(val time.Time) {
+	s.NextAttemptAt = val
+}
+
+// SetLastAttemptAt sets the value of LastAttemptAt.
+func (s *WebhookDelivery) SetLastAttemptAt(val OptDateTime) {
+	s.LastAttemptAt = val
+}
+
+// SetResponseStatus sets the value of ResponseStatus.
+func (s *WebhookDelivery) SetResponseStatus(val OptInt) {
+	s.ResponseStatus = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *WebhookDelivery) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookDelivery) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*WebhookDelivery) redeliverWebhookRes() {}
+
+// Ref: #/components/schemas/WebhookDeliveryStatus
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// AllValues returns all WebhookDeliveryStatus values.
+func (WebhookDeliveryStatus) AllValues() []WebhookDeliveryStatus {
+	return []WebhookDeliveryStatus{
+		WebhookDeliveryStatusPending,
+		WebhookDeliveryStatusSucceeded,
+		WebhookDeliveryStatusFailed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookDeliveryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookDeliveryStatusPending:
+		return []byte(s), nil
+	case WebhookDeliveryStatusSucceeded:
+		return []byte(s), nil
+	case WebhookDeliveryStatusFailed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookDeliveryStatus) UnmarshalText(data []byte) error {
+	switch WebhookDeliveryStatus(data) {
+	case WebhookDeliveryStatusPending:
+		*s = WebhookDeliveryStatusPending
+		return nil
+	case WebhookDeliveryStatusSucceeded:
+		*s = WebhookDeliveryStatusSucceeded
+		return nil
+	case WebhookDeliveryStatusFailed:
+		*s = WebhookDeliveryStatusFailed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/WebhookEndpoint
+type WebhookEndpoint struct {
+	ID         uuid.UUID          `json:"id"`
+	URL        string             `json:"url"`
+	EventTypes []WebhookEventType `json:"event_types"`
+	// Only returned when the secret was set or generated by the request.
+	Secret              OptString   `json:"secret"`
+	Enabled             bool        `json:"enabled"`
+	ConsecutiveFailures int         `json:"consecutive_failures"`
+	DisabledAt          OptDateTime `json:"disabled_at"`
+	CreatedAt           time.Time   `json:"created_at"`
+	UpdatedAt           time.Time   `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *WebhookEndpoint) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetURL returns the value of URL.
+func (s *WebhookEndpoint) GetURL() string {
+	return s.URL
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *WebhookEndpoint) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// GetSecret returns the value of Secret.
+func (s *WebhookEndpoint) GetSecret() OptString {
+	return s.Secret
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *WebhookEndpoint) GetEnabled() bool {
+	return s.Enabled
+}
+
+// GetConsecutiveFailures returns the value of ConsecutiveFailures.
+func (s *WebhookEndpoint) GetConsecutiveFailures() int {
+	return s.ConsecutiveFailures
+}
+
+// GetDisabledAt returns the value of DisabledAt.
+func (s *WebhookEndpoint) GetDisabledAt() OptDateTime {
+	return s.DisabledAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookEndpoint) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *WebhookEndpoint) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *WebhookEndpoint) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetURL sets the value of URL.
+func (s *WebhookEndpoint) SetURL(val string) {
+	s.URL = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *WebhookEndpoint) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *WebhookEndpoint) SetSecret(val OptString) {
+	s.Secret = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *WebhookEndpoint) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+// SetConsecutiveFailures sets the value of ConsecutiveFailures.
+func (s *WebhookEndpoint) SetConsecutiveFailures(val int) {
+	s.ConsecutiveFailures = val
+}
+
+// SetDisabledAt sets the value of DisabledAt.
+func (s *WebhookEndpoint) SetDisabledAt(val OptDateTime) {
+	s.DisabledAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookEndpoint) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *WebhookEndpoint) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*WebhookEndpoint) createWebhookEndpointRes() {}
+func (*WebhookEndpoint) getWebhookEndpointRes()    {}
+func (*WebhookEndpoint) updateWebhookEndpointRes() {}
+
+// Ref: #/components/schemas/WebhookEndpointRequest
+type WebhookEndpointRequest struct {
+	// Absolute http or https URL.
+	URL        string             `json:"url"`
+	EventTypes []WebhookEventType `json:"event_types"`
+	// At least 16 characters. Generated on creation and kept on update when omitted.
+	Secret  OptString `json:"secret"`
+	Enabled OptBool   `json:"enabled"`
+}
+
+// GetURL returns the value of URL.
+func (s *WebhookEndpointRequest) GetURL() string {
+	return s.URL
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *WebhookEndpointRequest) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// GetSecret returns the value of Secret.
+func (s *WebhookEndpointRequest) GetSecret() OptString {
+	return s.Secret
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *WebhookEndpointRequest) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// SetURL sets the value of URL.
+func (s *WebhookEndpointRequest) SetURL(val string) {
+	s.URL = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *WebhookEndpointRequest) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *WebhookEndpointRequest) SetSecret(val OptString) {
+	s.Secret = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *WebhookEndpointRequest) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// Ref: #/components/schemas/WebhookEventType
+type WebhookEventType string
+
+const (
+	WebhookEventTypeUserCreated     WebhookEventType = "user.created"
+	WebhookEventTypeDataSaved       WebhookEventType = "data.saved"
+	WebhookEventTypeDataDeleted     WebhookEventType = "data.deleted"
+	WebhookEventTypeDataRestored    WebhookEventType = "data.restored"
+	WebhookEventTypeCatalogCreated  WebhookEventType = "catalog.created"
+	WebhookEventTypeCatalogUpdated  WebhookEventType = "catalog.updated"
+	WebhookEventTypeCatalogDeleted  WebhookEventType = "catalog.deleted"
+	WebhookEventTypeCatalogRestored WebhookEventType = "catalog.restored"
+)
+
+// AllValues returns all WebhookEventType values.
+func (WebhookEventType) AllValues() []WebhookEventType {
+	return []WebhookEventType{
+		WebhookEventTypeUserCreated,
+		WebhookEventTypeDataSaved,
+		WebhookEventTypeDataDeleted,
+		WebhookEventTypeDataRestored,
+		WebhookEventTypeCatalogCreated,
+		WebhookEventTypeCatalogUpdated,
+		WebhookEventTypeCatalogDeleted,
+		WebhookEventTypeCatalogRestored,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookEventType) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookEventTypeUserCreated:
+		return []byte(s), nil
+	case WebhookEventTypeDataSaved:
+		return []byte(s), nil
+	case WebhookEventTypeDataDeleted:
+		return []byte(s), nil
+	case WebhookEventTypeDataRestored:
+		return []byte(s), nil
+	case WebhookEventTypeCatalogCreated:
+		return []byte(s), nil
+	case WebhookEventTypeCatalogUpdated:
+		return []byte(s), nil
+	case WebhookEventTypeCatalogDeleted:
+		return []byte(s), nil
+	case WebhookEventTypeCatalogRestored:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookEventType) UnmarshalText(data []byte) error {
+	switch WebhookEventType(data) {
+	case WebhookEventTypeUserCreated:
+		*s = WebhookEventTypeUserCreated
+		return nil
+	case WebhookEventTypeDataSaved:
+		*s = WebhookEventTypeDataSaved
+		return nil
+	case WebhookEventTypeDataDeleted:
+		*s = WebhookEventTypeDataDeleted
+		return nil
+	case WebhookEventTypeDataRestored:
+		*s = WebhookEventTypeDataRestored
+		return nil
+	case WebhookEventTypeCatalogCreated:
+		*s = WebhookEventTypeCatalogCreated
+		return nil
+	case WebhookEventTypeCatalogUpdated:
+		*s = WebhookEventTypeCatalogUpdated
+		return nil
+	case WebhookEventTypeCatalogDeleted:
+		*s = WebhookEventTypeCatalogDeleted
+		return nil
+	case WebhookEventTypeCatalogRestored:
+		*s = WebhookEventTypeCatalogRestored
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
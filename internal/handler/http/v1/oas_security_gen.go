@@ -33,8 +33,75 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 }
 
 var operationRolesCookieAuth = map[string][]string{
-	GetCatalogOperation: []string{},
-	PostDataOperation:   []string{},
+	AddCatalogFavoriteOperation:             []string{},
+	CreateCatalogCategoryOperation:          []string{},
+	CreateCatalogChangesetOperation:         []string{},
+	CreateCatalogDraftOperation:             []string{},
+	CreateCatalogItemOperation:              []string{},
+	CreateCatalogTagOperation:               []string{},
+	CreateWebhookEndpointOperation:          []string{},
+	DeleteAttachmentOperation:               []string{},
+	DeleteCatalogCategoryOperation:          []string{},
+	DeleteCatalogChangesetOperation:         []string{},
+	DeleteCatalogDraftOperation:             []string{},
+	DeleteCatalogImageOperation:             []string{},
+	DeleteCatalogItemOperation:              []string{},
+	DeleteCatalogReviewOperation:            []string{},
+	DeleteCatalogTagOperation:               []string{},
+	DeleteCatalogTranslationOperation:       []string{},
+	DeleteDataOperation:                     []string{},
+	DeleteDataSchemaOperation:               []string{},
+	DeleteWebhookEndpointOperation:          []string{},
+	ExportDataOperation:                     []string{},
+	GetCatalogOperation:                     []string{},
+	GetCatalogCategoryOperation:             []string{},
+	GetCatalogChangesetOperation:            []string{},
+	GetCatalogItemOperation:                 []string{},
+	GetCatalogReviewOperation:               []string{},
+	GetCatalogV2Operation:                   []string{},
+	GetDataOperation:                        []string{},
+	GetDataUsageOperation:                   []string{},
+	GetWebhookEndpointOperation:             []string{},
+	ImportCatalogOperation:                  []string{},
+	ImportDataOperation:                     []string{},
+	ListAttachmentsOperation:                []string{},
+	ListCatalogCategoriesOperation:          []string{},
+	ListCatalogChangesetsOperation:          []string{},
+	ListCatalogFavoritesOperation:           []string{},
+	ListCatalogReviewsOperation:             []string{},
+	ListCatalogReviewsByStatusOperation:     []string{},
+	ListCatalogTagsOperation:                []string{},
+	ListCatalogTranslationsOperation:        []string{},
+	ListDataSchemasOperation:                []string{},
+	ListDeletedCatalogItemsOperation:        []string{},
+	ListDeletedDataOperation:                []string{},
+	ListRecentlyViewedCatalogItemsOperation: []string{},
+	ListWebhookDeliveriesOperation:          []string{},
+	ListWebhookEndpointsOperation:           []string{},
+	PostDataOperation:                       []string{},
+	PreviewCatalogChangesetOperation:        []string{},
+	PublishCatalogChangesetOperation:        []string{},
+	PutCatalogDraftOperation:                []string{},
+	PutCatalogTranslationOperation:          []string{},
+	PutDataSchemaOperation:                  []string{},
+	RecordCatalogViewOperation:              []string{},
+	RedeliverWebhookOperation:               []string{},
+	ReleaseCatalogReservationOperation:      []string{},
+	RemoveCatalogFavoriteOperation:          []string{},
+	RenameCatalogTagOperation:               []string{},
+	ReserveCatalogItemOperation:             []string{},
+	RestoreCatalogItemOperation:             []string{},
+	RestoreDataOperation:                    []string{},
+	RollbackCatalogChangesetOperation:       []string{},
+	SaveCatalogReviewOperation:              []string{},
+	SearchCatalogOperation:                  []string{},
+	SetCatalogInventoryOperation:            []string{},
+	SetCatalogItemDisabledOperation:         []string{},
+	SetCatalogReviewStatusOperation:         []string{},
+	SetMyLocaleOperation:                    []string{},
+	UpdateCatalogCategoryOperation:          []string{},
+	UpdateCatalogItemOperation:              []string{},
+	UpdateWebhookEndpointOperation:          []string{},
 }
 
 func (s *Server) securityCookieAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
@@ -8,18 +8,302 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AddCatalogFavorite implements addCatalogFavorite operation.
+	//
+	// Adding a favorite again has no effect.
+	//
+	// PUT /api/v1/catalog/{id}/favorite
+	AddCatalogFavorite(ctx context.Context, params AddCatalogFavoriteParams) (AddCatalogFavoriteRes, error)
+	// CreateCatalogCategory implements createCatalogCategory operation.
+	//
+	// Create a catalog category (admin only).
+	//
+	// POST /api/v1/catalog/categories
+	CreateCatalogCategory(ctx context.Context, req *CatalogCategoryRequest) (CreateCatalogCategoryRes, error)
+	// CreateCatalogChangeset implements createCatalogChangeset operation.
+	//
+	// A changeset collects drafts of catalog items. Drafts stay invisible until the changeset is
+	// published, which applies all of them at once.
+	//
+	// POST /api/v1/catalog/changesets
+	CreateCatalogChangeset(ctx context.Context, req *CatalogChangesetRequest) (CreateCatalogChangesetRes, error)
+	// CreateCatalogDraft implements createCatalogDraft operation.
+	//
+	// The item is created with a new id when the changeset is published.
+	//
+	// POST /api/v1/catalog/changesets/{id}/items
+	CreateCatalogDraft(ctx context.Context, req *CatalogItemRequest, params CreateCatalogDraftParams) (CreateCatalogDraftRes, error)
+	// CreateCatalogItem implements createCatalogItem operation.
+	//
+	// Create a catalog item (admin only).
+	//
+	// POST /api/v1/catalog
+	CreateCatalogItem(ctx context.Context, req *CatalogItemRequest) (CreateCatalogItemRes, error)
+	// CreateCatalogTag implements createCatalogTag operation.
+	//
+	// Tags are also created when first assigned to an item.
+	//
+	// POST /api/v1/catalog/tags
+	CreateCatalogTag(ctx context.Context, req *CatalogTagRequest) (CreateCatalogTagRes, error)
+	// CreateWebhookEndpoint implements createWebhookEndpoint operation.
+	//
+	// Events of the subscribed types are POSTed to the URL as JSON, signed with the secret in the
+	// X-Webhook-Signature header as t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">. A secret is
+	// generated when none is given. The secret is only returned by this call and when it is replaced.
+	//
+	// POST /api/v1/webhooks
+	CreateWebhookEndpoint(ctx context.Context, req *WebhookEndpointRequest) (CreateWebhookEndpointRes, error)
+	// DeleteAttachment implements deleteAttachment operation.
+	//
+	// The content is deleted by garbage collection once no attachment references it.
+	//
+	// DELETE /api/v1/data/attachments/{id}
+	DeleteAttachment(ctx context.Context, params DeleteAttachmentParams) (DeleteAttachmentRes, error)
+	// DeleteCatalogCategory implements deleteCatalogCategory operation.
+	//
+	// Items of the category become uncategorized.
+	//
+	// DELETE /api/v1/catalog/categories/{id}
+	DeleteCatalogCategory(ctx context.Context, params DeleteCatalogCategoryParams) (DeleteCatalogCategoryRes, error)
+	// DeleteCatalogChangeset implements deleteCatalogChangeset operation.
+	//
+	// Discard a draft catalog changeset (admin only).
+	//
+	// DELETE /api/v1/catalog/changesets/{id}
+	DeleteCatalogChangeset(ctx context.Context, params DeleteCatalogChangesetParams) (DeleteCatalogChangesetRes, error)
+	// DeleteCatalogDraft implements deleteCatalogDraft operation.
+	//
+	// Unstage the draft of a catalog item (admin only).
+	//
+	// DELETE /api/v1/catalog/changesets/{id}/items/{item_id}
+	DeleteCatalogDraft(ctx context.Context, params DeleteCatalogDraftParams) (DeleteCatalogDraftRes, error)
+	// DeleteCatalogImage implements deleteCatalogImage operation.
+	//
+	// Images are uploaded with a multipart POST of a "file" field to /api/v1/catalog/{id}/images (admin
+	// only). They are downloaded from /api/v1/catalog/images/{image_id} and their thumbnails from
+	// /api/v1/catalog/images/{image_id}/thumbnails/{size}. These are served outside this contract.
+	//
+	// DELETE /api/v1/catalog/{id}/images/{image_id}
+	DeleteCatalogImage(ctx context.Context, params DeleteCatalogImageParams) (DeleteCatalogImageRes, error)
+	// DeleteCatalogItem implements deleteCatalogItem operation.
+	//
+	// The item disappears from all reads but can be restored until it is purged after the configured
+	// retention period.
+	//
+	// DELETE /api/v1/catalog/{id}
+	DeleteCatalogItem(ctx context.Context, params DeleteCatalogItemParams) (DeleteCatalogItemRes, error)
+	// DeleteCatalogReview implements deleteCatalogReview operation.
+	//
+	// Delete the review of a catalog item left by the session user.
+	//
+	// DELETE /api/v1/catalog/{id}/review
+	DeleteCatalogReview(ctx context.Context, params DeleteCatalogReviewParams) (DeleteCatalogReviewRes, error)
+	// DeleteCatalogTag implements deleteCatalogTag operation.
+	//
+	// The tag is removed from every item carrying it.
+	//
+	// DELETE /api/v1/catalog/tags/{name}
+	DeleteCatalogTag(ctx context.Context, params DeleteCatalogTagParams) (DeleteCatalogTagRes, error)
+	// DeleteCatalogTranslation implements deleteCatalogTranslation operation.
+	//
+	// Delete a translation of a catalog item (admin only).
+	//
+	// DELETE /api/v1/catalog/{id}/translations/{locale}
+	DeleteCatalogTranslation(ctx context.Context, params DeleteCatalogTranslationParams) (DeleteCatalogTranslationRes, error)
+	// DeleteData implements deleteData operation.
+	//
+	// Marks every version of the key as deleted. Like writes, deletes are open to every signed-in user,
+	// but only an admin can restore the key, until it is purged after the configured retention period.
+	// Attachments of the key are hidden meanwhile.
+	//
+	// DELETE /api/v1/data
+	DeleteData(ctx context.Context, params DeleteDataParams) (DeleteDataRes, error)
+	// DeleteDataSchema implements deleteDataSchema operation.
+	//
+	// Remove the JSON Schema for a data key prefix.
+	//
+	// DELETE /api/v1/data/schemas
+	DeleteDataSchema(ctx context.Context, params DeleteDataSchemaParams) (DeleteDataSchemaRes, error)
+	// DeleteWebhookEndpoint implements deleteWebhookEndpoint operation.
+	//
+	// Remove a webhook endpoint and its delivery log (admin only).
+	//
+	// DELETE /api/v1/webhooks/{id}
+	DeleteWebhookEndpoint(ctx context.Context, params DeleteWebhookEndpointParams) (DeleteWebhookEndpointRes, error)
+	// ExportData implements exportData operation.
+	//
+	// Export all live data entries as an NDJSON or CSV stream.
+	//
+	// GET /api/v1/data:export
+	ExportData(ctx context.Context, params ExportDataParams) (ExportDataRes, error)
 	// GetCatalog implements getCatalog operation.
 	//
-	// Get catalog items.
+	// Returns every item in one response. Use the paginated GET /api/v2/catalog instead.
+	//
+	// Deprecated: schema marks this operation as deprecated.
 	//
 	// GET /api/v1/catalog
-	GetCatalog(ctx context.Context) (GetCatalogRes, error)
+	GetCatalog(ctx context.Context, params GetCatalogParams) (GetCatalogRes, error)
+	// GetCatalogCategory implements getCatalogCategory operation.
+	//
+	// Get a catalog category.
+	//
+	// GET /api/v1/catalog/categories/{id}
+	GetCatalogCategory(ctx context.Context, params GetCatalogCategoryParams) (GetCatalogCategoryRes, error)
+	// GetCatalogChangeset implements getCatalogChangeset operation.
+	//
+	// Get a catalog changeset with its drafts (admin only).
+	//
+	// GET /api/v1/catalog/changesets/{id}
+	GetCatalogChangeset(ctx context.Context, params GetCatalogChangesetParams) (GetCatalogChangesetRes, error)
+	// GetCatalogItem implements getCatalogItem operation.
+	//
+	// Get a catalog item.
+	//
+	// GET /api/v1/catalog/{id}
+	GetCatalogItem(ctx context.Context, params GetCatalogItemParams) (GetCatalogItemRes, error)
+	// GetCatalogReview implements getCatalogReview operation.
+	//
+	// Returns the review whatever its moderation status.
+	//
+	// GET /api/v1/catalog/{id}/review
+	GetCatalogReview(ctx context.Context, params GetCatalogReviewParams) (GetCatalogReviewRes, error)
+	// GetCatalogV2 implements getCatalogV2 operation.
+	//
+	// Keyset pagination: pass next_cursor from the previous response as cursor to get the following page,
+	//  keeping the other parameters unchanged. next_cursor is omitted on the last page.
+	//
+	// GET /api/v2/catalog
+	GetCatalogV2(ctx context.Context, params GetCatalogV2Params) (GetCatalogV2Res, error)
+	// GetData implements getData operation.
+	//
+	// Get the current value of a data key.
+	//
+	// GET /api/v1/data
+	GetData(ctx context.Context, params GetDataParams) (GetDataRes, error)
+	// GetDataUsage implements getDataUsage operation.
+	//
+	// Get the storage used by the current user and their quota.
+	//
+	// GET /api/v1/data/usage
+	GetDataUsage(ctx context.Context) (GetDataUsageRes, error)
 	// GetMe implements getMe operation.
 	//
 	// Get current user info.
 	//
 	// GET /api/v1/auth/me
 	GetMe(ctx context.Context) (GetMeRes, error)
+	// GetWebhookEndpoint implements getWebhookEndpoint operation.
+	//
+	// Get a webhook endpoint (admin only).
+	//
+	// GET /api/v1/webhooks/{id}
+	GetWebhookEndpoint(ctx context.Context, params GetWebhookEndpointParams) (GetWebhookEndpointRes, error)
+	// ImportCatalog implements importCatalog operation.
+	//
+	// Rows are matched to items by their external SKU. Every row is validated first; rows with errors,
+	// duplicate SKUs or unknown category paths are listed in the report and nothing is written then.
+	// Otherwise all rows are applied in one transaction. CSV files have a header with a sku column and
+	// any of action, title, description, disabled, tags (separated by "|") and category; JSON files are
+	// an array of objects with the same fields, tags being an array. action is upsert (the default) or
+	// delete.
+	//
+	// POST /api/v1/catalog:import
+	ImportCatalog(ctx context.Context, req ImportCatalogReq, params ImportCatalogParams) (ImportCatalogRes, error)
+	// ImportData implements importData operation.
+	//
+	// Import data entries from an NDJSON or CSV stream.
+	//
+	// POST /api/v1/data:import
+	ImportData(ctx context.Context, req ImportDataReq, params ImportDataParams) (ImportDataRes, error)
+	// ListAttachments implements listAttachments operation.
+	//
+	// Files are uploaded with a streamed multipart POST to this path and downloaded from
+	// /api/v1/data/attachments/{id}/content; both are served outside this contract.
+	//
+	// GET /api/v1/data/attachments
+	ListAttachments(ctx context.Context, params ListAttachmentsParams) (ListAttachmentsRes, error)
+	// ListCatalogCategories implements listCatalogCategories operation.
+	//
+	// Returns the whole category tree as a flat list ordered by path.
+	//
+	// GET /api/v1/catalog/categories
+	ListCatalogCategories(ctx context.Context) (ListCatalogCategoriesRes, error)
+	// ListCatalogChangesets implements listCatalogChangesets operation.
+	//
+	// Newest first. Drafts are only returned for a single changeset.
+	//
+	// GET /api/v1/catalog/changesets
+	ListCatalogChangesets(ctx context.Context) (ListCatalogChangesetsRes, error)
+	// ListCatalogFavorites implements listCatalogFavorites operation.
+	//
+	// Most recently added first. Keyset pagination like GET /api/v2/catalog: pass next_cursor from
+	// the previous response as cursor to get the following page.
+	//
+	// GET /api/v1/catalog/favorites
+	ListCatalogFavorites(ctx context.Context, params ListCatalogFavoritesParams) (ListCatalogFavoritesRes, error)
+	// ListCatalogReviews implements listCatalogReviews operation.
+	//
+	// Newest first.
+	//
+	// GET /api/v1/catalog/{id}/reviews
+	ListCatalogReviews(ctx context.Context, params ListCatalogReviewsParams) (ListCatalogReviewsRes, error)
+	// ListCatalogReviewsByStatus implements listCatalogReviewsByStatus operation.
+	//
+	// Reviews of all items with the given status, least recently changed first.
+	//
+	// GET /api/v1/catalog/reviews
+	ListCatalogReviewsByStatus(ctx context.Context, params ListCatalogReviewsByStatusParams) (ListCatalogReviewsByStatusRes, error)
+	// ListCatalogTags implements listCatalogTags operation.
+	//
+	// List catalog tags.
+	//
+	// GET /api/v1/catalog/tags
+	ListCatalogTags(ctx context.Context) (ListCatalogTagsRes, error)
+	// ListCatalogTranslations implements listCatalogTranslations operation.
+	//
+	// List the translations of a catalog item (admin only).
+	//
+	// GET /api/v1/catalog/{id}/translations
+	ListCatalogTranslations(ctx context.Context, params ListCatalogTranslationsParams) (ListCatalogTranslationsRes, error)
+	// ListDataSchemas implements listDataSchemas operation.
+	//
+	// List JSON Schemas registered for data key prefixes.
+	//
+	// GET /api/v1/data/schemas
+	ListDataSchemas(ctx context.Context) (ListDataSchemasRes, error)
+	// ListDeletedCatalogItems implements listDeletedCatalogItems operation.
+	//
+	// Most recently deleted first. Items are purged once deleted longer than the configured retention
+	// period.
+	//
+	// GET /api/v1/catalog/deleted
+	ListDeletedCatalogItems(ctx context.Context, params ListDeletedCatalogItemsParams) (ListDeletedCatalogItemsRes, error)
+	// ListDeletedData implements listDeletedData operation.
+	//
+	// The last value of every deleted key that has not been written again, most recently deleted first.
+	// Keys are purged once deleted longer than the configured retention period.
+	//
+	// GET /api/v1/data/deleted
+	ListDeletedData(ctx context.Context, params ListDeletedDataParams) (ListDeletedDataRes, error)
+	// ListRecentlyViewedCatalogItems implements listRecentlyViewedCatalogItems operation.
+	//
+	// Most recently viewed first. Views are recorded with POST /api/v1/catalog/{id}/views.
+	//
+	// GET /api/v1/catalog/recently-viewed
+	ListRecentlyViewedCatalogItems(ctx context.Context, params ListRecentlyViewedCatalogItemsParams) (ListRecentlyViewedCatalogItemsRes, error)
+	// ListWebhookDeliveries implements listWebhookDeliveries operation.
+	//
+	// Newest first. Each delivery shows the outcome of its latest attempt.
+	//
+	// GET /api/v1/webhooks/{id}/deliveries
+	ListWebhookDeliveries(ctx context.Context, params ListWebhookDeliveriesParams) (ListWebhookDeliveriesRes, error)
+	// ListWebhookEndpoints implements listWebhookEndpoints operation.
+	//
+	// List webhook endpoints (admin only).
+	//
+	// GET /api/v1/webhooks
+	ListWebhookEndpoints(ctx context.Context) (ListWebhookEndpointsRes, error)
 	// Login implements login operation.
 	//
 	// Authenticate user.
@@ -38,6 +322,162 @@ type Handler interface {
 	//
 	// POST /api/v1/data
 	PostData(ctx context.Context, req *DataRequest) (PostDataRes, error)
+	// PreviewCatalogChangeset implements previewCatalogChangeset operation.
+	//
+	// Returns all catalog items as they would be after publishing, ordered by title.
+	//
+	// GET /api/v1/catalog/changesets/{id}/preview
+	PreviewCatalogChangeset(ctx context.Context, params PreviewCatalogChangesetParams) (PreviewCatalogChangesetRes, error)
+	// PublishCatalogChangeset implements publishCatalogChangeset operation.
+	//
+	// Applies all drafts in one transaction. Nothing is applied if the changeset is not a draft or an
+	// item changed after its draft was staged.
+	//
+	// POST /api/v1/catalog/changesets/{id}/publish
+	PublishCatalogChangeset(ctx context.Context, params PublishCatalogChangesetParams) (PublishCatalogChangesetRes, error)
+	// PutCatalogDraft implements putCatalogDraft operation.
+	//
+	// Replaces an earlier draft of the same item. Drafts of existing items are based on the item's
+	// current version; publishing fails if the item changes before then.
+	//
+	// PUT /api/v1/catalog/changesets/{id}/items/{item_id}
+	PutCatalogDraft(ctx context.Context, req *CatalogDraftRequest, params PutCatalogDraftParams) (PutCatalogDraftRes, error)
+	// PutCatalogTranslation implements putCatalogTranslation operation.
+	//
+	// The default locale is not translated; its text is the item's own title and description.
+	//
+	// PUT /api/v1/catalog/{id}/translations/{locale}
+	PutCatalogTranslation(ctx context.Context, req *CatalogTranslationRequest, params PutCatalogTranslationParams) (PutCatalogTranslationRes, error)
+	// PutDataSchema implements putDataSchema operation.
+	//
+	// Register or replace the JSON Schema for a data key prefix.
+	//
+	// PUT /api/v1/data/schemas
+	PutDataSchema(ctx context.Context, req *DataSchemaRequest) (PutDataSchemaRes, error)
+	// RecordCatalogView implements recordCatalogView operation.
+	//
+	// Moves the item to the front of the recently viewed list of the user. Only the most recent views
+	// are kept, as configured by catalog.recently_viewed_limit.
+	//
+	// POST /api/v1/catalog/{id}/views
+	RecordCatalogView(ctx context.Context, params RecordCatalogViewParams) (RecordCatalogViewRes, error)
+	// RedeliverWebhook implements redeliverWebhook operation.
+	//
+	// Queues the delivery with a fresh retry schedule, whatever its status. Deliveries to a disabled
+	// endpoint wait until it is enabled again.
+	//
+	// POST /api/v1/webhooks/deliveries/{delivery_id}/redeliver
+	RedeliverWebhook(ctx context.Context, params RedeliverWebhookParams) (RedeliverWebhookRes, error)
+	// ReleaseCatalogReservation implements releaseCatalogReservation operation.
+	//
+	// Returns the reserved units to stock. Releasing is idempotent: a reservation that expired, was
+	// already released or does not exist is reported as released as well.
+	//
+	// POST /api/v1/catalog/{id}/release
+	ReleaseCatalogReservation(ctx context.Context, req *CatalogReleaseRequest, params ReleaseCatalogReservationParams) (ReleaseCatalogReservationRes, error)
+	// RemoveCatalogFavorite implements removeCatalogFavorite operation.
+	//
+	// Remove a catalog item from the favorites of the session user.
+	//
+	// DELETE /api/v1/catalog/{id}/favorite
+	RemoveCatalogFavorite(ctx context.Context, params RemoveCatalogFavoriteParams) (RemoveCatalogFavoriteRes, error)
+	// RenameCatalogTag implements renameCatalogTag operation.
+	//
+	// The tag is renamed on every item carrying it.
+	//
+	// PUT /api/v1/catalog/tags/{name}
+	RenameCatalogTag(ctx context.Context, req *CatalogTagRequest, params RenameCatalogTagParams) (RenameCatalogTagRes, error)
+	// ReserveCatalogItem implements reserveCatalogItem operation.
+	//
+	// Holds `quantity` units of an enabled item with tracked stock for the session user until the
+	// reservation expires or is released. Concurrent reservations are serialized on the item, so stock
+	// is never oversold.
+	//
+	// POST /api/v1/catalog/{id}/reserve
+	ReserveCatalogItem(ctx context.Context, req *CatalogReservationRequest, params ReserveCatalogItemParams) (ReserveCatalogItemRes, error)
+	// RestoreCatalogItem implements restoreCatalogItem operation.
+	//
+	// Restore a deleted catalog item (admin only).
+	//
+	// POST /api/v1/catalog/{id}/restore
+	RestoreCatalogItem(ctx context.Context, params RestoreCatalogItemParams) (RestoreCatalogItemRes, error)
+	// RestoreData implements restoreData operation.
+	//
+	// Restore a deleted data key (admin only).
+	//
+	// POST /api/v1/data:restore
+	RestoreData(ctx context.Context, params RestoreDataParams) (RestoreDataRes, error)
+	// RollbackCatalogChangeset implements rollbackCatalogChangeset operation.
+	//
+	// Restores every item the changeset touched to its version before publishing, in one transaction.
+	// Nothing is restored if one of the items changed after publishing.
+	//
+	// POST /api/v1/catalog/changesets/{id}/rollback
+	RollbackCatalogChangeset(ctx context.Context, params RollbackCatalogChangesetParams) (RollbackCatalogChangesetRes, error)
+	// SaveCatalogReview implements saveCatalogReview operation.
+	//
+	// Creates the review of the session user or replaces its rating and text; a user has one review per
+	// item. When reviews require approval, the saved review is pending until an admin approves it. An
+	// edited rejected review is always pending again.
+	//
+	// PUT /api/v1/catalog/{id}/review
+	SaveCatalogReview(ctx context.Context, req *CatalogReviewRequest, params SaveCatalogReviewParams) (SaveCatalogReviewRes, error)
+	// SearchCatalog implements searchCatalog operation.
+	//
+	// Full-text search over titles and descriptions, best matches first. q supports web search syntax:
+	// "quoted phrases", or, and -excluded words. When nothing matches, titles with words similar to the
+	// query are returned instead and fuzzy is true. Highlights are HTML-escaped with matched words
+	// wrapped in <mark> elements.
+	//
+	// GET /api/v1/catalog/search
+	SearchCatalog(ctx context.Context, params SearchCatalogParams) (SearchCatalogRes, error)
+	// SetCatalogInventory implements setCatalogInventory operation.
+	//
+	// Replaces the price and stock quantity of an item. Omitting `price` removes the price; omitting
+	// `stock_quantity` stops tracking stock, which makes the item always available while enabled but
+	// impossible to reserve.
+	//
+	// PUT /api/v1/catalog/{id}/inventory
+	SetCatalogInventory(ctx context.Context, req *CatalogInventoryRequest, params SetCatalogInventoryParams) (SetCatalogInventoryRes, error)
+	// SetCatalogItemDisabled implements setCatalogItemDisabled operation.
+	//
+	// Enable or disable a catalog item (admin only).
+	//
+	// PUT /api/v1/catalog/{id}/disabled
+	SetCatalogItemDisabled(ctx context.Context, req *CatalogItemDisabledRequest, params SetCatalogItemDisabledParams) (SetCatalogItemDisabledRes, error)
+	// SetCatalogReviewStatus implements setCatalogReviewStatus operation.
+	//
+	// Only approved reviews are listed and count towards the rating of their item. Changing the status
+	// updates the rating totals of the item right away.
+	//
+	// PUT /api/v1/catalog/reviews/{review_id}/status
+	SetCatalogReviewStatus(ctx context.Context, req *CatalogReviewStatusRequest, params SetCatalogReviewStatusParams) (SetCatalogReviewStatusRes, error)
+	// SetMyLocale implements setMyLocale operation.
+	//
+	// Localized content is served in this locale when available, regardless of the Accept-Language
+	// header. An empty locale clears the setting.
+	//
+	// PUT /api/v1/auth/me/locale
+	SetMyLocale(ctx context.Context, req *UserLocaleRequest) (SetMyLocaleRes, error)
+	// UpdateCatalogCategory implements updateCatalogCategory operation.
+	//
+	// Subcategories and items move along; their paths change accordingly.
+	//
+	// PUT /api/v1/catalog/categories/{id}
+	UpdateCatalogCategory(ctx context.Context, req *CatalogCategoryRequest, params UpdateCatalogCategoryParams) (UpdateCatalogCategoryRes, error)
+	// UpdateCatalogItem implements updateCatalogItem operation.
+	//
+	// Replace a catalog item (admin only).
+	//
+	// PUT /api/v1/catalog/{id}
+	UpdateCatalogItem(ctx context.Context, req *CatalogItemRequest, params UpdateCatalogItemParams) (UpdateCatalogItemRes, error)
+	// UpdateWebhookEndpoint implements updateWebhookEndpoint operation.
+	//
+	// The secret is kept unless a new one is given. Enabling an endpoint that was disabled after
+	// repeated failures clears its failure count and resumes its pending deliveries.
+	//
+	// PUT /api/v1/webhooks/{id}
+	UpdateWebhookEndpoint(ctx context.Context, req *WebhookEndpointRequest, params UpdateWebhookEndpointParams) (UpdateWebhookEndpointRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
@@ -13,12 +13,267 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AddCatalogFavorite implements addCatalogFavorite operation.
+//
+// Adding a favorite again has no effect.
+//
+// PUT /api/v1/catalog/{id}/favorite
+func (UnimplementedHandler) AddCatalogFavorite(ctx context.Context, params AddCatalogFavoriteParams) (r AddCatalogFavoriteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateCatalogCategory implements createCatalogCategory operation.
+//
+// Create a catalog category (admin only).
+//
+// POST /api/v1/catalog/categories
+func (UnimplementedHandler) CreateCatalogCategory(ctx context.Context, req *CatalogCategoryRequest) (r CreateCatalogCategoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateCatalogChangeset implements createCatalogChangeset operation.
+//
+// A changeset collects drafts of catalog items. Drafts stay invisible until the changeset is
+// published, which applies all of them at once.
+//
+// POST /api/v1/catalog/changesets
+func (UnimplementedHandler) CreateCatalogChangeset(ctx context.Context, req *CatalogChangesetRequest) (r CreateCatalogChangesetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateCatalogDraft implements createCatalogDraft operation.
+//
+// The item is created with a new id when the changeset is published.
+//
+// POST /api/v1/catalog/changesets/{id}/items
+func (UnimplementedHandler) CreateCatalogDraft(ctx context.Context, req *CatalogItemRequest, params CreateCatalogDraftParams) (r CreateCatalogDraftRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateCatalogItem implements createCatalogItem operation.
+//
+// Create a catalog item (admin only).
+//
+// POST /api/v1/catalog
+func (UnimplementedHandler) CreateCatalogItem(ctx context.Context, req *CatalogItemRequest) (r CreateCatalogItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateCatalogTag implements createCatalogTag operation.
+//
+// Tags are also created when first assigned to an item.
+//
+// POST /api/v1/catalog/tags
+func (UnimplementedHandler) CreateCatalogTag(ctx context.Context, req *CatalogTagRequest) (r CreateCatalogTagRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateWebhookEndpoint implements createWebhookEndpoint operation.
+//
+// Events of the subscribed types are POSTed to the URL as JSON, signed with the secret in the
+// X-Webhook-Signature header as t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">. A secret is
+// generated when none is given. The secret is only returned by this call and when it is replaced.
+//
+// POST /api/v1/webhooks
+func (UnimplementedHandler) CreateWebhookEndpoint(ctx context.Context, req *WebhookEndpointRequest) (r CreateWebhookEndpointRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteAttachment implements deleteAttachment operation.
+//
+// The content is deleted by garbage collection once no attachment references it.
+//
+// DELETE /api/v1/data/attachments/{id}
+func (UnimplementedHandler) DeleteAttachment(ctx context.Context, params DeleteAttachmentParams) (r DeleteAttachmentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteCatalogCategory implements deleteCatalogCategory operation.
+//
+// Items of the category become uncategorized.
+//
+// DELETE /api/v1/catalog/categories/{id}
+func (UnimplementedHandler) DeleteCatalogCategory(ctx context.Context, params DeleteCatalogCategoryParams) (r DeleteCatalogCategoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteCatalogChangeset implements deleteCatalogChangeset operation.
+//
+// Discard a draft catalog changeset (admin only).
+//
+// DELETE /api/v1/catalog/changesets/{id}
+func (UnimplementedHandler) DeleteCatalogChangeset(ctx context.Context, params DeleteCatalogChangesetParams) (r DeleteCatalogChangesetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteCatalogDraft implements deleteCatalogDraft operation.
+//
+// Unstage the draft of a catalog item (admin only).
+//
+// DELETE /api/v1/catalog/changesets/{id}/items/{item_id}
+func (UnimplementedHandler) DeleteCatalogDraft(ctx context.Context, params DeleteCatalogDraftParams) (r DeleteCatalogDraftRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteCatalogImage implements deleteCatalogImage operation.
+//
+// Images are uploaded with a multipart POST of a "file" field to /api/v1/catalog/{id}/images (admin
+// only). They are downloaded from /api/v1/catalog/images/{image_id} and their thumbnails from
+// /api/v1/catalog/images/{image_id}/thumbnails/{size}. These are served outside this contract.
+//
+// DELETE /api/v1/catalog/{id}/images/{image_id}
+func (UnimplementedHandler) DeleteCatalogImage(ctx context.Context, params DeleteCatalogImageParams) (r DeleteCatalogImageRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteCatalogItem implements deleteCatalogItem operation.
+//
+// The item disappears from all reads but can be restored until it is purged after the configured
+// retention period.
+//
+// DELETE /api/v1/catalog/{id}
+func (UnimplementedHandler) DeleteCatalogItem(ctx context.Context, params DeleteCatalogItemParams) (r DeleteCatalogItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteCatalogReview implements deleteCatalogReview operation.
+//
+// Delete the review of a catalog item left by the session user.
+//
+// DELETE /api/v1/catalog/{id}/review
+func (UnimplementedHandler) DeleteCatalogReview(ctx context.Context, params DeleteCatalogReviewParams) (r DeleteCatalogReviewRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteCatalogTag implements deleteCatalogTag operation.
+//
+// The tag is removed from every item carrying it.
+//
+// DELETE /api/v1/catalog/tags/{name}
+func (UnimplementedHandler) DeleteCatalogTag(ctx context.Context, params DeleteCatalogTagParams) (r DeleteCatalogTagRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteCatalogTranslation implements deleteCatalogTranslation operation.
+//
+// Delete a translation of a catalog item (admin only).
+//
+// DELETE /api/v1/catalog/{id}/translations/{locale}
+func (UnimplementedHandler) DeleteCatalogTranslation(ctx context.Context, params DeleteCatalogTranslationParams) (r DeleteCatalogTranslationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteData implements deleteData operation.
+//
+// Marks every version of the key as deleted. Like writes, deletes are open to every signed-in user,
+// but only an admin can restore the key, until it is purged after the configured retention period.
+// Attachments of the key are hidden meanwhile.
+//
+// DELETE /api/v1/data
+func (UnimplementedHandler) DeleteData(ctx context.Context, params DeleteDataParams) (r DeleteDataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteDataSchema implements deleteDataSchema operation.
+//
+// Remove the JSON Schema for a data key prefix.
+//
+// DELETE /api/v1/data/schemas
+func (UnimplementedHandler) DeleteDataSchema(ctx context.Context, params DeleteDataSchemaParams) (r DeleteDataSchemaRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteWebhookEndpoint implements deleteWebhookEndpoint operation.
+//
+// Remove a webhook endpoint and its delivery log (admin only).
+//
+// DELETE /api/v1/webhooks/{id}
+func (UnimplementedHandler) DeleteWebhookEndpoint(ctx context.Context, params DeleteWebhookEndpointParams) (r DeleteWebhookEndpointRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ExportData implements exportData operation.
+//
+// Export all live data entries as an NDJSON or CSV stream.
+//
+// GET /api/v1/data:export
+func (UnimplementedHandler) ExportData(ctx context.Context, params ExportDataParams) (r ExportDataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetCatalog implements getCatalog operation.
 //
-// Get catalog items.
+// Returns every item in one response. Use the paginated GET /api/v2/catalog instead.
+//
+// Deprecated: schema marks this operation as deprecated.
 //
 // GET /api/v1/catalog
-func (UnimplementedHandler) GetCatalog(ctx context.Context) (r GetCatalogRes, _ error) {
+func (UnimplementedHandler) GetCatalog(ctx context.Context, params GetCatalogParams) (r GetCatalogRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetCatalogCategory implements getCatalogCategory operation.
+//
+// Get a catalog category.
+//
+// GET /api/v1/catalog/categories/{id}
+func (UnimplementedHandler) GetCatalogCategory(ctx context.Context, params GetCatalogCategoryParams) (r GetCatalogCategoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetCatalogChangeset implements getCatalogChangeset operation.
+//
+// Get a catalog changeset with its drafts (admin only).
+//
+// GET /api/v1/catalog/changesets/{id}
+func (UnimplementedHandler) GetCatalogChangeset(ctx context.Context, params GetCatalogChangesetParams) (r GetCatalogChangesetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetCatalogItem implements getCatalogItem operation.
+//
+// Get a catalog item.
+//
+// GET /api/v1/catalog/{id}
+func (UnimplementedHandler) GetCatalogItem(ctx context.Context, params GetCatalogItemParams) (r GetCatalogItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetCatalogReview implements getCatalogReview operation.
+//
+// Returns the review whatever its moderation status.
+//
+// GET /api/v1/catalog/{id}/review
+func (UnimplementedHandler) GetCatalogReview(ctx context.Context, params GetCatalogReviewParams) (r GetCatalogReviewRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetCatalogV2 implements getCatalogV2 operation.
+//
+// Keyset pagination: pass next_cursor from the previous response as cursor to get the following page,
+//
+//	keeping the other parameters unchanged. next_cursor is omitted on the last page.
+//
+// GET /api/v2/catalog
+func (UnimplementedHandler) GetCatalogV2(ctx context.Context, params GetCatalogV2Params) (r GetCatalogV2Res, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetData implements getData operation.
+//
+// Get the current value of a data key.
+//
+// GET /api/v1/data
+func (UnimplementedHandler) GetData(ctx context.Context, params GetDataParams) (r GetDataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetDataUsage implements getDataUsage operation.
+//
+// Get the storage used by the current user and their quota.
+//
+// GET /api/v1/data/usage
+func (UnimplementedHandler) GetDataUsage(ctx context.Context) (r GetDataUsageRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -31,6 +286,168 @@ func (UnimplementedHandler) GetMe(ctx context.Context) (r GetMeRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetWebhookEndpoint implements getWebhookEndpoint operation.
+//
+// Get a webhook endpoint (admin only).
+//
+// GET /api/v1/webhooks/{id}
+func (UnimplementedHandler) GetWebhookEndpoint(ctx context.Context, params GetWebhookEndpointParams) (r GetWebhookEndpointRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ImportCatalog implements importCatalog operation.
+//
+// Rows are matched to items by their external SKU. Every row is validated first; rows with errors,
+// duplicate SKUs or unknown category paths are listed in the report and nothing is written then.
+// Otherwise all rows are applied in one transaction. CSV files have a header with a sku column and
+// any of action, title, description, disabled, tags (separated by "|") and category; JSON files are
+// an array of objects with the same fields, tags being an array. action is upsert (the default) or
+// delete.
+//
+// POST /api/v1/catalog:import
+func (UnimplementedHandler) ImportCatalog(ctx context.Context, req ImportCatalogReq, params ImportCatalogParams) (r ImportCatalogRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ImportData implements importData operation.
+//
+// Import data entries from an NDJSON or CSV stream.
+//
+// POST /api/v1/data:import
+func (UnimplementedHandler) ImportData(ctx context.Context, req ImportDataReq, params ImportDataParams) (r ImportDataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListAttachments implements listAttachments operation.
+//
+// Files are uploaded with a streamed multipart POST to this path and downloaded from
+// /api/v1/data/attachments/{id}/content; both are served outside this contract.
+//
+// GET /api/v1/data/attachments
+func (UnimplementedHandler) ListAttachments(ctx context.Context, params ListAttachmentsParams) (r ListAttachmentsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListCatalogCategories implements listCatalogCategories operation.
+//
+// Returns the whole category tree as a flat list ordered by path.
+//
+// GET /api/v1/catalog/categories
+func (UnimplementedHandler) ListCatalogCategories(ctx context.Context) (r ListCatalogCategoriesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListCatalogChangesets implements listCatalogChangesets operation.
+//
+// Newest first. Drafts are only returned for a single changeset.
+//
+// GET /api/v1/catalog/changesets
+func (UnimplementedHandler) ListCatalogChangesets(ctx context.Context) (r ListCatalogChangesetsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListCatalogFavorites implements listCatalogFavorites operation.
+//
+// Most recently added first. Keyset pagination like GET /api/v2/catalog: pass next_cursor from
+// the previous response as cursor to get the following page.
+//
+// GET /api/v1/catalog/favorites
+func (UnimplementedHandler) ListCatalogFavorites(ctx context.Context, params ListCatalogFavoritesParams) (r ListCatalogFavoritesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListCatalogReviews implements listCatalogReviews operation.
+//
+// Newest first.
+//
+// GET /api/v1/catalog/{id}/reviews
+func (UnimplementedHandler) ListCatalogReviews(ctx context.Context, params ListCatalogReviewsParams) (r ListCatalogReviewsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListCatalogReviewsByStatus implements listCatalogReviewsByStatus operation.
+//
+// Reviews of all items with the given status, least recently changed first.
+//
+// GET /api/v1/catalog/reviews
+func (UnimplementedHandler) ListCatalogReviewsByStatus(ctx context.Context, params ListCatalogReviewsByStatusParams) (r ListCatalogReviewsByStatusRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListCatalogTags implements listCatalogTags operation.
+//
+// List catalog tags.
+//
+// GET /api/v1/catalog/tags
+func (UnimplementedHandler) ListCatalogTags(ctx context.Context) (r ListCatalogTagsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListCatalogTranslations implements listCatalogTranslations operation.
+//
+// List the translations of a catalog item (admin only).
+//
+// GET /api/v1/catalog/{id}/translations
+func (UnimplementedHandler) ListCatalogTranslations(ctx context.Context, params ListCatalogTranslationsParams) (r ListCatalogTranslationsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListDataSchemas implements listDataSchemas operation.
+//
+// List JSON Schemas registered for data key prefixes.
+//
+// GET /api/v1/data/schemas
+func (UnimplementedHandler) ListDataSchemas(ctx context.Context) (r ListDataSchemasRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListDeletedCatalogItems implements listDeletedCatalogItems operation.
+//
+// Most recently deleted first. Items are purged once deleted longer than the configured retention
+// period.
+//
+// GET /api/v1/catalog/deleted
+func (UnimplementedHandler) ListDeletedCatalogItems(ctx context.Context, params ListDeletedCatalogItemsParams) (r ListDeletedCatalogItemsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListDeletedData implements listDeletedData operation.
+//
+// The last value of every deleted key that has not been written again, most recently deleted first.
+// Keys are purged once deleted longer than the configured retention period.
+//
+// GET /api/v1/data/deleted
+func (UnimplementedHandler) ListDeletedData(ctx context.Context, params ListDeletedDataParams) (r ListDeletedDataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListRecentlyViewedCatalogItems implements listRecentlyViewedCatalogItems operation.
+//
+// Most recently viewed first. Views are recorded with POST /api/v1/catalog/{id}/views.
+//
+// GET /api/v1/catalog/recently-viewed
+func (UnimplementedHandler) ListRecentlyViewedCatalogItems(ctx context.Context, params ListRecentlyViewedCatalogItemsParams) (r ListRecentlyViewedCatalogItemsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhookDeliveries implements listWebhookDeliveries operation.
+//
+// Newest first. Each delivery shows the outcome of its latest attempt.
+//
+// GET /api/v1/webhooks/{id}/deliveries
+func (UnimplementedHandler) ListWebhookDeliveries(ctx context.Context, params ListWebhookDeliveriesParams) (r ListWebhookDeliveriesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhookEndpoints implements listWebhookEndpoints operation.
+//
+// List webhook endpoints (admin only).
+//
+// GET /api/v1/webhooks
+func (UnimplementedHandler) ListWebhookEndpoints(ctx context.Context) (r ListWebhookEndpointsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // Login implements login operation.
 //
 // Authenticate user.
@@ -57,3 +474,228 @@ func (UnimplementedHandler) Logout(ctx context.Context) (r LogoutRes, _ error) {
 func (UnimplementedHandler) PostData(ctx context.Context, req *DataRequest) (r PostDataRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// PreviewCatalogChangeset implements previewCatalogChangeset operation.
+//
+// Returns all catalog items as they would be after publishing, ordered by title.
+//
+// GET /api/v1/catalog/changesets/{id}/preview
+func (UnimplementedHandler) PreviewCatalogChangeset(ctx context.Context, params PreviewCatalogChangesetParams) (r PreviewCatalogChangesetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PublishCatalogChangeset implements publishCatalogChangeset operation.
+//
+// Applies all drafts in one transaction. Nothing is applied if the changeset is not a draft or an
+// item changed after its draft was staged.
+//
+// POST /api/v1/catalog/changesets/{id}/publish
+func (UnimplementedHandler) PublishCatalogChangeset(ctx context.Context, params PublishCatalogChangesetParams) (r PublishCatalogChangesetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PutCatalogDraft implements putCatalogDraft operation.
+//
+// Replaces an earlier draft of the same item. Drafts of existing items are based on the item's
+// current version; publishing fails if the item changes before then.
+//
+// PUT /api/v1/catalog/changesets/{id}/items/{item_id}
+func (UnimplementedHandler) PutCatalogDraft(ctx context.Context, req *CatalogDraftRequest, params PutCatalogDraftParams) (r PutCatalogDraftRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PutCatalogTranslation implements putCatalogTranslation operation.
+//
+// The default locale is not translated; its text is the item's own title and description.
+//
+// PUT /api/v1/catalog/{id}/translations/{locale}
+func (UnimplementedHandler) PutCatalogTranslation(ctx context.Context, req *CatalogTranslationRequest, params PutCatalogTranslationParams) (r PutCatalogTranslationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PutDataSchema implements putDataSchema operation.
+//
+// Register or replace the JSON Schema for a data key prefix.
+//
+// PUT /api/v1/data/schemas
+func (UnimplementedHandler) PutDataSchema(ctx context.Context, req *DataSchemaRequest) (r PutDataSchemaRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RecordCatalogView implements recordCatalogView operation.
+//
+// Moves the item to the front of the recently viewed list of the user. Only the most recent views
+// are kept, as configured by catalog.recently_viewed_limit.
+//
+// POST /api/v1/catalog/{id}/views
+func (UnimplementedHandler) RecordCatalogView(ctx context.Context, params RecordCatalogViewParams) (r RecordCatalogViewRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RedeliverWebhook implements redeliverWebhook operation.
+//
+// Queues the delivery with a fresh retry schedule, whatever its status. Deliveries to a disabled
+// endpoint wait until it is enabled again.
+//
+// POST /api/v1/webhooks/deliveries/{delivery_id}/redeliver
+func (UnimplementedHandler) RedeliverWebhook(ctx context.Context, params RedeliverWebhookParams) (r RedeliverWebhookRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReleaseCatalogReservation implements releaseCatalogReservation operation.
+//
+// Returns the reserved units to stock. Releasing is idempotent: a reservation that expired, was
+// already released or does not exist is reported as released as well.
+//
+// POST /api/v1/catalog/{id}/release
+func (UnimplementedHandler) ReleaseCatalogReservation(ctx context.Context, req *CatalogReleaseRequest, params ReleaseCatalogReservationParams) (r ReleaseCatalogReservationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RemoveCatalogFavorite implements removeCatalogFavorite operation.
+//
+// Remove a catalog item from the favorites of the session user.
+//
+// DELETE /api/v1/catalog/{id}/favorite
+func (UnimplementedHandler) RemoveCatalogFavorite(ctx context.Context, params RemoveCatalogFavoriteParams) (r RemoveCatalogFavoriteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RenameCatalogTag implements renameCatalogTag operation.
+//
+// The tag is renamed on every item carrying it.
+//
+// PUT /api/v1/catalog/tags/{name}
+func (UnimplementedHandler) RenameCatalogTag(ctx context.Context, req *CatalogTagRequest, params RenameCatalogTagParams) (r RenameCatalogTagRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReserveCatalogItem implements reserveCatalogItem operation.
+//
+// Holds `quantity` units of an enabled item with tracked stock for the session user until the
+// reservation expires or is released. Concurrent reservations are serialized on the item, so stock
+// is never oversold.
+//
+// POST /api/v1/catalog/{id}/reserve
+func (UnimplementedHandler) ReserveCatalogItem(ctx context.Context, req *CatalogReservationRequest, params ReserveCatalogItemParams) (r ReserveCatalogItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreCatalogItem implements restoreCatalogItem operation.
+//
+// Restore a deleted catalog item (admin only).
+//
+// POST /api/v1/catalog/{id}/restore
+func (UnimplementedHandler) RestoreCatalogItem(ctx context.Context, params RestoreCatalogItemParams) (r RestoreCatalogItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreData implements restoreData operation.
+//
+// Restore a deleted data key (admin only).
+//
+// POST /api/v1/data:restore
+func (UnimplementedHandler) RestoreData(ctx context.Context, params RestoreDataParams) (r RestoreDataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RollbackCatalogChangeset implements rollbackCatalogChangeset operation.
+//
+// Restores every item the changeset touched to its version before publishing, in one transaction.
+// Nothing is restored if one of the items changed after publishing.
+//
+// POST /api/v1/catalog/changesets/{id}/rollback
+func (UnimplementedHandler) RollbackCatalogChangeset(ctx context.Context, params RollbackCatalogChangesetParams) (r RollbackCatalogChangesetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SaveCatalogReview implements saveCatalogReview operation.
+//
+// Creates the review of the session user or replaces its rating and text; a user has one review per
+// item. When reviews require approval, the saved review is pending until an admin approves it. An
+// edited rejected review is always pending again.
+//
+// PUT /api/v1/catalog/{id}/review
+func (UnimplementedHandler) SaveCatalogReview(ctx context.Context, req *CatalogReviewRequest, params SaveCatalogReviewParams) (r SaveCatalogReviewRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SearchCatalog implements searchCatalog operation.
+//
+// Full-text search over titles and descriptions, best matches first. q supports web search syntax:
+// "quoted phrases", or, and -excluded words. When nothing matches, titles with words similar to the
+// query are returned instead and fuzzy is true. Highlights are HTML-escaped with matched words
+// wrapped in <mark> elements.
+//
+// GET /api/v1/catalog/search
+func (UnimplementedHandler) SearchCatalog(ctx context.Context, params SearchCatalogParams) (r SearchCatalogRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SetCatalogInventory implements setCatalogInventory operation.
+//
+// Replaces the price and stock quantity of an item. Omitting `price` removes the price; omitting
+// `stock_quantity` stops tracking stock, which makes the item always available while enabled but
+// impossible to reserve.
+//
+// PUT /api/v1/catalog/{id}/inventory
+func (UnimplementedHandler) SetCatalogInventory(ctx context.Context, req *CatalogInventoryRequest, params SetCatalogInventoryParams) (r SetCatalogInventoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SetCatalogItemDisabled implements setCatalogItemDisabled operation.
+//
+// Enable or disable a catalog item (admin only).
+//
+// PUT /api/v1/catalog/{id}/disabled
+func (UnimplementedHandler) SetCatalogItemDisabled(ctx context.Context, req *CatalogItemDisabledRequest, params SetCatalogItemDisabledParams) (r SetCatalogItemDisabledRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SetCatalogReviewStatus implements setCatalogReviewStatus operation.
+//
+// Only approved reviews are listed and count towards the rating of their item. Changing the status
+// updates the rating totals of the item right away.
+//
+// PUT /api/v1/catalog/reviews/{review_id}/status
+func (UnimplementedHandler) SetCatalogReviewStatus(ctx context.Context, req *CatalogReviewStatusRequest, params SetCatalogReviewStatusParams) (r SetCatalogReviewStatusRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SetMyLocale implements setMyLocale operation.
+//
+// Localized content is served in this locale when available, regardless of the Accept-Language
+// header. An empty locale clears the setting.
+//
+// PUT /api/v1/auth/me/locale
+func (UnimplementedHandler) SetMyLocale(ctx context.Context, req *UserLocaleRequest) (r SetMyLocaleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateCatalogCategory implements updateCatalogCategory operation.
+//
+// Subcategories and items move along; their paths change accordingly.
+//
+// PUT /api/v1/catalog/categories/{id}
+func (UnimplementedHandler) UpdateCatalogCategory(ctx context.Context, req *CatalogCategoryRequest, params UpdateCatalogCategoryParams) (r UpdateCatalogCategoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateCatalogItem implements updateCatalogItem operation.
+//
+// Replace a catalog item (admin only).
+//
+// PUT /api/v1/catalog/{id}
+func (UnimplementedHandler) UpdateCatalogItem(ctx context.Context, req *CatalogItemRequest, params UpdateCatalogItemParams) (r UpdateCatalogItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateWebhookEndpoint implements updateWebhookEndpoint operation.
+//
+// The secret is kept unless a new one is given. Enabling an endpoint that was disabled after
+// repeated failures clears its failure count and resumes its pending deliveries.
+//
+// PUT /api/v1/webhooks/{id}
+func (UnimplementedHandler) UpdateWebhookEndpoint(ctx context.Context, req *WebhookEndpointRequest, params UpdateWebhookEndpointParams) (r UpdateWebhookEndpointRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
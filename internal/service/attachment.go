@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"time"
+
+	"base_app/internal/entity"
+	"base_app/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentService acts as a domain service for attachments.
+// Metadata lives in the repository, content in the blob store.
+type AttachmentService struct {
+	attachmentRepo usecase.AttachmentRepo
+	blobs          usecase.BlobStore
+	log            *slog.Logger
+}
+
+func NewAttachmentService(attachmentRepo usecase.AttachmentRepo, blobs usecase.BlobStore, log *slog.Logger) *AttachmentService {
+	return &AttachmentService{
+		attachmentRepo: attachmentRepo,
+		blobs:          blobs,
+		log:            log,
+	}
+}
+
+func (s *AttachmentService) DataKeyExists(ctx context.Context, key string) (bool, error) {
+	return s.attachmentRepo.DataKeyExists(ctx, key)
+}
+
+func (s *AttachmentService) CreateAttachment(ctx context.Context, att *entity.Attachment, verify func() error) error {
+	return s.attachmentRepo.CreateAttachment(ctx, att, verify)
+}
+
+func (s *AttachmentService) GetAttachment(ctx context.Context, id uuid.UUID) (*entity.Attachment, error) {
+	return s.attachmentRepo.GetAttachment(ctx, id)
+}
+
+func (s *AttachmentService) ListAttachments(ctx context.Context, key string) ([]entity.Attachment, error) {
+	return s.attachmentRepo.ListAttachments(ctx, key)
+}
+
+func (s *AttachmentService) DeleteAttachment(ctx context.Context, id uuid.UUID) error {
+	return s.attachmentRepo.DeleteAttachment(ctx, id)
+}
+
+func (s *AttachmentService) DeleteDanglingAttachments(ctx context.Context) (int64, error) {
+	return s.attachmentRepo.DeleteDanglingAttachments(ctx)
+}
+
+func (s *AttachmentService) DeleteUnusedBlobs(ctx context.Context, olderThan time.Time, limit int32, remove func(digests []string) error) (int, error) {
+	return s.attachmentRepo.DeleteUnusedBlobs(ctx, olderThan, limit, remove)
+}
+
+func (s *AttachmentService) BlobExists(ctx context.Context, digest string) (bool, error) {
+	return s.attachmentRepo.BlobExists(ctx, digest)
+}
+
+func (s *AttachmentService) PutBlob(ctx context.Context, r io.Reader) (string, int64, error) {
+	return s.blobs.Put(ctx, r)
+}
+
+func (s *AttachmentService) OpenBlob(ctx context.Context, digest string) (io.ReadCloser, error) {
+	return s.blobs.Open(ctx, digest)
+}
+
+func (s *AttachmentService) BlobContentExists(ctx context.Context, digest string) (bool, error) {
+	return s.blobs.Exists(ctx, digest)
+}
+
+func (s *AttachmentService) DeleteBlob(ctx context.Context, digest string) error {
+	return s.blobs.Delete(ctx, digest)
+}
+
+func (s *AttachmentService) WalkBlobs(ctx context.Context, fn func(digest string, modTime time.Time) error) error {
+	return s.blobs.Walk(ctx, fn)
+}
@@ -5,6 +5,8 @@ import (
 	"base_app/internal/entity"
 	"base_app/internal/usecase" // To get the repo interface
 	"log/slog"
+
+	"github.com/google/uuid"
 )
 
 // AuthService acts as a domain service for authentication.
@@ -25,3 +27,7 @@ func NewAuthService(userRepo usecase.UserRepo, log *slog.Logger) *AuthService {
 func (s *AuthService) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
 	return s.userRepo.GetUserByEmail(ctx, email)
 }
+
+func (s *AuthService) SetUserLocale(ctx context.Context, id uuid.UUID, locale string) error {
+	return s.userRepo.SetUserLocale(ctx, id, locale)
+}
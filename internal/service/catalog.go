@@ -3,9 +3,12 @@ package service
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"base_app/internal/entity"
 	"base_app/internal/usecase"
+
+	"github.com/google/uuid"
 )
 
 // CatalogService acts as a domain service for catalog operations.
@@ -22,8 +25,273 @@ func NewCatalogService(repo usecase.CatalogRepo, log *slog.Logger) *CatalogServi
 	}
 }
 
-// GetCatalogItems retrieves all catalog items.
-func (s *CatalogService) GetCatalogItems(ctx context.Context) ([]entity.CatalogItem, error) {
+// GetCatalogItems retrieves all catalog items, optionally limited to a category subtree.
+func (s *CatalogService) GetCatalogItems(ctx context.Context, category string) ([]entity.CatalogItem, error) {
 	// In a real app, more complex domain logic could go here.
-	return s.catalogRepo.GetCatalogItems(ctx)
+	return s.catalogRepo.GetCatalogItems(ctx, category)
+}
+
+// ListCatalogItems retrieves a filtered, ordered page of catalog items.
+func (s *CatalogService) ListCatalogItems(ctx context.Context, q entity.CatalogQuery) ([]entity.CatalogItem, error) {
+	return s.catalogRepo.ListCatalogItems(ctx, q)
+}
+
+// GetCatalogItem retrieves a catalog item by id.
+func (s *CatalogService) GetCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error) {
+	return s.catalogRepo.GetCatalogItem(ctx, id)
+}
+
+// CreateCatalogItem creates a catalog item.
+func (s *CatalogService) CreateCatalogItem(ctx context.Context, item *entity.CatalogItem) error {
+	return s.catalogRepo.CreateCatalogItem(ctx, item)
+}
+
+// UpdateCatalogItem updates a catalog item.
+func (s *CatalogService) UpdateCatalogItem(ctx context.Context, item *entity.CatalogItem) error {
+	return s.catalogRepo.UpdateCatalogItem(ctx, item)
+}
+
+// SetCatalogItemDisabled enables or disables a catalog item.
+func (s *CatalogService) SetCatalogItemDisabled(ctx context.Context, id uuid.UUID, disabled bool) (*entity.CatalogItem, error) {
+	return s.catalogRepo.SetCatalogItemDisabled(ctx, id, disabled)
+}
+
+// DeleteCatalogItem soft deletes a catalog item.
+func (s *CatalogService) DeleteCatalogItem(ctx context.Context, id uuid.UUID) error {
+	return s.catalogRepo.DeleteCatalogItem(ctx, id)
+}
+
+// ListDeletedCatalogItems retrieves deleted catalog items, most recently deleted first.
+func (s *CatalogService) ListDeletedCatalogItems(ctx context.Context, limit int32) ([]entity.CatalogItem, error) {
+	return s.catalogRepo.ListDeletedCatalogItems(ctx, limit)
+}
+
+// RestoreCatalogItem undeletes a catalog item.
+func (s *CatalogService) RestoreCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error) {
+	return s.catalogRepo.RestoreCatalogItem(ctx, id)
+}
+
+// PurgeDeletedCatalogItems permanently removes catalog items deleted before cutoff.
+func (s *CatalogService) PurgeDeletedCatalogItems(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error) {
+	return s.catalogRepo.PurgeDeletedCatalogItems(ctx, cutoff, batchSize)
+}
+
+// SearchCatalogItems runs a full-text catalog search.
+func (s *CatalogService) SearchCatalogItems(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error) {
+	return s.catalogRepo.SearchCatalogItems(ctx, q)
+}
+
+// SearchCatalogItemsFuzzy runs a typo-tolerant search over catalog titles.
+func (s *CatalogService) SearchCatalogItemsFuzzy(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error) {
+	return s.catalogRepo.SearchCatalogItemsFuzzy(ctx, q)
+}
+
+// SetCatalogSearchLanguage re-indexes catalog items with another text search configuration.
+func (s *CatalogService) SetCatalogSearchLanguage(ctx context.Context, language string) (int64, error) {
+	return s.catalogRepo.SetCatalogSearchLanguage(ctx, language)
+}
+
+// ListCatalogCategories retrieves all catalog categories.
+func (s *CatalogService) ListCatalogCategories(ctx context.Context) ([]entity.CatalogCategory, error) {
+	return s.catalogRepo.ListCatalogCategories(ctx)
+}
+
+// GetCatalogCategory retrieves a catalog category by id.
+func (s *CatalogService) GetCatalogCategory(ctx context.Context, id uuid.UUID) (*entity.CatalogCategory, error) {
+	return s.catalogRepo.GetCatalogCategory(ctx, id)
+}
+
+// CreateCatalogCategory creates a catalog category.
+func (s *CatalogService) CreateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error {
+	return s.catalogRepo.CreateCatalogCategory(ctx, category)
+}
+
+// UpdateCatalogCategory renames or moves a catalog category.
+func (s *CatalogService) UpdateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error {
+	return s.catalogRepo.UpdateCatalogCategory(ctx, category)
+}
+
+// DeleteCatalogCategory deletes a catalog category.
+func (s *CatalogService) DeleteCatalogCategory(ctx context.Context, id uuid.UUID) error {
+	return s.catalogRepo.DeleteCatalogCategory(ctx, id)
+}
+
+// ListCatalogTags retrieves all catalog tags.
+func (s *CatalogService) ListCatalogTags(ctx context.Context) ([]entity.CatalogTag, error) {
+	return s.catalogRepo.ListCatalogTags(ctx)
+}
+
+// CreateCatalogTag creates a catalog tag.
+func (s *CatalogService) CreateCatalogTag(ctx context.Context, name string) error {
+	return s.catalogRepo.CreateCatalogTag(ctx, name)
+}
+
+// RenameCatalogTag renames a catalog tag.
+func (s *CatalogService) RenameCatalogTag(ctx context.Context, name, newName string) (*entity.CatalogTag, error) {
+	return s.catalogRepo.RenameCatalogTag(ctx, name, newName)
+}
+
+// DeleteCatalogTag deletes a catalog tag.
+func (s *CatalogService) DeleteCatalogTag(ctx context.Context, name string) error {
+	return s.catalogRepo.DeleteCatalogTag(ctx, name)
+}
+
+// ListCatalogChangesets retrieves all catalog changesets.
+func (s *CatalogService) ListCatalogChangesets(ctx context.Context) ([]entity.CatalogChangeset, error) {
+	return s.catalogRepo.ListCatalogChangesets(ctx)
+}
+
+// GetCatalogChangeset retrieves a catalog changeset with its drafts.
+func (s *CatalogService) GetCatalogChangeset(ctx context.Context, id uuid.UUID) (*entity.CatalogChangeset, error) {
+	return s.catalogRepo.GetCatalogChangeset(ctx, id)
+}
+
+// CreateCatalogChangeset creates a catalog changeset.
+func (s *CatalogService) CreateCatalogChangeset(ctx context.Context, changeset *entity.CatalogChangeset) error {
+	return s.catalogRepo.CreateCatalogChangeset(ctx, changeset)
+}
+
+// DeleteCatalogChangeset discards a draft catalog changeset.
+func (s *CatalogService) DeleteCatalogChangeset(ctx context.Context, id uuid.UUID) error {
+	return s.catalogRepo.DeleteCatalogChangeset(ctx, id)
+}
+
+// SaveCatalogDraft stages a draft in a catalog changeset.
+func (s *CatalogService) SaveCatalogDraft(ctx context.Context, changesetID uuid.UUID, draft *entity.CatalogDraft) error {
+	return s.catalogRepo.SaveCatalogDraft(ctx, changesetID, draft)
+}
+
+// DeleteCatalogDraft removes a draft from a catalog changeset.
+func (s *CatalogService) DeleteCatalogDraft(ctx context.Context, changesetID, itemID uuid.UUID) error {
+	return s.catalogRepo.DeleteCatalogDraft(ctx, changesetID, itemID)
+}
+
+// PublishCatalogChangeset applies a catalog changeset.
+func (s *CatalogService) PublishCatalogChangeset(ctx context.Context, id, publishedBy uuid.UUID, language string) (*entity.CatalogChangeset, error) {
+	return s.catalogRepo.PublishCatalogChangeset(ctx, id, publishedBy, language)
+}
+
+// RollbackCatalogChangeset undoes a published catalog changeset.
+func (s *CatalogService) RollbackCatalogChangeset(ctx context.Context, id, rolledBackBy uuid.UUID, language string) (*entity.CatalogChangeset, error) {
+	return s.catalogRepo.RollbackCatalogChangeset(ctx, id, rolledBackBy, language)
+}
+
+// ListCatalogTranslations retrieves the translations of a catalog item.
+func (s *CatalogService) ListCatalogTranslations(ctx context.Context, itemID uuid.UUID) ([]entity.CatalogTranslation, error) {
+	return s.catalogRepo.ListCatalogTranslations(ctx, itemID)
+}
+
+// ListCatalogTranslationsForItems retrieves the translations of catalog items into some locales.
+func (s *CatalogService) ListCatalogTranslationsForItems(ctx context.Context, itemIDs []uuid.UUID, locales []string) ([]entity.CatalogTranslation, error) {
+	return s.catalogRepo.ListCatalogTranslationsForItems(ctx, itemIDs, locales)
+}
+
+// SaveCatalogTranslation creates or replaces a catalog item translation.
+func (s *CatalogService) SaveCatalogTranslation(ctx context.Context, t *entity.CatalogTranslation) error {
+	return s.catalogRepo.SaveCatalogTranslation(ctx, t)
+}
+
+// DeleteCatalogTranslation deletes a catalog item translation.
+func (s *CatalogService) DeleteCatalogTranslation(ctx context.Context, itemID uuid.UUID, locale string) error {
+	return s.catalogRepo.DeleteCatalogTranslation(ctx, itemID, locale)
+}
+
+// ImportCatalogItems applies validated catalog import rows.
+func (s *CatalogService) ImportCatalogItems(ctx context.Context, rows []entity.CatalogImportRow, opts entity.CatalogImportOptions, language string) (*entity.CatalogImportResult, error) {
+	return s.catalogRepo.ImportCatalogItems(ctx, rows, opts, language)
+}
+
+// CreateCatalogImage records an image of a catalog item.
+func (s *CatalogService) CreateCatalogImage(ctx context.Context, img *entity.CatalogImage) error {
+	return s.catalogRepo.CreateCatalogImage(ctx, img)
+}
+
+// GetCatalogImage retrieves a catalog image by id.
+func (s *CatalogService) GetCatalogImage(ctx context.Context, id uuid.UUID) (*entity.CatalogImage, error) {
+	return s.catalogRepo.GetCatalogImage(ctx, id)
+}
+
+// DeleteCatalogImage deletes the record of a catalog item image.
+func (s *CatalogService) DeleteCatalogImage(ctx context.Context, itemID, id uuid.UUID) error {
+	return s.catalogRepo.DeleteCatalogImage(ctx, itemID, id)
+}
+
+// CatalogImageExists reports whether a catalog image is recorded.
+func (s *CatalogService) CatalogImageExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	return s.catalogRepo.CatalogImageExists(ctx, id)
+}
+
+// AddCatalogFavorite marks a catalog item as a favorite of a user.
+func (s *CatalogService) AddCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error {
+	return s.catalogRepo.AddCatalogFavorite(ctx, userID, itemID)
+}
+
+// DeleteCatalogFavorite unmarks a favorite catalog item of a user.
+func (s *CatalogService) DeleteCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error {
+	return s.catalogRepo.DeleteCatalogFavorite(ctx, userID, itemID)
+}
+
+// ListCatalogFavorites retrieves one page of the favorite catalog items of a user.
+func (s *CatalogService) ListCatalogFavorites(ctx context.Context, userID uuid.UUID, after *entity.CatalogFavoriteCursor, limit int) ([]entity.CatalogFavorite, error) {
+	return s.catalogRepo.ListCatalogFavorites(ctx, userID, after, limit)
+}
+
+// ListCatalogFavoriteIDs returns those of itemIDs that are favorites of a user.
+func (s *CatalogService) ListCatalogFavoriteIDs(ctx context.Context, userID uuid.UUID, itemIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return s.catalogRepo.ListCatalogFavoriteIDs(ctx, userID, itemIDs)
+}
+
+// RecordCatalogView records that a user viewed a catalog item, keeping the keep most recent views.
+func (s *CatalogService) RecordCatalogView(ctx context.Context, userID, itemID uuid.UUID, keep int) error {
+	return s.catalogRepo.RecordCatalogView(ctx, userID, itemID, keep)
+}
+
+// ListRecentlyViewedCatalogItems retrieves the catalog items a user viewed last.
+func (s *CatalogService) ListRecentlyViewedCatalogItems(ctx context.Context, userID uuid.UUID) ([]entity.CatalogItem, error) {
+	return s.catalogRepo.ListRecentlyViewedCatalogItems(ctx, userID)
+}
+
+// SetCatalogInventory replaces the price and stock of a catalog item.
+func (s *CatalogService) SetCatalogInventory(ctx context.Context, id uuid.UUID, price *entity.Money, stock *int64) (*entity.CatalogItem, error) {
+	return s.catalogRepo.SetCatalogInventory(ctx, id, price, stock)
+}
+
+// ReserveCatalogItem reserves stock of a catalog item.
+func (s *CatalogService) ReserveCatalogItem(ctx context.Context, res *entity.CatalogReservation) error {
+	return s.catalogRepo.ReserveCatalogItem(ctx, res)
+}
+
+// ReleaseCatalogReservation deletes a reservation of a catalog item made by a user.
+func (s *CatalogService) ReleaseCatalogReservation(ctx context.Context, itemID, reservationID, userID uuid.UUID) error {
+	return s.catalogRepo.ReleaseCatalogReservation(ctx, itemID, reservationID, userID)
+}
+
+// GetCatalogReview retrieves the review of a catalog item left by a user.
+func (s *CatalogService) GetCatalogReview(ctx context.Context, itemID, userID uuid.UUID) (*entity.CatalogReview, error) {
+	return s.catalogRepo.GetCatalogReview(ctx, itemID, userID)
+}
+
+// SaveCatalogReview creates or replaces the review of a catalog item left by a user.
+func (s *CatalogService) SaveCatalogReview(ctx context.Context, review *entity.CatalogReview) error {
+	return s.catalogRepo.SaveCatalogReview(ctx, review)
+}
+
+// DeleteCatalogReview deletes the review of a catalog item left by a user.
+func (s *CatalogService) DeleteCatalogReview(ctx context.Context, itemID, userID uuid.UUID) error {
+	return s.catalogRepo.DeleteCatalogReview(ctx, itemID, userID)
+}
+
+// SetCatalogReviewStatus moderates a catalog review.
+func (s *CatalogService) SetCatalogReviewStatus(ctx context.Context, id uuid.UUID, status entity.CatalogReviewStatus) (*entity.CatalogReview, error) {
+	return s.catalogRepo.SetCatalogReviewStatus(ctx, id, status)
+}
+
+// ListCatalogReviews retrieves reviews of a catalog item with the given status.
+func (s *CatalogService) ListCatalogReviews(ctx context.Context, itemID uuid.UUID, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error) {
+	return s.catalogRepo.ListCatalogReviews(ctx, itemID, status, limit)
+}
+
+// ListCatalogReviewsByStatus retrieves reviews of all catalog items with the given status.
+func (s *CatalogService) ListCatalogReviewsByStatus(ctx context.Context, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error) {
+	return s.catalogRepo.ListCatalogReviewsByStatus(ctx, status, limit)
 }
@@ -5,17 +5,22 @@ import (
 	"base_app/internal/entity"
 	"base_app/internal/usecase"
 	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 // DataService acts as a domain service for data operations.
 type DataService struct {
 	dataRepo usecase.DataRepo
+	dataFeed usecase.DataChangeFeed
 	log      *slog.Logger
 }
 
-func NewDataService(dataRepo usecase.DataRepo, log *slog.Logger) *DataService {
+func NewDataService(dataRepo usecase.DataRepo, dataFeed usecase.DataChangeFeed, log *slog.Logger) *DataService {
 	return &DataService{
 		dataRepo: dataRepo,
+		dataFeed: dataFeed,
 		log:      log,
 	}
 }
@@ -24,3 +29,67 @@ func (s *DataService) SaveData(ctx context.Context, data *entity.Data) error {
 	// In a real app, more complex domain logic could go here.
 	return s.dataRepo.SaveData(ctx, data)
 }
+
+func (s *DataService) LockDataUsage(ctx context.Context, ownerID uuid.UUID, excludeKeys []string) (*entity.DataUsage, error) {
+	return s.dataRepo.LockDataUsage(ctx, ownerID, excludeKeys)
+}
+
+func (s *DataService) GetDataUsage(ctx context.Context, ownerID uuid.UUID) (*entity.DataUsage, error) {
+	return s.dataRepo.GetDataUsage(ctx, ownerID)
+}
+
+func (s *DataService) GetData(ctx context.Context, key string) (*entity.Data, error) {
+	return s.dataRepo.GetData(ctx, key)
+}
+
+func (s *DataService) PurgeExpiredData(ctx context.Context, batchSize int32) (int64, error) {
+	return s.dataRepo.PurgeExpiredData(ctx, batchSize)
+}
+
+func (s *DataService) DeleteData(ctx context.Context, key string) error {
+	return s.dataRepo.DeleteData(ctx, key)
+}
+
+func (s *DataService) ListDeletedData(ctx context.Context, limit int32) ([]entity.Data, error) {
+	return s.dataRepo.ListDeletedData(ctx, limit)
+}
+
+func (s *DataService) RestoreData(ctx context.Context, key string) (*entity.Data, error) {
+	return s.dataRepo.RestoreData(ctx, key)
+}
+
+func (s *DataService) PurgeDeletedData(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error) {
+	return s.dataRepo.PurgeDeletedData(ctx, cutoff, batchSize)
+}
+
+func (s *DataService) BeginDataImport(ctx context.Context) (usecase.DataImportTx, error) {
+	return s.dataRepo.BeginDataImport(ctx)
+}
+
+func (s *DataService) ExportData(ctx context.Context, fn func(*entity.Data) error) error {
+	return s.dataRepo.ExportData(ctx, fn)
+}
+
+func (s *DataService) ListDataChanges(ctx context.Context, afterID int64, prefix string, limit int32) ([]entity.DataEvent, error) {
+	return s.dataRepo.ListDataChanges(ctx, afterID, prefix, limit)
+}
+
+func (s *DataService) SubscribeDataChanges(ctx context.Context, prefix string) <-chan entity.DataEvent {
+	return s.dataFeed.Subscribe(ctx, prefix)
+}
+
+func (s *DataService) GetDataSchemaForKey(ctx context.Context, key string) (*entity.DataSchema, error) {
+	return s.dataRepo.GetDataSchemaForKey(ctx, key)
+}
+
+func (s *DataService) ListDataSchemas(ctx context.Context) ([]entity.DataSchema, error) {
+	return s.dataRepo.ListDataSchemas(ctx)
+}
+
+func (s *DataService) SaveDataSchema(ctx context.Context, schema *entity.DataSchema) error {
+	return s.dataRepo.SaveDataSchema(ctx, schema)
+}
+
+func (s *DataService) DeleteDataSchema(ctx context.Context, prefix string) error {
+	return s.dataRepo.DeleteDataSchema(ctx, prefix)
+}
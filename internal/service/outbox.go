@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"base_app/internal/entity"
+	"base_app/internal/usecase"
+)
+
+// OutboxService acts as a domain service for the outbox.
+// Events are stored by the repository and published to the configured sinks.
+type OutboxService struct {
+	outboxRepo usecase.OutboxRepo
+	sinks      []usecase.EventSink
+	log        *slog.Logger
+}
+
+func NewOutboxService(outboxRepo usecase.OutboxRepo, sinks []usecase.EventSink, log *slog.Logger) *OutboxService {
+	return &OutboxService{
+		outboxRepo: outboxRepo,
+		sinks:      sinks,
+		log:        log,
+	}
+}
+
+func (s *OutboxService) RelayOutboxEvents(ctx context.Context, limit int32, publish func(events []entity.OutboxEvent) (int, error)) (int, error) {
+	return s.outboxRepo.RelayOutboxEvents(ctx, limit, publish)
+}
+
+func (s *OutboxService) PurgePublishedOutboxEvents(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error) {
+	return s.outboxRepo.PurgePublishedOutboxEvents(ctx, cutoff, batchSize)
+}
+
+// PublishOutboxEvent publishes event to the sinks in order and stops at the first failure.
+// Sinks before the failing one receive the event again on retry.
+func (s *OutboxService) PublishOutboxEvent(ctx context.Context, event entity.OutboxEvent) error {
+	for _, sink := range s.sinks {
+		if err := sink.Publish(ctx, event); err != nil {
+			return fmt.Errorf("sink %s: %w", sink.Name(), err)
+		}
+	}
+	return nil
+}
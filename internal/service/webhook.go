@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"base_app/internal/entity"
+	"base_app/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+// WebhookService acts as a domain service for outgoing webhooks.
+// Endpoints and deliveries live in the repository; the sender posts them.
+type WebhookService struct {
+	webhookRepo usecase.WebhookRepo
+	sender      usecase.WebhookSender
+	log         *slog.Logger
+}
+
+func NewWebhookService(webhookRepo usecase.WebhookRepo, sender usecase.WebhookSender, log *slog.Logger) *WebhookService {
+	return &WebhookService{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+		log:         log,
+	}
+}
+
+func (s *WebhookService) ListWebhookEndpoints(ctx context.Context) ([]entity.WebhookEndpoint, error) {
+	return s.webhookRepo.ListWebhookEndpoints(ctx)
+}
+
+func (s *WebhookService) GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*entity.WebhookEndpoint, error) {
+	return s.webhookRepo.GetWebhookEndpoint(ctx, id)
+}
+
+func (s *WebhookService) CreateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	return s.webhookRepo.CreateWebhookEndpoint(ctx, endpoint)
+}
+
+func (s *WebhookService) UpdateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	return s.webhookRepo.UpdateWebhookEndpoint(ctx, endpoint)
+}
+
+func (s *WebhookService) DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error {
+	return s.webhookRepo.DeleteWebhookEndpoint(ctx, id)
+}
+
+func (s *WebhookService) CreateWebhookDeliveries(ctx context.Context, event entity.OutboxEvent) (int64, error) {
+	return s.webhookRepo.CreateWebhookDeliveries(ctx, event)
+}
+
+func (s *WebhookService) ClaimDueWebhookDeliveries(ctx context.Context, leaseUntil time.Time, limit int32) ([]entity.WebhookDispatch, error) {
+	return s.webhookRepo.ClaimDueWebhookDeliveries(ctx, leaseUntil, limit)
+}
+
+func (s *WebhookService) RecordWebhookAttempt(ctx context.Context, delivery *entity.WebhookDelivery, disableAfter int) (bool, error) {
+	return s.webhookRepo.RecordWebhookAttempt(ctx, delivery, disableAfter)
+}
+
+func (s *WebhookService) GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	return s.webhookRepo.GetWebhookDelivery(ctx, id)
+}
+
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, endpointID uuid.UUID, status entity.WebhookDeliveryStatus, limit int32) ([]entity.WebhookDelivery, error) {
+	return s.webhookRepo.ListWebhookDeliveries(ctx, endpointID, status, limit)
+}
+
+func (s *WebhookService) RedeliverWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	return s.webhookRepo.RedeliverWebhookDelivery(ctx, id)
+}
+
+func (s *WebhookService) PurgeWebhookDeliveries(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error) {
+	return s.webhookRepo.PurgeWebhookDeliveries(ctx, cutoff, batchSize)
+}
+
+func (s *WebhookService) SendWebhook(ctx context.Context, dispatch entity.WebhookDispatch) (int, error) {
+	return s.sender.Send(ctx, dispatch.URL, dispatch.Secret, dispatch.WebhookDelivery)
+}
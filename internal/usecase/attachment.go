@@ -0,0 +1,284 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// sniffLen is the number of leading bytes inspected to detect the content type.
+	sniffLen = 512
+	// maxAttachmentNameLength bounds stored file names.
+	maxAttachmentNameLength = 255
+	// blobGCBatchSize is the number of blobs deleted per transaction during garbage collection.
+	blobGCBatchSize = 500
+)
+
+// AttachmentUsecaseImpl handles the business logic for file attachments.
+type AttachmentUsecaseImpl struct {
+	service AttachmentService
+	policy  entity.AttachmentPolicy
+	log     *slog.Logger
+}
+
+// NewAttachmentUsecase creates a new AttachmentUsecase.
+func NewAttachmentUsecase(s AttachmentService, policy entity.AttachmentPolicy, l *slog.Logger) AttachmentUsecase {
+	return &AttachmentUsecaseImpl{
+		service: s,
+		policy:  policy,
+		log:     l,
+	}
+}
+
+// UploadAttachment streams content from r into the blob store and attaches it to a live data key.
+// The content type is detected from the content itself; the client's claim is ignored.
+func (uc *AttachmentUsecaseImpl) UploadAttachment(ctx context.Context, key, name string, createdBy uuid.UUID, r io.Reader) (*entity.Attachment, error) {
+	const op = "usecase.UploadAttachment"
+
+	if key == "" {
+		return nil, entity.NewValidationError("key cannot be empty")
+	}
+	name, err := cleanAttachmentName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.service.DataKeyExists(ctx, key)
+	if err != nil {
+		uc.log.Error("failed to check data key", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if !exists {
+		return nil, entity.ErrNotFound
+	}
+
+	br := bufio.NewReaderSize(r, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if len(head) == 0 {
+		return nil, entity.NewValidationError("file is empty")
+	}
+	contentType := http.DetectContentType(head)
+	if !uc.allowed(contentType) {
+		return nil, entity.NewValidationError("content type " + contentType + " is not allowed")
+	}
+
+	digest, size, err := uc.service.PutBlob(ctx, &sizeLimitReader{r: br, remaining: uc.policy.MaxSize})
+	if errors.Is(err, entity.ErrTooLarge) {
+		return nil, fmt.Errorf("%w: at most %d bytes allowed", entity.ErrTooLarge, uc.policy.MaxSize)
+	}
+	if err != nil {
+		uc.log.Error("failed to store blob", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	att := &entity.Attachment{
+		Key:         key,
+		Name:        name,
+		ContentType: contentType,
+		Size:        size,
+		Digest:      digest,
+		CreatedBy:   createdBy,
+	}
+	err = uc.service.CreateAttachment(ctx, att, func() error {
+		// Garbage collection may have removed identical, unreferenced content after PutBlob
+		// found it; the blob row is locked now, so this answer holds until the commit.
+		ok, err := uc.service.BlobContentExists(ctx, digest)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return entity.ErrBlobMissing
+		}
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, entity.ErrBlobMissing) {
+			uc.log.Error("failed to create attachment", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	uc.log.Info("attachment uploaded", slog.String("op", op), slog.String("key", key),
+		slog.String("digest", digest), slog.Int64("size", size))
+	return att, nil
+}
+
+// ListAttachments returns the attachments of a data key. A soft deleted key has none until it
+// is restored.
+func (uc *AttachmentUsecaseImpl) ListAttachments(ctx context.Context, key string) ([]entity.Attachment, error) {
+	const op = "usecase.ListAttachments"
+
+	if key == "" {
+		return nil, entity.NewValidationError("key cannot be empty")
+	}
+	attachments, err := uc.service.ListAttachments(ctx, key)
+	if err != nil {
+		uc.log.Error("failed to list attachments", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return attachments, nil
+}
+
+// OpenAttachment returns an attachment together with its content. The caller must close the reader.
+// Attachments of a soft deleted key are not found until the key is restored.
+func (uc *AttachmentUsecaseImpl) OpenAttachment(ctx context.Context, id uuid.UUID) (*entity.Attachment, io.ReadCloser, error) {
+	const op = "usecase.OpenAttachment"
+
+	att, err := uc.service.GetAttachment(ctx, id)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get attachment", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, nil, err
+	}
+
+	content, err := uc.service.OpenBlob(ctx, att.Digest)
+	if err != nil {
+		uc.log.Error("failed to open attachment content", slog.String("op", op),
+			slog.String("digest", att.Digest), slog.String("error", err.Error()))
+		return nil, nil, err
+	}
+	return att, content, nil
+}
+
+// DeleteAttachment detaches a file. Its content is removed by garbage collection once unreferenced.
+func (uc *AttachmentUsecaseImpl) DeleteAttachment(ctx context.Context, id uuid.UUID) error {
+	const op = "usecase.DeleteAttachment"
+
+	if err := uc.service.DeleteAttachment(ctx, id); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to delete attachment", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+	return nil
+}
+
+// CollectGarbage removes attachments of deleted data keys, then blobs nobody has referenced
+// for longer than grace, then stored content without metadata older than grace.
+func (uc *AttachmentUsecaseImpl) CollectGarbage(ctx context.Context, grace time.Duration) (*entity.BlobGCResult, error) {
+	const op = "usecase.CollectGarbage"
+
+	res := &entity.BlobGCResult{}
+	n, err := uc.service.DeleteDanglingAttachments(ctx)
+	if err != nil {
+		return res, err
+	}
+	res.Attachments = int(n)
+
+	cutoff := time.Now().Add(-grace)
+	remove := func(digests []string) error {
+		for _, digest := range digests {
+			if err := uc.service.DeleteBlob(ctx, digest); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for {
+		n, err := uc.service.DeleteUnusedBlobs(ctx, cutoff, blobGCBatchSize, remove)
+		res.Blobs += n
+		if err != nil {
+			uc.log.Error("failed to delete unused blobs", slog.String("op", op), slog.String("error", err.Error()))
+			return res, err
+		}
+		if n < blobGCBatchSize {
+			break
+		}
+	}
+
+	err = uc.service.WalkBlobs(ctx, func(digest string, modTime time.Time) error {
+		if !modTime.Before(cutoff) {
+			return nil
+		}
+		known, err := uc.service.BlobExists(ctx, digest)
+		if err != nil || known {
+			return err
+		}
+		if err := uc.service.DeleteBlob(ctx, digest); err != nil {
+			return err
+		}
+		res.Orphans++
+		return nil
+	})
+	if err != nil {
+		uc.log.Error("failed to sweep orphaned blobs", slog.String("op", op), slog.String("error", err.Error()))
+		return res, err
+	}
+
+	if res.Attachments > 0 || res.Blobs > 0 || res.Orphans > 0 {
+		uc.log.Info("blob garbage collected", slog.String("op", op), slog.Int("attachments", res.Attachments),
+			slog.Int("blobs", res.Blobs), slog.Int("orphans", res.Orphans))
+	}
+	return res, nil
+}
+
+// allowed reports whether the policy permits the detected content type.
+func (uc *AttachmentUsecaseImpl) allowed(contentType string) bool {
+	if len(uc.policy.AllowedTypes) == 0 {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range uc.policy.AllowedTypes {
+		if allowed == mediaType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanAttachmentName strips any directory part a client may send along with the file name.
+func cleanAttachmentName(name string) (string, error) {
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+	if name == "." || name == "/" || name == "" {
+		return "", entity.NewValidationError("file name cannot be empty")
+	}
+	if len(name) > maxAttachmentNameLength {
+		return "", entity.NewValidationError("file name is too long")
+	}
+	return name, nil
+}
+
+// sizeLimitReader fails with entity.ErrTooLarge once more than remaining bytes were read,
+// so the blob store discards the upload instead of storing a truncated file.
+type sizeLimitReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *sizeLimitReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, entity.ErrTooLarge
+	}
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, entity.ErrTooLarge
+	}
+	return n, err
+}
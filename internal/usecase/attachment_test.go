@@ -0,0 +1,169 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"base_app/internal/adapter/blobstore/local"
+	"base_app/internal/adapter/repository/memory"
+	"base_app/internal/entity"
+	"base_app/internal/service"
+	"base_app/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+// newAttachmentUsecase returns an attachment use case with local blob storage, together with
+// a data use case on the same in-memory repository that holds the data key "doc".
+func newAttachmentUsecase(t *testing.T, policy entity.AttachmentPolicy) (usecase.AttachmentUsecase, usecase.DataUsecase) {
+	t.Helper()
+
+	log := slog.New(slog.DiscardHandler)
+	feed := memory.NewDataFeed(16)
+	repo := memory.New(feed)
+	blobs, err := local.New(t.TempDir(), log)
+	if err != nil {
+		t.Fatalf("local.New: %v", err)
+	}
+	dataUC := usecase.NewDataUsecase(service.NewDataService(repo, feed, log), repo, entity.DataQuota{}, log)
+	if err := save(t, dataUC, uuid.New(), "doc", `{}`); err != nil {
+		t.Fatalf("SaveData: %v", err)
+	}
+	return usecase.NewAttachmentUsecase(service.NewAttachmentService(repo, blobs, log), policy, log), dataUC
+}
+
+func TestUploadAttachment(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	tests := []struct {
+		name    string
+		key     string
+		file    string
+		content []byte
+		wantErr func(error) bool
+	}{
+		{"text file", "doc", "notes.txt", []byte("hello"), nil},
+		{"directories are stripped", "doc", `..\..\notes.txt`, []byte("hello"), nil},
+		{"missing key", "nope", "notes.txt", []byte("hello"), isErr(entity.ErrNotFound)},
+		{"empty file", "doc", "notes.txt", nil, isValidationError},
+		{"type not allowed", "doc", "image.png", png, isValidationError},
+		{"too large", "doc", "big.txt", bytes.Repeat([]byte("a"), 65), isErr(entity.ErrTooLarge)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _ := newAttachmentUsecase(t, entity.AttachmentPolicy{MaxSize: 64, AllowedTypes: []string{"text/*"}})
+
+			att, err := uc.UploadAttachment(context.Background(), tt.key, tt.file, uuid.New(), bytes.NewReader(tt.content))
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Fatalf("UploadAttachment error = %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UploadAttachment: %v", err)
+			}
+			if att.Name != "notes.txt" || att.ContentType != "text/plain; charset=utf-8" || att.Size != 5 {
+				t.Errorf("attachment = %+v", att)
+			}
+
+			got, rc, err := uc.OpenAttachment(context.Background(), att.ID)
+			if err != nil {
+				t.Fatalf("OpenAttachment: %v", err)
+			}
+			content, _ := io.ReadAll(rc)
+			_ = rc.Close()
+			if got.Digest != att.Digest || string(content) != "hello" {
+				t.Errorf("OpenAttachment = %+v, %q", got, content)
+			}
+		})
+	}
+}
+
+func TestCollectGarbageRemovesUnreferencedContent(t *testing.T) {
+	ctx := context.Background()
+	uc, _ := newAttachmentUsecase(t, entity.AttachmentPolicy{MaxSize: 1024})
+
+	kept, err := uc.UploadAttachment(ctx, "doc", "kept.txt", uuid.New(), strings.NewReader("kept"))
+	if err != nil {
+		t.Fatalf("UploadAttachment: %v", err)
+	}
+	// Identical content is stored once, so deleting one of two attachments keeps the blob.
+	twin, err := uc.UploadAttachment(ctx, "doc", "twin.txt", uuid.New(), strings.NewReader("kept"))
+	if err != nil {
+		t.Fatalf("UploadAttachment: %v", err)
+	}
+	gone, err := uc.UploadAttachment(ctx, "doc", "gone.txt", uuid.New(), strings.NewReader("gone"))
+	if err != nil {
+		t.Fatalf("UploadAttachment: %v", err)
+	}
+	for _, id := range []uuid.UUID{twin.ID, gone.ID} {
+		if err := uc.DeleteAttachment(ctx, id); err != nil {
+			t.Fatalf("DeleteAttachment: %v", err)
+		}
+	}
+
+	if res, err := uc.CollectGarbage(ctx, time.Hour); err != nil || *res != (entity.BlobGCResult{}) {
+		t.Fatalf("CollectGarbage within grace = %+v, %v; want nothing removed", res, err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	res, err := uc.CollectGarbage(ctx, 0)
+	if err != nil {
+		t.Fatalf("CollectGarbage: %v", err)
+	}
+	if *res != (entity.BlobGCResult{Blobs: 1}) {
+		t.Errorf("CollectGarbage = %+v, want one blob", res)
+	}
+
+	if _, rc, err := uc.OpenAttachment(ctx, kept.ID); err != nil {
+		t.Errorf("OpenAttachment(kept): %v", err)
+	} else {
+		_ = rc.Close()
+	}
+	if _, _, err := uc.OpenAttachment(ctx, gone.ID); !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("OpenAttachment(gone) = %v, want ErrNotFound", err)
+	}
+}
+
+func isErr(target error) func(error) bool {
+	return func(err error) bool { return errors.Is(err, target) }
+}
+
+func isValidationError(err error) bool {
+	var vErr *entity.ValidationError
+	return errors.As(err, &vErr)
+}
+
+func TestAttachmentsOfDeletedKeysAreHidden(t *testing.T) {
+	ctx := context.Background()
+	uc, dataUC := newAttachmentUsecase(t, entity.AttachmentPolicy{MaxSize: 64, AllowedTypes: []string{"text/*"}})
+	att, err := uc.UploadAttachment(ctx, "doc", "notes.txt", uuid.New(), strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("UploadAttachment: %v", err)
+	}
+
+	if err := dataUC.DeleteData(ctx, "doc"); err != nil {
+		t.Fatalf("DeleteData: %v", err)
+	}
+	if list, err := uc.ListAttachments(ctx, "doc"); err != nil || len(list) != 0 {
+		t.Errorf("ListAttachments(deleted key) = %v, %v; want none", list, err)
+	}
+	if _, _, err := uc.OpenAttachment(ctx, att.ID); !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("OpenAttachment(deleted key) = %v, want ErrNotFound", err)
+	}
+	if err := uc.DeleteAttachment(ctx, att.ID); !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("DeleteAttachment(deleted key) = %v, want ErrNotFound", err)
+	}
+
+	if _, err := dataUC.RestoreData(ctx, "doc"); err != nil {
+		t.Fatalf("RestoreData: %v", err)
+	}
+	if list, err := uc.ListAttachments(ctx, "doc"); err != nil || len(list) != 1 || list[0].ID != att.ID {
+		t.Errorf("ListAttachments(restored key) = %v, %v; want the attachment", list, err)
+	}
+}
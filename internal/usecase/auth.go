@@ -7,6 +7,8 @@ import (
 
 	"base_app/internal/entity"
 	"base_app/pkg/hash"
+
+	"github.com/google/uuid"
 )
 
 // AuthUsecaseImpl handles the business logic for authentication.
@@ -40,3 +42,23 @@ func (uc *AuthUsecaseImpl) Authenticate(ctx context.Context, email, password str
 
 	return user, nil
 }
+
+// SetLocale validates and stores the preferred locale of a user and returns its canonical
+// form. An empty locale clears the setting.
+func (uc *AuthUsecaseImpl) SetLocale(ctx context.Context, userID uuid.UUID, locale string) (string, error) {
+	const op = "usecase.SetLocale"
+
+	if locale != "" {
+		var err error
+		if locale, err = normalizeLocale(locale); err != nil {
+			return "", err
+		}
+	}
+	if err := uc.service.SetUserLocale(ctx, userID, locale); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to set user locale", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return "", err
+	}
+	return locale, nil
+}
@@ -0,0 +1,47 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"base_app/internal/adapter/auth/inmemory"
+	"base_app/internal/adapter/repository/memory"
+	"base_app/internal/entity"
+	"base_app/internal/service"
+	"base_app/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+func TestSetLocalePersistsInMemoryRepo(t *testing.T) {
+	log := slog.New(slog.DiscardHandler)
+	provider, err := inmemory.New(log, inmemory.Admin{})
+	if err != nil {
+		t.Fatalf("inmemory.New: %v", err)
+	}
+	repo := memory.New(memory.NewDataFeed(16))
+	repo.AddUsers(provider.Users()...)
+	uc := usecase.NewAuthUsecase(service.NewAuthService(repo, log), log)
+	ctx := context.Background()
+
+	user, err := uc.Authenticate(ctx, "test@example.com", "password123")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if _, err := uc.Authenticate(ctx, "test@example.com", "wrong"); err == nil {
+		t.Errorf("Authenticate() accepts a wrong password")
+	}
+
+	locale, err := uc.SetLocale(ctx, user.ID, "de-de")
+	if err != nil || locale != "de-DE" {
+		t.Fatalf("SetLocale() = %q, %v; want de-DE", locale, err)
+	}
+	if user, err = uc.Authenticate(ctx, "test@example.com", "password123"); err != nil || user.Locale != "de-DE" {
+		t.Errorf("user after SetLocale = %+v, %v; want locale de-DE", user, err)
+	}
+	if _, err := uc.SetLocale(ctx, uuid.New(), "de"); !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("SetLocale(unknown user) = %v, want ErrNotFound", err)
+	}
+}
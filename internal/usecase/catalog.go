@@ -2,34 +2,376 @@ package usecase
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"unicode/utf8"
 
 	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// maxCatalogTitleLength matches the VARCHAR(255) title column, which counts characters.
+	maxCatalogTitleLength = 255
+	// maxCatalogTagLength matches the VARCHAR(64) tag columns.
+	maxCatalogTagLength = 64
+	// maxCatalogItemTags bounds the tags of a single item.
+	maxCatalogItemTags = 20
+
+	defaultCatalogPageSize = 20
+	maxCatalogPageSize     = 100
+
+	// maxCatalogSearchQueryLength bounds search queries, which are parsed on every request.
+	maxCatalogSearchQueryLength = 200
 )
 
 // CatalogUsecaseImpl handles the business logic for catalog operations.
 type CatalogUsecaseImpl struct {
-	service CatalogService
-	log     *slog.Logger
+	service        CatalogService
+	imageStore     ImageStore
+	search         entity.CatalogSearchSettings
+	images         entity.CatalogImagePolicy
+	defaultLocale  string
+	recentlyViewed int
+	reservations   entity.CatalogReservationPolicy
+	reviews        entity.CatalogReviewPolicy
+	thumbnails     singleflight.Group
+	log            *slog.Logger
 }
 
-// NewCatalogUsecase creates a new CatalogUsecase.
-func NewCatalogUsecase(s CatalogService, l *slog.Logger) CatalogUsecase {
+// NewCatalogUsecase creates a new CatalogUsecase. imageStore keeps the image files, whose
+// records live in the catalog service. defaultLocale is the canonical BCP 47 tag of the text
+// stored on the items themselves; recentlyViewed bounds the view history of each user.
+func NewCatalogUsecase(s CatalogService, imageStore ImageStore, search entity.CatalogSearchSettings, images entity.CatalogImagePolicy, defaultLocale string, recentlyViewed int,
+	reservations entity.CatalogReservationPolicy, reviews entity.CatalogReviewPolicy, l *slog.Logger) CatalogUsecase {
 	return &CatalogUsecaseImpl{
-		service: s,
-		log:     l,
+		service:        s,
+		imageStore:     imageStore,
+		search:         search,
+		images:         images,
+		defaultLocale:  defaultLocale,
+		recentlyViewed: recentlyViewed,
+		reservations:   reservations,
+		reviews:        reviews,
+		log:            l,
 	}
 }
 
-// GetCatalogItems retrieves all catalog items.
-func (uc *CatalogUsecaseImpl) GetCatalogItems(ctx context.Context) ([]entity.CatalogItem, error) {
+// GetCatalogItems retrieves all catalog items, localized for pref and marked with the favorites
+// of userID. A non-empty category limits them to the category at that path and its subcategories.
+func (uc *CatalogUsecaseImpl) GetCatalogItems(ctx context.Context, category string, userID uuid.UUID, pref entity.LocalePreference) ([]entity.CatalogItem, error) {
 	const op = "usecase.GetCatalogItems"
 
-	items, err := uc.service.GetCatalogItems(ctx)
+	items, err := uc.service.GetCatalogItems(ctx, normalizeCategoryPath(category))
 	if err != nil {
 		uc.log.Error("failed to get catalog items", slog.String("op", op), slog.String("error", err.Error()))
 		return nil, err
 	}
+	if err := uc.localize(ctx, items, pref); err != nil {
+		uc.log.Error("failed to localize catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	uc.describeImages(items)
+	if err := uc.markFavorites(ctx, userID, items); err != nil {
+		uc.log.Error("failed to mark favorite catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
 
 	return items, nil
 }
+
+// ListCatalogItems returns one page of catalog items, localized for pref and marked with the
+// favorites of userID. cursor is the NextCursor of the previous page, or empty for the first
+// one; it only continues a listing with the same sort order. Filters and sort orders apply to
+// the default locale text.
+func (uc *CatalogUsecaseImpl) ListCatalogItems(ctx context.Context, q entity.CatalogQuery, cursor string, userID uuid.UUID, pref entity.LocalePreference) (*entity.CatalogPage, error) {
+	const op = "usecase.ListCatalogItems"
+
+	switch q.Sort {
+	case "":
+		q.Sort = entity.CatalogSortTitle
+	case entity.CatalogSortTitle, entity.CatalogSortTitleDesc, entity.CatalogSortCreatedAt, entity.CatalogSortCreatedAtDesc:
+	default:
+		return nil, entity.NewValidationError("unknown sort order: " + string(q.Sort))
+	}
+	switch {
+	case q.Limit == 0:
+		q.Limit = defaultCatalogPageSize
+	case q.Limit < 0 || q.Limit > maxCatalogPageSize:
+		return nil, entity.NewValidationError(fmt.Sprintf("limit must be between 1 and %d", maxCatalogPageSize))
+	}
+	q.Tag = normalizeTag(q.Tag)
+	q.Category = normalizeCategoryPath(q.Category)
+
+	if cursor != "" {
+		var after entity.CatalogCursor
+		if err := decodeCursor(cursor, &after); err != nil || after.Sort != q.Sort {
+			return nil, entity.NewValidationError("invalid cursor")
+		}
+		q.After = &after
+	}
+
+	// One extra row tells whether another page follows.
+	limit := q.Limit
+	q.Limit++
+	items, err := uc.service.ListCatalogItems(ctx, q)
+	if err != nil {
+		uc.log.Error("failed to list catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	page := &entity.CatalogPage{Items: items}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		last := page.Items[limit-1]
+		page.NextCursor = encodeCursor(&entity.CatalogCursor{
+			Sort:      q.Sort,
+			ID:        last.ID,
+			Title:     last.Title,
+			CreatedAt: last.CreatedAt,
+		})
+	}
+	// The cursor holds the default locale title the listing is sorted by, so localize last.
+	if err := uc.localize(ctx, page.Items, pref); err != nil {
+		uc.log.Error("failed to localize catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	uc.describeImages(page.Items)
+	if err := uc.markFavorites(ctx, userID, page.Items); err != nil {
+		uc.log.Error("failed to mark favorite catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return page, nil
+}
+
+// GetCatalogItem retrieves a single catalog item, localized for pref and marked if it is a
+// favorite of userID.
+func (uc *CatalogUsecaseImpl) GetCatalogItem(ctx context.Context, id, userID uuid.UUID, pref entity.LocalePreference) (*entity.CatalogItem, error) {
+	const op = "usecase.GetCatalogItem"
+
+	item, err := uc.service.GetCatalogItem(ctx, id)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	items := []entity.CatalogItem{*item}
+	if err := uc.localize(ctx, items, pref); err != nil {
+		uc.log.Error("failed to localize catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	uc.describeImages(items)
+	if err := uc.markFavorites(ctx, userID, items); err != nil {
+		uc.log.Error("failed to mark favorite catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return &items[0], nil
+}
+
+// CreateCatalogItem validates and creates a catalog item.
+func (uc *CatalogUsecaseImpl) CreateCatalogItem(ctx context.Context, item *entity.CatalogItem) error {
+	const op = "usecase.CreateCatalogItem"
+
+	if err := validateCatalogItem(item); err != nil {
+		return err
+	}
+	if err := uc.checkCategoryExists(ctx, item.CategoryID, "category"); err != nil {
+		return err
+	}
+	item.SearchLanguage = uc.search.Language
+	if err := uc.service.CreateCatalogItem(ctx, item); err != nil {
+		uc.log.Error("failed to create catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	uc.log.Info("catalog item created", slog.String("op", op), slog.String("id", item.ID.String()))
+	return nil
+}
+
+// UpdateCatalogItem validates and replaces an existing catalog item.
+func (uc *CatalogUsecaseImpl) UpdateCatalogItem(ctx context.Context, item *entity.CatalogItem) error {
+	const op = "usecase.UpdateCatalogItem"
+
+	if err := validateCatalogItem(item); err != nil {
+		return err
+	}
+	if err := uc.checkCategoryExists(ctx, item.CategoryID, "category"); err != nil {
+		return err
+	}
+	item.SearchLanguage = uc.search.Language
+	if err := uc.service.UpdateCatalogItem(ctx, item); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to update catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+	uc.describeItemImages(item)
+
+	uc.log.Info("catalog item updated", slog.String("op", op), slog.String("id", item.ID.String()))
+	return nil
+}
+
+// SetCatalogItemDisabled enables or disables a catalog item.
+func (uc *CatalogUsecaseImpl) SetCatalogItemDisabled(ctx context.Context, id uuid.UUID, disabled bool) (*entity.CatalogItem, error) {
+	const op = "usecase.SetCatalogItemDisabled"
+
+	item, err := uc.service.SetCatalogItemDisabled(ctx, id, disabled)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to set catalog item disabled", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	uc.describeItemImages(item)
+
+	uc.log.Info("catalog item disabled changed", slog.String("op", op), slog.String("id", id.String()),
+		slog.Bool("disabled", disabled))
+	return item, nil
+}
+
+// DeleteCatalogItem soft deletes a catalog item. It can be restored until the purge job
+// removes it after the retention period.
+func (uc *CatalogUsecaseImpl) DeleteCatalogItem(ctx context.Context, id uuid.UUID) error {
+	const op = "usecase.DeleteCatalogItem"
+
+	if err := uc.service.DeleteCatalogItem(ctx, id); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to delete catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("catalog item deleted", slog.String("op", op), slog.String("id", id.String()))
+	return nil
+}
+
+// SearchCatalog finds items whose title or description match q.Query, best matches first.
+// The query uses web search syntax: quoted phrases, "or" and a leading "-" to exclude words.
+// When nothing matches, it falls back to titles containing words similar to the query, so
+// a misspelled query still finds something.
+func (uc *CatalogUsecaseImpl) SearchCatalog(ctx context.Context, q entity.CatalogSearchQuery) (*entity.CatalogSearchResult, error) {
+	const op = "usecase.SearchCatalog"
+
+	q.Query = strings.TrimSpace(q.Query)
+	if q.Query == "" {
+		return nil, entity.NewValidationError("query cannot be empty")
+	}
+	if !utf8.ValidString(q.Query) {
+		return nil, entity.NewValidationError("query must be valid UTF-8")
+	}
+	if n := utf8.RuneCountInString(q.Query); n > maxCatalogSearchQueryLength {
+		return nil, entity.NewValidationError(fmt.Sprintf("query is too long: %d characters, at most %d allowed", n, maxCatalogSearchQueryLength))
+	}
+	switch {
+	case q.Limit == 0:
+		q.Limit = defaultCatalogPageSize
+	case q.Limit < 0 || q.Limit > maxCatalogPageSize:
+		return nil, entity.NewValidationError(fmt.Sprintf("limit must be between 1 and %d", maxCatalogPageSize))
+	}
+	q.Language = uc.search.Language
+	q.FuzzyThreshold = uc.search.FuzzyThreshold
+
+	hits, err := uc.service.SearchCatalogItems(ctx, q)
+	if err != nil {
+		uc.log.Error("failed to search catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if len(hits) > 0 || q.FuzzyThreshold <= 0 {
+		uc.describeHitImages(hits)
+		return &entity.CatalogSearchResult{Hits: hits}, nil
+	}
+
+	hits, err = uc.service.SearchCatalogItemsFuzzy(ctx, q)
+	if err != nil {
+		uc.log.Error("failed to search catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	uc.describeHitImages(hits)
+	return &entity.CatalogSearchResult{Hits: hits, Fuzzy: true}, nil
+}
+
+// SyncSearchLanguage re-indexes items indexed with a language other than the configured one.
+// It runs at startup, so changing the language only takes a restart.
+func (uc *CatalogUsecaseImpl) SyncSearchLanguage(ctx context.Context) error {
+	const op = "usecase.SyncSearchLanguage"
+
+	n, err := uc.service.SetCatalogSearchLanguage(ctx, uc.search.Language)
+	if err != nil {
+		uc.log.Error("failed to set catalog search language", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	if n > 0 {
+		uc.log.Info("catalog items re-indexed", slog.String("op", op), slog.String("language", uc.search.Language),
+			slog.Int64("items", n))
+	}
+	return nil
+}
+
+// validateCatalogItem trims the title and checks it fits the title column.
+func validateCatalogItem(item *entity.CatalogItem) error {
+	item.Title = strings.TrimSpace(item.Title)
+	if item.Title == "" {
+		return entity.NewValidationError("title cannot be empty")
+	}
+	if !utf8.ValidString(item.Title) || !utf8.ValidString(item.Description) {
+		return entity.NewValidationError("title and description must be valid UTF-8")
+	}
+	if n := utf8.RuneCountInString(item.Title); n > maxCatalogTitleLength {
+		return entity.NewValidationError(fmt.Sprintf("title is too long: %d characters, at most %d allowed", n, maxCatalogTitleLength))
+	}
+
+	if len(item.Tags) > maxCatalogItemTags {
+		return entity.NewValidationError(fmt.Sprintf("at most %d tags allowed", maxCatalogItemTags))
+	}
+	tags := make([]string, 0, len(item.Tags))
+	for _, tag := range item.Tags {
+		tag, err := validateTag(tag)
+		if err != nil {
+			return err
+		}
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+	item.Tags = slices.Compact(tags)
+	return nil
+}
+
+// validateTag normalizes a tag and checks it fits the tag columns.
+func validateTag(tag string) (string, error) {
+	tag = normalizeTag(tag)
+	if tag == "" || !utf8.ValidString(tag) {
+		return "", entity.NewValidationError("tags cannot be empty")
+	}
+	if utf8.RuneCountInString(tag) > maxCatalogTagLength {
+		return "", entity.NewValidationError(fmt.Sprintf("tag %q is too long: at most %d characters allowed", tag, maxCatalogTagLength))
+	}
+	return tag, nil
+}
+
+// normalizeTag makes tags case-insensitive.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// encodeCursor makes a cursor opaque to clients, so its contents can change freely.
+func encodeCursor(c any) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCursor reverses encodeCursor into c.
+func decodeCursor(cursor string, c any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, c)
+}
@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxCatalogCategorySlugLength matches the VARCHAR(64) slug column.
+	maxCatalogCategorySlugLength = 64
+	// maxCatalogCategoryNameLength matches the VARCHAR(255) name column.
+	maxCatalogCategoryNameLength = 255
+)
+
+// categorySlugPattern keeps slugs safe to join into paths and to use in URLs.
+var categorySlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// ListCatalogCategories returns the whole category tree ordered by path.
+func (uc *CatalogUsecaseImpl) ListCatalogCategories(ctx context.Context) ([]entity.CatalogCategory, error) {
+	const op = "usecase.ListCatalogCategories"
+
+	categories, err := uc.service.ListCatalogCategories(ctx)
+	if err != nil {
+		uc.log.Error("failed to list catalog categories", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return categories, nil
+}
+
+// GetCatalogCategory retrieves a single category.
+func (uc *CatalogUsecaseImpl) GetCatalogCategory(ctx context.Context, id uuid.UUID) (*entity.CatalogCategory, error) {
+	const op = "usecase.GetCatalogCategory"
+
+	category, err := uc.service.GetCatalogCategory(ctx, id)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get catalog category", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	return category, nil
+}
+
+// CreateCatalogCategory validates and creates a category. It returns entity.ErrConflict if
+// the parent already has a subcategory with the same slug.
+func (uc *CatalogUsecaseImpl) CreateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error {
+	const op = "usecase.CreateCatalogCategory"
+
+	if err := validateCatalogCategory(category); err != nil {
+		return err
+	}
+	if err := uc.service.CreateCatalogCategory(ctx, category); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			return entity.NewValidationError("parent category does not exist")
+		case !errors.Is(err, entity.ErrConflict):
+			uc.log.Error("failed to create catalog category", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("catalog category created", slog.String("op", op), slog.String("id", category.ID.String()),
+		slog.String("path", category.Path))
+	return nil
+}
+
+// UpdateCatalogCategory renames a category or moves it to another parent, together with its
+// subcategories and items. It returns entity.ErrConflict if the new path is taken.
+func (uc *CatalogUsecaseImpl) UpdateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error {
+	const op = "usecase.UpdateCatalogCategory"
+
+	if err := validateCatalogCategory(category); err != nil {
+		return err
+	}
+	if category.ParentID == category.ID {
+		return entity.NewValidationError("a category cannot be its own parent")
+	}
+	if err := uc.checkCategoryExists(ctx, category.ParentID, "parent category"); err != nil {
+		return err
+	}
+	if err := uc.service.UpdateCatalogCategory(ctx, category); err != nil {
+		var vErr *entity.ValidationError
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) && !errors.As(err, &vErr) {
+			uc.log.Error("failed to update catalog category", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("catalog category updated", slog.String("op", op), slog.String("id", category.ID.String()),
+		slog.String("path", category.Path))
+	return nil
+}
+
+// DeleteCatalogCategory deletes a category; its items become uncategorized. It returns
+// entity.ErrConflict while the category has subcategories.
+func (uc *CatalogUsecaseImpl) DeleteCatalogCategory(ctx context.Context, id uuid.UUID) error {
+	const op = "usecase.DeleteCatalogCategory"
+
+	if err := uc.service.DeleteCatalogCategory(ctx, id); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to delete catalog category", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("catalog category deleted", slog.String("op", op), slog.String("id", id.String()))
+	return nil
+}
+
+// ListCatalogTags returns every tag with the number of items carrying it.
+func (uc *CatalogUsecaseImpl) ListCatalogTags(ctx context.Context) ([]entity.CatalogTag, error) {
+	const op = "usecase.ListCatalogTags"
+
+	tags, err := uc.service.ListCatalogTags(ctx)
+	if err != nil {
+		uc.log.Error("failed to list catalog tags", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return tags, nil
+}
+
+// CreateCatalogTag creates a tag ahead of its use. It returns entity.ErrConflict if the tag exists.
+func (uc *CatalogUsecaseImpl) CreateCatalogTag(ctx context.Context, name string) (*entity.CatalogTag, error) {
+	const op = "usecase.CreateCatalogTag"
+
+	name, err := validateTag(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.service.CreateCatalogTag(ctx, name); err != nil {
+		if !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to create catalog tag", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	uc.log.Info("catalog tag created", slog.String("op", op), slog.String("tag", name))
+	return &entity.CatalogTag{Name: name}, nil
+}
+
+// RenameCatalogTag renames a tag on every item carrying it. Merging into an existing tag is
+// not supported; it returns entity.ErrConflict instead.
+func (uc *CatalogUsecaseImpl) RenameCatalogTag(ctx context.Context, name, newName string) (*entity.CatalogTag, error) {
+	const op = "usecase.RenameCatalogTag"
+
+	newName, err := validateTag(newName)
+	if err != nil {
+		return nil, err
+	}
+	tag, err := uc.service.RenameCatalogTag(ctx, normalizeTag(name), newName)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to rename catalog tag", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	uc.log.Info("catalog tag renamed", slog.String("op", op), slog.String("tag", name), slog.String("new_name", newName))
+	return tag, nil
+}
+
+// DeleteCatalogTag removes a tag from every item and deletes it.
+func (uc *CatalogUsecaseImpl) DeleteCatalogTag(ctx context.Context, name string) error {
+	const op = "usecase.DeleteCatalogTag"
+
+	name = normalizeTag(name)
+	if err := uc.service.DeleteCatalogTag(ctx, name); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to delete catalog tag", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("catalog tag deleted", slog.String("op", op), slog.String("tag", name))
+	return nil
+}
+
+// checkCategoryExists turns a reference to a missing category into a validation error.
+// uuid.Nil refers to no category and always passes.
+func (uc *CatalogUsecaseImpl) checkCategoryExists(ctx context.Context, id uuid.UUID, what string) error {
+	if id == uuid.Nil {
+		return nil
+	}
+	if _, err := uc.GetCatalogCategory(ctx, id); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.NewValidationError(what + " does not exist")
+		}
+		return err
+	}
+	return nil
+}
+
+// validateCatalogCategory normalizes the slug and trims the name.
+func validateCatalogCategory(category *entity.CatalogCategory) error {
+	category.Slug = strings.ToLower(strings.TrimSpace(category.Slug))
+	if len(category.Slug) > maxCatalogCategorySlugLength {
+		return entity.NewValidationError(fmt.Sprintf("slug is too long: at most %d characters allowed", maxCatalogCategorySlugLength))
+	}
+	if !categorySlugPattern.MatchString(category.Slug) {
+		return entity.NewValidationError("slug must consist of lower case letters, digits and single dashes")
+	}
+
+	category.Name = strings.TrimSpace(category.Name)
+	if category.Name == "" {
+		return entity.NewValidationError("name cannot be empty")
+	}
+	if !utf8.ValidString(category.Name) {
+		return entity.NewValidationError("name must be valid UTF-8")
+	}
+	if n := utf8.RuneCountInString(category.Name); n > maxCatalogCategoryNameLength {
+		return entity.NewValidationError(fmt.Sprintf("name is too long: %d characters, at most %d allowed", n, maxCatalogCategoryNameLength))
+	}
+	return nil
+}
+
+// normalizeCategoryPath accepts paths with surrounding slashes and any letter case.
+func normalizeCategoryPath(path string) string {
+	return strings.Trim(strings.ToLower(strings.TrimSpace(path)), "/")
+}
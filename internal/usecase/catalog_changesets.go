@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// maxCatalogChangesetTitleLength matches the VARCHAR(255) title column.
+const maxCatalogChangesetTitleLength = 255
+
+// ListCatalogChangesets returns all changesets, newest first, without their drafts.
+func (uc *CatalogUsecaseImpl) ListCatalogChangesets(ctx context.Context) ([]entity.CatalogChangeset, error) {
+	const op = "usecase.ListCatalogChangesets"
+
+	changesets, err := uc.service.ListCatalogChangesets(ctx)
+	if err != nil {
+		uc.log.Error("failed to list catalog changesets", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return changesets, nil
+}
+
+// GetCatalogChangeset retrieves a changeset together with its drafts.
+func (uc *CatalogUsecaseImpl) GetCatalogChangeset(ctx context.Context, id uuid.UUID) (*entity.CatalogChangeset, error) {
+	const op = "usecase.GetCatalogChangeset"
+
+	changeset, err := uc.service.GetCatalogChangeset(ctx, id)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get catalog changeset", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	return changeset, nil
+}
+
+// CreateCatalogChangeset validates and creates an empty draft changeset.
+func (uc *CatalogUsecaseImpl) CreateCatalogChangeset(ctx context.Context, changeset *entity.CatalogChangeset) error {
+	const op = "usecase.CreateCatalogChangeset"
+
+	changeset.Title = strings.TrimSpace(changeset.Title)
+	if changeset.Title == "" {
+		return entity.NewValidationError("title cannot be empty")
+	}
+	if !utf8.ValidString(changeset.Title) {
+		return entity.NewValidationError("title must be valid UTF-8")
+	}
+	if n := utf8.RuneCountInString(changeset.Title); n > maxCatalogChangesetTitleLength {
+		return entity.NewValidationError(fmt.Sprintf("title is too long: %d characters, at most %d allowed", n, maxCatalogChangesetTitleLength))
+	}
+	if err := uc.service.CreateCatalogChangeset(ctx, changeset); err != nil {
+		uc.log.Error("failed to create catalog changeset", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	uc.log.Info("catalog changeset created", slog.String("op", op), slog.String("id", changeset.ID.String()),
+		slog.String("created_by", changeset.CreatedBy.String()))
+	return nil
+}
+
+// DeleteCatalogChangeset discards a draft changeset. It returns entity.ErrConflict if the
+// changeset is already published.
+func (uc *CatalogUsecaseImpl) DeleteCatalogChangeset(ctx context.Context, id uuid.UUID) error {
+	const op = "usecase.DeleteCatalogChangeset"
+
+	if err := uc.service.DeleteCatalogChangeset(ctx, id); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to delete catalog changeset", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("catalog changeset deleted", slog.String("op", op), slog.String("id", id.String()))
+	return nil
+}
+
+// StageCatalogDraft validates a draft and adds it to a draft changeset, replacing an earlier
+// draft of the same item. A draft without an item ID creates a new item; the generated ID is
+// set on the draft. Drafts of existing items remember the item version they were based on.
+func (uc *CatalogUsecaseImpl) StageCatalogDraft(ctx context.Context, changesetID uuid.UUID, draft *entity.CatalogDraft) error {
+	const op = "usecase.StageCatalogDraft"
+
+	switch draft.Action {
+	case entity.CatalogDraftUpsert:
+		if err := validateCatalogItem(&draft.Item); err != nil {
+			return err
+		}
+		if err := uc.checkCategoryExists(ctx, draft.Item.CategoryID, "category"); err != nil {
+			return err
+		}
+	case entity.CatalogDraftDelete:
+		if draft.Item.ID == uuid.Nil {
+			return entity.NewValidationError("item id is required to delete an item")
+		}
+	default:
+		return entity.NewValidationError(fmt.Sprintf("unknown draft action %q", draft.Action))
+	}
+
+	draft.BaseUpdatedAt = nil
+	if draft.Item.ID == uuid.Nil {
+		draft.Item.ID = uuid.New()
+	} else {
+		item, err := uc.service.GetCatalogItem(ctx, draft.Item.ID)
+		switch {
+		case err == nil:
+			draft.BaseUpdatedAt = &item.UpdatedAt
+		case !errors.Is(err, entity.ErrNotFound):
+			uc.log.Error("failed to get catalog item", slog.String("op", op), slog.String("error", err.Error()))
+			return err
+		default:
+			// The item may be new in this changeset, in which case the draft is replaced as is.
+			if err := uc.checkNewItemDraft(ctx, changesetID, draft); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := uc.service.SaveCatalogDraft(ctx, changesetID, draft); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to save catalog draft", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("catalog draft staged", slog.String("op", op), slog.String("changeset_id", changesetID.String()),
+		slog.String("item_id", draft.Item.ID.String()), slog.String("action", string(draft.Action)))
+	return nil
+}
+
+// UnstageCatalogDraft removes the draft of an item from a draft changeset.
+func (uc *CatalogUsecaseImpl) UnstageCatalogDraft(ctx context.Context, changesetID, itemID uuid.UUID) error {
+	const op = "usecase.UnstageCatalogDraft"
+
+	if err := uc.service.DeleteCatalogDraft(ctx, changesetID, itemID); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to delete catalog draft", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("catalog draft unstaged", slog.String("op", op), slog.String("changeset_id", changesetID.String()),
+		slog.String("item_id", itemID.String()))
+	return nil
+}
+
+// PreviewCatalogChangeset returns the whole catalog as it would look after publishing a draft
+// changeset, ordered by title. It returns entity.ErrConflict if the changeset is not a draft.
+func (uc *CatalogUsecaseImpl) PreviewCatalogChangeset(ctx context.Context, id uuid.UUID) ([]entity.CatalogItem, error) {
+	const op = "usecase.PreviewCatalogChangeset"
+
+	changeset, err := uc.GetCatalogChangeset(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if changeset.Status != entity.CatalogChangesetDraft {
+		return nil, fmt.Errorf("%w: changeset is %s", entity.ErrConflict, changeset.Status)
+	}
+	items, err := uc.service.GetCatalogItems(ctx, "")
+	if err != nil {
+		uc.log.Error("failed to get catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]entity.CatalogItem, len(items))
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+	for _, draft := range changeset.Drafts {
+		if draft.Action == entity.CatalogDraftDelete {
+			delete(byID, draft.Item.ID)
+			continue
+		}
+		item := draft.Item
+		item.CreatedAt, item.UpdatedAt = draft.UpdatedAt, draft.UpdatedAt
+		if current, ok := byID[item.ID]; ok {
+			// Drafts do not touch images.
+			item.CreatedAt, item.Images = current.CreatedAt, current.Images
+		}
+		byID[item.ID] = item
+	}
+
+	preview := make([]entity.CatalogItem, 0, len(byID))
+	for _, item := range byID {
+		preview = append(preview, item)
+	}
+	slices.SortFunc(preview, func(a, b entity.CatalogItem) int {
+		if c := cmp.Compare(a.Title, b.Title); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID.String(), b.ID.String())
+	})
+	uc.describeImages(preview)
+	return preview, nil
+}
+
+// PublishCatalogChangeset applies all drafts of a changeset at once. It returns
+// entity.ErrConflict if the changeset is not a draft or an item changed after it was staged.
+func (uc *CatalogUsecaseImpl) PublishCatalogChangeset(ctx context.Context, id, publishedBy uuid.UUID) (*entity.CatalogChangeset, error) {
+	const op = "usecase.PublishCatalogChangeset"
+
+	changeset, err := uc.service.PublishCatalogChangeset(ctx, id, publishedBy, uc.search.Language)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to publish catalog changeset", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	uc.log.Info("catalog changeset published", slog.String("op", op), slog.String("id", id.String()),
+		slog.String("published_by", publishedBy.String()))
+	return changeset, nil
+}
+
+// RollbackCatalogChangeset restores the items of a published changeset to their versions
+// before publishing. It returns entity.ErrConflict if the changeset is not published or one
+// of its items changed after publishing.
+func (uc *CatalogUsecaseImpl) RollbackCatalogChangeset(ctx context.Context, id, rolledBackBy uuid.UUID) (*entity.CatalogChangeset, error) {
+	const op = "usecase.RollbackCatalogChangeset"
+
+	changeset, err := uc.service.RollbackCatalogChangeset(ctx, id, rolledBackBy, uc.search.Language)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to roll back catalog changeset", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	uc.log.Info("catalog changeset rolled back", slog.String("op", op), slog.String("id", id.String()),
+		slog.String("rolled_back_by", rolledBackBy.String()))
+	return changeset, nil
+}
+
+// checkNewItemDraft accepts a draft for an item that does not exist yet only if the changeset
+// already creates that item.
+func (uc *CatalogUsecaseImpl) checkNewItemDraft(ctx context.Context, changesetID uuid.UUID, draft *entity.CatalogDraft) error {
+	changeset, err := uc.GetCatalogChangeset(ctx, changesetID)
+	if err != nil {
+		return err
+	}
+	for _, staged := range changeset.Drafts {
+		if staged.Item.ID == draft.Item.ID && staged.BaseUpdatedAt == nil && staged.Action == entity.CatalogDraftUpsert {
+			if draft.Action == entity.CatalogDraftDelete {
+				return entity.NewValidationError("item is new in this changeset; unstage its draft instead")
+			}
+			return nil
+		}
+	}
+	return entity.ErrNotFound
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// AddCatalogFavorite marks an item as a favorite of a user. Adding a favorite twice is not an error.
+func (uc *CatalogUsecaseImpl) AddCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error {
+	const op = "usecase.AddCatalogFavorite"
+
+	if err := uc.service.AddCatalogFavorite(ctx, userID, itemID); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to add catalog favorite", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+	return nil
+}
+
+// RemoveCatalogFavorite unmarks a favorite item of a user.
+func (uc *CatalogUsecaseImpl) RemoveCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error {
+	const op = "usecase.RemoveCatalogFavorite"
+
+	if err := uc.service.DeleteCatalogFavorite(ctx, userID, itemID); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to remove catalog favorite", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+	return nil
+}
+
+// ListCatalogFavorites returns one page of the favorite items of a user, most recently added
+// first, localized for pref. cursor is the NextCursor of the previous page, or empty for the
+// first one; a zero limit means the default page size.
+func (uc *CatalogUsecaseImpl) ListCatalogFavorites(ctx context.Context, userID uuid.UUID, cursor string, limit int, pref entity.LocalePreference) (*entity.CatalogPage, error) {
+	const op = "usecase.ListCatalogFavorites"
+
+	switch {
+	case limit == 0:
+		limit = defaultCatalogPageSize
+	case limit < 0 || limit > maxCatalogPageSize:
+		return nil, entity.NewValidationError(fmt.Sprintf("limit must be between 1 and %d", maxCatalogPageSize))
+	}
+	var after *entity.CatalogFavoriteCursor
+	if cursor != "" {
+		after = &entity.CatalogFavoriteCursor{}
+		if err := decodeCursor(cursor, after); err != nil || after.ItemID == uuid.Nil {
+			return nil, entity.NewValidationError("invalid cursor")
+		}
+	}
+
+	// One extra row tells whether another page follows.
+	favorites, err := uc.service.ListCatalogFavorites(ctx, userID, after, limit+1)
+	if err != nil {
+		uc.log.Error("failed to list catalog favorites", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	page := &entity.CatalogPage{}
+	if len(favorites) > limit {
+		favorites = favorites[:limit]
+		last := favorites[limit-1]
+		page.NextCursor = encodeCursor(&entity.CatalogFavoriteCursor{ItemID: last.Item.ID, AddedAt: last.AddedAt})
+	}
+	page.Items = make([]entity.CatalogItem, len(favorites))
+	for i := range favorites {
+		page.Items[i] = favorites[i].Item
+		page.Items[i].IsFavorite = true
+	}
+	if err := uc.localize(ctx, page.Items, pref); err != nil {
+		uc.log.Error("failed to localize catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	uc.describeImages(page.Items)
+	return page, nil
+}
+
+// RecordCatalogView records that a user viewed an item. Only the most recent views of each
+// user are kept; viewing an item again moves it to the front.
+func (uc *CatalogUsecaseImpl) RecordCatalogView(ctx context.Context, userID, itemID uuid.UUID) error {
+	const op = "usecase.RecordCatalogView"
+
+	if err := uc.service.RecordCatalogView(ctx, userID, itemID, uc.recentlyViewed); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to record catalog view", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+	return nil
+}
+
+// ListRecentlyViewedCatalogItems returns the items a user viewed last, most recent first,
+// localized for pref and marked with the favorites of the user.
+func (uc *CatalogUsecaseImpl) ListRecentlyViewedCatalogItems(ctx context.Context, userID uuid.UUID, pref entity.LocalePreference) ([]entity.CatalogItem, error) {
+	const op = "usecase.ListRecentlyViewedCatalogItems"
+
+	items, err := uc.service.ListRecentlyViewedCatalogItems(ctx, userID)
+	if err != nil {
+		uc.log.Error("failed to list recently viewed catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if err := uc.localize(ctx, items, pref); err != nil {
+		uc.log.Error("failed to localize catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	uc.describeImages(items)
+	if err := uc.markFavorites(ctx, userID, items); err != nil {
+		uc.log.Error("failed to mark favorite catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return items, nil
+}
+
+// markFavorites sets IsFavorite on those of items that are favorites of userID. Reads without
+// a user leave all items unmarked.
+func (uc *CatalogUsecaseImpl) markFavorites(ctx context.Context, userID uuid.UUID, items []entity.CatalogItem) error {
+	if userID == uuid.Nil || len(items) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(items))
+	for i := range items {
+		ids[i] = items[i].ID
+	}
+	favorites, err := uc.service.ListCatalogFavoriteIDs(ctx, userID, ids)
+	if err != nil {
+		return err
+	}
+	favorite := make(map[uuid.UUID]bool, len(favorites))
+	for _, id := range favorites {
+		favorite[id] = true
+	}
+	for i := range items {
+		items[i].IsFavorite = favorite[items[i].ID]
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // Register the GIF decoder for catalog images.
+	_ "image/jpeg" // Register the JPEG decoder for catalog images.
+	_ "image/png"  // Register the PNG decoder for catalog images.
+	"io"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"base_app/internal/entity"
+	"base_app/pkg/thumbnail"
+
+	"github.com/google/uuid"
+	_ "golang.org/x/image/webp" // Register the WebP decoder for catalog images.
+)
+
+// maxCatalogImagePixels bounds the dimensions of uploaded images, which are decoded in memory
+// at four bytes per pixel to validate them and to render thumbnails.
+const maxCatalogImagePixels = 25_000_000
+
+// catalogImageTypes maps the formats of the registered image decoders to media types.
+var catalogImageTypes = map[string]string{
+	"jpeg": "image/jpeg",
+	"png":  "image/png",
+	"gif":  "image/gif",
+	"webp": "image/webp",
+}
+
+// UploadCatalogImage reads an image from r and adds it to an item. Only JPEG, PNG, GIF and
+// WebP images are accepted; the format is detected from the content itself. Thumbnails are
+// rendered when they are first requested.
+func (uc *CatalogUsecaseImpl) UploadCatalogImage(ctx context.Context, itemID uuid.UUID, r io.Reader) (*entity.CatalogImage, error) {
+	const op = "usecase.UploadCatalogImage"
+
+	if _, err := uc.service.GetCatalogItem(ctx, itemID); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	content, err := io.ReadAll(io.LimitReader(r, uc.images.MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > uc.images.MaxSize {
+		return nil, fmt.Errorf("%w: at most %d bytes allowed", entity.ErrTooLarge, uc.images.MaxSize)
+	}
+	if len(content) == 0 {
+		return nil, entity.NewValidationError("file is empty")
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(content))
+	contentType, ok := catalogImageTypes[format]
+	if err != nil || !ok {
+		return nil, entity.NewValidationError("file must be a JPEG, PNG, GIF or WebP image")
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxCatalogImagePixels {
+		return nil, entity.NewValidationError(fmt.Sprintf("image is %dx%d pixels, at most %d pixels allowed",
+			cfg.Width, cfg.Height, maxCatalogImagePixels))
+	}
+	// The header alone does not prove the rest of the file can be decoded into thumbnails.
+	if _, _, err := image.Decode(bytes.NewReader(content)); err != nil {
+		return nil, entity.NewValidationError("image is corrupt: " + err.Error())
+	}
+
+	img := &entity.CatalogImage{
+		ID:          uuid.New(),
+		ItemID:      itemID,
+		ContentType: contentType,
+		Width:       cfg.Width,
+		Height:      cfg.Height,
+		Size:        int64(len(content)),
+	}
+	name := img.ID.String()
+	if err := uc.imageStore.Put(ctx, name, bytes.NewReader(content)); err != nil {
+		uc.log.Error("failed to store catalog image", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	if err := uc.service.CreateCatalogImage(ctx, img); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to create catalog image", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		if delErr := uc.imageStore.Delete(ctx, name); delErr != nil {
+			uc.log.Error("failed to delete catalog image file", slog.String("op", op), slog.String("error", delErr.Error()))
+		}
+		return nil, err
+	}
+	uc.describeImage(img)
+
+	uc.log.Info("catalog image uploaded", slog.String("op", op), slog.String("item_id", itemID.String()),
+		slog.String("id", img.ID.String()), slog.Int64("size", img.Size))
+	return img, nil
+}
+
+// OpenCatalogImage returns an image together with its original content. The caller must close the reader.
+func (uc *CatalogUsecaseImpl) OpenCatalogImage(ctx context.Context, id uuid.UUID) (*entity.CatalogImage, io.ReadCloser, error) {
+	const op = "usecase.OpenCatalogImage"
+
+	img, err := uc.service.GetCatalogImage(ctx, id)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get catalog image", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, nil, err
+	}
+
+	content, err := uc.imageStore.Open(ctx, img.ID.String())
+	if err != nil {
+		uc.log.Error("failed to open catalog image content", slog.String("op", op),
+			slog.String("id", img.ID.String()), slog.String("error", err.Error()))
+		return nil, nil, err
+	}
+	uc.describeImage(img)
+	return img, content, nil
+}
+
+// OpenCatalogThumbnail returns a thumbnail of an image together with its content, rendering
+// and storing it on first use. Sizes other than the configured ones are not found.
+// The caller must close the reader.
+func (uc *CatalogUsecaseImpl) OpenCatalogThumbnail(ctx context.Context, id uuid.UUID, size int) (*entity.CatalogThumbnail, io.ReadCloser, error) {
+	const op = "usecase.OpenCatalogThumbnail"
+
+	if !slices.Contains(uc.images.ThumbnailSizes, size) {
+		return nil, nil, entity.ErrNotFound
+	}
+	img, err := uc.service.GetCatalogImage(ctx, id)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get catalog image", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, nil, err
+	}
+
+	name := catalogThumbnailName(img.ID, size)
+	content, err := uc.imageStore.Open(ctx, name)
+	if errors.Is(err, entity.ErrNotFound) {
+		// Concurrent first requests for a thumbnail render it once.
+		_, err, _ = uc.thumbnails.Do(name, func() (any, error) {
+			return nil, uc.renderThumbnail(context.WithoutCancel(ctx), img, size)
+		})
+		if err == nil {
+			content, err = uc.imageStore.Open(ctx, name)
+		}
+	}
+	if err != nil {
+		uc.log.Error("failed to open catalog thumbnail", slog.String("op", op),
+			slog.String("name", name), slog.String("error", err.Error()))
+		return nil, nil, err
+	}
+
+	thumb := catalogThumbnail(img, size)
+	return &thumb, content, nil
+}
+
+// DeleteCatalogImage removes an image from an item. Its files are deleted right away; files
+// left behind by a failure are removed by garbage collection.
+func (uc *CatalogUsecaseImpl) DeleteCatalogImage(ctx context.Context, itemID, id uuid.UUID) error {
+	const op = "usecase.DeleteCatalogImage"
+
+	if err := uc.service.DeleteCatalogImage(ctx, itemID, id); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to delete catalog image", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	names := []string{id.String()}
+	for _, size := range uc.images.ThumbnailSizes {
+		names = append(names, catalogThumbnailName(id, size))
+	}
+	for _, name := range names {
+		if err := uc.imageStore.Delete(ctx, name); err != nil {
+			uc.log.Error("failed to delete catalog image file", slog.String("op", op),
+				slog.String("name", name), slog.String("error", err.Error()))
+		}
+	}
+
+	uc.log.Info("catalog image deleted", slog.String("op", op), slog.String("item_id", itemID.String()),
+		slog.String("id", id.String()))
+	return nil
+}
+
+// CollectCatalogImageGarbage removes stored files older than grace that belong to no image,
+// such as the files of deleted items, and thumbnails of sizes no longer configured.
+// It returns the number of files removed.
+func (uc *CatalogUsecaseImpl) CollectCatalogImageGarbage(ctx context.Context, grace time.Duration) (int, error) {
+	const op = "usecase.CollectCatalogImageGarbage"
+
+	cutoff := time.Now().Add(-grace)
+	known := make(map[uuid.UUID]bool)
+	removed := 0
+	err := uc.imageStore.Walk(ctx, func(name string, modTime time.Time) error {
+		if !modTime.Before(cutoff) {
+			return nil
+		}
+		idPart, sizePart, isThumbnail := strings.Cut(name, "_")
+		id, err := uuid.Parse(idPart)
+		if err != nil {
+			return nil
+		}
+		exists, ok := known[id]
+		if !ok {
+			if exists, err = uc.service.CatalogImageExists(ctx, id); err != nil {
+				return err
+			}
+			known[id] = exists
+		}
+		if exists && isThumbnail {
+			size, err := strconv.Atoi(sizePart)
+			exists = err == nil && slices.Contains(uc.images.ThumbnailSizes, size)
+		}
+		if exists {
+			return nil
+		}
+		if err := uc.imageStore.Delete(ctx, name); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	if err != nil {
+		uc.log.Error("failed to sweep catalog image files", slog.String("op", op), slog.String("error", err.Error()))
+		return removed, err
+	}
+
+	if removed > 0 {
+		uc.log.Info("catalog image garbage collected", slog.String("op", op), slog.Int("files", removed))
+	}
+	return removed, nil
+}
+
+// renderThumbnail scales the original of img down to size and stores the result.
+func (uc *CatalogUsecaseImpl) renderThumbnail(ctx context.Context, img *entity.CatalogImage, size int) error {
+	original, err := uc.imageStore.Open(ctx, img.ID.String())
+	if err != nil {
+		return err
+	}
+	defer original.Close()
+
+	src, _, err := image.Decode(original)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := thumbnail.Encode(&buf, thumbnail.Scale(src, size), img.ContentType); err != nil {
+		return err
+	}
+	return uc.imageStore.Put(ctx, catalogThumbnailName(img.ID, size), &buf)
+}
+
+// describeImages lists the thumbnails of the images of items.
+func (uc *CatalogUsecaseImpl) describeImages(items []entity.CatalogItem) {
+	for i := range items {
+		uc.describeItemImages(&items[i])
+	}
+}
+
+// describeItemImages lists the thumbnails of the images of item.
+func (uc *CatalogUsecaseImpl) describeItemImages(item *entity.CatalogItem) {
+	for i := range item.Images {
+		uc.describeImage(&item.Images[i])
+	}
+}
+
+// describeHitImages lists the thumbnails of the images of the items found by a search.
+func (uc *CatalogUsecaseImpl) describeHitImages(hits []entity.CatalogSearchHit) {
+	for i := range hits {
+		uc.describeItemImages(&hits[i].Item)
+	}
+}
+
+// describeImage lists one thumbnail of img per configured size. Their dimensions follow from
+// those of the image, so nothing has to be rendered for that.
+func (uc *CatalogUsecaseImpl) describeImage(img *entity.CatalogImage) {
+	img.Thumbnails = make([]entity.CatalogThumbnail, len(uc.images.ThumbnailSizes))
+	for i, size := range uc.images.ThumbnailSizes {
+		img.Thumbnails[i] = catalogThumbnail(img, size)
+	}
+}
+
+func catalogThumbnail(img *entity.CatalogImage, size int) entity.CatalogThumbnail {
+	width, height := thumbnail.Fit(img.Width, img.Height, size)
+	return entity.CatalogThumbnail{
+		Size:        size,
+		Width:       width,
+		Height:      height,
+		ContentType: thumbnail.ContentType(img.ContentType),
+	}
+}
+
+// catalogThumbnailName is the name a thumbnail is stored under in the image store.
+func catalogThumbnailName(id uuid.UUID, size int) string {
+	return id.String() + "_" + strconv.Itoa(size)
+}
@@ -0,0 +1,323 @@
+package usecase
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxCatalogImportRows bounds imports, which are validated in memory and applied in a single transaction.
+	maxCatalogImportRows = 10000
+	// maxCatalogSKULength matches the VARCHAR(64) sku column.
+	maxCatalogSKULength = 64
+	// catalogImportTagSeparator separates the tags of an item in the tags column of a CSV import.
+	catalogImportTagSeparator = "|"
+)
+
+// ImportCatalogItems reads catalog rows from r and validates all of them. If every row is
+// valid, they are applied in one transaction keyed by SKU: upserts create or update the item
+// with their SKU, deletes remove it. Invalid rows are listed in the report and nothing is
+// written then; a dry run reports what would change without writing either.
+func (uc *CatalogUsecaseImpl) ImportCatalogItems(ctx context.Context, r io.Reader, opts entity.CatalogImportOptions) (*entity.CatalogImportReport, error) {
+	const op = "usecase.ImportCatalogItems"
+
+	dec, err := newCatalogImportDecoder(opts.Format, r)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := uc.service.ListCatalogCategories(ctx)
+	if err != nil {
+		uc.log.Error("failed to list catalog categories", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	categoryIDs := make(map[string]uuid.UUID, len(categories))
+	for _, category := range categories {
+		categoryIDs[category.Path] = category.ID
+	}
+
+	report := &entity.CatalogImportReport{DryRun: opts.DryRun, Errors: []entity.ImportLineError{}}
+	var rows []entity.CatalogImportRow
+	seen := make(map[string]int) // sku -> line
+	for {
+		rec, line, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if report.Total == maxCatalogImportRows {
+			return nil, entity.NewValidationError(fmt.Sprintf("too many rows: at most %d allowed per import", maxCatalogImportRows))
+		}
+		var recErr *recordError
+		if errors.As(err, &recErr) {
+			report.Total++
+			failCatalogImport(report, recErr.line, "", recErr.err.Error())
+			continue
+		}
+		if err != nil {
+			return nil, entity.NewValidationError("failed to read import file: " + err.Error())
+		}
+
+		report.Total++
+		row, err := toCatalogImportRow(rec, line, categoryIDs)
+		if err != nil {
+			failCatalogImport(report, line, rec.SKU, err.Error())
+			continue
+		}
+		if first, ok := seen[row.Item.SKU]; ok {
+			failCatalogImport(report, line, row.Item.SKU, "duplicate sku, first seen on line "+strconv.Itoa(first))
+			continue
+		}
+		seen[row.Item.SKU] = line
+		rows = append(rows, *row)
+	}
+
+	if report.Total == 0 {
+		return nil, entity.NewValidationError("import file contains no rows")
+	}
+	if report.Failed > 0 {
+		uc.log.Info("catalog import rejected", slog.String("op", op),
+			slog.Int("total", report.Total), slog.Int("failed", report.Failed))
+		return report, nil
+	}
+
+	res, err := uc.service.ImportCatalogItems(ctx, rows, opts, uc.search.Language)
+	if err != nil {
+		if !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to import catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	if len(res.Missing) > 0 {
+		slices.SortFunc(res.Missing, func(a, b string) int { return cmp.Compare(seen[a], seen[b]) })
+		for _, sku := range res.Missing {
+			failCatalogImport(report, seen[sku], sku, "no item with this sku")
+		}
+		return report, nil
+	}
+
+	report.Created, report.Updated, report.Deleted = res.Created, res.Updated, res.Deleted
+	report.Committed = !opts.DryRun
+	uc.log.Info("catalog import finished", slog.String("op", op),
+		slog.Bool("dry_run", opts.DryRun), slog.Bool("prune", opts.Prune), slog.Int("total", report.Total),
+		slog.Int("created", report.Created), slog.Int("updated", report.Updated), slog.Int("deleted", report.Deleted))
+	return report, nil
+}
+
+// toCatalogImportRow validates a record the same way as CreateCatalogItem and resolves its category path.
+func toCatalogImportRow(rec *catalogImportRecord, line int, categoryIDs map[string]uuid.UUID) (*entity.CatalogImportRow, error) {
+	row := &entity.CatalogImportRow{
+		Line:   line,
+		Action: entity.CatalogImportAction(strings.ToLower(strings.TrimSpace(rec.Action))),
+		Item: entity.CatalogItem{
+			SKU:         strings.TrimSpace(rec.SKU),
+			Title:       rec.Title,
+			Description: rec.Description,
+			Disabled:    rec.Disabled,
+			Tags:        rec.Tags,
+		},
+	}
+	if row.Item.SKU == "" {
+		return nil, entity.NewValidationError("sku cannot be empty")
+	}
+	if !utf8.ValidString(row.Item.SKU) {
+		return nil, entity.NewValidationError("sku must be valid UTF-8")
+	}
+	if n := utf8.RuneCountInString(row.Item.SKU); n > maxCatalogSKULength {
+		return nil, entity.NewValidationError(fmt.Sprintf("sku is too long: %d characters, at most %d allowed", n, maxCatalogSKULength))
+	}
+
+	switch row.Action {
+	case "", entity.CatalogImportUpsert:
+		row.Action = entity.CatalogImportUpsert
+	case entity.CatalogImportDelete:
+		return row, nil
+	default:
+		return nil, entity.NewValidationError(fmt.Sprintf("unknown action %q", rec.Action))
+	}
+
+	if err := validateCatalogItem(&row.Item); err != nil {
+		return nil, err
+	}
+	if path := strings.TrimSpace(rec.Category); path != "" {
+		id, ok := categoryIDs[path]
+		if !ok {
+			return nil, entity.NewValidationError(fmt.Sprintf("unknown category %q", path))
+		}
+		row.Item.CategoryID = id
+	}
+	return row, nil
+}
+
+func failCatalogImport(report *entity.CatalogImportReport, line int, sku, message string) {
+	report.Failed++
+	if len(report.Errors) < maxImportErrors {
+		report.Errors = append(report.Errors, entity.ImportLineError{Line: line, Key: sku, Message: message})
+	}
+}
+
+// catalogImportRecord is a single catalog item as it appears in an import file.
+// Category is a category path; Action defaults to upsert.
+type catalogImportRecord struct {
+	SKU         string   `json:"sku"`
+	Action      string   `json:"action"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Disabled    bool     `json:"disabled"`
+	Tags        []string `json:"tags"`
+	Category    string   `json:"category"`
+}
+
+// catalogImportDecoder reads catalog records one by one from an import file.
+type catalogImportDecoder interface {
+	// Next returns the next record and its line number, a *recordError for an
+	// unparsable record, or io.EOF when the input is exhausted.
+	Next() (*catalogImportRecord, int, error)
+}
+
+func newCatalogImportDecoder(format entity.DataFormat, r io.Reader) (catalogImportDecoder, error) {
+	switch format {
+	case entity.DataFormatCSV:
+		return newCatalogCSVDecoder(r)
+	case entity.DataFormatJSON:
+		return newCatalogJSONDecoder(r)
+	default:
+		return nil, entity.NewValidationError(fmt.Sprintf("unsupported format %q", format))
+	}
+}
+
+// catalogJSONDecoder reads a JSON array of records. The line of a record is its position in the array.
+type catalogJSONDecoder struct {
+	dec  *json.Decoder
+	line int
+}
+
+func newCatalogJSONDecoder(r io.Reader) (*catalogJSONDecoder, error) {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, entity.NewValidationError("json input is empty")
+		}
+		return nil, entity.NewValidationError("failed to read json input: " + err.Error())
+	}
+	if tok != json.Delim('[') {
+		return nil, entity.NewValidationError("json input must be an array of items")
+	}
+	return &catalogJSONDecoder{dec: dec}, nil
+}
+
+func (d *catalogJSONDecoder) Next() (*catalogImportRecord, int, error) {
+	if !d.dec.More() {
+		if _, err := d.dec.Token(); err != nil {
+			return nil, d.line, err
+		}
+		return nil, d.line, io.EOF
+	}
+
+	var raw json.RawMessage
+	if err := d.dec.Decode(&raw); err != nil {
+		return nil, d.line, err
+	}
+	d.line++
+
+	if raw = bytes.TrimSpace(raw); len(raw) == 0 || raw[0] != '{' {
+		return nil, d.line, &recordError{line: d.line, err: errors.New("item must be a JSON object")}
+	}
+	// Unknown fields are rejected so that misspelled fields do not silently clear a value.
+	rd := json.NewDecoder(bytes.NewReader(raw))
+	rd.DisallowUnknownFields()
+	var rec catalogImportRecord
+	if err := rd.Decode(&rec); err != nil {
+		return nil, d.line, &recordError{line: d.line, err: err}
+	}
+	return &rec, d.line, nil
+}
+
+var catalogCSVColumns = []string{"sku", "action", "title", "description", "disabled", "tags", "category"}
+
+type catalogCSVDecoder struct {
+	r       *csv.Reader
+	columns map[string]int
+}
+
+func newCatalogCSVDecoder(r io.Reader) (*catalogCSVDecoder, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, entity.NewValidationError("csv input is empty")
+		}
+		return nil, entity.NewValidationError("failed to read csv header: " + err.Error())
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(catalogCSVColumns, name) {
+			return nil, entity.NewValidationError(fmt.Sprintf("unknown csv column %q", name))
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["sku"]; !ok {
+		return nil, entity.NewValidationError(`csv header must contain a "sku" column`)
+	}
+
+	return &catalogCSVDecoder{r: cr, columns: columns}, nil
+}
+
+func (d *catalogCSVDecoder) Next() (*catalogImportRecord, int, error) {
+	record, err := d.r.Read()
+	if err != nil {
+		var pErr *csv.ParseError
+		if errors.As(err, &pErr) {
+			return nil, pErr.Line, &recordError{line: pErr.Line, err: pErr.Err}
+		}
+		return nil, 0, err
+	}
+	line, _ := d.r.FieldPos(0)
+
+	rec := &catalogImportRecord{
+		SKU:         d.field(record, "sku"),
+		Action:      d.field(record, "action"),
+		Title:       d.field(record, "title"),
+		Description: d.field(record, "description"),
+		Category:    d.field(record, "category"),
+	}
+	if s := strings.TrimSpace(d.field(record, "disabled")); s != "" {
+		disabled, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, line, &recordError{line: line, err: fmt.Errorf("invalid disabled value %q", s)}
+		}
+		rec.Disabled = disabled
+	}
+	if s := d.field(record, "tags"); strings.TrimSpace(s) != "" {
+		rec.Tags = strings.Split(s, catalogImportTagSeparator)
+	}
+	return rec, line, nil
+}
+
+func (d *catalogCSVDecoder) field(record []string, name string) string {
+	i, ok := d.columns[name]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return record[i]
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// SetCatalogInventory replaces the price and stock of a catalog item. Lowering the stock below
+// the reserved quantity is allowed; the item is out of stock until reservations end.
+func (uc *CatalogUsecaseImpl) SetCatalogInventory(ctx context.Context, id uuid.UUID, update entity.CatalogInventoryUpdate) (*entity.CatalogItem, error) {
+	const op = "usecase.SetCatalogInventory"
+
+	var (
+		price   *entity.Money
+		details []entity.ValidationDetail
+	)
+	if update.PriceAmount != "" || update.Currency != "" {
+		m, err := entity.ParseMoney(update.PriceAmount, update.Currency)
+		if err != nil {
+			details = append(details, entity.ValidationDetail{Path: "price", Message: err.Error()})
+		} else {
+			price = &m
+		}
+	}
+	if update.StockQuantity != nil && *update.StockQuantity < 0 {
+		details = append(details, entity.ValidationDetail{Path: "stock_quantity", Message: "must not be negative"})
+	}
+	if len(details) > 0 {
+		return nil, entity.NewValidationError("invalid catalog inventory", details...)
+	}
+
+	item, err := uc.service.SetCatalogInventory(ctx, id, price, update.StockQuantity)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to set catalog inventory", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	uc.describeItemImages(item)
+
+	uc.log.Info("catalog inventory set", slog.String("op", op), slog.String("id", id.String()))
+	return item, nil
+}
+
+// ReserveCatalogItem holds quantity units of an item for a user. A zero ttl stands for the
+// default reservation TTL.
+func (uc *CatalogUsecaseImpl) ReserveCatalogItem(ctx context.Context, itemID, userID uuid.UUID, quantity int64, ttl time.Duration) (*entity.CatalogReservation, error) {
+	const op = "usecase.ReserveCatalogItem"
+
+	if quantity < 1 {
+		return nil, entity.NewValidationError("quantity must be at least 1")
+	}
+	switch {
+	case ttl == 0:
+		ttl = uc.reservations.DefaultTTL
+	case ttl < 0 || ttl > uc.reservations.MaxTTL:
+		return nil, entity.NewValidationError(fmt.Sprintf("ttl must be between 1s and %s", uc.reservations.MaxTTL))
+	}
+
+	res := &entity.CatalogReservation{
+		ItemID:    itemID,
+		UserID:    userID,
+		Quantity:  quantity,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if err := uc.service.ReserveCatalogItem(ctx, res); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to reserve catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	uc.log.Info("catalog item reserved", slog.String("op", op), slog.String("item_id", itemID.String()),
+		slog.String("reservation_id", res.ID.String()), slog.Int64("quantity", quantity))
+	return res, nil
+}
+
+// ReleaseCatalogReservation ends a reservation of an item made by a user, returning its units
+// to the stock. Releasing is idempotent: a reservation that is gone already, because it expired
+// and was purged or was released before, counts as released.
+func (uc *CatalogUsecaseImpl) ReleaseCatalogReservation(ctx context.Context, itemID, reservationID, userID uuid.UUID) error {
+	const op = "usecase.ReleaseCatalogReservation"
+
+	if err := uc.service.ReleaseCatalogReservation(ctx, itemID, reservationID, userID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return nil
+		}
+		uc.log.Error("failed to release catalog reservation", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	uc.log.Info("catalog reservation released", slog.String("op", op), slog.String("reservation_id", reservationID.String()))
+	return nil
+}
@@ -0,0 +1,88 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"base_app/internal/entity"
+	"base_app/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+// newInventoryUsecase returns a catalog use case holding one item with stock units in stock.
+func newInventoryUsecase(t *testing.T, stock int64) (usecase.CatalogUsecase, uuid.UUID) {
+	t.Helper()
+
+	ctx := context.Background()
+	uc := newCatalogUsecaseWith(t, memoryStorage, catalogPolicies{reservations: entity.CatalogReservationPolicy{DefaultTTL: time.Hour, MaxTTL: time.Hour}})
+	item := &entity.CatalogItem{Title: "item"}
+	if err := uc.CreateCatalogItem(ctx, item); err != nil {
+		t.Fatalf("CreateCatalogItem: %v", err)
+	}
+	if _, err := uc.SetCatalogInventory(ctx, item.ID, entity.CatalogInventoryUpdate{StockQuantity: &stock}); err != nil {
+		t.Fatalf("SetCatalogInventory: %v", err)
+	}
+	return uc, item.ID
+}
+
+func TestReserveCatalogItemNeverOversells(t *testing.T) {
+	const stock, buyers = 10, 50
+	uc, itemID := newInventoryUsecase(t, stock)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		reserved int64
+	)
+	for range buyers {
+		wg.Go(func() {
+			_, err := uc.ReserveCatalogItem(context.Background(), itemID, uuid.New(), 1, 0)
+			switch {
+			case err == nil:
+				mu.Lock()
+				reserved++
+				mu.Unlock()
+			case !errors.Is(err, entity.ErrConflict):
+				t.Errorf("ReserveCatalogItem: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if reserved != stock {
+		t.Errorf("%d units reserved, want exactly the %d in stock", reserved, stock)
+	}
+	item, err := uc.GetCatalogItem(context.Background(), itemID, uuid.Nil, entity.LocalePreference{})
+	if err != nil {
+		t.Fatalf("GetCatalogItem: %v", err)
+	}
+	if item.Availability() != entity.CatalogOutOfStock {
+		t.Errorf("availability = %s, want %s", item.Availability(), entity.CatalogOutOfStock)
+	}
+}
+
+func TestReleaseCatalogReservationIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	uc, itemID := newInventoryUsecase(t, 1)
+	userID := uuid.New()
+
+	res, err := uc.ReserveCatalogItem(ctx, itemID, userID, 1, 0)
+	if err != nil {
+		t.Fatalf("ReserveCatalogItem: %v", err)
+	}
+	for range 2 {
+		if err := uc.ReleaseCatalogReservation(ctx, itemID, res.ID, userID); err != nil {
+			t.Errorf("ReleaseCatalogReservation: %v", err)
+		}
+	}
+	if err := uc.ReleaseCatalogReservation(ctx, itemID, uuid.New(), userID); err != nil {
+		t.Errorf("ReleaseCatalogReservation(unknown) = %v, want success", err)
+	}
+	if _, err := uc.ReserveCatalogItem(ctx, itemID, userID, 1, 0); err != nil {
+		t.Errorf("released unit cannot be reserved again: %v", err)
+	}
+}
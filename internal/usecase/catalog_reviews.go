@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultReviewPageSize = 50
+	maxReviewPageSize     = 500
+)
+
+// reviewPageSize applies the default to a zero limit and caps it at maxReviewPageSize.
+func reviewPageSize(limit int) int32 {
+	switch {
+	case limit <= 0:
+		return defaultReviewPageSize
+	case limit > maxReviewPageSize:
+		return maxReviewPageSize
+	}
+	return int32(limit)
+}
+
+// GetCatalogReview retrieves the review a user left for an item, whatever its status.
+func (uc *CatalogUsecaseImpl) GetCatalogReview(ctx context.Context, itemID, userID uuid.UUID) (*entity.CatalogReview, error) {
+	const op = "usecase.GetCatalogReview"
+
+	review, err := uc.service.GetCatalogReview(ctx, itemID, userID)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get catalog review", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	return review, nil
+}
+
+// SaveCatalogReview creates the review of an item by a user or replaces the rating and text
+// of the existing one. Saved reviews are approved right away unless the policy requires
+// approval, in which case they stay pending, and hidden, until an admin approves them. An edit
+// of a rejected review always goes back to pending, so it cannot publish itself.
+func (uc *CatalogUsecaseImpl) SaveCatalogReview(ctx context.Context, itemID, userID uuid.UUID, rating int, body string) (*entity.CatalogReview, error) {
+	const op = "usecase.SaveCatalogReview"
+
+	body = strings.TrimSpace(body)
+	var details []entity.ValidationDetail
+	if rating < entity.MinCatalogRating || rating > entity.MaxCatalogRating {
+		details = append(details, entity.ValidationDetail{Path: "rating",
+			Message: fmt.Sprintf("must be between %d and %d", entity.MinCatalogRating, entity.MaxCatalogRating)})
+	}
+	if n := utf8.RuneCountInString(body); n > uc.reviews.MaxBodyLength {
+		details = append(details, entity.ValidationDetail{Path: "body",
+			Message: fmt.Sprintf("must be at most %d characters", uc.reviews.MaxBodyLength)})
+	}
+	if len(details) > 0 {
+		return nil, entity.NewValidationError("invalid catalog review", details...)
+	}
+
+	review := &entity.CatalogReview{
+		ItemID: itemID,
+		UserID: userID,
+		Rating: rating,
+		Body:   body,
+		Status: entity.CatalogReviewApproved,
+	}
+	if uc.reviews.RequireApproval {
+		review.Status = entity.CatalogReviewPending
+	}
+	if err := uc.service.SaveCatalogReview(ctx, review); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to save catalog review", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	uc.log.Info("catalog review saved", slog.String("op", op), slog.String("item_id", itemID.String()),
+		slog.String("review_id", review.ID.String()), slog.String("status", string(review.Status)))
+	return review, nil
+}
+
+// DeleteCatalogReview deletes the review a user left for an item.
+func (uc *CatalogUsecaseImpl) DeleteCatalogReview(ctx context.Context, itemID, userID uuid.UUID) error {
+	const op = "usecase.DeleteCatalogReview"
+
+	if err := uc.service.DeleteCatalogReview(ctx, itemID, userID); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to delete catalog review", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("catalog review deleted", slog.String("op", op), slog.String("item_id", itemID.String()))
+	return nil
+}
+
+// SetCatalogReviewStatus moderates a review. Approving a review adds it to the rating of its
+// item; rejecting or resetting it to pending removes it again.
+func (uc *CatalogUsecaseImpl) SetCatalogReviewStatus(ctx context.Context, id uuid.UUID, status entity.CatalogReviewStatus) (*entity.CatalogReview, error) {
+	const op = "usecase.SetCatalogReviewStatus"
+
+	if !status.Valid() {
+		return nil, entity.NewValidationError(fmt.Sprintf("unknown review status %q", status))
+	}
+	review, err := uc.service.SetCatalogReviewStatus(ctx, id, status)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to set catalog review status", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	uc.log.Info("catalog review moderated", slog.String("op", op), slog.String("review_id", id.String()),
+		slog.String("status", string(status)))
+	return review, nil
+}
+
+// ListCatalogReviews retrieves the approved reviews of an item, newest first.
+func (uc *CatalogUsecaseImpl) ListCatalogReviews(ctx context.Context, itemID uuid.UUID, limit int) ([]entity.CatalogReview, error) {
+	const op = "usecase.ListCatalogReviews"
+
+	if _, err := uc.service.GetCatalogItem(ctx, itemID); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	reviews, err := uc.service.ListCatalogReviews(ctx, itemID, entity.CatalogReviewApproved, reviewPageSize(limit))
+	if err != nil {
+		uc.log.Error("failed to list catalog reviews", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// ListCatalogReviewsByStatus retrieves the reviews of all items with the given status for
+// moderation, least recently changed first.
+func (uc *CatalogUsecaseImpl) ListCatalogReviewsByStatus(ctx context.Context, status entity.CatalogReviewStatus, limit int) ([]entity.CatalogReview, error) {
+	const op = "usecase.ListCatalogReviewsByStatus"
+
+	if !status.Valid() {
+		return nil, entity.NewValidationError(fmt.Sprintf("unknown review status %q", status))
+	}
+	reviews, err := uc.service.ListCatalogReviewsByStatus(ctx, status, reviewPageSize(limit))
+	if err != nil {
+		uc.log.Error("failed to list catalog reviews", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return reviews, nil
+}
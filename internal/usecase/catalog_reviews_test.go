@@ -0,0 +1,113 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+func TestCatalogReviewRatings(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		ctx := context.Background()
+		uc := newCatalogUsecaseWith(t, open, catalogPolicies{reviews: entity.CatalogReviewPolicy{RequireApproval: true, MaxBodyLength: 100}})
+		item := &entity.CatalogItem{Title: "item"}
+		if err := uc.CreateCatalogItem(ctx, item); err != nil {
+			t.Fatalf("CreateCatalogItem: %v", err)
+		}
+		alice, bob := uuid.New(), uuid.New()
+
+		check := func(step string, wantCount, wantSum int64) {
+			t.Helper()
+			got, err := uc.GetCatalogItem(ctx, item.ID, uuid.Nil, entity.LocalePreference{})
+			if err != nil {
+				t.Fatalf("%s: GetCatalogItem: %v", step, err)
+			}
+			if got.ReviewCount != wantCount || got.RatingSum != wantSum {
+				t.Errorf("%s: %d reviews rated %d in total, want %d rated %d", step, got.ReviewCount, got.RatingSum, wantCount, wantSum)
+			}
+		}
+		save := func(user uuid.UUID, rating int) *entity.CatalogReview {
+			t.Helper()
+			review, err := uc.SaveCatalogReview(ctx, item.ID, user, rating, "")
+			if err != nil {
+				t.Fatalf("SaveCatalogReview: %v", err)
+			}
+			return review
+		}
+		moderate := func(review *entity.CatalogReview, status entity.CatalogReviewStatus) {
+			t.Helper()
+			if _, err := uc.SetCatalogReviewStatus(ctx, review.ID, status); err != nil {
+				t.Fatalf("SetCatalogReviewStatus: %v", err)
+			}
+		}
+
+		a := save(alice, 5)
+		if a.Status != entity.CatalogReviewPending {
+			t.Errorf("status = %s, want pending until approved", a.Status)
+		}
+		check("pending review", 0, 0)
+
+		moderate(a, entity.CatalogReviewApproved)
+		check("approved", 1, 5)
+
+		b := save(bob, 2)
+		moderate(b, entity.CatalogReviewApproved)
+		check("second approved", 2, 7)
+
+		save(alice, 1)
+		check("edited review is pending again", 1, 2)
+
+		moderate(a, entity.CatalogReviewApproved)
+		check("edit approved", 2, 3)
+
+		moderate(b, entity.CatalogReviewRejected)
+		check("rejected", 1, 1)
+
+		if err := uc.DeleteCatalogReview(ctx, item.ID, alice); err != nil {
+			t.Fatalf("DeleteCatalogReview: %v", err)
+		}
+		check("deleted", 0, 0)
+
+		if _, err := uc.SaveCatalogReview(ctx, item.ID, alice, entity.MaxCatalogRating+1, ""); err == nil {
+			t.Errorf("SaveCatalogReview accepted a rating of %d", entity.MaxCatalogRating+1)
+		}
+	})
+}
+
+func TestEditedRejectedReviewGoesBackToModeration(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		ctx := context.Background()
+		uc := newCatalogUsecaseWith(t, open, catalogPolicies{reviews: entity.CatalogReviewPolicy{MaxBodyLength: 100}})
+		item := &entity.CatalogItem{Title: "item"}
+		if err := uc.CreateCatalogItem(ctx, item); err != nil {
+			t.Fatalf("CreateCatalogItem: %v", err)
+		}
+		userID := uuid.New()
+
+		review, err := uc.SaveCatalogReview(ctx, item.ID, userID, 1, "spam")
+		if err != nil || review.Status != entity.CatalogReviewApproved {
+			t.Fatalf("SaveCatalogReview = %+v, %v; want an approved review", review, err)
+		}
+		rejected, err := uc.SetCatalogReviewStatus(ctx, review.ID, entity.CatalogReviewRejected)
+		if err != nil {
+			t.Fatalf("SetCatalogReviewStatus: %v", err)
+		}
+		if !rejected.UpdatedAt.After(review.UpdatedAt) {
+			t.Errorf("moderation kept updated_at at %v", rejected.UpdatedAt)
+		}
+
+		edited, err := uc.SaveCatalogReview(ctx, item.ID, userID, 5, "more spam")
+		if err != nil {
+			t.Fatalf("SaveCatalogReview: %v", err)
+		}
+		if edited.Status != entity.CatalogReviewPending {
+			t.Errorf("edited rejected review is %s, want %s", edited.Status, entity.CatalogReviewPending)
+		}
+		if got, err := uc.GetCatalogItem(ctx, item.ID, uuid.Nil, entity.LocalePreference{}); err != nil || got.ReviewCount != 0 {
+			t.Errorf("GetCatalogItem = %d reviews, %v; want the edit unpublished", got.ReviewCount, err)
+		}
+	})
+}
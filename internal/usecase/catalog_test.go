@@ -0,0 +1,210 @@
+package usecase_test
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"base_app/internal/entity"
+	"base_app/internal/service"
+	"base_app/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+// catalogPolicies holds the policies of a catalog use case under test. The zero value is what
+// most tests need.
+type catalogPolicies struct {
+	reservations entity.CatalogReservationPolicy
+	reviews      entity.CatalogReviewPolicy
+}
+
+// newCatalogUsecase returns a catalog use case backed by an in-memory repository holding an
+// item for each of titles.
+func newCatalogUsecase(t *testing.T, titles ...string) usecase.CatalogUsecase {
+	t.Helper()
+	return newCatalogUsecaseWith(t, memoryStorage, catalogPolicies{}, titles...)
+}
+
+// newCatalogUsecaseWith is newCatalogUsecase over the storage of open, with the given policies.
+func newCatalogUsecaseWith(t *testing.T, open storageDriver, policies catalogPolicies, titles ...string) usecase.CatalogUsecase {
+	t.Helper()
+
+	log := slog.New(slog.DiscardHandler)
+	uc := usecase.NewCatalogUsecase(service.NewCatalogService(open(t).repo, log), nil,
+		entity.CatalogSearchSettings{Language: "simple"}, entity.CatalogImagePolicy{}, "en", 0,
+		policies.reservations, policies.reviews, log)
+	for _, title := range titles {
+		if err := uc.CreateCatalogItem(context.Background(), &entity.CatalogItem{Title: title}); err != nil {
+			t.Fatalf("create %q: %v", title, err)
+		}
+	}
+	return uc
+}
+
+// listAll follows the cursors of a listing to its end and returns the items in page order.
+func listAll(t *testing.T, uc usecase.CatalogUsecase, q entity.CatalogQuery) []entity.CatalogItem {
+	t.Helper()
+
+	var items []entity.CatalogItem
+	cursor := ""
+	for range 100 {
+		page, err := uc.ListCatalogItems(context.Background(), q, cursor, uuid.Nil, entity.LocalePreference{})
+		if err != nil {
+			t.Fatalf("ListCatalogItems: %v", err)
+		}
+		if len(page.Items) > q.Limit {
+			t.Fatalf("page holds %d items, limit is %d", len(page.Items), q.Limit)
+		}
+		items = append(items, page.Items...)
+		if page.NextCursor == "" {
+			return items
+		}
+		if url.QueryEscape(page.NextCursor) != page.NextCursor {
+			t.Errorf("cursor %q is not URL safe", page.NextCursor)
+		}
+		cursor = page.NextCursor
+	}
+	t.Fatal("listing does not end")
+	return nil
+}
+
+func TestListCatalogItemsPaging(t *testing.T) {
+	titles := []string{"b", "a", "c", "b", "a", "b", "d"} // Ties are broken by id
+	byTitle := func(a, b entity.CatalogItem) int {
+		return cmp.Or(strings.Compare(a.Title, b.Title), slices.Compare(a.ID[:], b.ID[:]))
+	}
+	byCreatedAt := func(a, b entity.CatalogItem) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), slices.Compare(a.ID[:], b.ID[:]))
+	}
+	reverse := func(f func(a, b entity.CatalogItem) int) func(a, b entity.CatalogItem) int {
+		return func(a, b entity.CatalogItem) int { return f(b, a) }
+	}
+
+	tests := []struct {
+		sort    entity.CatalogSort
+		compare func(a, b entity.CatalogItem) int
+	}{
+		{entity.CatalogSortTitle, byTitle},
+		{entity.CatalogSortTitleDesc, reverse(byTitle)},
+		{entity.CatalogSortCreatedAt, byCreatedAt},
+		{entity.CatalogSortCreatedAtDesc, reverse(byCreatedAt)},
+	}
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		uc := newCatalogUsecaseWith(t, open, catalogPolicies{}, titles...)
+		for _, tt := range tests {
+			for _, limit := range []int{1, 2, 3, len(titles), len(titles) + 1} {
+				t.Run(fmt.Sprintf("%s/%d", tt.sort, limit), func(t *testing.T) {
+					items := listAll(t, uc, entity.CatalogQuery{Sort: tt.sort, Limit: limit})
+					if len(items) != len(titles) {
+						t.Fatalf("listed %d items, want %d", len(items), len(titles))
+					}
+					if !slices.IsSortedFunc(items, tt.compare) {
+						t.Errorf("items are not in %s order", tt.sort)
+					}
+					if ids := slices.CompactFunc(slices.Clone(items), func(a, b entity.CatalogItem) bool { return a.ID == b.ID }); len(ids) != len(items) {
+						t.Errorf("items repeat across pages")
+					}
+				})
+			}
+		}
+	})
+}
+
+func TestListCatalogItemsFiltersWithCursor(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		uc := newCatalogUsecaseWith(t, open, catalogPolicies{}, "apple", "banana", "apricot", "avocado", "blueberry")
+
+		items := listAll(t, uc, entity.CatalogQuery{TitlePrefix: "a", Limit: 1})
+		var got []string
+		for _, item := range items {
+			got = append(got, item.Title)
+		}
+		if want := []string{"apple", "apricot", "avocado"}; !slices.Equal(got, want) {
+			t.Errorf("titles = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestListCatalogItemsRejectsInvalidCursors(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		uc := newCatalogUsecaseWith(t, open, catalogPolicies{}, "a", "b")
+		page, err := uc.ListCatalogItems(context.Background(), entity.CatalogQuery{Limit: 1}, "", uuid.Nil, entity.LocalePreference{})
+		if err != nil || page.NextCursor == "" {
+			t.Fatalf("first page = %v, %v; want a next cursor", page, err)
+		}
+
+		tests := []struct {
+			name   string
+			sort   entity.CatalogSort
+			cursor string
+		}{
+			{"not base64", entity.CatalogSortTitle, "!!"},
+			{"not json", entity.CatalogSortTitle, "bm90IGpzb24"},
+			{"other sort order", entity.CatalogSortCreatedAt, page.NextCursor},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := uc.ListCatalogItems(context.Background(), entity.CatalogQuery{Sort: tt.sort, Limit: 1}, tt.cursor, uuid.Nil, entity.LocalePreference{})
+				var vErr *entity.ValidationError
+				if !errors.As(err, &vErr) {
+					t.Errorf("ListCatalogItems() = %v, want a validation error", err)
+				}
+			})
+		}
+	})
+}
+
+func TestListCatalogFavoritesPaging(t *testing.T) {
+	uc := newCatalogUsecase(t, "a", "b", "c", "d", "e")
+	ctx := context.Background()
+	userID := uuid.New()
+
+	var want []uuid.UUID
+	for _, item := range listAll(t, uc, entity.CatalogQuery{Limit: 10}) {
+		if err := uc.AddCatalogFavorite(ctx, userID, item.ID); err != nil {
+			t.Fatalf("AddCatalogFavorite: %v", err)
+		}
+		want = append(want, item.ID)
+	}
+
+	for _, limit := range []int{1, 2, len(want), len(want) + 1} {
+		t.Run(fmt.Sprint(limit), func(t *testing.T) {
+			var got []uuid.UUID
+			cursor := ""
+			for range 100 {
+				page, err := uc.ListCatalogFavorites(ctx, userID, cursor, limit, entity.LocalePreference{})
+				if err != nil {
+					t.Fatalf("ListCatalogFavorites: %v", err)
+				}
+				if len(page.Items) > limit {
+					t.Fatalf("page holds %d items, limit is %d", len(page.Items), limit)
+				}
+				for _, item := range page.Items {
+					if !item.IsFavorite {
+						t.Errorf("favorite %s is not marked", item.ID)
+					}
+					got = append(got, item.ID)
+				}
+				if cursor = page.NextCursor; cursor == "" {
+					break
+				}
+			}
+			slices.SortFunc(got, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+			sorted := slices.SortedFunc(slices.Values(want), func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+			if !slices.Equal(got, sorted) {
+				t.Errorf("listed favorites %v, want each of %v once", got, sorted)
+			}
+		})
+	}
+
+	if _, err := uc.ListCatalogFavorites(ctx, userID, "!!", 1, entity.LocalePreference{}); !isValidationError(err) {
+		t.Errorf("ListCatalogFavorites(invalid cursor) = %v, want a validation error", err)
+	}
+}
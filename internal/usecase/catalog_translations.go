@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/language"
+)
+
+const (
+	// maxLocaleLength matches the VARCHAR(35) locale columns.
+	maxLocaleLength = 35
+	// maxLocaleCandidates bounds the locales looked up for one read, however long the
+	// Accept-Language header is.
+	maxLocaleCandidates = 10
+)
+
+// ListCatalogTranslations returns the translations of an item ordered by locale.
+func (uc *CatalogUsecaseImpl) ListCatalogTranslations(ctx context.Context, itemID uuid.UUID) ([]entity.CatalogTranslation, error) {
+	const op = "usecase.ListCatalogTranslations"
+
+	if _, err := uc.GetCatalogItem(ctx, itemID, uuid.Nil, entity.LocalePreference{}); err != nil {
+		return nil, err
+	}
+	translations, err := uc.service.ListCatalogTranslations(ctx, itemID)
+	if err != nil {
+		uc.log.Error("failed to list catalog translations", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return translations, nil
+}
+
+// SaveCatalogTranslation validates and creates or replaces the translation of an item into
+// one locale. Text in the default locale is the item's own title and description.
+func (uc *CatalogUsecaseImpl) SaveCatalogTranslation(ctx context.Context, t *entity.CatalogTranslation) error {
+	const op = "usecase.SaveCatalogTranslation"
+
+	locale, err := normalizeLocale(t.Locale)
+	if err != nil {
+		return err
+	}
+	if locale == uc.defaultLocale {
+		return entity.NewValidationError(fmt.Sprintf("%s is the default locale; update the item itself instead", locale))
+	}
+	t.Locale = locale
+	t.Title = strings.TrimSpace(t.Title)
+	if t.Title == "" {
+		return entity.NewValidationError("title cannot be empty")
+	}
+	if !utf8.ValidString(t.Title) || !utf8.ValidString(t.Description) {
+		return entity.NewValidationError("title and description must be valid UTF-8")
+	}
+	if n := utf8.RuneCountInString(t.Title); n > maxCatalogTitleLength {
+		return entity.NewValidationError(fmt.Sprintf("title is too long: %d characters, at most %d allowed", n, maxCatalogTitleLength))
+	}
+
+	if err := uc.service.SaveCatalogTranslation(ctx, t); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to save catalog translation", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("catalog translation saved", slog.String("op", op), slog.String("item_id", t.ItemID.String()),
+		slog.String("locale", t.Locale))
+	return nil
+}
+
+// DeleteCatalogTranslation deletes the translation of an item into one locale.
+func (uc *CatalogUsecaseImpl) DeleteCatalogTranslation(ctx context.Context, itemID uuid.UUID, locale string) error {
+	const op = "usecase.DeleteCatalogTranslation"
+
+	locale, err := normalizeLocale(locale)
+	if err != nil {
+		// No translation can be stored under an invalid locale.
+		return entity.ErrNotFound
+	}
+	if err := uc.service.DeleteCatalogTranslation(ctx, itemID, locale); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to delete catalog translation", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("catalog translation deleted", slog.String("op", op), slog.String("item_id", itemID.String()),
+		slog.String("locale", locale))
+	return nil
+}
+
+// localize replaces the title and description of items with their best translation for
+// pref and records the locale used in each item.
+func (uc *CatalogUsecaseImpl) localize(ctx context.Context, items []entity.CatalogItem, pref entity.LocalePreference) error {
+	for i := range items {
+		items[i].Locale = uc.defaultLocale
+	}
+	locales := localeCandidates(pref, uc.defaultLocale)
+	if len(items) == 0 || len(locales) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(items))
+	for i := range items {
+		ids[i] = items[i].ID
+	}
+	translations, err := uc.service.ListCatalogTranslationsForItems(ctx, ids, locales)
+	if err != nil {
+		return err
+	}
+
+	best := make(map[uuid.UUID]entity.CatalogTranslation, len(translations))
+	for _, t := range translations {
+		if b, ok := best[t.ItemID]; !ok || slices.Index(locales, t.Locale) < slices.Index(locales, b.Locale) {
+			best[t.ItemID] = t
+		}
+	}
+	for i := range items {
+		if t, ok := best[items[i].ID]; ok {
+			items[i].Title, items[i].Description, items[i].Locale = t.Title, t.Description, t.Locale
+		}
+	}
+	return nil
+}
+
+// localeCandidates lists the locales acceptable for pref, most preferred first. Each locale
+// is followed by its more general parents ("de-AT", then "de"). The list stops at the
+// default locale, as its text is always available.
+func localeCandidates(pref entity.LocalePreference, defaultLocale string) []string {
+	var tags []language.Tag
+	if pref.Profile != "" {
+		if tag, err := language.Parse(pref.Profile); err == nil {
+			tags = append(tags, tag)
+		}
+	}
+	if pref.AcceptLanguage != "" {
+		// A malformed header is ignored rather than rejected, as browsers send it unasked.
+		if accepted, _, err := language.ParseAcceptLanguage(pref.AcceptLanguage); err == nil {
+			tags = append(tags, accepted...)
+		}
+	}
+
+	var locales []string
+	for _, tag := range tags {
+		for ; tag != language.Und; tag = tag.Parent() {
+			locale := tag.String()
+			if locale == defaultLocale || len(locales) == maxLocaleCandidates {
+				return locales
+			}
+			if !slices.Contains(locales, locale) {
+				locales = append(locales, locale)
+			}
+		}
+	}
+	return locales
+}
+
+// normalizeLocale checks that locale is a BCP 47 tag and returns its canonical form.
+func normalizeLocale(locale string) (string, error) {
+	tag, err := language.Parse(strings.TrimSpace(locale))
+	if err != nil || tag == language.Und {
+		return "", entity.NewValidationError(fmt.Sprintf("invalid locale %q", locale))
+	}
+	if s := tag.String(); len(s) <= maxLocaleLength {
+		return s, nil
+	}
+	return "", entity.NewValidationError(fmt.Sprintf("locale is too long: at most %d characters allowed", maxLocaleLength))
+}
@@ -2,40 +2,246 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log/slog"
+	"time"
 
 	"base_app/internal/entity"
+	"base_app/pkg/jsonschema"
+
+	"github.com/google/uuid"
 )
 
 // DataUsecaseImpl handles the business logic for data operations.
 type DataUsecaseImpl struct {
 	service DataService
+	tx      TxManager
+	quota   entity.DataQuota
+	schemas *schemaCache
 	log     *slog.Logger
 }
 
-// NewDataUsecase creates a new DataUsecase.
-func NewDataUsecase(s DataService, l *slog.Logger) DataUsecase {
+// NewDataUsecase creates a new DataUsecase. quota applies to every user writing through SaveData.
+func NewDataUsecase(s DataService, tx TxManager, quota entity.DataQuota, l *slog.Logger) DataUsecase {
 	return &DataUsecaseImpl{
 		service: s,
+		tx:      tx,
+		quota:   quota,
+		schemas: newSchemaCache(),
 		log:     l,
 	}
 }
 
 // SaveData validates and saves data.
+// If a schema is registered for a prefix of the key, the value must satisfy it.
+// The write must keep the owner within the configured quota, otherwise a *entity.QuotaError is returned.
+// The quota check and the write, including its outbox event, run in one unit of work that holds
+// off the checks of the owner's other writes, so concurrent writes cannot overshoot the quota.
 func (uc *DataUsecaseImpl) SaveData(ctx context.Context, data *entity.Data) error {
 	const op = "usecase.SaveData"
 
 	if data.Key == "" {
-		return errors.New("key cannot be empty")
+		return entity.NewValidationError("key cannot be empty")
+	}
+	if !json.Valid(data.Value) {
+		return entity.NewValidationError("value must be valid JSON")
+	}
+	if err := resolveExpiry(data); err != nil {
+		return err
+	}
+
+	if err := uc.validateValue(ctx, data); err != nil {
+		return err
+	}
+	size := int64(len(data.Value))
+	if uc.quota.MaxValueBytes > 0 && size > uc.quota.MaxValueBytes {
+		return &entity.QuotaError{Kind: entity.QuotaValueSize, Limit: uc.quota.MaxValueBytes, Requested: size}
 	}
 
-	err := uc.service.SaveData(ctx, data)
+	var err error
+	if uc.quota.MaxKeys > 0 || uc.quota.MaxTotalBytes > 0 {
+		err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+			others, err := uc.service.LockDataUsage(ctx, data.OwnerID, []string{data.Key})
+			if err != nil {
+				return err
+			}
+			if err := uc.checkQuota(*others, 1, size); err != nil {
+				return err
+			}
+			return uc.service.SaveData(ctx, data)
+		})
+	} else {
+		err = uc.service.SaveData(ctx, data)
+	}
 	if err != nil {
-		uc.log.Error("failed to save data", slog.String("op", op), slog.String("error", err.Error()))
+		var qErr *entity.QuotaError
+		if !errors.As(err, &qErr) {
+			uc.log.Error("failed to save data", slog.String("op", op), slog.String("error", err.Error()))
+		}
 		return err
 	}
 
 	uc.log.Info("data saved successfully", slog.String("op", op), slog.String("key", data.Key))
 	return nil
 }
+
+// checkQuota verifies the usage of the owner's other keys leaves room for keys more values of
+// size bytes in total.
+func (uc *DataUsecaseImpl) checkQuota(others entity.DataUsage, keys, size int64) error {
+	if keys := others.KeyCount + keys; uc.quota.MaxKeys > 0 && keys > uc.quota.MaxKeys {
+		return &entity.QuotaError{Kind: entity.QuotaKeys, Limit: uc.quota.MaxKeys, Requested: keys}
+	}
+	if total := others.TotalBytes + size; uc.quota.MaxTotalBytes > 0 && total > uc.quota.MaxTotalBytes {
+		return &entity.QuotaError{Kind: entity.QuotaTotalBytes, Limit: uc.quota.MaxTotalBytes, Requested: total}
+	}
+	return nil
+}
+
+// GetDataUsage reports the storage used by a user together with their quota.
+func (uc *DataUsecaseImpl) GetDataUsage(ctx context.Context, ownerID uuid.UUID) (*entity.DataUsage, error) {
+	const op = "usecase.GetDataUsage"
+
+	usage, err := uc.service.GetDataUsage(ctx, ownerID)
+	if err != nil {
+		uc.log.Error("failed to get data usage", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	usage.Quota = uc.quota
+	return usage, nil
+}
+
+// resolveExpiry converts a relative TTL into an absolute expiry time.
+func resolveExpiry(data *entity.Data) error {
+	switch {
+	case data.TTL < 0:
+		return entity.NewValidationError("ttl must be positive")
+	case data.TTL > 0 && data.ExpiresAt != nil:
+		return entity.NewValidationError("ttl and expires_at are mutually exclusive")
+	case data.TTL > 0:
+		expiresAt := time.Now().Add(data.TTL)
+		data.ExpiresAt = &expiresAt
+	case data.ExpiresAt != nil && !data.ExpiresAt.After(time.Now()):
+		return entity.NewValidationError("expires_at must be in the future")
+	}
+	return nil
+}
+
+// GetData retrieves the current value of a key. Expired values are reported as not found.
+func (uc *DataUsecaseImpl) GetData(ctx context.Context, key string) (*entity.Data, error) {
+	const op = "usecase.GetData"
+
+	data, err := uc.service.GetData(ctx, key)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get data", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// PurgeExpiredData permanently deletes expired data and returns how many rows were removed.
+func (uc *DataUsecaseImpl) PurgeExpiredData(ctx context.Context, batchSize int32) (int64, error) {
+	const op = "usecase.PurgeExpiredData"
+
+	n, err := uc.service.PurgeExpiredData(ctx, batchSize)
+	if err != nil {
+		uc.log.Error("failed to purge expired data", slog.String("op", op), slog.String("error", err.Error()))
+		return n, err
+	}
+
+	if n > 0 {
+		uc.log.Info("expired data purged", slog.String("op", op), slog.Int64("rows", n))
+	}
+	return n, nil
+}
+
+// validateValue checks the value against the schema registered for the key, if any.
+func (uc *DataUsecaseImpl) validateValue(ctx context.Context, data *entity.Data) error {
+	const op = "usecase.validateValue"
+
+	ds, err := uc.service.GetDataSchemaForKey(ctx, data.Key)
+	if errors.Is(err, entity.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		uc.log.Error("failed to get data schema", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	schema, err := uc.schemas.compile(ds)
+	if err != nil {
+		uc.log.Error("stored data schema is invalid", slog.String("op", op), slog.String("prefix", ds.Prefix), slog.String("error", err.Error()))
+		return err
+	}
+
+	return checkSchema(ds.Prefix, schema, data.Value)
+}
+
+// checkSchema validates a value and converts schema violations into a ValidationError.
+func checkSchema(prefix string, schema *jsonschema.Schema, value []byte) error {
+	violations, err := schema.Validate(value)
+	if err != nil {
+		return entity.NewValidationError(err.Error())
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	details := make([]entity.ValidationDetail, len(violations))
+	for i, v := range violations {
+		details[i] = entity.ValidationDetail{Path: v.Path, Message: v.Message}
+	}
+	return entity.NewValidationError("value does not match schema for prefix "+prefix, details...)
+}
+
+// ListDataSchemas retrieves all registered data schemas.
+func (uc *DataUsecaseImpl) ListDataSchemas(ctx context.Context) ([]entity.DataSchema, error) {
+	const op = "usecase.ListDataSchemas"
+
+	schemas, err := uc.service.ListDataSchemas(ctx)
+	if err != nil {
+		uc.log.Error("failed to list data schemas", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return schemas, nil
+}
+
+// SaveDataSchema validates and registers a schema for a key prefix.
+func (uc *DataUsecaseImpl) SaveDataSchema(ctx context.Context, schema *entity.DataSchema) error {
+	const op = "usecase.SaveDataSchema"
+
+	if schema.Prefix == "" {
+		return entity.NewValidationError("prefix cannot be empty")
+	}
+	if _, err := jsonschema.Compile(schema.Schema); err != nil {
+		return entity.NewValidationError("invalid schema", entity.ValidationDetail{Path: "/schema", Message: err.Error()})
+	}
+
+	if err := uc.service.SaveDataSchema(ctx, schema); err != nil {
+		uc.log.Error("failed to save data schema", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	uc.log.Info("data schema saved successfully", slog.String("op", op), slog.String("prefix", schema.Prefix))
+	return nil
+}
+
+// DeleteDataSchema removes the schema registered for a key prefix.
+func (uc *DataUsecaseImpl) DeleteDataSchema(ctx context.Context, prefix string) error {
+	const op = "usecase.DeleteDataSchema"
+
+	if err := uc.service.DeleteDataSchema(ctx, prefix); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to delete data schema", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+	uc.schemas.forget(prefix)
+
+	uc.log.Info("data schema deleted successfully", slog.String("op", op), slog.String("prefix", prefix))
+	return nil
+}
@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"base_app/internal/entity"
+)
+
+// dataRecord is a single entry as it appears in an import or export file.
+type dataRecord struct {
+	Key       string          `json:"key"`
+	Value     json.RawMessage `json:"value"`
+	ExpiresAt *time.Time      `json:"expires_at,omitempty"`
+	CreatedAt *time.Time      `json:"created_at,omitempty"`
+}
+
+// recordError is a problem with a single input record that does not stop decoding.
+type recordError struct {
+	line int
+	err  error
+}
+
+func (e *recordError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.line, e.err)
+}
+
+// dataDecoder reads data records one by one from an import stream.
+type dataDecoder interface {
+	// Next returns the next record and its line number, a *recordError for an
+	// unparsable record, or io.EOF when the input is exhausted.
+	Next() (*entity.Data, int, error)
+}
+
+// dataEncoder writes data records to an export stream.
+type dataEncoder interface {
+	Write(data *entity.Data) error
+	Flush() error
+}
+
+func newDataDecoder(format entity.DataFormat, r io.Reader) (dataDecoder, error) {
+	switch format {
+	case entity.DataFormatNDJSON:
+		return &ndjsonDecoder{r: bufio.NewReader(r)}, nil
+	case entity.DataFormatCSV:
+		return newCSVDecoder(r)
+	default:
+		return nil, entity.NewValidationError(fmt.Sprintf("unsupported format %q", format))
+	}
+}
+
+func newDataEncoder(format entity.DataFormat, w io.Writer) (dataEncoder, error) {
+	switch format {
+	case entity.DataFormatNDJSON:
+		return &ndjsonEncoder{w: bufio.NewWriter(w)}, nil
+	case entity.DataFormatCSV:
+		enc := &csvEncoder{w: csv.NewWriter(w)}
+		return enc, enc.w.Write(csvHeader)
+	default:
+		return nil, entity.NewValidationError(fmt.Sprintf("unsupported format %q", format))
+	}
+}
+
+// --- NDJSON ---
+
+type ndjsonDecoder struct {
+	r    *bufio.Reader
+	line int
+}
+
+func (d *ndjsonDecoder) Next() (*entity.Data, int, error) {
+	for {
+		raw, err := d.r.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, d.line, err
+		}
+		if len(raw) == 0 && errors.Is(err, io.EOF) {
+			return nil, d.line, io.EOF
+		}
+		d.line++
+
+		raw = bytes.TrimSpace(raw)
+		if len(raw) == 0 {
+			continue
+		}
+
+		var rec dataRecord
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return nil, d.line, &recordError{line: d.line, err: err}
+		}
+		return &entity.Data{Key: rec.Key, Value: rec.Value, ExpiresAt: rec.ExpiresAt}, d.line, nil
+	}
+}
+
+type ndjsonEncoder struct {
+	w *bufio.Writer
+}
+
+func (e *ndjsonEncoder) Write(data *entity.Data) error {
+	raw, err := json.Marshal(dataRecord{
+		Key:       data.Key,
+		Value:     data.Value,
+		ExpiresAt: data.ExpiresAt,
+		CreatedAt: &data.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := e.w.Write(raw); err != nil {
+		return err
+	}
+	return e.w.WriteByte('\n')
+}
+
+func (e *ndjsonEncoder) Flush() error {
+	return e.w.Flush()
+}
+
+// --- CSV ---
+
+var csvHeader = []string{"key", "value", "expires_at", "created_at"}
+
+type csvDecoder struct {
+	r       *csv.Reader
+	columns map[string]int
+}
+
+func newCSVDecoder(r io.Reader) (*csvDecoder, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, entity.NewValidationError("csv input is empty")
+		}
+		return nil, entity.NewValidationError("failed to read csv header: " + err.Error())
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[name] = i
+	}
+	for _, required := range []string{"key", "value"} {
+		if _, ok := columns[required]; !ok {
+			return nil, entity.NewValidationError(fmt.Sprintf("csv header must contain a %q column", required))
+		}
+	}
+
+	return &csvDecoder{r: cr, columns: columns}, nil
+}
+
+func (d *csvDecoder) Next() (*entity.Data, int, error) {
+	record, err := d.r.Read()
+	if err != nil {
+		var pErr *csv.ParseError
+		if errors.As(err, &pErr) {
+			return nil, pErr.Line, &recordError{line: pErr.Line, err: pErr.Err}
+		}
+		return nil, 0, err
+	}
+	line, _ := d.r.FieldPos(0)
+
+	data := &entity.Data{
+		Key:   d.field(record, "key"),
+		Value: json.RawMessage(d.field(record, "value")),
+	}
+	if s := d.field(record, "expires_at"); s != "" {
+		expiresAt, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, line, &recordError{line: line, err: fmt.Errorf("invalid expires_at: %w", err)}
+		}
+		data.ExpiresAt = &expiresAt
+	}
+	return data, line, nil
+}
+
+func (d *csvDecoder) field(record []string, name string) string {
+	i, ok := d.columns[name]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return record[i]
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvEncoder) Write(data *entity.Data) error {
+	var expiresAt string
+	if data.ExpiresAt != nil {
+		expiresAt = data.ExpiresAt.Format(time.RFC3339)
+	}
+	return e.w.Write([]string{data.Key, string(data.Value), expiresAt, data.CreatedAt.Format(time.RFC3339)})
+}
+
+func (e *csvEncoder) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
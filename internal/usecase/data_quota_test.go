@@ -0,0 +1,227 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+
+	"base_app/internal/adapter/repository/memory"
+	"base_app/internal/entity"
+	"base_app/internal/service"
+	"base_app/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+// newDataUsecase returns a data use case backed by an empty in-memory repository.
+func newDataUsecase(quota entity.DataQuota) usecase.DataUsecase {
+	log := slog.New(slog.DiscardHandler)
+	feed := memory.NewDataFeed(16)
+	repo := memory.New(feed)
+	return usecase.NewDataUsecase(service.NewDataService(repo, feed, log), repo, quota, log)
+}
+
+func save(t *testing.T, uc usecase.DataUsecase, owner uuid.UUID, key, value string) error {
+	t.Helper()
+	return uc.SaveData(context.Background(), &entity.Data{OwnerID: owner, Key: key, Value: []byte(value)})
+}
+
+func usage(t *testing.T, uc usecase.DataUsecase, owner uuid.UUID) entity.DataUsage {
+	t.Helper()
+	u, err := uc.GetDataUsage(context.Background(), owner)
+	if err != nil {
+		t.Fatalf("GetDataUsage: %v", err)
+	}
+	return entity.DataUsage{KeyCount: u.KeyCount, TotalBytes: u.TotalBytes}
+}
+
+func quotaKind(err error) entity.QuotaKind {
+	var qErr *entity.QuotaError
+	if errors.As(err, &qErr) {
+		return qErr.Kind
+	}
+	return ""
+}
+
+func TestSaveDataQuota(t *testing.T) {
+	tests := []struct {
+		name  string
+		quota entity.DataQuota
+		key   string
+		value string
+		want  entity.QuotaKind
+	}{
+		{"new key within limits", entity.DataQuota{MaxKeys: 3, MaxTotalBytes: 10}, "c", `1`, ""},
+		{"new key over the key limit", entity.DataQuota{MaxKeys: 2}, "c", `1`, entity.QuotaKeys},
+		{"new key over the byte limit", entity.DataQuota{MaxTotalBytes: 5}, "c", `1234`, entity.QuotaTotalBytes},
+		{"overwrite at the key limit", entity.DataQuota{MaxKeys: 2}, "a", `1`, ""},
+		{"overwrite freeing bytes", entity.DataQuota{MaxTotalBytes: 7}, "a", `12345`, ""},
+		{"value over the value limit", entity.DataQuota{MaxValueBytes: 3}, "a", `1234`, entity.QuotaValueSize},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newDataUsecase(tt.quota)
+			owner := uuid.New()
+			for _, key := range []string{"a", "b"} {
+				if err := save(t, uc, owner, key, `1`); err != nil {
+					t.Fatalf("save %s: %v", key, err)
+				}
+			}
+			// Keys of other owners do not count.
+			if err := save(t, uc, uuid.New(), "other", `1`); err != nil {
+				t.Fatalf("save other: %v", err)
+			}
+
+			err := save(t, uc, owner, tt.key, tt.value)
+			if got := quotaKind(err); got != tt.want || (tt.want == "" && err != nil) {
+				t.Errorf("SaveData() = %v, want quota kind %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaveDataQuotaConcurrent(t *testing.T) {
+	const maxKeys = 5
+	uc := newDataUsecase(entity.DataQuota{MaxKeys: maxKeys})
+	owner := uuid.New()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 4*maxKeys)
+	for i := range 4 * maxKeys {
+		wg.Go(func() {
+			errs <- save(t, uc, owner, fmt.Sprintf("key-%d", i), `1`)
+		})
+	}
+	wg.Wait()
+	close(errs)
+
+	var saved int
+	for err := range errs {
+		switch {
+		case err == nil:
+			saved++
+		case quotaKind(err) != entity.QuotaKeys:
+			t.Errorf("SaveData() = %v, want a key quota error", err)
+		}
+	}
+	if saved != maxKeys {
+		t.Errorf("saved %d keys, want %d", saved, maxKeys)
+	}
+	if got := usage(t, uc, owner).KeyCount; got != maxKeys {
+		t.Errorf("usage = %d keys, want %d", got, maxKeys)
+	}
+}
+
+func ndjson(records ...string) *strings.Reader {
+	var b strings.Builder
+	for _, rec := range records {
+		b.WriteString(rec)
+		b.WriteByte('\n')
+	}
+	return strings.NewReader(b.String())
+}
+
+func TestImportDataQuota(t *testing.T) {
+	tests := []struct {
+		name       string
+		quota      entity.DataQuota
+		onConflict entity.ConflictMode
+		dryRun     bool
+		chunkSize  int
+		input      []string
+		wantFailed int
+		want       entity.DataUsage // Usage after the import
+	}{
+		{
+			name:  "within limits",
+			quota: entity.DataQuota{MaxKeys: 4},
+			input: []string{`{"key":"c","value":1}`, `{"key":"d","value":1}`},
+			want:  entity.DataUsage{KeyCount: 4, TotalBytes: 4},
+		},
+		{
+			name:       "over the key limit",
+			quota:      entity.DataQuota{MaxKeys: 3},
+			input:      []string{`{"key":"c","value":1}`, `{"key":"d","value":1}`},
+			wantFailed: 2,
+			want:       entity.DataUsage{KeyCount: 2, TotalBytes: 2},
+		},
+		{
+			name:       "over the key limit in a dry run",
+			quota:      entity.DataQuota{MaxKeys: 3},
+			dryRun:     true,
+			input:      []string{`{"key":"c","value":1}`, `{"key":"d","value":1}`},
+			wantFailed: 2,
+			want:       entity.DataUsage{KeyCount: 2, TotalBytes: 2},
+		},
+		{
+			name:       "over the byte limit",
+			quota:      entity.DataQuota{MaxTotalBytes: 5},
+			input:      []string{`{"key":"c","value":1234}`},
+			wantFailed: 1,
+			want:       entity.DataUsage{KeyCount: 2, TotalBytes: 2},
+		},
+		{
+			name:       "upsert replacing values frees their bytes",
+			quota:      entity.DataQuota{MaxKeys: 2, MaxTotalBytes: 6},
+			onConflict: entity.ConflictUpsert,
+			input:      []string{`{"key":"a","value":123}`, `{"key":"b","value":123}`},
+			want:       entity.DataUsage{KeyCount: 2, TotalBytes: 6},
+		},
+		{
+			name:       "skipped keys do not count",
+			quota:      entity.DataQuota{MaxKeys: 3},
+			onConflict: entity.ConflictSkip,
+			input:      []string{`{"key":"a","value":1}`, `{"key":"c","value":1}`},
+			want:       entity.DataUsage{KeyCount: 3, TotalBytes: 3},
+		},
+		{
+			name:       "earlier chunks count",
+			quota:      entity.DataQuota{MaxKeys: 3},
+			chunkSize:  1,
+			input:      []string{`{"key":"c","value":1}`, `{"key":"d","value":1}`},
+			wantFailed: 1,
+			want:       entity.DataUsage{KeyCount: 3, TotalBytes: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newDataUsecase(tt.quota)
+			owner := uuid.New()
+			for _, key := range []string{"a", "b"} {
+				if err := save(t, uc, owner, key, `1`); err != nil {
+					t.Fatalf("save %s: %v", key, err)
+				}
+			}
+
+			onConflict := tt.onConflict
+			if onConflict == "" {
+				onConflict = entity.ConflictFail
+			}
+			report, err := uc.ImportData(context.Background(), ndjson(tt.input...), entity.ImportOptions{
+				OwnerID:    owner,
+				Format:     entity.DataFormatNDJSON,
+				OnConflict: onConflict,
+				DryRun:     tt.dryRun,
+				ChunkSize:  tt.chunkSize,
+			})
+			if err != nil {
+				t.Fatalf("ImportData: %v", err)
+			}
+			if report.Failed != tt.wantFailed || report.Aborted != (tt.wantFailed > 0) {
+				t.Errorf("report failed=%d aborted=%v, want failed=%d", report.Failed, report.Aborted, tt.wantFailed)
+			}
+			for _, e := range report.Errors {
+				if !strings.Contains(e.Message, "quota exceeded") {
+					t.Errorf("line %d: %q is not a quota error", e.Line, e.Message)
+				}
+			}
+			if got := usage(t, uc, owner); got != tt.want {
+				t.Errorf("usage = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
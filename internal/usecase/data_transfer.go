@@ -0,0 +1,329 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+
+	"base_app/internal/entity"
+	"base_app/pkg/jsonschema"
+)
+
+const (
+	// importBatchSize bounds memory use when an import runs in a single transaction.
+	importBatchSize = 1000
+	// maxImportErrors caps the number of line errors returned in an import report.
+	maxImportErrors = 1000
+	// maxImportKeys caps the distinct keys of one import, which bounds the duplicate detection.
+	maxImportKeys = 100000
+)
+
+// ImportData reads data entries from r and writes them in chunks.
+// Invalid records are reported per line and skipped; a conflict in fail mode aborts the import.
+// The quota applies as in SaveData: a chunk that would take the owner past the key or byte
+// limit is rejected, which aborts the import like a conflict in fail mode.
+func (uc *DataUsecaseImpl) ImportData(ctx context.Context, r io.Reader, opts entity.ImportOptions) (*entity.ImportReport, error) {
+	const op = "usecase.ImportData"
+
+	switch opts.OnConflict {
+	case entity.ConflictUpsert, entity.ConflictSkip, entity.ConflictFail:
+	default:
+		return nil, entity.NewValidationError("unsupported conflict mode " + string(opts.OnConflict))
+	}
+
+	dec, err := newDataDecoder(opts.Format, r)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, err := uc.loadSchemas(ctx)
+	if err != nil {
+		uc.log.Error("failed to load data schemas", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	imp := &dataImport{
+		service: uc.service,
+		opts:    opts,
+		check:   uc.importQuotaCheck(),
+		report:  &entity.ImportReport{DryRun: opts.DryRun, Errors: []entity.ImportLineError{}},
+		seen:    make(map[string]int),
+		lines:   make(map[string]int),
+	}
+
+	for !imp.report.Aborted {
+		data, line, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var recErr *recordError
+		if errors.As(err, &recErr) {
+			imp.report.Total++
+			imp.fail(recErr.line, "", recErr.err.Error())
+			continue
+		}
+		if err != nil {
+			imp.rollback(ctx)
+			return nil, entity.NewValidationError("failed to read import stream: " + err.Error())
+		}
+
+		imp.report.Total++
+		if err := validateImportRecord(data, schemas); err != nil {
+			imp.fail(line, data.Key, err.Error())
+			continue
+		}
+		if size := int64(len(data.Value)); uc.quota.MaxValueBytes > 0 && size > uc.quota.MaxValueBytes {
+			imp.fail(line, data.Key, (&entity.QuotaError{Kind: entity.QuotaValueSize, Limit: uc.quota.MaxValueBytes, Requested: size}).Error())
+			continue
+		}
+		if first, ok := imp.seen[data.Key]; ok {
+			imp.fail(line, data.Key, "duplicate key, first seen on line "+strconv.Itoa(first))
+			continue
+		}
+		if len(imp.seen) >= maxImportKeys {
+			imp.fail(line, data.Key, "import exceeds "+strconv.Itoa(maxImportKeys)+" keys, split it into smaller files")
+			imp.report.Aborted = true
+			imp.rollback(ctx)
+			continue
+		}
+		imp.seen[data.Key] = line
+		data.OwnerID = opts.OwnerID
+
+		if err := imp.add(ctx, *data, line); err != nil {
+			uc.log.Error("failed to import data", slog.String("op", op), slog.String("error", err.Error()))
+			return nil, err
+		}
+	}
+
+	if err := imp.finish(ctx); err != nil {
+		uc.log.Error("failed to import data", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	uc.log.Info("data import finished", slog.String("op", op),
+		slog.Bool("dry_run", opts.DryRun), slog.Bool("aborted", imp.report.Aborted),
+		slog.Int("total", imp.report.Total), slog.Int("created", imp.report.Created),
+		slog.Int("updated", imp.report.Updated), slog.Int("failed", imp.report.Failed))
+	return imp.report, nil
+}
+
+// ExportData streams all live data entries to w in the requested format.
+func (uc *DataUsecaseImpl) ExportData(ctx context.Context, w io.Writer, format entity.DataFormat) error {
+	const op = "usecase.ExportData"
+
+	enc, err := newDataEncoder(format, w)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.service.ExportData(ctx, enc.Write); err != nil {
+		uc.log.Error("failed to export data", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+	return enc.Flush()
+}
+
+// importQuotaCheck returns the check that keeps the chunks of an import within the key and
+// byte limits, or nil when neither is set.
+func (uc *DataUsecaseImpl) importQuotaCheck() func(others entity.DataUsage, records []entity.Data) error {
+	if uc.quota.MaxKeys == 0 && uc.quota.MaxTotalBytes == 0 {
+		return nil
+	}
+	return func(others entity.DataUsage, records []entity.Data) error {
+		var size int64
+		for _, rec := range records {
+			size += int64(len(rec.Value))
+		}
+		return uc.checkQuota(others, int64(len(records)), size)
+	}
+}
+
+// dataImport tracks the state of a single import run.
+type dataImport struct {
+	service DataService
+	opts    entity.ImportOptions
+	check   func(others entity.DataUsage, records []entity.Data) error
+	report  *entity.ImportReport
+	seen    map[string]int // key -> line, for duplicate detection across the whole input
+
+	tx      DataImportTx
+	chunk   []entity.Data
+	lines   map[string]int // key -> line, for the current chunk
+	created int            // counts written in the open transaction
+	updated int
+}
+
+func (imp *dataImport) add(ctx context.Context, data entity.Data, line int) error {
+	imp.chunk = append(imp.chunk, data)
+	imp.lines[data.Key] = line
+
+	size := imp.opts.ChunkSize
+	if size == 0 {
+		size = importBatchSize
+	}
+	if len(imp.chunk) < size {
+		return nil
+	}
+
+	if err := imp.flush(ctx); err != nil {
+		return err
+	}
+	if imp.opts.ChunkSize > 0 && !imp.report.Aborted {
+		return imp.end(ctx)
+	}
+	return nil
+}
+
+// flush writes the buffered chunk in the current transaction, opening one if needed.
+func (imp *dataImport) flush(ctx context.Context) error {
+	if len(imp.chunk) == 0 {
+		return nil
+	}
+
+	if imp.tx == nil {
+		tx, err := imp.service.BeginDataImport(ctx)
+		if err != nil {
+			return err
+		}
+		imp.tx = tx
+	}
+
+	res, err := imp.tx.WriteChunk(ctx, imp.chunk, imp.opts.OnConflict, imp.check)
+	var qErr *entity.QuotaError
+	if errors.As(err, &qErr) {
+		for _, rec := range imp.chunk {
+			imp.fail(imp.lines[rec.Key], rec.Key, qErr.Error())
+		}
+		imp.report.Aborted = true
+		imp.rollback(ctx)
+		imp.chunk = imp.chunk[:0]
+		clear(imp.lines)
+		return nil
+	}
+	if err != nil {
+		imp.rollback(ctx)
+		return err
+	}
+
+	switch imp.opts.OnConflict {
+	case entity.ConflictFail:
+		if len(res.Conflicts) > 0 {
+			for _, key := range res.Conflicts {
+				imp.fail(imp.lines[key], key, "key already exists")
+			}
+			imp.report.Aborted = true
+			imp.rollback(ctx)
+		}
+	case entity.ConflictSkip:
+		imp.report.Skipped += len(res.Conflicts)
+	}
+
+	imp.created += res.Created
+	imp.updated += res.Updated
+	imp.chunk = imp.chunk[:0]
+	clear(imp.lines)
+	return nil
+}
+
+// end closes the current transaction, committing it unless this is a dry run.
+func (imp *dataImport) end(ctx context.Context) error {
+	if imp.tx == nil {
+		return nil
+	}
+
+	tx := imp.tx
+	imp.tx = nil
+
+	var err error
+	if imp.opts.DryRun {
+		err = tx.Rollback(ctx)
+	} else {
+		err = tx.Commit(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	imp.report.Created += imp.created
+	imp.report.Updated += imp.updated
+	imp.created, imp.updated = 0, 0
+	return nil
+}
+
+func (imp *dataImport) finish(ctx context.Context) error {
+	if imp.report.Aborted {
+		return nil
+	}
+	if err := imp.flush(ctx); err != nil {
+		return err
+	}
+	if imp.report.Aborted {
+		return nil
+	}
+	return imp.end(ctx)
+}
+
+// rollback discards the open transaction together with the counts written in it.
+func (imp *dataImport) rollback(ctx context.Context) {
+	if imp.tx != nil {
+		_ = imp.tx.Rollback(ctx)
+		imp.tx = nil
+	}
+	imp.created, imp.updated = 0, 0
+}
+
+func (imp *dataImport) fail(line int, key, message string) {
+	imp.report.Failed++
+	if len(imp.report.Errors) < maxImportErrors {
+		imp.report.Errors = append(imp.report.Errors, entity.ImportLineError{Line: line, Key: key, Message: message})
+	}
+}
+
+// prefixSchema is a compiled schema together with the key prefix it applies to.
+type prefixSchema struct {
+	prefix string
+	schema *jsonschema.Schema
+}
+
+// loadSchemas compiles all registered schemas, longest prefix first.
+func (uc *DataUsecaseImpl) loadSchemas(ctx context.Context) ([]prefixSchema, error) {
+	stored, err := uc.service.ListDataSchemas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make([]prefixSchema, 0, len(stored))
+	for _, ds := range stored {
+		schema, err := uc.schemas.compile(&ds)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, prefixSchema{prefix: ds.Prefix, schema: schema})
+	}
+	sort.Slice(schemas, func(i, j int) bool { return len(schemas[i].prefix) > len(schemas[j].prefix) })
+	return schemas, nil
+}
+
+// validateImportRecord applies the same rules as SaveData to a single imported record.
+func validateImportRecord(data *entity.Data, schemas []prefixSchema) error {
+	if data.Key == "" {
+		return entity.NewValidationError("key cannot be empty")
+	}
+	if !json.Valid(data.Value) {
+		return entity.NewValidationError("value must be valid JSON")
+	}
+	if err := resolveExpiry(data); err != nil {
+		return err
+	}
+	for _, ps := range schemas {
+		if strings.HasPrefix(data.Key, ps.prefix) {
+			return checkSchema(ps.prefix, ps.schema, data.Value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"base_app/internal/entity"
+)
+
+// watchReplayPageSize is the number of past changes loaded per query when a watcher resumes.
+const watchReplayPageSize = 500
+
+// WatchData streams changes of keys starting with prefix.
+// When lastEventID is set, creates and updates committed after that event are replayed first.
+// The returned channel is closed when ctx is done or the live feed drops the watcher.
+func (uc *DataUsecaseImpl) WatchData(ctx context.Context, prefix string, lastEventID int64) (<-chan entity.DataEvent, error) {
+	const op = "usecase.WatchData"
+
+	if lastEventID < 0 {
+		return nil, entity.NewValidationError("last event id must not be negative")
+	}
+
+	// Subscribe before replaying so nothing committed in between is lost;
+	// duplicates are filtered by id below.
+	live := uc.service.SubscribeDataChanges(ctx, prefix)
+	out := make(chan entity.DataEvent)
+
+	go func() {
+		defer close(out)
+
+		lastID := lastEventID
+		send := func(ev entity.DataEvent) bool {
+			select {
+			case out <- ev:
+				if ev.ID > lastID {
+					lastID = ev.ID
+				}
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for lastID > 0 {
+			events, err := uc.service.ListDataChanges(ctx, lastID, prefix, watchReplayPageSize)
+			if err != nil {
+				uc.log.Error("failed to replay data changes", slog.String("op", op), slog.String("error", err.Error()))
+				return
+			}
+			for _, ev := range events {
+				if !send(ev) {
+					return
+				}
+			}
+			if len(events) < watchReplayPageSize {
+				break
+			}
+		}
+
+		// Only skip what the replay already delivered: ids are not committed in
+		// strict order, so a live event may carry a lower id than one seen before.
+		replayed := lastID
+		for ev := range live {
+			if ev.ID != 0 && ev.ID <= replayed {
+				continue
+			}
+			if !send(ev) {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
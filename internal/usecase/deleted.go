@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultDeletedPageSize = 100
+	maxDeletedPageSize     = 1000
+)
+
+// deletedPageSize applies the default to a zero limit and caps it at maxDeletedPageSize.
+func deletedPageSize(limit int) int32 {
+	switch {
+	case limit <= 0:
+		return defaultDeletedPageSize
+	case limit > maxDeletedPageSize:
+		return maxDeletedPageSize
+	}
+	return int32(limit)
+}
+
+// DeleteData soft deletes a key. It can be restored until the purge job removes it after the
+// retention period.
+func (uc *DataUsecaseImpl) DeleteData(ctx context.Context, key string) error {
+	const op = "usecase.DeleteData"
+
+	if err := uc.service.DeleteData(ctx, key); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to delete data", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("data deleted", slog.String("op", op), slog.String("key", key))
+	return nil
+}
+
+// ListDeletedData retrieves the last value of deleted keys that have not been written again,
+// most recently deleted first.
+func (uc *DataUsecaseImpl) ListDeletedData(ctx context.Context, limit int) ([]entity.Data, error) {
+	const op = "usecase.ListDeletedData"
+
+	entries, err := uc.service.ListDeletedData(ctx, deletedPageSize(limit))
+	if err != nil {
+		uc.log.Error("failed to list deleted data", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return entries, nil
+}
+
+// RestoreData undeletes a key and returns its current value. A key written again after it was
+// deleted cannot be restored, since that would resurrect values older than the current one.
+// Restored keys count against the quota of their owner again, even beyond its limits.
+func (uc *DataUsecaseImpl) RestoreData(ctx context.Context, key string) (*entity.Data, error) {
+	const op = "usecase.RestoreData"
+
+	data, err := uc.service.RestoreData(ctx, key)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to restore data", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	uc.log.Info("data restored", slog.String("op", op), slog.String("key", key))
+	return data, nil
+}
+
+// PurgeDeletedData permanently removes keys deleted longer than retention ago and returns how
+// many rows were removed.
+func (uc *DataUsecaseImpl) PurgeDeletedData(ctx context.Context, retention time.Duration, batchSize int32) (int64, error) {
+	const op = "usecase.PurgeDeletedData"
+
+	n, err := uc.service.PurgeDeletedData(ctx, time.Now().Add(-retention), batchSize)
+	if err != nil {
+		uc.log.Error("failed to purge deleted data", slog.String("op", op), slog.String("error", err.Error()))
+		return n, err
+	}
+
+	if n > 0 {
+		uc.log.Info("deleted data purged", slog.String("op", op), slog.Int64("rows", n))
+	}
+	return n, nil
+}
+
+// ListDeletedCatalogItems retrieves deleted catalog items, most recently deleted first.
+func (uc *CatalogUsecaseImpl) ListDeletedCatalogItems(ctx context.Context, limit int) ([]entity.CatalogItem, error) {
+	const op = "usecase.ListDeletedCatalogItems"
+
+	items, err := uc.service.ListDeletedCatalogItems(ctx, deletedPageSize(limit))
+	if err != nil {
+		uc.log.Error("failed to list deleted catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	uc.describeImages(items)
+	return items, nil
+}
+
+// RestoreCatalogItem undeletes a catalog item together with its tags, translations, images
+// and the favorites and views of users. It fails with entity.ErrConflict when another item
+// took over the sku of the item meanwhile.
+func (uc *CatalogUsecaseImpl) RestoreCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error) {
+	const op = "usecase.RestoreCatalogItem"
+
+	item, err := uc.service.RestoreCatalogItem(ctx, id)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) && !errors.Is(err, entity.ErrConflict) {
+			uc.log.Error("failed to restore catalog item", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	uc.describeItemImages(item)
+
+	uc.log.Info("catalog item restored", slog.String("op", op), slog.String("id", id.String()))
+	return item, nil
+}
+
+// PurgeDeletedCatalogItems permanently removes catalog items deleted longer than retention ago
+// and returns how many were removed. Their image files are left to the image collector.
+func (uc *CatalogUsecaseImpl) PurgeDeletedCatalogItems(ctx context.Context, retention time.Duration, batchSize int32) (int64, error) {
+	const op = "usecase.PurgeDeletedCatalogItems"
+
+	n, err := uc.service.PurgeDeletedCatalogItems(ctx, time.Now().Add(-retention), batchSize)
+	if err != nil {
+		uc.log.Error("failed to purge deleted catalog items", slog.String("op", op), slog.String("error", err.Error()))
+		return n, err
+	}
+
+	if n > 0 {
+		uc.log.Info("deleted catalog items purged", slog.String("op", op), slog.Int64("items", n))
+	}
+	return n, nil
+}
@@ -3,19 +3,119 @@ package usecase
 import (
 	"base_app/internal/entity"
 	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 // AuthUsecase defines the interface for authentication business logic.
 type AuthUsecase interface {
 	Authenticate(ctx context.Context, email, password string) (*entity.User, error)
+	SetLocale(ctx context.Context, userID uuid.UUID, locale string) (string, error)
 }
 
 // DataUsecase defines the interface for data-related business logic.
 type DataUsecase interface {
 	SaveData(ctx context.Context, data *entity.Data) error
+	GetData(ctx context.Context, key string) (*entity.Data, error)
+	GetDataUsage(ctx context.Context, ownerID uuid.UUID) (*entity.DataUsage, error)
+	PurgeExpiredData(ctx context.Context, batchSize int32) (int64, error)
+	DeleteData(ctx context.Context, key string) error
+	ListDeletedData(ctx context.Context, limit int) ([]entity.Data, error)
+	RestoreData(ctx context.Context, key string) (*entity.Data, error)
+	PurgeDeletedData(ctx context.Context, retention time.Duration, batchSize int32) (int64, error)
+	ImportData(ctx context.Context, r io.Reader, opts entity.ImportOptions) (*entity.ImportReport, error)
+	ExportData(ctx context.Context, w io.Writer, format entity.DataFormat) error
+	WatchData(ctx context.Context, prefix string, lastEventID int64) (<-chan entity.DataEvent, error)
+	ListDataSchemas(ctx context.Context) ([]entity.DataSchema, error)
+	SaveDataSchema(ctx context.Context, schema *entity.DataSchema) error
+	DeleteDataSchema(ctx context.Context, prefix string) error
+}
+
+// AttachmentUsecase defines the interface for file attachment business logic.
+type AttachmentUsecase interface {
+	UploadAttachment(ctx context.Context, key, name string, createdBy uuid.UUID, r io.Reader) (*entity.Attachment, error)
+	ListAttachments(ctx context.Context, key string) ([]entity.Attachment, error)
+	OpenAttachment(ctx context.Context, id uuid.UUID) (*entity.Attachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, id uuid.UUID) error
+	CollectGarbage(ctx context.Context, grace time.Duration) (*entity.BlobGCResult, error)
 }
 
 // CatalogUsecase defines the interface for catalog-related business logic.
 type CatalogUsecase interface {
-	GetCatalogItems(ctx context.Context) ([]entity.CatalogItem, error)
+	GetCatalogItems(ctx context.Context, category string, userID uuid.UUID, pref entity.LocalePreference) ([]entity.CatalogItem, error)
+	ListCatalogItems(ctx context.Context, q entity.CatalogQuery, cursor string, userID uuid.UUID, pref entity.LocalePreference) (*entity.CatalogPage, error)
+	GetCatalogItem(ctx context.Context, id, userID uuid.UUID, pref entity.LocalePreference) (*entity.CatalogItem, error)
+	CreateCatalogItem(ctx context.Context, item *entity.CatalogItem) error
+	UpdateCatalogItem(ctx context.Context, item *entity.CatalogItem) error
+	SetCatalogItemDisabled(ctx context.Context, id uuid.UUID, disabled bool) (*entity.CatalogItem, error)
+	DeleteCatalogItem(ctx context.Context, id uuid.UUID) error
+	ListDeletedCatalogItems(ctx context.Context, limit int) ([]entity.CatalogItem, error)
+	RestoreCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error)
+	PurgeDeletedCatalogItems(ctx context.Context, retention time.Duration, batchSize int32) (int64, error)
+	SearchCatalog(ctx context.Context, q entity.CatalogSearchQuery) (*entity.CatalogSearchResult, error)
+	SyncSearchLanguage(ctx context.Context) error
+	ListCatalogCategories(ctx context.Context) ([]entity.CatalogCategory, error)
+	GetCatalogCategory(ctx context.Context, id uuid.UUID) (*entity.CatalogCategory, error)
+	CreateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error
+	UpdateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error
+	DeleteCatalogCategory(ctx context.Context, id uuid.UUID) error
+	ListCatalogTags(ctx context.Context) ([]entity.CatalogTag, error)
+	CreateCatalogTag(ctx context.Context, name string) (*entity.CatalogTag, error)
+	RenameCatalogTag(ctx context.Context, name, newName string) (*entity.CatalogTag, error)
+	DeleteCatalogTag(ctx context.Context, name string) error
+	ListCatalogChangesets(ctx context.Context) ([]entity.CatalogChangeset, error)
+	GetCatalogChangeset(ctx context.Context, id uuid.UUID) (*entity.CatalogChangeset, error)
+	CreateCatalogChangeset(ctx context.Context, changeset *entity.CatalogChangeset) error
+	DeleteCatalogChangeset(ctx context.Context, id uuid.UUID) error
+	StageCatalogDraft(ctx context.Context, changesetID uuid.UUID, draft *entity.CatalogDraft) error
+	UnstageCatalogDraft(ctx context.Context, changesetID, itemID uuid.UUID) error
+	PreviewCatalogChangeset(ctx context.Context, id uuid.UUID) ([]entity.CatalogItem, error)
+	PublishCatalogChangeset(ctx context.Context, id, publishedBy uuid.UUID) (*entity.CatalogChangeset, error)
+	RollbackCatalogChangeset(ctx context.Context, id, rolledBackBy uuid.UUID) (*entity.CatalogChangeset, error)
+	ListCatalogTranslations(ctx context.Context, itemID uuid.UUID) ([]entity.CatalogTranslation, error)
+	SaveCatalogTranslation(ctx context.Context, t *entity.CatalogTranslation) error
+	DeleteCatalogTranslation(ctx context.Context, itemID uuid.UUID, locale string) error
+	ImportCatalogItems(ctx context.Context, r io.Reader, opts entity.CatalogImportOptions) (*entity.CatalogImportReport, error)
+	UploadCatalogImage(ctx context.Context, itemID uuid.UUID, r io.Reader) (*entity.CatalogImage, error)
+	OpenCatalogImage(ctx context.Context, id uuid.UUID) (*entity.CatalogImage, io.ReadCloser, error)
+	OpenCatalogThumbnail(ctx context.Context, id uuid.UUID, size int) (*entity.CatalogThumbnail, io.ReadCloser, error)
+	DeleteCatalogImage(ctx context.Context, itemID, id uuid.UUID) error
+	CollectCatalogImageGarbage(ctx context.Context, grace time.Duration) (int, error)
+	AddCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error
+	RemoveCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error
+	ListCatalogFavorites(ctx context.Context, userID uuid.UUID, cursor string, limit int, pref entity.LocalePreference) (*entity.CatalogPage, error)
+	RecordCatalogView(ctx context.Context, userID, itemID uuid.UUID) error
+	ListRecentlyViewedCatalogItems(ctx context.Context, userID uuid.UUID, pref entity.LocalePreference) ([]entity.CatalogItem, error)
+	SetCatalogInventory(ctx context.Context, id uuid.UUID, update entity.CatalogInventoryUpdate) (*entity.CatalogItem, error)
+	ReserveCatalogItem(ctx context.Context, itemID, userID uuid.UUID, quantity int64, ttl time.Duration) (*entity.CatalogReservation, error)
+	ReleaseCatalogReservation(ctx context.Context, itemID, reservationID, userID uuid.UUID) error
+	GetCatalogReview(ctx context.Context, itemID, userID uuid.UUID) (*entity.CatalogReview, error)
+	SaveCatalogReview(ctx context.Context, itemID, userID uuid.UUID, rating int, body string) (*entity.CatalogReview, error)
+	DeleteCatalogReview(ctx context.Context, itemID, userID uuid.UUID) error
+	SetCatalogReviewStatus(ctx context.Context, id uuid.UUID, status entity.CatalogReviewStatus) (*entity.CatalogReview, error)
+	ListCatalogReviews(ctx context.Context, itemID uuid.UUID, limit int) ([]entity.CatalogReview, error)
+	ListCatalogReviewsByStatus(ctx context.Context, status entity.CatalogReviewStatus, limit int) ([]entity.CatalogReview, error)
+}
+
+// OutboxUsecase defines the interface for relaying domain events from the outbox.
+type OutboxUsecase interface {
+	RelayOutboxEvents(ctx context.Context, batchSize int32) (int, error)
+	PurgePublishedOutboxEvents(ctx context.Context, retention time.Duration, batchSize int32) (int64, error)
+}
+
+// WebhookUsecase defines the interface for outgoing webhook business logic.
+type WebhookUsecase interface {
+	ListWebhookEndpoints(ctx context.Context) ([]entity.WebhookEndpoint, error)
+	GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*entity.WebhookEndpoint, error)
+	CreateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	UpdateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error
+	ListWebhookDeliveries(ctx context.Context, endpointID uuid.UUID, status entity.WebhookDeliveryStatus, limit int) ([]entity.WebhookDelivery, error)
+	RedeliverWebhook(ctx context.Context, deliveryID uuid.UUID) (*entity.WebhookDelivery, error)
+	// EnqueueWebhookDeliveries queues event for the endpoints subscribed to it.
+	EnqueueWebhookDeliveries(ctx context.Context, event entity.OutboxEvent) error
+	DeliverWebhooks(ctx context.Context, batchSize int32) (*entity.WebhookDeliveryResult, error)
+	PurgeWebhookDeliveries(ctx context.Context, retention time.Duration, batchSize int32) (int64, error)
 }
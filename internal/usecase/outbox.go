@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"base_app/internal/entity"
+)
+
+// OutboxUsecaseImpl relays domain events from the outbox to the event sinks.
+type OutboxUsecaseImpl struct {
+	service OutboxService
+	retry   entity.RetryPolicy
+	log     *slog.Logger
+}
+
+// NewOutboxUsecase creates a new OutboxUsecase.
+func NewOutboxUsecase(s OutboxService, retry entity.RetryPolicy, l *slog.Logger) OutboxUsecase {
+	return &OutboxUsecaseImpl{
+		service: s,
+		retry:   retry,
+		log:     l,
+	}
+}
+
+// RelayOutboxEvents publishes up to batchSize pending events in the order they were stored
+// and returns how many were published. Publishing stops at the first event that fails, or
+// that failed before and is still backing off, so later events never overtake it.
+func (uc *OutboxUsecaseImpl) RelayOutboxEvents(ctx context.Context, batchSize int32) (int, error) {
+	const op = "usecase.RelayOutboxEvents"
+
+	n, err := uc.service.RelayOutboxEvents(ctx, batchSize, func(events []entity.OutboxEvent) (int, error) {
+		now := time.Now()
+		for i, event := range events {
+			if event.Attempts > 0 && now.Before(event.LastAttemptAt.Add(uc.retry.Backoff(event.Attempts))) {
+				return i, nil
+			}
+			if err := uc.service.PublishOutboxEvent(ctx, event); err != nil {
+				return i, err
+			}
+		}
+		return len(events), nil
+	})
+	if err != nil {
+		uc.log.Error("failed to relay outbox events", slog.String("op", op), slog.Int("published", n),
+			slog.String("error", err.Error()))
+		return n, err
+	}
+	return n, nil
+}
+
+// PurgePublishedOutboxEvents removes events published longer than retention ago and returns
+// how many were removed.
+func (uc *OutboxUsecaseImpl) PurgePublishedOutboxEvents(ctx context.Context, retention time.Duration, batchSize int32) (int64, error) {
+	const op = "usecase.PurgePublishedOutboxEvents"
+
+	n, err := uc.service.PurgePublishedOutboxEvents(ctx, time.Now().Add(-retention), batchSize)
+	if err != nil {
+		uc.log.Error("failed to purge published outbox events", slog.String("op", op), slog.String("error", err.Error()))
+		return n, err
+	}
+
+	if n > 0 {
+		uc.log.Info("published outbox events purged", slog.String("op", op), slog.Int64("rows", n))
+	}
+	return n, nil
+}
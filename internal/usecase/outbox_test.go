@@ -0,0 +1,146 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"base_app/internal/adapter/repository/memory"
+	"base_app/internal/entity"
+	"base_app/internal/service"
+	"base_app/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+// recordingSink records the keys of the data events it receives and fails while fail
+// returns an error for them.
+type recordingSink struct {
+	name string
+	fail func(key string) error
+	keys []string
+}
+
+func (s *recordingSink) Name() string { return s.name }
+
+func (s *recordingSink) Publish(_ context.Context, event entity.OutboxEvent) error {
+	var payload entity.DataEventPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return err
+	}
+	s.keys = append(s.keys, payload.Key)
+	if s.fail != nil {
+		return s.fail(payload.Key)
+	}
+	return nil
+}
+
+// newOutboxUsecase returns an outbox use case relaying the events of saving keys to sinks.
+func newOutboxUsecase(t *testing.T, retry entity.RetryPolicy, keys []string, sinks ...usecase.EventSink) usecase.OutboxUsecase {
+	t.Helper()
+
+	log := slog.New(slog.DiscardHandler)
+	repo := memory.New(memory.NewDataFeed(16))
+	for _, key := range keys {
+		if err := repo.SaveData(context.Background(), &entity.Data{OwnerID: uuid.New(), Key: key, Value: []byte(`1`)}); err != nil {
+			t.Fatalf("SaveData: %v", err)
+		}
+	}
+	return usecase.NewOutboxUsecase(service.NewOutboxService(repo, sinks, log), retry, log)
+}
+
+func relay(t *testing.T, uc usecase.OutboxUsecase, batchSize int32) (int, error) {
+	t.Helper()
+	return uc.RelayOutboxEvents(context.Background(), batchSize)
+}
+
+var errSinkDown = errors.New("sink down")
+
+// failOnce fails the first delivery of key.
+func failOnce(key string) func(string) error {
+	failed := false
+	return func(k string) error {
+		if k == key && !failed {
+			failed = true
+			return errSinkDown
+		}
+		return nil
+	}
+}
+
+func TestRelayOutboxEventsInOrder(t *testing.T) {
+	sink := &recordingSink{name: "sink"}
+	uc := newOutboxUsecase(t, entity.RetryPolicy{}, []string{"a", "b", "c"}, sink)
+
+	if n, err := relay(t, uc, 2); n != 2 || err != nil {
+		t.Fatalf("first relay = %d, %v; want 2 events", n, err)
+	}
+	if n, err := relay(t, uc, 2); n != 1 || err != nil {
+		t.Fatalf("second relay = %d, %v; want 1 event", n, err)
+	}
+	if n, err := relay(t, uc, 2); n != 0 || err != nil {
+		t.Fatalf("third relay = %d, %v; want nothing left", n, err)
+	}
+	if want := []string{"a", "b", "c"}; !slices.Equal(sink.keys, want) {
+		t.Errorf("published %q, want %q", sink.keys, want)
+	}
+}
+
+func TestRelayOutboxEventsRetriesFailedEventFirst(t *testing.T) {
+	sink := &recordingSink{name: "sink", fail: failOnce("b")}
+	uc := newOutboxUsecase(t, entity.RetryPolicy{InitialBackoff: time.Nanosecond, MaxBackoff: time.Nanosecond},
+		[]string{"a", "b", "c"}, sink)
+
+	n, err := relay(t, uc, 10)
+	if n != 1 || !errors.Is(err, errSinkDown) {
+		t.Fatalf("first relay = %d, %v; want 1 event and the sink error", n, err)
+	}
+	if n, err := relay(t, uc, 10); n != 2 || err != nil {
+		t.Fatalf("retry = %d, %v; want 2 events", n, err)
+	}
+	// c is never published before b.
+	if want := []string{"a", "b", "b", "c"}; !slices.Equal(sink.keys, want) {
+		t.Errorf("published %q, want %q", sink.keys, want)
+	}
+}
+
+func TestRelayOutboxEventsWaitsForBackoff(t *testing.T) {
+	sink := &recordingSink{name: "sink", fail: failOnce("a")}
+	uc := newOutboxUsecase(t, entity.RetryPolicy{InitialBackoff: time.Hour, MaxBackoff: time.Hour},
+		[]string{"a", "b"}, sink)
+
+	if n, err := relay(t, uc, 10); n != 0 || !errors.Is(err, errSinkDown) {
+		t.Fatalf("first relay = %d, %v; want the sink error", n, err)
+	}
+	// a is backing off and holds back b.
+	if n, err := relay(t, uc, 10); n != 0 || err != nil {
+		t.Fatalf("relay while backing off = %d, %v; want nothing published", n, err)
+	}
+	if want := []string{"a"}; !slices.Equal(sink.keys, want) {
+		t.Errorf("published %q, want %q", sink.keys, want)
+	}
+}
+
+func TestRelayOutboxEventsRepublishesToEarlierSinks(t *testing.T) {
+	first := &recordingSink{name: "first"}
+	second := &recordingSink{name: "second", fail: failOnce("a")}
+	uc := newOutboxUsecase(t, entity.RetryPolicy{}, []string{"a"}, first, second)
+
+	if _, err := relay(t, uc, 10); !errors.Is(err, errSinkDown) {
+		t.Fatalf("first relay error = %v, want the sink error", err)
+	}
+	if n, err := relay(t, uc, 10); n != 1 || err != nil {
+		t.Fatalf("retry = %d, %v; want 1 event", n, err)
+	}
+	// Delivery is at least once: the first sink sees the event again.
+	if want := []string{"a", "a"}; !slices.Equal(first.keys, want) {
+		t.Errorf("first sink got %q, want %q", first.keys, want)
+	}
+	if want := []string{"a", "a"}; !slices.Equal(second.keys, want) {
+		t.Errorf("second sink got %q, want %q", second.keys, want)
+	}
+}
@@ -3,20 +3,226 @@ package usecase
 import (
 	"base_app/internal/entity"
 	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
 )
 
+// DataChangeFeed delivers data change events as they are committed.
+type DataChangeFeed interface {
+	// Subscribe returns events for keys starting with prefix. The channel is
+	// closed when ctx is done or when the subscriber cannot keep up.
+	Subscribe(ctx context.Context, prefix string) <-chan entity.DataEvent
+}
+
+// DataImportTx writes imported data inside a single database transaction.
+type DataImportTx interface {
+	// WriteChunk stores records according to onConflict. In fail mode nothing is
+	// written when any key already exists; the conflicting keys are reported instead.
+	// Records belong to one owner. When check is not nil, it is called with the owner's
+	// usage of the keys outside the records to be written, under the same lock as
+	// LockDataUsage; if it fails, nothing is written and its error is returned unchanged.
+	WriteChunk(ctx context.Context, records []entity.Data, onConflict entity.ConflictMode, check func(others entity.DataUsage, records []entity.Data) error) (*entity.ImportChunkResult, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+// IdempotencyStore keeps responses of requests made with an Idempotency-Key.
+type IdempotencyStore interface {
+	// Reserve claims key for a request with the given hash for at most lockTTL.
+	// When the key is already taken it returns the existing record and false.
+	Reserve(ctx context.Context, key, requestHash string, lockTTL time.Duration) (*entity.IdempotencyRecord, bool, error)
+	// Complete stores the final response under a reserved key for ttl.
+	Complete(ctx context.Context, key string, rec *entity.IdempotencyRecord, ttl time.Duration) error
+	// Release drops a reservation so the request can be retried.
+	Release(ctx context.Context, key string) error
+}
+
+// BlobStore keeps attachment content addressed by its SHA-256 digest.
+// Implementations must store identical content only once.
+type BlobStore interface {
+	// Put stores everything read from r and returns its hex digest and size.
+	// Nothing is kept when reading r fails.
+	Put(ctx context.Context, r io.Reader) (digest string, size int64, err error)
+	// Open returns the content stored under digest, or entity.ErrNotFound.
+	Open(ctx context.Context, digest string) (io.ReadCloser, error)
+	Exists(ctx context.Context, digest string) (bool, error)
+	Delete(ctx context.Context, digest string) error
+	// Walk calls fn for all stored content with the time it was last written.
+	Walk(ctx context.Context, fn func(digest string, modTime time.Time) error) error
+}
+
+// ImageStore keeps catalog image files under names chosen by the caller.
+type ImageStore interface {
+	// Put stores everything read from r under name, replacing earlier content at once.
+	// Nothing is kept when reading r fails.
+	Put(ctx context.Context, name string, r io.Reader) error
+	// Open returns the content stored under name, or entity.ErrNotFound.
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+	// Delete removes the content stored under name. Missing content is not an error.
+	Delete(ctx context.Context, name string) error
+	// Walk calls fn for all stored files with the time they were written.
+	Walk(ctx context.Context, fn func(name string, modTime time.Time) error) error
+}
+
+// EventSink delivers outbox events to a consumer. Publish may be called again for an event
+// it has already accepted, so consumers must ignore event ids they have seen.
+type EventSink interface {
+	Name() string
+	Publish(ctx context.Context, event entity.OutboxEvent) error
+}
+
+// OutboxRepo is the interface for the transactional outbox of domain events.
+type OutboxRepo interface {
+	// RelayOutboxEvents hands up to limit pending events, oldest first, to publish, which
+	// returns how many of them it delivered. Those are marked as published; a failure is
+	// recorded on the first event that was not delivered.
+	RelayOutboxEvents(ctx context.Context, limit int32, publish func(events []entity.OutboxEvent) (delivered int, err error)) (int, error)
+	PurgePublishedOutboxEvents(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error)
+}
+
+// WebhookSender posts events to webhook endpoints.
+type WebhookSender interface {
+	// Send posts the event of delivery to url, signed with secret, and returns the HTTP status
+	// of the response. Any status other than 2xx is returned together with an error.
+	Send(ctx context.Context, url, secret string, delivery entity.WebhookDelivery) (status int, err error)
+}
+
+// TxManager runs a unit of work: every repository call made with the context passed to fn
+// is committed together when fn returns nil, and rolled back otherwise. fn may be called
+// again when the transaction conflicts with a concurrent one, so it must not have side
+// effects outside the repositories. Nested calls run in a savepoint of the enclosing unit.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 // UserRepo is the interface for user database operations.
 type UserRepo interface {
 	GetUserByEmail(ctx context.Context, email string) (*entity.User, error)
+	SetUserLocale(ctx context.Context, id uuid.UUID, locale string) error
 	// In a real app, you'd have more methods like CreateUser, etc.
 }
 
 // DataRepo is the interface for data database operations.
 type DataRepo interface {
 	SaveData(ctx context.Context, data *entity.Data) error
+	// LockDataUsage returns the usage of the owner's keys other than excludeKeys and holds off
+	// the quota checks of the owner's other writes until the unit of work of ctx ends, so
+	// writes checked against the usage cannot overshoot a quota together. It must be called
+	// within a unit of work; otherwise the lock is released at once.
+	LockDataUsage(ctx context.Context, ownerID uuid.UUID, excludeKeys []string) (*entity.DataUsage, error)
+	GetDataUsage(ctx context.Context, ownerID uuid.UUID) (*entity.DataUsage, error)
+	GetData(ctx context.Context, key string) (*entity.Data, error)
+	PurgeExpiredData(ctx context.Context, batchSize int32) (int64, error)
+	// DeleteData soft deletes every version of a key; RestoreData undoes it until
+	// PurgeDeletedData removes the versions for good.
+	DeleteData(ctx context.Context, key string) error
+	ListDeletedData(ctx context.Context, limit int32) ([]entity.Data, error)
+	RestoreData(ctx context.Context, key string) (*entity.Data, error)
+	PurgeDeletedData(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error)
+	BeginDataImport(ctx context.Context) (DataImportTx, error)
+	ExportData(ctx context.Context, fn func(*entity.Data) error) error
+	ListDataChanges(ctx context.Context, afterID int64, prefix string, limit int32) ([]entity.DataEvent, error)
+	GetDataSchemaForKey(ctx context.Context, key string) (*entity.DataSchema, error)
+	ListDataSchemas(ctx context.Context) ([]entity.DataSchema, error)
+	SaveDataSchema(ctx context.Context, schema *entity.DataSchema) error
+	DeleteDataSchema(ctx context.Context, prefix string) error
+}
+
+// AttachmentRepo is the interface for attachment metadata operations.
+type AttachmentRepo interface {
+	DataKeyExists(ctx context.Context, key string) (bool, error)
+	// CreateAttachment records att and its blob. verify runs before the commit; when it
+	// fails nothing is recorded. Fills in the generated fields of att.
+	CreateAttachment(ctx context.Context, att *entity.Attachment, verify func() error) error
+	GetAttachment(ctx context.Context, id uuid.UUID) (*entity.Attachment, error)
+	ListAttachments(ctx context.Context, key string) ([]entity.Attachment, error)
+	DeleteAttachment(ctx context.Context, id uuid.UUID) error
+	// DeleteDanglingAttachments removes attachments of data keys that no longer exist.
+	DeleteDanglingAttachments(ctx context.Context) (int64, error)
+	// DeleteUnusedBlobs removes up to limit blobs unreferenced since before olderThan.
+	// remove deletes their content before the commit, so a concurrent upload of the same
+	// content either keeps the blob alive or finds its content gone.
+	DeleteUnusedBlobs(ctx context.Context, olderThan time.Time, limit int32, remove func(digests []string) error) (int, error)
+	BlobExists(ctx context.Context, digest string) (bool, error)
 }
 
 // CatalogRepo is the interface for catalog database operations.
 type CatalogRepo interface {
-	GetCatalogItems(ctx context.Context) ([]entity.CatalogItem, error)
+	GetCatalogItems(ctx context.Context, category string) ([]entity.CatalogItem, error)
+	ListCatalogItems(ctx context.Context, q entity.CatalogQuery) ([]entity.CatalogItem, error)
+	GetCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error)
+	CreateCatalogItem(ctx context.Context, item *entity.CatalogItem) error
+	UpdateCatalogItem(ctx context.Context, item *entity.CatalogItem) error
+	SetCatalogItemDisabled(ctx context.Context, id uuid.UUID, disabled bool) (*entity.CatalogItem, error)
+	// DeleteCatalogItem soft deletes an item; RestoreCatalogItem undoes it until
+	// PurgeDeletedCatalogItems removes the item for good.
+	DeleteCatalogItem(ctx context.Context, id uuid.UUID) error
+	ListDeletedCatalogItems(ctx context.Context, limit int32) ([]entity.CatalogItem, error)
+	RestoreCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error)
+	PurgeDeletedCatalogItems(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error)
+	SearchCatalogItems(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error)
+	SearchCatalogItemsFuzzy(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error)
+	SetCatalogSearchLanguage(ctx context.Context, language string) (int64, error)
+	ListCatalogCategories(ctx context.Context) ([]entity.CatalogCategory, error)
+	GetCatalogCategory(ctx context.Context, id uuid.UUID) (*entity.CatalogCategory, error)
+	CreateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error
+	UpdateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error
+	DeleteCatalogCategory(ctx context.Context, id uuid.UUID) error
+	ListCatalogTags(ctx context.Context) ([]entity.CatalogTag, error)
+	CreateCatalogTag(ctx context.Context, name string) error
+	RenameCatalogTag(ctx context.Context, name, newName string) (*entity.CatalogTag, error)
+	DeleteCatalogTag(ctx context.Context, name string) error
+	ListCatalogChangesets(ctx context.Context) ([]entity.CatalogChangeset, error)
+	GetCatalogChangeset(ctx context.Context, id uuid.UUID) (*entity.CatalogChangeset, error)
+	CreateCatalogChangeset(ctx context.Context, changeset *entity.CatalogChangeset) error
+	DeleteCatalogChangeset(ctx context.Context, id uuid.UUID) error
+	SaveCatalogDraft(ctx context.Context, changesetID uuid.UUID, draft *entity.CatalogDraft) error
+	DeleteCatalogDraft(ctx context.Context, changesetID, itemID uuid.UUID) error
+	PublishCatalogChangeset(ctx context.Context, id, publishedBy uuid.UUID, language string) (*entity.CatalogChangeset, error)
+	RollbackCatalogChangeset(ctx context.Context, id, rolledBackBy uuid.UUID, language string) (*entity.CatalogChangeset, error)
+	ListCatalogTranslations(ctx context.Context, itemID uuid.UUID) ([]entity.CatalogTranslation, error)
+	ListCatalogTranslationsForItems(ctx context.Context, itemIDs []uuid.UUID, locales []string) ([]entity.CatalogTranslation, error)
+	SaveCatalogTranslation(ctx context.Context, t *entity.CatalogTranslation) error
+	DeleteCatalogTranslation(ctx context.Context, itemID uuid.UUID, locale string) error
+	ImportCatalogItems(ctx context.Context, rows []entity.CatalogImportRow, opts entity.CatalogImportOptions, language string) (*entity.CatalogImportResult, error)
+	CreateCatalogImage(ctx context.Context, img *entity.CatalogImage) error
+	GetCatalogImage(ctx context.Context, id uuid.UUID) (*entity.CatalogImage, error)
+	DeleteCatalogImage(ctx context.Context, itemID, id uuid.UUID) error
+	CatalogImageExists(ctx context.Context, id uuid.UUID) (bool, error)
+	AddCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error
+	DeleteCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error
+	ListCatalogFavorites(ctx context.Context, userID uuid.UUID, after *entity.CatalogFavoriteCursor, limit int) ([]entity.CatalogFavorite, error)
+	ListCatalogFavoriteIDs(ctx context.Context, userID uuid.UUID, itemIDs []uuid.UUID) ([]uuid.UUID, error)
+	RecordCatalogView(ctx context.Context, userID, itemID uuid.UUID, keep int) error
+	ListRecentlyViewedCatalogItems(ctx context.Context, userID uuid.UUID) ([]entity.CatalogItem, error)
+	SetCatalogInventory(ctx context.Context, id uuid.UUID, price *entity.Money, stock *int64) (*entity.CatalogItem, error)
+	ReserveCatalogItem(ctx context.Context, res *entity.CatalogReservation) error
+	ReleaseCatalogReservation(ctx context.Context, itemID, reservationID, userID uuid.UUID) error
+	GetCatalogReview(ctx context.Context, itemID, userID uuid.UUID) (*entity.CatalogReview, error)
+	SaveCatalogReview(ctx context.Context, review *entity.CatalogReview) error
+	DeleteCatalogReview(ctx context.Context, itemID, userID uuid.UUID) error
+	SetCatalogReviewStatus(ctx context.Context, id uuid.UUID, status entity.CatalogReviewStatus) (*entity.CatalogReview, error)
+	ListCatalogReviews(ctx context.Context, itemID uuid.UUID, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error)
+	ListCatalogReviewsByStatus(ctx context.Context, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error)
+}
+
+// WebhookRepo is the interface for webhook endpoint and delivery operations.
+type WebhookRepo interface {
+	ListWebhookEndpoints(ctx context.Context) ([]entity.WebhookEndpoint, error)
+	GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*entity.WebhookEndpoint, error)
+	CreateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	UpdateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error
+	CreateWebhookDeliveries(ctx context.Context, event entity.OutboxEvent) (int64, error)
+	// ClaimDueWebhookDeliveries hides the claimed deliveries from other claims until leaseUntil.
+	ClaimDueWebhookDeliveries(ctx context.Context, leaseUntil time.Time, limit int32) ([]entity.WebhookDispatch, error)
+	// RecordWebhookAttempt stores the outcome of an attempt and reports whether the failure
+	// count of the endpoint reached disableAfter, disabling it.
+	RecordWebhookAttempt(ctx context.Context, delivery *entity.WebhookDelivery, disableAfter int) (disabled bool, err error)
+	GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, endpointID uuid.UUID, status entity.WebhookDeliveryStatus, limit int32) ([]entity.WebhookDelivery, error)
+	RedeliverWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	PurgeWebhookDeliveries(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error)
 }
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"sync"
+	"time"
+
+	"base_app/internal/entity"
+	"base_app/pkg/jsonschema"
+)
+
+// schemaCache keeps the compiled data schemas, so writes do not compile the schema of their
+// prefix every time. An entry is reused while the stored schema keeps its updated_at.
+type schemaCache struct {
+	mu      sync.Mutex
+	entries map[string]compiledSchema // By prefix
+}
+
+type compiledSchema struct {
+	updatedAt time.Time
+	schema    *jsonschema.Schema
+}
+
+func newSchemaCache() *schemaCache {
+	return &schemaCache{entries: make(map[string]compiledSchema)}
+}
+
+// compile returns the compiled form of ds, compiling it only when the cache holds no entry for
+// this version of the schema.
+func (c *schemaCache) compile(ds *entity.DataSchema) (*jsonschema.Schema, error) {
+	c.mu.Lock()
+	e, ok := c.entries[ds.Prefix]
+	c.mu.Unlock()
+	if ok && e.updatedAt.Equal(ds.UpdatedAt) {
+		return e.schema, nil
+	}
+
+	schema, err := jsonschema.Compile(ds.Schema)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.entries[ds.Prefix] = compiledSchema{updatedAt: ds.UpdatedAt, schema: schema}
+	c.mu.Unlock()
+	return schema, nil
+}
+
+// forget drops the entry of a prefix whose schema was deleted.
+func (c *schemaCache) forget(prefix string) {
+	c.mu.Lock()
+	delete(c.entries, prefix)
+	c.mu.Unlock()
+}
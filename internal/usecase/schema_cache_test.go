@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"base_app/internal/entity"
+)
+
+func TestSchemaCacheCompilesEachVersionOnce(t *testing.T) {
+	c := newSchemaCache()
+	at := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	ds := &entity.DataSchema{Prefix: "user:", Schema: json.RawMessage(`{"type":"object"}`), UpdatedAt: at}
+
+	first, err := c.compile(ds)
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	if again, _ := c.compile(ds); again != first {
+		t.Errorf("unchanged schema compiled again")
+	}
+
+	updated := &entity.DataSchema{Prefix: "user:", Schema: json.RawMessage(`{"type":"string"}`), UpdatedAt: at.Add(time.Second)}
+	schema, err := c.compile(updated)
+	if err != nil || schema == first {
+		t.Fatalf("compile(updated) = %p, %v; want a new schema", schema, err)
+	}
+	if violations, _ := schema.Validate([]byte(`"s"`)); len(violations) != 0 {
+		t.Errorf("updated schema rejects a string: %v", violations)
+	}
+
+	c.forget("user:")
+	if again, _ := c.compile(updated); again == schema {
+		t.Errorf("forgotten schema served from the cache")
+	}
+
+	if _, err := c.compile(&entity.DataSchema{Prefix: "bad:", Schema: json.RawMessage(`{"type":1}`), UpdatedAt: at}); err == nil {
+		t.Errorf("compile accepts an invalid schema")
+	}
+	if _, ok := c.entries["bad:"]; ok {
+		t.Errorf("invalid schema cached")
+	}
+}
@@ -3,19 +3,139 @@ package usecase
 import (
 	"base_app/internal/entity"
 	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 // AuthService defines the interface for the authentication domain service.
 type AuthService interface {
 	GetUserByEmail(ctx context.Context, email string) (*entity.User, error)
+	SetUserLocale(ctx context.Context, id uuid.UUID, locale string) error
 }
 
 // DataService defines the interface for the data domain service.
 type DataService interface {
 	SaveData(ctx context.Context, data *entity.Data) error
+	LockDataUsage(ctx context.Context, ownerID uuid.UUID, excludeKeys []string) (*entity.DataUsage, error)
+	GetDataUsage(ctx context.Context, ownerID uuid.UUID) (*entity.DataUsage, error)
+	GetData(ctx context.Context, key string) (*entity.Data, error)
+	PurgeExpiredData(ctx context.Context, batchSize int32) (int64, error)
+	DeleteData(ctx context.Context, key string) error
+	ListDeletedData(ctx context.Context, limit int32) ([]entity.Data, error)
+	RestoreData(ctx context.Context, key string) (*entity.Data, error)
+	PurgeDeletedData(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error)
+	BeginDataImport(ctx context.Context) (DataImportTx, error)
+	ExportData(ctx context.Context, fn func(*entity.Data) error) error
+	ListDataChanges(ctx context.Context, afterID int64, prefix string, limit int32) ([]entity.DataEvent, error)
+	SubscribeDataChanges(ctx context.Context, prefix string) <-chan entity.DataEvent
+	GetDataSchemaForKey(ctx context.Context, key string) (*entity.DataSchema, error)
+	ListDataSchemas(ctx context.Context) ([]entity.DataSchema, error)
+	SaveDataSchema(ctx context.Context, schema *entity.DataSchema) error
+	DeleteDataSchema(ctx context.Context, prefix string) error
+}
+
+// AttachmentService defines the interface for the attachment domain service.
+// It combines attachment metadata with the blob store holding the content.
+type AttachmentService interface {
+	DataKeyExists(ctx context.Context, key string) (bool, error)
+	CreateAttachment(ctx context.Context, att *entity.Attachment, verify func() error) error
+	GetAttachment(ctx context.Context, id uuid.UUID) (*entity.Attachment, error)
+	ListAttachments(ctx context.Context, key string) ([]entity.Attachment, error)
+	DeleteAttachment(ctx context.Context, id uuid.UUID) error
+	DeleteDanglingAttachments(ctx context.Context) (int64, error)
+	DeleteUnusedBlobs(ctx context.Context, olderThan time.Time, limit int32, remove func(digests []string) error) (int, error)
+	BlobExists(ctx context.Context, digest string) (bool, error)
+	PutBlob(ctx context.Context, r io.Reader) (digest string, size int64, err error)
+	OpenBlob(ctx context.Context, digest string) (io.ReadCloser, error)
+	BlobContentExists(ctx context.Context, digest string) (bool, error)
+	DeleteBlob(ctx context.Context, digest string) error
+	WalkBlobs(ctx context.Context, fn func(digest string, modTime time.Time) error) error
 }
 
 // CatalogService defines the interface for the catalog domain service.
+// It combines the catalog repository with the image store holding image files.
 type CatalogService interface {
-	GetCatalogItems(ctx context.Context) ([]entity.CatalogItem, error)
+	GetCatalogItems(ctx context.Context, category string) ([]entity.CatalogItem, error)
+	ListCatalogItems(ctx context.Context, q entity.CatalogQuery) ([]entity.CatalogItem, error)
+	GetCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error)
+	CreateCatalogItem(ctx context.Context, item *entity.CatalogItem) error
+	UpdateCatalogItem(ctx context.Context, item *entity.CatalogItem) error
+	SetCatalogItemDisabled(ctx context.Context, id uuid.UUID, disabled bool) (*entity.CatalogItem, error)
+	DeleteCatalogItem(ctx context.Context, id uuid.UUID) error
+	ListDeletedCatalogItems(ctx context.Context, limit int32) ([]entity.CatalogItem, error)
+	RestoreCatalogItem(ctx context.Context, id uuid.UUID) (*entity.CatalogItem, error)
+	PurgeDeletedCatalogItems(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error)
+	SearchCatalogItems(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error)
+	SearchCatalogItemsFuzzy(ctx context.Context, q entity.CatalogSearchQuery) ([]entity.CatalogSearchHit, error)
+	SetCatalogSearchLanguage(ctx context.Context, language string) (int64, error)
+	ListCatalogCategories(ctx context.Context) ([]entity.CatalogCategory, error)
+	GetCatalogCategory(ctx context.Context, id uuid.UUID) (*entity.CatalogCategory, error)
+	CreateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error
+	UpdateCatalogCategory(ctx context.Context, category *entity.CatalogCategory) error
+	DeleteCatalogCategory(ctx context.Context, id uuid.UUID) error
+	ListCatalogTags(ctx context.Context) ([]entity.CatalogTag, error)
+	CreateCatalogTag(ctx context.Context, name string) error
+	RenameCatalogTag(ctx context.Context, name, newName string) (*entity.CatalogTag, error)
+	DeleteCatalogTag(ctx context.Context, name string) error
+	ListCatalogChangesets(ctx context.Context) ([]entity.CatalogChangeset, error)
+	GetCatalogChangeset(ctx context.Context, id uuid.UUID) (*entity.CatalogChangeset, error)
+	CreateCatalogChangeset(ctx context.Context, changeset *entity.CatalogChangeset) error
+	DeleteCatalogChangeset(ctx context.Context, id uuid.UUID) error
+	SaveCatalogDraft(ctx context.Context, changesetID uuid.UUID, draft *entity.CatalogDraft) error
+	DeleteCatalogDraft(ctx context.Context, changesetID, itemID uuid.UUID) error
+	PublishCatalogChangeset(ctx context.Context, id, publishedBy uuid.UUID, language string) (*entity.CatalogChangeset, error)
+	RollbackCatalogChangeset(ctx context.Context, id, rolledBackBy uuid.UUID, language string) (*entity.CatalogChangeset, error)
+	ListCatalogTranslations(ctx context.Context, itemID uuid.UUID) ([]entity.CatalogTranslation, error)
+	ListCatalogTranslationsForItems(ctx context.Context, itemIDs []uuid.UUID, locales []string) ([]entity.CatalogTranslation, error)
+	SaveCatalogTranslation(ctx context.Context, t *entity.CatalogTranslation) error
+	DeleteCatalogTranslation(ctx context.Context, itemID uuid.UUID, locale string) error
+	ImportCatalogItems(ctx context.Context, rows []entity.CatalogImportRow, opts entity.CatalogImportOptions, language string) (*entity.CatalogImportResult, error)
+	CreateCatalogImage(ctx context.Context, img *entity.CatalogImage) error
+	GetCatalogImage(ctx context.Context, id uuid.UUID) (*entity.CatalogImage, error)
+	DeleteCatalogImage(ctx context.Context, itemID, id uuid.UUID) error
+	CatalogImageExists(ctx context.Context, id uuid.UUID) (bool, error)
+	AddCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error
+	DeleteCatalogFavorite(ctx context.Context, userID, itemID uuid.UUID) error
+	ListCatalogFavorites(ctx context.Context, userID uuid.UUID, after *entity.CatalogFavoriteCursor, limit int) ([]entity.CatalogFavorite, error)
+	ListCatalogFavoriteIDs(ctx context.Context, userID uuid.UUID, itemIDs []uuid.UUID) ([]uuid.UUID, error)
+	RecordCatalogView(ctx context.Context, userID, itemID uuid.UUID, keep int) error
+	ListRecentlyViewedCatalogItems(ctx context.Context, userID uuid.UUID) ([]entity.CatalogItem, error)
+	SetCatalogInventory(ctx context.Context, id uuid.UUID, price *entity.Money, stock *int64) (*entity.CatalogItem, error)
+	ReserveCatalogItem(ctx context.Context, res *entity.CatalogReservation) error
+	ReleaseCatalogReservation(ctx context.Context, itemID, reservationID, userID uuid.UUID) error
+	GetCatalogReview(ctx context.Context, itemID, userID uuid.UUID) (*entity.CatalogReview, error)
+	SaveCatalogReview(ctx context.Context, review *entity.CatalogReview) error
+	DeleteCatalogReview(ctx context.Context, itemID, userID uuid.UUID) error
+	SetCatalogReviewStatus(ctx context.Context, id uuid.UUID, status entity.CatalogReviewStatus) (*entity.CatalogReview, error)
+	ListCatalogReviews(ctx context.Context, itemID uuid.UUID, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error)
+	ListCatalogReviewsByStatus(ctx context.Context, status entity.CatalogReviewStatus, limit int32) ([]entity.CatalogReview, error)
+}
+
+// OutboxService defines the interface for the outbox domain service.
+// It combines the outbox in the repository with the sinks events are published to.
+type OutboxService interface {
+	RelayOutboxEvents(ctx context.Context, limit int32, publish func(events []entity.OutboxEvent) (delivered int, err error)) (int, error)
+	PurgePublishedOutboxEvents(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error)
+	// PublishOutboxEvent publishes event to every sink. It fails if any sink fails.
+	PublishOutboxEvent(ctx context.Context, event entity.OutboxEvent) error
+}
+
+// WebhookService defines the interface for the webhook domain service.
+// It combines endpoints and deliveries in the repository with the sender posting them.
+type WebhookService interface {
+	ListWebhookEndpoints(ctx context.Context) ([]entity.WebhookEndpoint, error)
+	GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*entity.WebhookEndpoint, error)
+	CreateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	UpdateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error
+	CreateWebhookDeliveries(ctx context.Context, event entity.OutboxEvent) (int64, error)
+	ClaimDueWebhookDeliveries(ctx context.Context, leaseUntil time.Time, limit int32) ([]entity.WebhookDispatch, error)
+	RecordWebhookAttempt(ctx context.Context, delivery *entity.WebhookDelivery, disableAfter int) (bool, error)
+	GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, endpointID uuid.UUID, status entity.WebhookDeliveryStatus, limit int32) ([]entity.WebhookDelivery, error)
+	RedeliverWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	PurgeWebhookDeliveries(ctx context.Context, cutoff time.Time, batchSize int32) (int64, error)
+	SendWebhook(ctx context.Context, dispatch entity.WebhookDispatch) (status int, err error)
 }
@@ -0,0 +1,106 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"base_app/internal/adapter/repository/memory"
+	"base_app/internal/adapter/repository/postgresql"
+	"base_app/internal/entity"
+	"base_app/internal/usecase"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// testPostgresDSN is the environment variable naming a PostgreSQL database the tests may
+// wipe. Without it, tests run against the memory driver only.
+const testPostgresDSN = "TEST_POSTGRES_DSN"
+
+// truncateTables empties every table but the migration state, so each test starts from an
+// empty database like it does from an empty memory repository.
+const truncateTables = `DO $$
+DECLARE
+    tables TEXT;
+BEGIN
+    SELECT string_agg(format('%I', tablename), ', ') INTO tables
+    FROM pg_tables
+    WHERE schemaname = current_schema() AND tablename <> 'schema_migrations';
+    EXECUTE 'TRUNCATE ' || tables || ' RESTART IDENTITY CASCADE';
+END $$`
+
+// testStorage is what a storage driver provides to the use cases under test.
+type testStorage struct {
+	repo interface {
+		usecase.CatalogRepo
+		usecase.WebhookRepo
+	}
+	tx usecase.TxManager
+}
+
+// storageDriver opens an empty storage for a test.
+type storageDriver func(t *testing.T) testStorage
+
+// forEachDriver runs test once for every storage driver, as subtests named after them.
+func forEachDriver(t *testing.T, test func(t *testing.T, open storageDriver)) {
+	t.Run("memory", func(t *testing.T) { test(t, memoryStorage) })
+	t.Run("postgres", func(t *testing.T) { test(t, postgresStorage) })
+}
+
+func memoryStorage(*testing.T) testStorage {
+	repo := memory.New(memory.NewDataFeed(16))
+	return testStorage{repo: repo, tx: repo}
+}
+
+// migrateTestDatabase applies the migrations to the database of testPostgresDSN once per run.
+var migrateTestDatabase = sync.OnceValue(func() error {
+	m, err := migrate.New("file://../../contracts/pgsql/migrations", os.Getenv(testPostgresDSN))
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+})
+
+// postgresStorage empties the database of testPostgresDSN, or skips the test when it is not set.
+func postgresStorage(t *testing.T) testStorage {
+	t.Helper()
+
+	dsn := os.Getenv(testPostgresDSN)
+	if dsn == "" {
+		t.Skipf("%s is not set", testPostgresDSN)
+	}
+	if err := migrateTestDatabase(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	if _, err := pool.Exec(ctx, truncateTables); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+
+	log := slog.New(slog.DiscardHandler)
+	tx, err := postgresql.NewTxManager(pool, "repeatable read", 5, entity.RetryPolicy{
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+	}, log)
+	if err != nil {
+		t.Fatalf("NewTxManager: %v", err)
+	}
+	return testStorage{repo: postgresql.NewRepo(pool, nil, log), tx: tx}
+}
@@ -0,0 +1,353 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"base_app/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultWebhookPageSize = 50
+	maxWebhookPageSize     = 500
+	// minWebhookSecretLength keeps admin chosen secrets from being guessable.
+	minWebhookSecretLength = 16
+	// webhookSecretBytes is the amount of randomness in generated secrets.
+	webhookSecretBytes = 32
+	// webhookLeaseMargin is added to the attempt timeout when claiming deliveries, so a claim
+	// outlives the attempt and the recording of its outcome.
+	webhookLeaseMargin = time.Minute
+)
+
+// webhookPageSize applies the default to a zero limit and caps it at maxWebhookPageSize.
+func webhookPageSize(limit int) int32 {
+	switch {
+	case limit <= 0:
+		return defaultWebhookPageSize
+	case limit > maxWebhookPageSize:
+		return maxWebhookPageSize
+	}
+	return int32(limit)
+}
+
+// WebhookUsecaseImpl handles the business logic for outgoing webhooks.
+type WebhookUsecaseImpl struct {
+	service WebhookService
+	tx      TxManager
+	policy  entity.WebhookPolicy
+	log     *slog.Logger
+}
+
+// NewWebhookUsecase creates a new WebhookUsecase.
+func NewWebhookUsecase(s WebhookService, tx TxManager, policy entity.WebhookPolicy, l *slog.Logger) WebhookUsecase {
+	return &WebhookUsecaseImpl{
+		service: s,
+		tx:      tx,
+		policy:  policy,
+		log:     l,
+	}
+}
+
+// ListWebhookEndpoints retrieves all webhook endpoints.
+func (uc *WebhookUsecaseImpl) ListWebhookEndpoints(ctx context.Context) ([]entity.WebhookEndpoint, error) {
+	const op = "usecase.ListWebhookEndpoints"
+
+	endpoints, err := uc.service.ListWebhookEndpoints(ctx)
+	if err != nil {
+		uc.log.Error("failed to list webhook endpoints", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return endpoints, nil
+}
+
+// GetWebhookEndpoint retrieves a webhook endpoint by id.
+func (uc *WebhookUsecaseImpl) GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*entity.WebhookEndpoint, error) {
+	const op = "usecase.GetWebhookEndpoint"
+
+	endpoint, err := uc.service.GetWebhookEndpoint(ctx, id)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get webhook endpoint", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	return endpoint, nil
+}
+
+// CreateWebhookEndpoint registers an endpoint. Without a secret, a random one is generated;
+// either way it is returned in endpoint so the admin can hand it to the receiver.
+func (uc *WebhookUsecaseImpl) CreateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	const op = "usecase.CreateWebhookEndpoint"
+
+	if endpoint.Secret == "" {
+		secret, err := newWebhookSecret()
+		if err != nil {
+			uc.log.Error("failed to generate webhook secret", slog.String("op", op), slog.String("error", err.Error()))
+			return err
+		}
+		endpoint.Secret = secret
+	}
+	if err := validateWebhookEndpoint(endpoint); err != nil {
+		return err
+	}
+	if err := uc.service.CreateWebhookEndpoint(ctx, endpoint); err != nil {
+		uc.log.Error("failed to create webhook endpoint", slog.String("op", op), slog.String("error", err.Error()))
+		return err
+	}
+
+	uc.log.Info("webhook endpoint created", slog.String("op", op), slog.String("id", endpoint.ID.String()),
+		slog.String("url", endpoint.URL))
+	return nil
+}
+
+// UpdateWebhookEndpoint replaces the URL, event types and enabled flag of an endpoint. The
+// secret is kept unless a new one is given. Re-enabling an endpoint resumes its pending
+// deliveries.
+func (uc *WebhookUsecaseImpl) UpdateWebhookEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	const op = "usecase.UpdateWebhookEndpoint"
+
+	// The current secret is kept when none is given; reading it in the same transaction as
+	// the update keeps a concurrent rotation from being overwritten with the old one.
+	keepSecret := endpoint.Secret == ""
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if keepSecret {
+			current, err := uc.service.GetWebhookEndpoint(ctx, endpoint.ID)
+			if err != nil {
+				return err
+			}
+			endpoint.Secret = current.Secret
+		}
+		if err := validateWebhookEndpoint(endpoint); err != nil {
+			return err
+		}
+		return uc.service.UpdateWebhookEndpoint(ctx, endpoint)
+	})
+	if err != nil {
+		var vErr *entity.ValidationError
+		if !errors.Is(err, entity.ErrNotFound) && !errors.As(err, &vErr) {
+			uc.log.Error("failed to update webhook endpoint", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("webhook endpoint updated", slog.String("op", op), slog.String("id", endpoint.ID.String()),
+		slog.Bool("enabled", endpoint.Enabled))
+	return nil
+}
+
+// DeleteWebhookEndpoint removes an endpoint and its delivery log.
+func (uc *WebhookUsecaseImpl) DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error {
+	const op = "usecase.DeleteWebhookEndpoint"
+
+	if err := uc.service.DeleteWebhookEndpoint(ctx, id); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to delete webhook endpoint", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	uc.log.Info("webhook endpoint deleted", slog.String("op", op), slog.String("id", id.String()))
+	return nil
+}
+
+// ListWebhookDeliveries retrieves the delivery log of an endpoint, newest first. An empty
+// status lists deliveries of every status.
+func (uc *WebhookUsecaseImpl) ListWebhookDeliveries(ctx context.Context, endpointID uuid.UUID, status entity.WebhookDeliveryStatus, limit int) ([]entity.WebhookDelivery, error) {
+	const op = "usecase.ListWebhookDeliveries"
+
+	if status != "" && !status.Valid() {
+		return nil, entity.NewValidationError(fmt.Sprintf("unknown delivery status %q", status))
+	}
+	if _, err := uc.service.GetWebhookEndpoint(ctx, endpointID); err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to get webhook endpoint", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+	deliveries, err := uc.service.ListWebhookDeliveries(ctx, endpointID, status, webhookPageSize(limit))
+	if err != nil {
+		uc.log.Error("failed to list webhook deliveries", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// RedeliverWebhook sends a delivery again, whatever its status, starting a new retry
+// schedule. Deliveries to a disabled endpoint wait until it is enabled again.
+func (uc *WebhookUsecaseImpl) RedeliverWebhook(ctx context.Context, deliveryID uuid.UUID) (*entity.WebhookDelivery, error) {
+	const op = "usecase.RedeliverWebhook"
+
+	delivery, err := uc.service.RedeliverWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			uc.log.Error("failed to redeliver webhook", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	uc.log.Info("webhook redelivery queued", slog.String("op", op), slog.String("delivery_id", deliveryID.String()),
+		slog.Int64("event_id", delivery.Event.ID))
+	return delivery, nil
+}
+
+// EnqueueWebhookDeliveries queues event for every endpoint subscribed to its type. Deliveries
+// of a disabled endpoint are held until an admin enables it again. Queuing an event again is
+// harmless, so it can be fed from the at-least-once outbox.
+func (uc *WebhookUsecaseImpl) EnqueueWebhookDeliveries(ctx context.Context, event entity.OutboxEvent) error {
+	const op = "usecase.EnqueueWebhookDeliveries"
+
+	if _, err := uc.service.CreateWebhookDeliveries(ctx, event); err != nil {
+		uc.log.Error("failed to enqueue webhook deliveries", slog.String("op", op), slog.Int64("event_id", event.ID),
+			slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// DeliverWebhooks makes one attempt at up to batchSize due deliveries, in parallel. Failed
+// attempts are retried with exponential backoff until the policy's MaxAttempts is used up;
+// endpoints failing DisableAfter times in a row are disabled.
+func (uc *WebhookUsecaseImpl) DeliverWebhooks(ctx context.Context, batchSize int32) (*entity.WebhookDeliveryResult, error) {
+	const op = "usecase.DeliverWebhooks"
+
+	dispatches, err := uc.service.ClaimDueWebhookDeliveries(ctx, time.Now().Add(uc.policy.Timeout+webhookLeaseMargin), batchSize)
+	if err != nil {
+		uc.log.Error("failed to claim webhook deliveries", slog.String("op", op), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		result   entity.WebhookDeliveryResult
+		firstErr error
+	)
+	for _, dispatch := range dispatches {
+		wg.Go(func() {
+			status, err := uc.attemptWebhook(ctx, dispatch)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			switch status {
+			case entity.WebhookDeliverySucceeded:
+				result.Succeeded++
+			case entity.WebhookDeliveryPending:
+				result.Failed++
+			case entity.WebhookDeliveryFailed:
+				result.Abandoned++
+			}
+		})
+	}
+	wg.Wait()
+	return &result, firstErr
+}
+
+// attemptWebhook sends one delivery and records the outcome, returning the new status.
+func (uc *WebhookUsecaseImpl) attemptWebhook(ctx context.Context, dispatch entity.WebhookDispatch) (entity.WebhookDeliveryStatus, error) {
+	const op = "usecase.attemptWebhook"
+
+	code, sendErr := uc.service.SendWebhook(ctx, dispatch)
+	now := time.Now()
+	delivery := dispatch.WebhookDelivery
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = code
+	delivery.NextAttemptAt = now
+	delivery.LastError = ""
+	switch {
+	case sendErr == nil:
+		delivery.Status = entity.WebhookDeliverySucceeded
+	case delivery.Attempts >= uc.policy.MaxAttempts:
+		delivery.Status = entity.WebhookDeliveryFailed
+		delivery.LastError = sendErr.Error()
+	default:
+		delivery.Status = entity.WebhookDeliveryPending
+		delivery.NextAttemptAt = now.Add(uc.policy.Retry.Backoff(delivery.Attempts))
+		delivery.LastError = sendErr.Error()
+	}
+
+	disabled, err := uc.service.RecordWebhookAttempt(ctx, &delivery, uc.policy.DisableAfter)
+	if err != nil {
+		if ctx.Err() == nil {
+			uc.log.Error("failed to record webhook attempt", slog.String("op", op), slog.String("error", err.Error()))
+		}
+		return "", err
+	}
+	if delivery.Status == entity.WebhookDeliveryFailed {
+		uc.log.Warn("webhook delivery abandoned", slog.String("op", op), slog.String("delivery_id", delivery.ID.String()),
+			slog.Int("attempts", delivery.Attempts), slog.String("error", delivery.LastError))
+	}
+	if disabled {
+		uc.log.Warn("webhook endpoint disabled after repeated failures", slog.String("op", op),
+			slog.String("endpoint_id", delivery.EndpointID.String()), slog.String("url", dispatch.URL))
+	}
+	return delivery.Status, nil
+}
+
+// PurgeWebhookDeliveries removes finished deliveries created longer than retention ago and
+// returns how many were removed.
+func (uc *WebhookUsecaseImpl) PurgeWebhookDeliveries(ctx context.Context, retention time.Duration, batchSize int32) (int64, error) {
+	const op = "usecase.PurgeWebhookDeliveries"
+
+	n, err := uc.service.PurgeWebhookDeliveries(ctx, time.Now().Add(-retention), batchSize)
+	if err != nil {
+		uc.log.Error("failed to purge webhook deliveries", slog.String("op", op), slog.String("error", err.Error()))
+		return n, err
+	}
+
+	if n > 0 {
+		uc.log.Info("webhook deliveries purged", slog.String("op", op), slog.Int64("rows", n))
+	}
+	return n, nil
+}
+
+// validateWebhookEndpoint checks the URL and event types and removes duplicate types.
+func validateWebhookEndpoint(endpoint *entity.WebhookEndpoint) error {
+	var details []entity.ValidationDetail
+	if u, err := url.Parse(endpoint.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		details = append(details, entity.ValidationDetail{Path: "url", Message: "must be an absolute http or https URL"})
+	}
+	if len(endpoint.EventTypes) == 0 {
+		details = append(details, entity.ValidationDetail{Path: "event_types", Message: "must not be empty"})
+	}
+	for i, t := range endpoint.EventTypes {
+		if !t.Valid() {
+			details = append(details, entity.ValidationDetail{Path: fmt.Sprintf("event_types/%d", i),
+				Message: fmt.Sprintf("unknown event type %q", t)})
+		}
+	}
+	if len(endpoint.Secret) < minWebhookSecretLength {
+		details = append(details, entity.ValidationDetail{Path: "secret",
+			Message: fmt.Sprintf("must be at least %d characters", minWebhookSecretLength)})
+	}
+	if len(details) > 0 {
+		return entity.NewValidationError("invalid webhook endpoint", details...)
+	}
+
+	slices.Sort(endpoint.EventTypes)
+	endpoint.EventTypes = slices.Compact(endpoint.EventTypes)
+	return nil
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
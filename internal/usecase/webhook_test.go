@@ -0,0 +1,292 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"base_app/internal/entity"
+	"base_app/internal/service"
+	"base_app/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+// scriptedSender answers every send with the current status; 0 means no response.
+type scriptedSender struct {
+	mu     sync.Mutex
+	status int
+	sent   int
+}
+
+func (s *scriptedSender) Send(_ context.Context, _, _ string, _ entity.WebhookDelivery) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent++
+	switch {
+	case s.status == 0:
+		return 0, errors.New("connection refused")
+	case s.status > 299:
+		return s.status, errors.New("webhook responded with an error")
+	}
+	return s.status, nil
+}
+
+func (s *scriptedSender) respond(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+// webhookFixture is a webhook use case over the storage of a driver with one endpoint
+// subscribed to data.saved.
+type webhookFixture struct {
+	t        *testing.T
+	uc       usecase.WebhookUsecase
+	sender   *scriptedSender
+	endpoint entity.WebhookEndpoint
+	events   int64
+}
+
+func newWebhookFixture(t *testing.T, open storageDriver, policy entity.WebhookPolicy) *webhookFixture {
+	t.Helper()
+
+	log := slog.New(slog.DiscardHandler)
+	storage := open(t)
+	sender := &scriptedSender{status: 200}
+	f := &webhookFixture{
+		t:      t,
+		uc:     usecase.NewWebhookUsecase(service.NewWebhookService(storage.repo, sender, log), storage.tx, policy, log),
+		sender: sender,
+		endpoint: entity.WebhookEndpoint{
+			URL:        "https://example.com/hook",
+			EventTypes: []entity.OutboxEventType{entity.EventDataSaved},
+			Enabled:    true,
+		},
+	}
+	if err := f.uc.CreateWebhookEndpoint(context.Background(), &f.endpoint); err != nil {
+		t.Fatalf("CreateWebhookEndpoint: %v", err)
+	}
+	return f
+}
+
+// enqueue queues a new data.saved event.
+func (f *webhookFixture) enqueue() {
+	f.t.Helper()
+	f.events++
+	event := entity.OutboxEvent{ID: f.events, Type: entity.EventDataSaved, Payload: json.RawMessage(`{"key":"k"}`), CreatedAt: time.Now()}
+	if err := f.uc.EnqueueWebhookDeliveries(context.Background(), event); err != nil {
+		f.t.Fatalf("EnqueueWebhookDeliveries: %v", err)
+	}
+}
+
+func (f *webhookFixture) deliver() entity.WebhookDeliveryResult {
+	f.t.Helper()
+	res, err := f.uc.DeliverWebhooks(context.Background(), 10)
+	if err != nil {
+		f.t.Fatalf("DeliverWebhooks: %v", err)
+	}
+	return *res
+}
+
+func (f *webhookFixture) deliveries() []entity.WebhookDelivery {
+	f.t.Helper()
+	deliveries, err := f.uc.ListWebhookDeliveries(context.Background(), f.endpoint.ID, "", 0)
+	if err != nil {
+		f.t.Fatalf("ListWebhookDeliveries: %v", err)
+	}
+	return deliveries
+}
+
+func (f *webhookFixture) currentEndpoint() *entity.WebhookEndpoint {
+	f.t.Helper()
+	endpoint, err := f.uc.GetWebhookEndpoint(context.Background(), f.endpoint.ID)
+	if err != nil {
+		f.t.Fatalf("GetWebhookEndpoint: %v", err)
+	}
+	return endpoint
+}
+
+func TestDeliverWebhooksSucceeds(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		f := newWebhookFixture(t, open, entity.WebhookPolicy{MaxAttempts: 3, DisableAfter: 3})
+		f.enqueue()
+		f.enqueue()
+
+		if res := f.deliver(); res != (entity.WebhookDeliveryResult{Succeeded: 2}) {
+			t.Errorf("result = %+v, want 2 succeeded", res)
+		}
+		for _, d := range f.deliveries() {
+			if d.Status != entity.WebhookDeliverySucceeded || d.Attempts != 1 || d.ResponseStatus != 200 || d.LastError != "" {
+				t.Errorf("delivery = %+v, want succeeded on the first attempt", d)
+			}
+		}
+		if res := f.deliver(); res.Attempts() != 0 {
+			t.Errorf("second round made %d attempts, want none", res.Attempts())
+		}
+	})
+}
+
+func TestDeliverWebhooksBacksOff(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		f := newWebhookFixture(t, open, entity.WebhookPolicy{
+			Retry:        entity.RetryPolicy{InitialBackoff: time.Hour, MaxBackoff: time.Hour},
+			MaxAttempts:  3,
+			DisableAfter: 10,
+		})
+		f.sender.respond(503)
+		f.enqueue()
+
+		before := time.Now()
+		if res := f.deliver(); res != (entity.WebhookDeliveryResult{Failed: 1}) {
+			t.Errorf("result = %+v, want 1 failed", res)
+		}
+		d := f.deliveries()[0]
+		if d.Status != entity.WebhookDeliveryPending || d.Attempts != 1 || d.ResponseStatus != 503 || d.LastError == "" {
+			t.Errorf("delivery = %+v, want pending after one failed attempt", d)
+		}
+		if wait := d.NextAttemptAt.Sub(before); wait < time.Hour || wait > time.Hour+time.Minute {
+			t.Errorf("next attempt in %v, want the backoff of %v", wait, time.Hour)
+		}
+
+		f.sender.respond(200)
+		if res := f.deliver(); res.Attempts() != 0 {
+			t.Errorf("retried %d deliveries during the backoff, want none", res.Attempts())
+		}
+	})
+}
+
+func TestDeliverWebhooksAbandonsAfterMaxAttempts(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		f := newWebhookFixture(t, open, entity.WebhookPolicy{MaxAttempts: 3, DisableAfter: 10}) // Retries are due at once
+		f.sender.respond(0)
+		f.enqueue()
+
+		want := []entity.WebhookDeliveryResult{{Failed: 1}, {Failed: 1}, {Abandoned: 1}, {}}
+		for i, w := range want {
+			if res := f.deliver(); res != w {
+				t.Errorf("round %d: result = %+v, want %+v", i+1, res, w)
+			}
+		}
+		d := f.deliveries()[0]
+		if d.Status != entity.WebhookDeliveryFailed || d.Attempts != 3 || d.ResponseStatus != 0 || d.LastError == "" {
+			t.Errorf("delivery = %+v, want failed after 3 attempts", d)
+		}
+		if f.sender.sent != 3 {
+			t.Errorf("sent %d times, want 3", f.sender.sent)
+		}
+	})
+}
+
+func TestDeliverWebhooksDisablesFailingEndpoint(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		f := newWebhookFixture(t, open, entity.WebhookPolicy{MaxAttempts: 10, DisableAfter: 3})
+		f.sender.respond(500)
+		f.enqueue()
+
+		for range 2 {
+			f.deliver()
+			if !f.currentEndpoint().Enabled {
+				t.Fatalf("endpoint disabled before %d failures", 3)
+			}
+		}
+		f.deliver()
+		endpoint := f.currentEndpoint()
+		if endpoint.Enabled || endpoint.DisabledAt == nil || endpoint.ConsecutiveFailures != 3 {
+			t.Fatalf("endpoint = %+v, want disabled after 3 failures", endpoint)
+		}
+
+		// A disabled endpoint still gets new deliveries, which wait with the pending one.
+		f.enqueue()
+		if res := f.deliver(); res.Attempts() != 0 {
+			t.Errorf("made %d attempts to a disabled endpoint, want none", res.Attempts())
+		}
+		if n := len(f.deliveries()); n != 2 {
+			t.Errorf("endpoint has %d deliveries, want 2", n)
+		}
+
+		// Enabling it again resumes the waiting deliveries with a clean failure count.
+		f.sender.respond(200)
+		endpoint.Enabled = true
+		endpoint.Secret = ""
+		if err := f.uc.UpdateWebhookEndpoint(context.Background(), endpoint); err != nil {
+			t.Fatalf("UpdateWebhookEndpoint: %v", err)
+		}
+		if res := f.deliver(); res != (entity.WebhookDeliveryResult{Succeeded: 2}) {
+			t.Errorf("result after enabling = %+v, want 2 succeeded", res)
+		}
+		if got := f.currentEndpoint(); !got.Enabled || got.ConsecutiveFailures != 0 {
+			t.Errorf("endpoint = %+v, want enabled without failures", got)
+		}
+	})
+}
+
+func TestDeliverWebhooksSuccessResetsFailures(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		f := newWebhookFixture(t, open, entity.WebhookPolicy{MaxAttempts: 10, DisableAfter: 2})
+		f.sender.respond(500)
+		f.enqueue()
+		f.deliver()
+
+		f.sender.respond(200)
+		f.deliver()
+		f.sender.respond(500)
+		f.enqueue()
+		f.deliver()
+
+		if endpoint := f.currentEndpoint(); !endpoint.Enabled || endpoint.ConsecutiveFailures != 1 {
+			t.Errorf("endpoint = %+v, want enabled with 1 failure in a row", endpoint)
+		}
+	})
+}
+
+func TestRedeliverWebhook(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		f := newWebhookFixture(t, open, entity.WebhookPolicy{MaxAttempts: 1, DisableAfter: 10})
+		f.sender.respond(500)
+		f.enqueue()
+		if res := f.deliver(); res != (entity.WebhookDeliveryResult{Abandoned: 1}) {
+			t.Fatalf("result = %+v, want 1 abandoned", res)
+		}
+		failed := f.deliveries()[0]
+
+		redelivered, err := f.uc.RedeliverWebhook(context.Background(), failed.ID)
+		if err != nil {
+			t.Fatalf("RedeliverWebhook: %v", err)
+		}
+		if redelivered.Status != entity.WebhookDeliveryPending || redelivered.Attempts != 0 {
+			t.Errorf("redelivered = %+v, want pending with a fresh retry schedule", redelivered)
+		}
+
+		f.sender.respond(200)
+		if res := f.deliver(); res != (entity.WebhookDeliveryResult{Succeeded: 1}) {
+			t.Errorf("result = %+v, want 1 succeeded", res)
+		}
+		d := f.deliveries()[0]
+		if d.ID != failed.ID || d.Status != entity.WebhookDeliverySucceeded || d.Attempts != 1 {
+			t.Errorf("delivery = %+v, want the same delivery succeeded", d)
+		}
+
+		if _, err := f.uc.RedeliverWebhook(context.Background(), uuid.New()); !errors.Is(err, entity.ErrNotFound) {
+			t.Errorf("RedeliverWebhook(unknown) = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestCreateWebhookEndpointRejectsUnknownEventTypes(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, open storageDriver) {
+		f := newWebhookFixture(t, open, entity.WebhookPolicy{})
+		endpoint := entity.WebhookEndpoint{
+			URL:        "https://example.com/hook",
+			EventTypes: []entity.OutboxEventType{"user.deleted"},
+		}
+		var vErr *entity.ValidationError
+		if err := f.uc.CreateWebhookEndpoint(context.Background(), &endpoint); !errors.As(err, &vErr) {
+			t.Errorf("CreateWebhookEndpoint() = %v, want a validation error", err)
+		}
+	})
+}
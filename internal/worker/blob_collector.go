@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"base_app/internal/usecase"
+)
+
+// BlobCollector periodically removes attachment content that is no longer referenced.
+type BlobCollector struct {
+	attachmentUsecase usecase.AttachmentUsecase
+	interval          time.Duration
+	grace             time.Duration
+	log               *slog.Logger
+}
+
+// NewBlobCollector creates a new BlobCollector.
+func NewBlobCollector(uc usecase.AttachmentUsecase, interval, grace time.Duration, log *slog.Logger) *BlobCollector {
+	return &BlobCollector{
+		attachmentUsecase: uc,
+		interval:          interval,
+		grace:             grace,
+		log:               log,
+	}
+}
+
+// Run collects garbage on every tick until ctx is cancelled.
+func (c *BlobCollector) Run(ctx context.Context) {
+	const op = "worker.BlobCollector.Run"
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.log.Info("blob collector started", slog.String("op", op), slog.Duration("interval", c.interval))
+	for {
+		select {
+		case <-ctx.Done():
+			c.log.Info("blob collector stopped", slog.String("op", op))
+			return
+		case <-ticker.C:
+			if _, err := c.attachmentUsecase.CollectGarbage(ctx, c.grace); err != nil && ctx.Err() == nil {
+				c.log.Error("failed to collect blob garbage", slog.String("op", op), slog.String("error", err.Error()))
+			}
+		}
+	}
+}
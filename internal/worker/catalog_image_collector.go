@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"base_app/internal/usecase"
+)
+
+// CatalogImageCollector periodically removes catalog image files that belong to no image.
+type CatalogImageCollector struct {
+	catalogUsecase usecase.CatalogUsecase
+	interval       time.Duration
+	grace          time.Duration
+	log            *slog.Logger
+}
+
+// NewCatalogImageCollector creates a new CatalogImageCollector.
+func NewCatalogImageCollector(uc usecase.CatalogUsecase, interval, grace time.Duration, log *slog.Logger) *CatalogImageCollector {
+	return &CatalogImageCollector{
+		catalogUsecase: uc,
+		interval:       interval,
+		grace:          grace,
+		log:            log,
+	}
+}
+
+// Run collects garbage on every tick until ctx is cancelled.
+func (c *CatalogImageCollector) Run(ctx context.Context) {
+	const op = "worker.CatalogImageCollector.Run"
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.log.Info("catalog image collector started", slog.String("op", op), slog.Duration("interval", c.interval))
+	for {
+		select {
+		case <-ctx.Done():
+			c.log.Info("catalog image collector stopped", slog.String("op", op))
+			return
+		case <-ticker.C:
+			if _, err := c.catalogUsecase.CollectCatalogImageGarbage(ctx, c.grace); err != nil && ctx.Err() == nil {
+				c.log.Error("failed to collect catalog image garbage", slog.String("op", op), slog.String("error", err.Error()))
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"base_app/internal/usecase"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DataReaper periodically deletes expired data entries.
+type DataReaper struct {
+	dataUsecase usecase.DataUsecase
+	interval    time.Duration
+	batchSize   int32
+	purged      prometheus.Counter
+	log         *slog.Logger
+}
+
+// NewDataReaper creates a new DataReaper.
+func NewDataReaper(uc usecase.DataUsecase, interval time.Duration, batchSize int32, purged prometheus.Counter, log *slog.Logger) *DataReaper {
+	return &DataReaper{
+		dataUsecase: uc,
+		interval:    interval,
+		batchSize:   batchSize,
+		purged:      purged,
+		log:         log,
+	}
+}
+
+// Run purges expired data on every tick until ctx is cancelled.
+func (r *DataReaper) Run(ctx context.Context) {
+	const op = "worker.DataReaper.Run"
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	r.log.Info("data reaper started", slog.String("op", op), slog.Duration("interval", r.interval))
+	for {
+		select {
+		case <-ctx.Done():
+			r.log.Info("data reaper stopped", slog.String("op", op))
+			return
+		case <-ticker.C:
+			n, err := r.dataUsecase.PurgeExpiredData(ctx, r.batchSize)
+			if n > 0 {
+				r.purged.Add(float64(n))
+			}
+			if err != nil && ctx.Err() == nil {
+				r.log.Error("failed to purge expired data", slog.String("op", op), slog.String("error", err.Error()))
+			}
+		}
+	}
+}
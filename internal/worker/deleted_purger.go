@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"base_app/internal/usecase"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DeletedPurger periodically removes soft deleted catalog items and data keys for good once
+// their retention period has passed.
+type DeletedPurger struct {
+	dataUsecase    usecase.DataUsecase
+	catalogUsecase usecase.CatalogUsecase
+	interval       time.Duration
+	retention      time.Duration
+	batchSize      int32
+	purged         *prometheus.CounterVec
+	log            *slog.Logger
+}
+
+// NewDeletedPurger creates a new DeletedPurger.
+func NewDeletedPurger(dataUC usecase.DataUsecase, catalogUC usecase.CatalogUsecase, interval, retention time.Duration,
+	batchSize int32, purged *prometheus.CounterVec, log *slog.Logger) *DeletedPurger {
+	return &DeletedPurger{
+		dataUsecase:    dataUC,
+		catalogUsecase: catalogUC,
+		interval:       interval,
+		retention:      retention,
+		batchSize:      batchSize,
+		purged:         purged,
+		log:            log,
+	}
+}
+
+// Run purges deleted rows on every tick until ctx is cancelled.
+func (p *DeletedPurger) Run(ctx context.Context) {
+	const op = "worker.DeletedPurger.Run"
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	p.log.Info("deleted purger started", slog.String("op", op), slog.Duration("interval", p.interval),
+		slog.Duration("retention", p.retention))
+	for {
+		select {
+		case <-ctx.Done():
+			p.log.Info("deleted purger stopped", slog.String("op", op))
+			return
+		case <-ticker.C:
+			n, err := p.dataUsecase.PurgeDeletedData(ctx, p.retention, p.batchSize)
+			if n > 0 {
+				p.purged.WithLabelValues("data").Add(float64(n))
+			}
+			if err != nil && ctx.Err() == nil {
+				p.log.Error("failed to purge deleted data", slog.String("op", op), slog.String("error", err.Error()))
+			}
+
+			n, err = p.catalogUsecase.PurgeDeletedCatalogItems(ctx, p.retention, p.batchSize)
+			if n > 0 {
+				p.purged.WithLabelValues("catalog").Add(float64(n))
+			}
+			if err != nil && ctx.Err() == nil {
+				p.log.Error("failed to purge deleted catalog items", slog.String("op", op), slog.String("error", err.Error()))
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"base_app/internal/usecase"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// OutboxRelay periodically publishes pending outbox events to the event sinks and removes
+// published events once their retention period has passed.
+type OutboxRelay struct {
+	outboxUsecase usecase.OutboxUsecase
+	interval      time.Duration
+	purgeInterval time.Duration
+	retention     time.Duration
+	batchSize     int32
+	events        *prometheus.CounterVec
+	log           *slog.Logger
+}
+
+// NewOutboxRelay creates a new OutboxRelay.
+func NewOutboxRelay(outboxUC usecase.OutboxUsecase, interval, purgeInterval, retention time.Duration, batchSize int32,
+	events *prometheus.CounterVec, log *slog.Logger) *OutboxRelay {
+	return &OutboxRelay{
+		outboxUsecase: outboxUC,
+		interval:      interval,
+		purgeInterval: purgeInterval,
+		retention:     retention,
+		batchSize:     batchSize,
+		events:        events,
+		log:           log,
+	}
+}
+
+// Run relays events on every tick until ctx is cancelled. A tick keeps relaying while full
+// batches go out, so a backlog drains without waiting for further ticks.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	const op = "worker.OutboxRelay.Run"
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	purgeTicker := time.NewTicker(r.purgeInterval)
+	defer purgeTicker.Stop()
+
+	r.log.Info("outbox relay started", slog.String("op", op), slog.Duration("interval", r.interval),
+		slog.Duration("retention", r.retention))
+	for {
+		select {
+		case <-ctx.Done():
+			r.log.Info("outbox relay stopped", slog.String("op", op))
+			return
+		case <-ticker.C:
+			r.relay(ctx)
+		case <-purgeTicker.C:
+			_, err := r.outboxUsecase.PurgePublishedOutboxEvents(ctx, r.retention, r.batchSize)
+			if err != nil && ctx.Err() == nil {
+				r.log.Error("failed to purge published outbox events", slog.String("op", op), slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+func (r *OutboxRelay) relay(ctx context.Context) {
+	const op = "worker.OutboxRelay.relay"
+
+	for ctx.Err() == nil {
+		n, err := r.outboxUsecase.RelayOutboxEvents(ctx, r.batchSize)
+		if n > 0 {
+			r.events.WithLabelValues("published").Add(float64(n))
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				r.events.WithLabelValues("failed").Inc()
+				r.log.Warn("outbox event delivery failed, will retry", slog.String("op", op), slog.String("error", err.Error()))
+			}
+			return
+		}
+		if n < int(r.batchSize) {
+			return
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// scriptedOutbox returns the next of its results on every relay.
+type scriptedOutbox struct {
+	results []relayResult
+	calls   int
+}
+
+type relayResult struct {
+	n   int
+	err error
+}
+
+func (o *scriptedOutbox) RelayOutboxEvents(_ context.Context, _ int32) (int, error) {
+	res := o.results[o.calls]
+	o.calls++
+	return res.n, res.err
+}
+
+func (o *scriptedOutbox) PurgePublishedOutboxEvents(_ context.Context, _ time.Duration, _ int32) (int64, error) {
+	return 0, nil
+}
+
+func TestOutboxRelayDrainsFullBatches(t *testing.T) {
+	errSink := errors.New("sink down")
+	tests := []struct {
+		name          string
+		results       []relayResult
+		wantPublished float64
+		wantFailed    float64
+	}{
+		{"empty", []relayResult{{0, nil}}, 0, 0},
+		{"partial batch", []relayResult{{1, nil}}, 1, 0},
+		{"backlog", []relayResult{{2, nil}, {2, nil}, {1, nil}}, 5, 0},
+		{"backlog ending in a full batch", []relayResult{{2, nil}, {2, nil}, {0, nil}}, 4, 0},
+		{"failure stops the tick", []relayResult{{2, nil}, {1, errSink}}, 3, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outbox := &scriptedOutbox{results: tt.results}
+			events := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "outbox_events_total"}, []string{"result"})
+			r := NewOutboxRelay(outbox, time.Second, time.Second, time.Hour, 2, events, slog.New(slog.DiscardHandler))
+
+			r.relay(context.Background())
+			if outbox.calls != len(tt.results) {
+				t.Errorf("relayed %d times, want %d", outbox.calls, len(tt.results))
+			}
+			if got := counterValue(t, events, "published"); got != tt.wantPublished {
+				t.Errorf("published = %v, want %v", got, tt.wantPublished)
+			}
+			if got := counterValue(t, events, "failed"); got != tt.wantFailed {
+				t.Errorf("failed = %v, want %v", got, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func counterValue(t *testing.T, counters *prometheus.CounterVec, label string) float64 {
+	t.Helper()
+	var m dto.Metric
+	if err := counters.WithLabelValues(label).Write(&m); err != nil {
+		t.Fatalf("read counter: %v", err)
+	}
+	return m.GetCounter().GetValue()
+}
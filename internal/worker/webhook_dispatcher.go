@@ -0,0 +1,87 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"base_app/internal/usecase"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// WebhookDispatcher periodically sends due webhook deliveries and removes finished ones once
+// their retention period has passed.
+type WebhookDispatcher struct {
+	webhookUsecase usecase.WebhookUsecase
+	interval       time.Duration
+	purgeInterval  time.Duration
+	retention      time.Duration
+	batchSize      int32
+	deliveries     *prometheus.CounterVec
+	log            *slog.Logger
+}
+
+// NewWebhookDispatcher creates a new WebhookDispatcher.
+func NewWebhookDispatcher(webhookUC usecase.WebhookUsecase, interval, purgeInterval, retention time.Duration, batchSize int32,
+	deliveries *prometheus.CounterVec, log *slog.Logger) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		webhookUsecase: webhookUC,
+		interval:       interval,
+		purgeInterval:  purgeInterval,
+		retention:      retention,
+		batchSize:      batchSize,
+		deliveries:     deliveries,
+		log:            log,
+	}
+}
+
+// Run sends deliveries on every tick until ctx is cancelled. A tick keeps going while full
+// batches are due, so a backlog drains without waiting for further ticks.
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	const op = "worker.WebhookDispatcher.Run"
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	purgeTicker := time.NewTicker(d.purgeInterval)
+	defer purgeTicker.Stop()
+
+	d.log.Info("webhook dispatcher started", slog.String("op", op), slog.Duration("interval", d.interval),
+		slog.Duration("retention", d.retention))
+	for {
+		select {
+		case <-ctx.Done():
+			d.log.Info("webhook dispatcher stopped", slog.String("op", op))
+			return
+		case <-ticker.C:
+			d.dispatch(ctx)
+		case <-purgeTicker.C:
+			_, err := d.webhookUsecase.PurgeWebhookDeliveries(ctx, d.retention, d.batchSize)
+			if err != nil && ctx.Err() == nil {
+				d.log.Error("failed to purge webhook deliveries", slog.String("op", op), slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+func (d *WebhookDispatcher) dispatch(ctx context.Context) {
+	const op = "worker.WebhookDispatcher.dispatch"
+
+	for ctx.Err() == nil {
+		res, err := d.webhookUsecase.DeliverWebhooks(ctx, d.batchSize)
+		if res != nil {
+			d.deliveries.WithLabelValues("succeeded").Add(float64(res.Succeeded))
+			d.deliveries.WithLabelValues("failed").Add(float64(res.Failed))
+			d.deliveries.WithLabelValues("abandoned").Add(float64(res.Abandoned))
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				d.log.Error("failed to deliver webhooks", slog.String("op", op), slog.String("error", err.Error()))
+			}
+			return
+		}
+		if res.Attempts() < int(d.batchSize) {
+			return
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"base_app/internal/entity"
+	"base_app/internal/usecase"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// scriptedWebhooks returns the next of its results on every delivery round.
+type scriptedWebhooks struct {
+	usecase.WebhookUsecase
+	results []deliverResult
+	calls   int
+}
+
+type deliverResult struct {
+	res *entity.WebhookDeliveryResult
+	err error
+}
+
+func (w *scriptedWebhooks) DeliverWebhooks(_ context.Context, _ int32) (*entity.WebhookDeliveryResult, error) {
+	r := w.results[w.calls]
+	w.calls++
+	return r.res, r.err
+}
+
+func TestWebhookDispatcherDrainsFullBatches(t *testing.T) {
+	errClaim := errors.New("claim failed")
+	round := func(succeeded, failed, abandoned int) deliverResult {
+		return deliverResult{res: &entity.WebhookDeliveryResult{Succeeded: succeeded, Failed: failed, Abandoned: abandoned}}
+	}
+	tests := []struct {
+		name    string
+		results []deliverResult
+		want    map[string]float64
+	}{
+		{"nothing due", []deliverResult{round(0, 0, 0)}, map[string]float64{}},
+		{"partial batch", []deliverResult{round(1, 0, 0)}, map[string]float64{"succeeded": 1}},
+		{"backlog", []deliverResult{round(1, 1, 0), round(0, 1, 1), round(1, 0, 0)},
+			map[string]float64{"succeeded": 2, "failed": 2, "abandoned": 1}},
+		{"claim failure stops the tick", []deliverResult{round(2, 0, 0), {err: errClaim}}, map[string]float64{"succeeded": 2}},
+		{"recording failure keeps the counts", []deliverResult{{res: &entity.WebhookDeliveryResult{Succeeded: 1}, err: errClaim}},
+			map[string]float64{"succeeded": 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhooks := &scriptedWebhooks{results: tt.results}
+			deliveries := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "webhook_deliveries_total"}, []string{"result"})
+			d := NewWebhookDispatcher(webhooks, time.Second, time.Second, time.Hour, 2, deliveries, slog.New(slog.DiscardHandler))
+
+			d.dispatch(context.Background())
+			if webhooks.calls != len(tt.results) {
+				t.Errorf("ran %d rounds, want %d", webhooks.calls, len(tt.results))
+			}
+			for _, label := range []string{"succeeded", "failed", "abandoned"} {
+				if got := counterValue(t, deliveries, label); got != tt.want[label] {
+					t.Errorf("%s = %v, want %v", label, got, tt.want[label])
+				}
+			}
+		})
+	}
+}
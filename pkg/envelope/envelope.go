@@ -0,0 +1,169 @@
+// Package envelope implements envelope encryption with AES-256-GCM.
+//
+// Every payload is encrypted with a fresh random data key, and the data key is
+// wrapped (encrypted) by a long-lived master key. Only the wrapped data key and the
+// id of the master key are stored next to the ciphertext; the master keys never
+// leave the process.
+package envelope
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// KeySize is the size of master and data keys in bytes (AES-256).
+const KeySize = 32
+
+// ErrUnknownKey is returned when an envelope was sealed with a master key that is not in the keyring.
+var ErrUnknownKey = errors.New("envelope: unknown master key")
+
+// Envelope is a sealed payload.
+type Envelope struct {
+	KeyID      string // id of the master key that wraps DataKey
+	DataKey    []byte // wrapped data key: nonce || ciphertext
+	Ciphertext []byte // payload encrypted with the data key: nonce || ciphertext
+}
+
+// Keyring holds the master keys. New envelopes are sealed with the active key;
+// the other keys are kept to open envelopes sealed before a rotation.
+type Keyring struct {
+	keys     map[string]cipher.AEAD
+	activeID string
+}
+
+// NewKeyring creates a keyring from master keys indexed by id. activeID must be one of them.
+func NewKeyring(keys map[string][]byte, activeID string) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("envelope: no master keys")
+	}
+	if _, ok := keys[activeID]; !ok {
+		return nil, fmt.Errorf("envelope: active key %q is not in the keyring", activeID)
+	}
+
+	kr := &Keyring{
+		keys:     make(map[string]cipher.AEAD, len(keys)),
+		activeID: activeID,
+	}
+	for id, key := range keys {
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("envelope: master key %q must be %d bytes, got %d", id, KeySize, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		kr.keys[id] = aead
+	}
+	return kr, nil
+}
+
+// ParseKeys reads master keys in the form "id:base64key", one per line or comma separated.
+// Blank lines and lines starting with # are ignored.
+func ParseKeys(r io.Reader) (map[string][]byte, error) {
+	keys := make(map[string][]byte)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		for _, entry := range strings.Split(sc.Text(), ",") {
+			entry = strings.TrimSpace(entry)
+			if entry == "" || strings.HasPrefix(entry, "#") {
+				continue
+			}
+			id, encoded, ok := strings.Cut(entry, ":")
+			if !ok || id == "" {
+				return nil, errors.New("envelope: master key entry must look like id:base64key")
+			}
+			if _, dup := keys[id]; dup {
+				return nil, fmt.Errorf("envelope: duplicate master key %q", id)
+			}
+			key, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return nil, fmt.Errorf("envelope: master key %q is not valid base64: %w", id, err)
+			}
+			keys[id] = key
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// ActiveKeyID returns the id of the master key used for new envelopes.
+func (kr *Keyring) ActiveKeyID() string {
+	return kr.activeID
+}
+
+// Seal encrypts plaintext under a new data key wrapped by the active master key.
+// aad is authenticated but not encrypted; the same aad must be passed to Open.
+func (kr *Keyring) Seal(plaintext, aad []byte) (*Envelope, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := seal(dek, plaintext, aad)
+	if err != nil {
+		return nil, err
+	}
+	// The wrapped key is bound to the key id so it cannot be replayed under another master key.
+	wrapped, err := seal(kr.keys[kr.activeID], dataKey, []byte(kr.activeID))
+	if err != nil {
+		return nil, err
+	}
+	return &Envelope{KeyID: kr.activeID, DataKey: wrapped, Ciphertext: ciphertext}, nil
+}
+
+// Open decrypts an envelope sealed by Seal with the same aad.
+func (kr *Keyring) Open(env *Envelope, aad []byte) ([]byte, error) {
+	kek, ok := kr.keys[env.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, env.KeyID)
+	}
+	dataKey, err := open(kek, env.DataKey, []byte(env.KeyID))
+	if err != nil {
+		return nil, fmt.Errorf("envelope: unwrap data key: %w", err)
+	}
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := open(dek, env.Ciphertext, aad)
+	if err != nil {
+		return nil, fmt.Errorf("envelope: decrypt payload: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(aead cipher.AEAD, sealed, aad []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, aad)
+}
@@ -0,0 +1,161 @@
+package envelope
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newKey(t *testing.T) []byte {
+	t.Helper()
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func mustKeyring(t *testing.T, keys map[string][]byte, activeID string) *Keyring {
+	t.Helper()
+	kr, err := NewKeyring(keys, activeID)
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	return kr
+}
+
+func TestSealOpen(t *testing.T) {
+	kr := mustKeyring(t, map[string][]byte{"k1": newKey(t)}, "k1")
+	plaintext := []byte(`{"answer":42}`)
+
+	env, err := kr.Seal(plaintext, []byte("aad"))
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	if env.KeyID != "k1" {
+		t.Errorf("KeyID = %q, want k1", env.KeyID)
+	}
+	if bytes.Contains(env.Ciphertext, plaintext) {
+		t.Errorf("ciphertext contains the plaintext")
+	}
+
+	got, err := kr.Open(env, []byte("aad"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if !bytes.Equal(got, plaintext) {
+		t.Errorf("Open = %q, want %q", got, plaintext)
+	}
+
+	again, err := kr.Seal(plaintext, []byte("aad"))
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	if bytes.Equal(again.DataKey, env.DataKey) || bytes.Equal(again.Ciphertext, env.Ciphertext) {
+		t.Errorf("sealing twice reused a data key or nonce")
+	}
+}
+
+func TestOpenRejectsTampering(t *testing.T) {
+	kr := mustKeyring(t, map[string][]byte{"k1": newKey(t), "k2": newKey(t)}, "k1")
+	env, err := kr.Seal([]byte("secret"), []byte("aad"))
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+
+	flip := func(b []byte) []byte {
+		b = bytes.Clone(b)
+		b[len(b)-1] ^= 1
+		return b
+	}
+	tests := []struct {
+		name string
+		env  Envelope
+		aad  string
+	}{
+		{"other aad", *env, "other"},
+		{"tampered ciphertext", Envelope{KeyID: env.KeyID, DataKey: env.DataKey, Ciphertext: flip(env.Ciphertext)}, "aad"},
+		{"tampered data key", Envelope{KeyID: env.KeyID, DataKey: flip(env.DataKey), Ciphertext: env.Ciphertext}, "aad"},
+		{"data key moved to another master key", Envelope{KeyID: "k2", DataKey: env.DataKey, Ciphertext: env.Ciphertext}, "aad"},
+		{"truncated ciphertext", Envelope{KeyID: env.KeyID, DataKey: env.DataKey, Ciphertext: env.Ciphertext[:4]}, "aad"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := kr.Open(&tt.env, []byte(tt.aad)); err == nil {
+				t.Errorf("Open succeeded")
+			}
+		})
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	k1, k2 := newKey(t), newKey(t)
+	old := mustKeyring(t, map[string][]byte{"k1": k1}, "k1")
+	env, err := old.Seal([]byte("secret"), nil)
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+
+	// After adding k2 and making it active, old envelopes still open and new ones use k2.
+	rotated := mustKeyring(t, map[string][]byte{"k1": k1, "k2": k2}, "k2")
+	got, err := rotated.Open(env, nil)
+	if err != nil || string(got) != "secret" {
+		t.Fatalf("Open old envelope = %q, %v", got, err)
+	}
+	resealed, err := rotated.Seal(got, nil)
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	if resealed.KeyID != "k2" {
+		t.Errorf("KeyID = %q, want k2", resealed.KeyID)
+	}
+
+	// Once k1 is removed, only re-sealed envelopes open.
+	retired := mustKeyring(t, map[string][]byte{"k2": k2}, "k2")
+	if _, err := retired.Open(env, nil); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Open envelope of a removed key: err = %v, want ErrUnknownKey", err)
+	}
+	if got, err := retired.Open(resealed, nil); err != nil || string(got) != "secret" {
+		t.Errorf("Open re-sealed envelope = %q, %v", got, err)
+	}
+}
+
+func TestNewKeyring(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     map[string][]byte
+		activeID string
+	}{
+		{"no keys", nil, "k1"},
+		{"active key missing", map[string][]byte{"k1": make([]byte, KeySize)}, "k2"},
+		{"short key", map[string][]byte{"k1": make([]byte, 16)}, "k1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewKeyring(tt.keys, tt.activeID); err == nil {
+				t.Errorf("NewKeyring succeeded")
+			}
+		})
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	k1, k2 := base64.StdEncoding.EncodeToString(newKey(t)), base64.StdEncoding.EncodeToString(newKey(t))
+
+	keys, err := ParseKeys(strings.NewReader("# master keys\nk1:" + k1 + "\n\n k2:" + k2 + " , \n"))
+	if err != nil {
+		t.Fatalf("ParseKeys: %v", err)
+	}
+	if len(keys) != 2 || base64.StdEncoding.EncodeToString(keys["k2"]) != k2 {
+		t.Errorf("ParseKeys = %v", keys)
+	}
+
+	for _, input := range []string{"k1", ":" + k1, "k1:not base64!", "k1:" + k1 + ",k1:" + k2} {
+		if _, err := ParseKeys(strings.NewReader(input)); err == nil {
+			t.Errorf("ParseKeys(%q) succeeded", input)
+		}
+	}
+}
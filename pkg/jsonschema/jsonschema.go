@@ -0,0 +1,80 @@
+package jsonschema
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+const resourceURL = "mem://schema.json"
+
+// Violation describes a single place where a document does not match the schema.
+type Violation struct {
+	Path    string // JSON pointer into the validated document
+	Message string
+}
+
+// Schema is a compiled JSON Schema.
+type Schema struct {
+	schema *jsonschema.Schema
+}
+
+// Compile parses and compiles a JSON Schema document.
+// External $ref resolution is disabled, so the schema must be self-contained.
+func Compile(raw []byte) (*Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	c := jsonschema.NewCompiler()
+	c.UseLoader(jsonschema.SchemeURLLoader{})
+	if err := c.AddResource(resourceURL, doc); err != nil {
+		return nil, fmt.Errorf("failed to add schema resource: %w", err)
+	}
+
+	s, err := c.Compile(resourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile schema: %w", err)
+	}
+	return &Schema{schema: s}, nil
+}
+
+// Validate checks a JSON document against the schema.
+// It returns the list of violations, which is empty when the document is valid.
+func (s *Schema) Validate(raw []byte) ([]Violation, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse document: %w", err)
+	}
+
+	err = s.schema.Validate(doc)
+	if err == nil {
+		return nil, nil
+	}
+
+	var vErr *jsonschema.ValidationError
+	if !errors.As(err, &vErr) {
+		return nil, err
+	}
+
+	out := vErr.BasicOutput()
+	units := out.Errors
+	if len(units) == 0 {
+		units = []jsonschema.OutputUnit{*out}
+	}
+
+	var violations []Violation
+	for _, unit := range units {
+		if unit.Error == nil {
+			continue
+		}
+		violations = append(violations, Violation{
+			Path:    unit.InstanceLocation,
+			Message: unit.Error.String(),
+		})
+	}
+	return violations, nil
+}
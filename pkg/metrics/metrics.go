@@ -11,9 +11,13 @@ const (
 
 // Metrics holds the Prometheus metrics collectors.
 type Metrics struct {
-	RequestsTotal   *prometheus.CounterVec
-	RequestDuration *prometheus.HistogramVec
-	RequestsInFlight prometheus.Gauge
+	RequestsTotal        *prometheus.CounterVec
+	RequestDuration      *prometheus.HistogramVec
+	RequestsInFlight     prometheus.Gauge
+	DataPurgedTotal      prometheus.Counter
+	DeletedPurgedTotal   *prometheus.CounterVec
+	OutboxEventsTotal    *prometheus.CounterVec
+	WebhookAttemptsTotal *prometheus.CounterVec
 }
 
 // New creates and registers the metrics.
@@ -55,6 +59,53 @@ func New(appName string) *Metrics {
 				},
 			},
 		),
+		DataPurgedTotal: promauto.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "data",
+				Name:      "expired_purged_total",
+				Help:      "Total number of expired data entries deleted by the reaper.",
+				ConstLabels: prometheus.Labels{
+					"app": appName,
+				},
+			},
+		),
+		DeletedPurgedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "soft_delete",
+				Name:      "purged_total",
+				Help:      "Total number of soft deleted rows permanently removed after the retention period.",
+				ConstLabels: prometheus.Labels{
+					"app": appName,
+				},
+			},
+			[]string{"resource"},
+		),
+		OutboxEventsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "outbox",
+				Name:      "events_total",
+				Help:      "Total number of outbox events published to the event sinks, and of failed delivery attempts.",
+				ConstLabels: prometheus.Labels{
+					"app": appName,
+				},
+			},
+			[]string{"result"},
+		),
+		WebhookAttemptsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "webhook",
+				Name:      "delivery_attempts_total",
+				Help:      "Total number of webhook delivery attempts by outcome.",
+				ConstLabels: prometheus.Labels{
+					"app": appName,
+				},
+			},
+			[]string{"result"},
+		),
 	}
 	return m
 }
@@ -0,0 +1,55 @@
+// Package thumbnail scales images down to fit a square bounding box.
+package thumbnail
+
+import (
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"golang.org/x/image/draw"
+)
+
+// jpegQuality balances size and artifacts for small previews.
+const jpegQuality = 85
+
+// Fit returns the dimensions of a width×height image scaled down to fit within size×size
+// pixels, keeping its aspect ratio. Images that already fit keep their dimensions.
+func Fit(width, height, size int) (int, int) {
+	if width <= size && height <= size {
+		return width, height
+	}
+	if width >= height {
+		return size, max(1, (height*size+width/2)/width)
+	}
+	return max(1, (width*size+height/2)/height), size
+}
+
+// Scale returns src scaled down to fit within size×size pixels.
+func Scale(src image.Image, size int) image.Image {
+	b := src.Bounds()
+	w, h := Fit(b.Dx(), b.Dy(), size)
+	if w == b.Dx() && h == b.Dy() {
+		return src
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Src, nil)
+	return dst
+}
+
+// ContentType returns the media type thumbnails of images of contentType are encoded in:
+// JPEG for JPEG images and PNG for everything else, which keeps transparency.
+func ContentType(contentType string) string {
+	if contentType == "image/jpeg" {
+		return "image/jpeg"
+	}
+	return "image/png"
+}
+
+// Encode writes img to w in the format of ContentType(contentType).
+func Encode(w io.Writer, img image.Image, contentType string) error {
+	if ContentType(contentType) == "image/jpeg" {
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	return png.Encode(w, img)
+}